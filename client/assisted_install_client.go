@@ -13,13 +13,18 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/openshift/assisted-service/client/access"
 	"github.com/openshift/assisted-service/client/assisted_service_iso"
 	"github.com/openshift/assisted-service/client/events"
+	"github.com/openshift/assisted-service/client/export"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/manifests"
 	"github.com/openshift/assisted-service/client/operators"
+	"github.com/openshift/assisted-service/client/tokens"
 	"github.com/openshift/assisted-service/client/versions"
+	"github.com/openshift/assisted-service/client/watch"
+	"github.com/openshift/assisted-service/client/webhooks"
 )
 
 const (
@@ -64,24 +69,34 @@ func New(c Config) *AssistedInstall {
 
 	cli := new(AssistedInstall)
 	cli.Transport = transport
+	cli.Access = access.New(transport, strfmt.Default, c.AuthInfo)
 	cli.AssistedServiceIso = assisted_service_iso.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Export = export.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Manifests = manifests.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Operators = operators.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Tokens = tokens.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Versions = versions.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Watch = watch.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Webhooks = webhooks.New(transport, strfmt.Default, c.AuthInfo)
 	return cli
 }
 
 // AssistedInstall is a client for assisted install
 type AssistedInstall struct {
+	Access             *access.Client
 	AssistedServiceIso *assisted_service_iso.Client
 	Events             *events.Client
+	Export             *export.Client
 	Installer          *installer.Client
 	ManagedDomains     *managed_domains.Client
 	Manifests          *manifests.Client
 	Operators          *operators.Client
+	Tokens             *tokens.Client
 	Versions           *versions.Client
+	Watch              *watch.Client
+	Webhooks           *webhooks.Client
 	Transport          runtime.ClientTransport
 }
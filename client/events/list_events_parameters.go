@@ -20,8 +20,11 @@ import (
 // NewListEventsParams creates a new ListEventsParams object
 // with the default values initialized.
 func NewListEventsParams() *ListEventsParams {
-	var ()
+	var (
+		orderDefault = string("asc")
+	)
 	return &ListEventsParams{
+		Order: &orderDefault,
 
 		timeout: cr.DefaultTimeout,
 	}
@@ -30,8 +33,11 @@ func NewListEventsParams() *ListEventsParams {
 // NewListEventsParamsWithTimeout creates a new ListEventsParams object
 // with the default values initialized, and the ability to set a timeout on a request
 func NewListEventsParamsWithTimeout(timeout time.Duration) *ListEventsParams {
-	var ()
+	var (
+		orderDefault = string("asc")
+	)
 	return &ListEventsParams{
+		Order: &orderDefault,
 
 		timeout: timeout,
 	}
@@ -40,8 +46,11 @@ func NewListEventsParamsWithTimeout(timeout time.Duration) *ListEventsParams {
 // NewListEventsParamsWithContext creates a new ListEventsParams object
 // with the default values initialized, and the ability to set a context for a request
 func NewListEventsParamsWithContext(ctx context.Context) *ListEventsParams {
-	var ()
+	var (
+		orderDefault = string("asc")
+	)
 	return &ListEventsParams{
+		Order: &orderDefault,
 
 		Context: ctx,
 	}
@@ -50,8 +59,11 @@ func NewListEventsParamsWithContext(ctx context.Context) *ListEventsParams {
 // NewListEventsParamsWithHTTPClient creates a new ListEventsParams object
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewListEventsParamsWithHTTPClient(client *http.Client) *ListEventsParams {
-	var ()
+	var (
+		orderDefault = string("asc")
+	)
 	return &ListEventsParams{
+		Order:      &orderDefault,
 		HTTPClient: client,
 	}
 }
@@ -71,11 +83,46 @@ type ListEventsParams struct {
 
 	*/
 	ClusterID strfmt.UUID
+	/*Cursor
+	  The cursor of the page to return, as returned in the Next-Cursor header of the previous page.
+
+	*/
+	Cursor *string
+	/*EventTimeAfter
+	  If set, returned events are filtered to those that occurred after this time.
+
+	*/
+	EventTimeAfter *strfmt.DateTime
+	/*EventTimeBefore
+	  If set, returned events are filtered to those that occurred before this time.
+
+	*/
+	EventTimeBefore *strfmt.DateTime
 	/*HostID
 	  A host in the specified cluster to return events for.
 
 	*/
 	HostID *strfmt.UUID
+	/*Limit
+	  The maximal number of events to return. The cursor of the next page is returned in the Next-Cursor header.
+
+	*/
+	Limit *int64
+	/*Message
+	  If set, returned events are filtered to those whose message contains this string, ignoring case.
+
+	*/
+	Message *string
+	/*Order
+	  The order of the events by their time.
+
+	*/
+	Order *string
+	/*Severities
+	  If non-empty, returned events are filtered to those with matching severities.
+
+	*/
+	Severities []string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -137,6 +184,39 @@ func (o *ListEventsParams) SetClusterID(clusterID strfmt.UUID) {
 	o.ClusterID = clusterID
 }
 
+// WithCursor adds the cursor to the list events params
+func (o *ListEventsParams) WithCursor(cursor *string) *ListEventsParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list events params
+func (o *ListEventsParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithEventTimeAfter adds the eventTimeAfter to the list events params
+func (o *ListEventsParams) WithEventTimeAfter(eventTimeAfter *strfmt.DateTime) *ListEventsParams {
+	o.SetEventTimeAfter(eventTimeAfter)
+	return o
+}
+
+// SetEventTimeAfter adds the eventTimeAfter to the list events params
+func (o *ListEventsParams) SetEventTimeAfter(eventTimeAfter *strfmt.DateTime) {
+	o.EventTimeAfter = eventTimeAfter
+}
+
+// WithEventTimeBefore adds the eventTimeBefore to the list events params
+func (o *ListEventsParams) WithEventTimeBefore(eventTimeBefore *strfmt.DateTime) *ListEventsParams {
+	o.SetEventTimeBefore(eventTimeBefore)
+	return o
+}
+
+// SetEventTimeBefore adds the eventTimeBefore to the list events params
+func (o *ListEventsParams) SetEventTimeBefore(eventTimeBefore *strfmt.DateTime) {
+	o.EventTimeBefore = eventTimeBefore
+}
+
 // WithHostID adds the hostID to the list events params
 func (o *ListEventsParams) WithHostID(hostID *strfmt.UUID) *ListEventsParams {
 	o.SetHostID(hostID)
@@ -148,6 +228,50 @@ func (o *ListEventsParams) SetHostID(hostID *strfmt.UUID) {
 	o.HostID = hostID
 }
 
+// WithLimit adds the limit to the list events params
+func (o *ListEventsParams) WithLimit(limit *int64) *ListEventsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list events params
+func (o *ListEventsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMessage adds the message to the list events params
+func (o *ListEventsParams) WithMessage(message *string) *ListEventsParams {
+	o.SetMessage(message)
+	return o
+}
+
+// SetMessage adds the message to the list events params
+func (o *ListEventsParams) SetMessage(message *string) {
+	o.Message = message
+}
+
+// WithOrder adds the order to the list events params
+func (o *ListEventsParams) WithOrder(order *string) *ListEventsParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the list events params
+func (o *ListEventsParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithSeverities adds the severities to the list events params
+func (o *ListEventsParams) WithSeverities(severities []string) *ListEventsParams {
+	o.SetSeverities(severities)
+	return o
+}
+
+// SetSeverities adds the severities to the list events params
+func (o *ListEventsParams) SetSeverities(severities []string) {
+	o.Severities = severities
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -169,6 +293,54 @@ func (o *ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		return err
 	}
 
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.EventTimeAfter != nil {
+
+		// query param event_time_after
+		var qrEventTimeAfter strfmt.DateTime
+		if o.EventTimeAfter != nil {
+			qrEventTimeAfter = *o.EventTimeAfter
+		}
+		qEventTimeAfter := qrEventTimeAfter.String()
+		if qEventTimeAfter != "" {
+			if err := r.SetQueryParam("event_time_after", qEventTimeAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.EventTimeBefore != nil {
+
+		// query param event_time_before
+		var qrEventTimeBefore strfmt.DateTime
+		if o.EventTimeBefore != nil {
+			qrEventTimeBefore = *o.EventTimeBefore
+		}
+		qEventTimeBefore := qrEventTimeBefore.String()
+		if qEventTimeBefore != "" {
+			if err := r.SetQueryParam("event_time_before", qEventTimeBefore); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.HostID != nil {
 
 		// query param host_id
@@ -185,6 +357,62 @@ func (o *ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Message != nil {
+
+		// query param message
+		var qrMessage string
+		if o.Message != nil {
+			qrMessage = *o.Message
+		}
+		qMessage := qrMessage
+		if qMessage != "" {
+			if err := r.SetQueryParam("message", qMessage); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	valuesSeverities := o.Severities
+
+	joinedSeverities := swag.JoinByFormat(valuesSeverities, "")
+	// query array param severities
+	if err := r.SetQueryParam("severities", joinedSeverities...); err != nil {
+		return err
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
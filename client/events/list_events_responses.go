@@ -29,6 +29,12 @@ func (o *ListEventsReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewListEventsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 401:
 		result := NewListEventsUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -75,6 +81,10 @@ func NewListEventsOK() *ListEventsOK {
 Success.
 */
 type ListEventsOK struct {
+	/*The cursor of the next page. Not set for the last page.
+	 */
+	NextCursor string
+
 	Payload models.EventList
 }
 
@@ -88,6 +98,9 @@ func (o *ListEventsOK) GetPayload() models.EventList {
 
 func (o *ListEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header Next-Cursor
+	o.NextCursor = response.GetHeader("Next-Cursor")
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -96,6 +109,39 @@ func (o *ListEventsOK) readResponse(response runtime.ClientResponse, consumer ru
 	return nil
 }
 
+// NewListEventsBadRequest creates a ListEventsBadRequest with default headers values
+func NewListEventsBadRequest() *ListEventsBadRequest {
+	return &ListEventsBadRequest{}
+}
+
+/*ListEventsBadRequest handles this case with default header values.
+
+Error.
+*/
+type ListEventsBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *ListEventsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/events][%d] listEventsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListEventsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListEventsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListEventsUnauthorized creates a ListEventsUnauthorized with default headers values
 func NewListEventsUnauthorized() *ListEventsUnauthorized {
 	return &ListEventsUnauthorized{}
@@ -72,6 +72,9 @@ type API interface {
 	/*
 	   GetClusterInstallConfig Get the cluster's install config YAML.*/
 	GetClusterInstallConfig(ctx context.Context, params *GetClusterInstallConfigParams) (*GetClusterInstallConfigOK, error)
+	/*
+	   GetClusterInstallationReport Reports the timeline of the latest installation of the cluster, with the duration of the phases of the cluster and of the stages of its hosts.*/
+	GetClusterInstallationReport(ctx context.Context, params *GetClusterInstallationReportParams) (*GetClusterInstallationReportOK, error)
 	/*
 	   GetCredentials Get the cluster admin credentials.*/
 	GetCredentials(ctx context.Context, params *GetCredentialsParams) (*GetCredentialsOK, error)
@@ -90,6 +93,9 @@ type API interface {
 	/*
 	   GetHostIgnition Get the customized ignition file for this host*/
 	GetHostIgnition(ctx context.Context, params *GetHostIgnitionParams) (*GetHostIgnitionOK, error)
+	/*
+	   GetHostInventoryHistory Lists the latest inventory snapshots of the host, with the changes of each snapshot from the previous one.*/
+	GetHostInventoryHistory(ctx context.Context, params *GetHostInventoryHistoryParams) (*GetHostInventoryHistoryOK, error)
 	/*
 	   GetHostRequirements Get minimum host requirements.*/
 	GetHostRequirements(ctx context.Context, params *GetHostRequirementsParams) (*GetHostRequirementsOK, error)
@@ -114,6 +120,9 @@ type API interface {
 	/*
 	   ListClusters Retrieves the list of OpenShift clusters.*/
 	ListClusters(ctx context.Context, params *ListClustersParams) (*ListClustersOK, error)
+	/*
+	   ListHostValidationRules Lists the user-defined host validation rules of the cluster.*/
+	ListHostValidationRules(ctx context.Context, params *ListHostValidationRulesParams) (*ListHostValidationRulesOK, error)
 	/*
 	   ListHosts Retrieves the list of OpenShift hosts.*/
 	ListHosts(ctx context.Context, params *ListHostsParams) (*ListHostsOK, error)
@@ -140,6 +149,45 @@ type API interface {
 
 	   Reset failed host validation.  It may be performed on any host validation with persistent validation result.*/
 	ResetHostValidation(ctx context.Context, params *ResetHostValidationParams) (*ResetHostValidationOK, error)
+	/*
+	   ScheduleClusterInstallation Schedules the installation of the OpenShift cluster. The installation starts once the schedule's window opens and the cluster is ready.*/
+	ScheduleClusterInstallation(ctx context.Context, params *ScheduleClusterInstallationParams) (*ScheduleClusterInstallationOK, error)
+	/*
+	   AssignClusterRoles Assigns the roles of the hosts whose role is selected automatically, considering all the hosts of the cluster at once. Masters are selected by their capacity and spread across failure domains, while minimizing the operator requirements that are not satisfied.*/
+	AssignClusterRoles(ctx context.Context, params *AssignClusterRolesParams) (*AssignClusterRolesOK, error)
+	/*
+	   ListClusterStaticNetworkConfigs Lists the static network configs of the hosts of the cluster, that are included in its discovery ISO.*/
+	ListClusterStaticNetworkConfigs(ctx context.Context, params *ListClusterStaticNetworkConfigsParams) (*ListClusterStaticNetworkConfigsOK, error)
+	/*
+	   CreateClusterStaticNetworkConfig Adds the static network config of a host to the cluster. The discovery ISO of the cluster is regenerated when it was already generated.*/
+	CreateClusterStaticNetworkConfig(ctx context.Context, params *CreateClusterStaticNetworkConfigParams) (*CreateClusterStaticNetworkConfigCreated, error)
+	/*
+	   UpdateClusterStaticNetworkConfig Replaces the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated.*/
+	UpdateClusterStaticNetworkConfig(ctx context.Context, params *UpdateClusterStaticNetworkConfigParams) (*UpdateClusterStaticNetworkConfigOK, error)
+	/*
+	   DeleteClusterStaticNetworkConfig Removes the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated.*/
+	DeleteClusterStaticNetworkConfig(ctx context.Context, params *DeleteClusterStaticNetworkConfigParams) (*DeleteClusterStaticNetworkConfigNoContent, error)
+	/*
+	   PreviewClusterStaticNetworkConfigs Validates static network configs of the hosts of the cluster and returns the NetworkManager keyfiles generated from them, without changing the cluster.*/
+	PreviewClusterStaticNetworkConfigs(ctx context.Context, params *PreviewClusterStaticNetworkConfigsParams) (*PreviewClusterStaticNetworkConfigsOK, error)
+	/*
+	   GetClusterIPPool Returns the IP pool of the cluster, from which the addresses of its static-IP hosts are allocated.*/
+	GetClusterIPPool(ctx context.Context, params *GetClusterIPPoolParams) (*GetClusterIPPoolOK, error)
+	/*
+	   UpdateClusterIPPool Replaces the IP pool of the cluster. The addresses that are already leased must remain in the pool.*/
+	UpdateClusterIPPool(ctx context.Context, params *UpdateClusterIPPoolParams) (*UpdateClusterIPPoolOK, error)
+	/*
+	   ListClusterIPLeases Lists the addresses leased to the hosts of the cluster from its IP pool.*/
+	ListClusterIPLeases(ctx context.Context, params *ListClusterIPLeasesParams) (*ListClusterIPLeasesOK, error)
+	/*
+	   AllocateClusterIPLease Leases an address from the IP pool of the cluster to a host, and adds the static network config of the host, generated with the address, to the cluster. The discovery ISO of the cluster is regenerated when it was already generated.*/
+	AllocateClusterIPLease(ctx context.Context, params *AllocateClusterIPLeaseParams) (*AllocateClusterIPLeaseCreated, error)
+	/*
+	   ReleaseClusterIPLease Releases the address leased to the host that has the MAC address, and removes the static network config of the host from the cluster. The discovery ISO of the cluster is regenerated when it was already generated.*/
+	ReleaseClusterIPLease(ctx context.Context, params *ReleaseClusterIPLeaseParams) (*ReleaseClusterIPLeaseNoContent, error)
+	/*
+	   UnscheduleClusterInstallation Removes the installation schedule of the OpenShift cluster.*/
+	UnscheduleClusterInstallation(ctx context.Context, params *UnscheduleClusterInstallationParams) (*UnscheduleClusterInstallationOK, error)
 	/*
 	   UpdateCluster Updates an OpenShift cluster definition.*/
 	UpdateCluster(ctx context.Context, params *UpdateClusterParams) (*UpdateClusterCreated, error)
@@ -164,6 +212,9 @@ type API interface {
 	/*
 	   UpdateHostLogsProgress Update log collection state and progress.*/
 	UpdateHostLogsProgress(ctx context.Context, params *UpdateHostLogsProgressParams) (*UpdateHostLogsProgressNoContent, error)
+	/*
+	   UpdateHostValidationRules Replaces the user-defined host validation rules of the cluster. The rules are evaluated in addition to the service-wide rules, and replace the service-wide rules that have the same identifier.*/
+	UpdateHostValidationRules(ctx context.Context, params *UpdateHostValidationRulesParams) (*UpdateHostValidationRulesOK, error)
 	/*
 	   UploadClusterIngressCert Transfer the ingress certificate for the cluster.*/
 	UploadClusterIngressCert(ctx context.Context, params *UploadClusterIngressCertParams) (*UploadClusterIngressCertCreated, error)
@@ -643,6 +694,31 @@ func (a *Client) GetClusterInstallConfig(ctx context.Context, params *GetCluster
 
 }
 
+/*
+GetClusterInstallationReport Reports the timeline of the latest installation of the cluster, with the duration of the phases of the cluster and of the stages of its hosts.
+*/
+func (a *Client) GetClusterInstallationReport(ctx context.Context, params *GetClusterInstallationReportParams) (*GetClusterInstallationReportOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterInstallationReport",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/installation-report",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterInstallationReportReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterInstallationReportOK), nil
+
+}
+
 /*
 GetCredentials Get the cluster admin credentials.
 */
@@ -771,6 +847,31 @@ func (a *Client) GetHostIgnition(ctx context.Context, params *GetHostIgnitionPar
 
 }
 
+/*
+GetHostInventoryHistory Lists the latest inventory snapshots of the host, with the changes of each snapshot from the previous one.
+*/
+func (a *Client) GetHostInventoryHistory(ctx context.Context, params *GetHostInventoryHistoryParams) (*GetHostInventoryHistoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetHostInventoryHistory",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/inventory-history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetHostInventoryHistoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetHostInventoryHistoryOK), nil
+
+}
+
 /*
 GetHostRequirements Get minimum host requirements.
 */
@@ -971,6 +1072,31 @@ func (a *Client) ListClusters(ctx context.Context, params *ListClustersParams) (
 
 }
 
+/*
+ListHostValidationRules Lists the user-defined host validation rules of the cluster.
+*/
+func (a *Client) ListHostValidationRules(ctx context.Context, params *ListHostValidationRulesParams) (*ListHostValidationRulesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListHostValidationRules",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/host-validation-rules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListHostValidationRulesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListHostValidationRulesOK), nil
+
+}
+
 /*
 ListHosts Retrieves the list of OpenShift hosts.
 */
@@ -1173,6 +1299,331 @@ func (a *Client) ResetHostValidation(ctx context.Context, params *ResetHostValid
 
 }
 
+/*
+ScheduleClusterInstallation Schedules the installation of the OpenShift cluster. The installation starts once the schedule's window opens and the cluster is ready.
+*/
+func (a *Client) ScheduleClusterInstallation(ctx context.Context, params *ScheduleClusterInstallationParams) (*ScheduleClusterInstallationOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ScheduleClusterInstallation",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/install-schedule",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ScheduleClusterInstallationReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ScheduleClusterInstallationOK), nil
+
+}
+
+/*
+AssignClusterRoles Assigns the roles of the hosts whose role is selected automatically, considering all the hosts of the cluster at once. Masters are selected by their capacity and spread across failure domains, while minimizing the operator requirements that are not satisfied.
+*/
+func (a *Client) AssignClusterRoles(ctx context.Context, params *AssignClusterRolesParams) (*AssignClusterRolesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "AssignClusterRoles",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/actions/assign-roles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &AssignClusterRolesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AssignClusterRolesOK), nil
+
+}
+
+/*
+ListClusterStaticNetworkConfigs Lists the static network configs of the hosts of the cluster, that are included in its discovery ISO.
+*/
+func (a *Client) ListClusterStaticNetworkConfigs(ctx context.Context, params *ListClusterStaticNetworkConfigsParams) (*ListClusterStaticNetworkConfigsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterStaticNetworkConfigs",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/static-network-configs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListClusterStaticNetworkConfigsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterStaticNetworkConfigsOK), nil
+
+}
+
+/*
+CreateClusterStaticNetworkConfig Adds the static network config of a host to the cluster. The discovery ISO of the cluster is regenerated when it was already generated.
+*/
+func (a *Client) CreateClusterStaticNetworkConfig(ctx context.Context, params *CreateClusterStaticNetworkConfigParams) (*CreateClusterStaticNetworkConfigCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateClusterStaticNetworkConfig",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/static-network-configs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateClusterStaticNetworkConfigReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateClusterStaticNetworkConfigCreated), nil
+
+}
+
+/*
+UpdateClusterStaticNetworkConfig Replaces the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated.
+*/
+func (a *Client) UpdateClusterStaticNetworkConfig(ctx context.Context, params *UpdateClusterStaticNetworkConfigParams) (*UpdateClusterStaticNetworkConfigOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateClusterStaticNetworkConfig",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/static-network-configs/{mac_address}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateClusterStaticNetworkConfigReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateClusterStaticNetworkConfigOK), nil
+
+}
+
+/*
+DeleteClusterStaticNetworkConfig Removes the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated.
+*/
+func (a *Client) DeleteClusterStaticNetworkConfig(ctx context.Context, params *DeleteClusterStaticNetworkConfigParams) (*DeleteClusterStaticNetworkConfigNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterStaticNetworkConfig",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/static-network-configs/{mac_address}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteClusterStaticNetworkConfigReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteClusterStaticNetworkConfigNoContent), nil
+
+}
+
+/*
+PreviewClusterStaticNetworkConfigs Validates static network configs of the hosts of the cluster and returns the NetworkManager keyfiles generated from them, without changing the cluster.
+*/
+func (a *Client) PreviewClusterStaticNetworkConfigs(ctx context.Context, params *PreviewClusterStaticNetworkConfigsParams) (*PreviewClusterStaticNetworkConfigsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PreviewClusterStaticNetworkConfigs",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/static-network-configs/preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PreviewClusterStaticNetworkConfigsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PreviewClusterStaticNetworkConfigsOK), nil
+
+}
+
+/*
+GetClusterIPPool Returns the IP pool of the cluster, from which the addresses of its static-IP hosts are allocated.
+*/
+func (a *Client) GetClusterIPPool(ctx context.Context, params *GetClusterIPPoolParams) (*GetClusterIPPoolOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterIPPool",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/ip-pool",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterIPPoolReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterIPPoolOK), nil
+
+}
+
+/*
+UpdateClusterIPPool Replaces the IP pool of the cluster. The addresses that are already leased must remain in the pool.
+*/
+func (a *Client) UpdateClusterIPPool(ctx context.Context, params *UpdateClusterIPPoolParams) (*UpdateClusterIPPoolOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateClusterIPPool",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/ip-pool",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateClusterIPPoolReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateClusterIPPoolOK), nil
+
+}
+
+/*
+ListClusterIPLeases Lists the addresses leased to the hosts of the cluster from its IP pool.
+*/
+func (a *Client) ListClusterIPLeases(ctx context.Context, params *ListClusterIPLeasesParams) (*ListClusterIPLeasesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterIPLeases",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/ip-leases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListClusterIPLeasesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterIPLeasesOK), nil
+
+}
+
+/*
+AllocateClusterIPLease Leases an address from the IP pool of the cluster to a host, and adds the static network config of the host, generated with the address, to the cluster. The discovery ISO of the cluster is regenerated when it was already generated.
+*/
+func (a *Client) AllocateClusterIPLease(ctx context.Context, params *AllocateClusterIPLeaseParams) (*AllocateClusterIPLeaseCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "AllocateClusterIPLease",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/ip-leases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &AllocateClusterIPLeaseReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AllocateClusterIPLeaseCreated), nil
+
+}
+
+/*
+ReleaseClusterIPLease Releases the address leased to the host that has the MAC address, and removes the static network config of the host from the cluster. The discovery ISO of the cluster is regenerated when it was already generated.
+*/
+func (a *Client) ReleaseClusterIPLease(ctx context.Context, params *ReleaseClusterIPLeaseParams) (*ReleaseClusterIPLeaseNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ReleaseClusterIPLease",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/ip-leases/{mac_address}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ReleaseClusterIPLeaseReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ReleaseClusterIPLeaseNoContent), nil
+
+}
+
+/*
+UnscheduleClusterInstallation Removes the installation schedule of the OpenShift cluster.
+*/
+func (a *Client) UnscheduleClusterInstallation(ctx context.Context, params *UnscheduleClusterInstallationParams) (*UnscheduleClusterInstallationOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UnscheduleClusterInstallation",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/install-schedule",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UnscheduleClusterInstallationReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UnscheduleClusterInstallationOK), nil
+
+}
+
 /*
 UpdateCluster Updates an OpenShift cluster definition.
 */
@@ -1373,6 +1824,31 @@ func (a *Client) UpdateHostLogsProgress(ctx context.Context, params *UpdateHostL
 
 }
 
+/*
+UpdateHostValidationRules Replaces the user-defined host validation rules of the cluster. The rules are evaluated in addition to the service-wide rules, and replace the service-wide rules that have the same identifier.
+*/
+func (a *Client) UpdateHostValidationRules(ctx context.Context, params *UpdateHostValidationRulesParams) (*UpdateHostValidationRulesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateHostValidationRules",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/host-validation-rules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateHostValidationRulesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateHostValidationRulesOK), nil
+
+}
+
 /*
 UploadClusterIngressCert Transfer the ingress certificate for the cluster.
 */
@@ -22,9 +22,13 @@ import (
 func NewListClustersParams() *ListClustersParams {
 	var (
 		getUnregisteredClustersDefault = bool(false)
+		orderDefault                   = string("asc")
+		sortByDefault                  = string("created_at")
 	)
 	return &ListClustersParams{
 		GetUnregisteredClusters: &getUnregisteredClustersDefault,
+		Order:                   &orderDefault,
+		SortBy:                  &sortByDefault,
 
 		timeout: cr.DefaultTimeout,
 	}
@@ -35,9 +39,13 @@ func NewListClustersParams() *ListClustersParams {
 func NewListClustersParamsWithTimeout(timeout time.Duration) *ListClustersParams {
 	var (
 		getUnregisteredClustersDefault = bool(false)
+		orderDefault                   = string("asc")
+		sortByDefault                  = string("created_at")
 	)
 	return &ListClustersParams{
 		GetUnregisteredClusters: &getUnregisteredClustersDefault,
+		Order:                   &orderDefault,
+		SortBy:                  &sortByDefault,
 
 		timeout: timeout,
 	}
@@ -48,9 +56,13 @@ func NewListClustersParamsWithTimeout(timeout time.Duration) *ListClustersParams
 func NewListClustersParamsWithContext(ctx context.Context) *ListClustersParams {
 	var (
 		getUnregisteredClustersDefault = bool(false)
+		orderDefault                   = string("asc")
+		sortByDefault                  = string("created_at")
 	)
 	return &ListClustersParams{
 		GetUnregisteredClusters: &getUnregisteredClustersDefault,
+		Order:                   &orderDefault,
+		SortBy:                  &sortByDefault,
 
 		Context: ctx,
 	}
@@ -61,9 +73,13 @@ func NewListClustersParamsWithContext(ctx context.Context) *ListClustersParams {
 func NewListClustersParamsWithHTTPClient(client *http.Client) *ListClustersParams {
 	var (
 		getUnregisteredClustersDefault = bool(false)
+		orderDefault                   = string("asc")
+		sortByDefault                  = string("created_at")
 	)
 	return &ListClustersParams{
 		GetUnregisteredClusters: &getUnregisteredClustersDefault,
+		Order:                   &orderDefault,
+		SortBy:                  &sortByDefault,
 		HTTPClient:              client,
 	}
 }
@@ -78,16 +94,61 @@ type ListClustersParams struct {
 
 	*/
 	AmsSubscriptionIds []string
+	/*CreatedAfter
+	  If set, returned clusters are filtered to those created after this time.
+
+	*/
+	CreatedAfter *strfmt.DateTime
+	/*CreatedBefore
+	  If set, returned clusters are filtered to those created before this time.
+
+	*/
+	CreatedBefore *strfmt.DateTime
+	/*Cursor
+	  The cursor of the page to return, as returned in the Next-Cursor header of the previous page.
+
+	*/
+	Cursor *string
 	/*GetUnregisteredClusters
 	  Whether to return clusters that have been unregistered.
 
 	*/
 	GetUnregisteredClusters *bool
+	/*Limit
+	  The maximal number of items to return. The cursor of the next page is returned in the Next-Cursor header.
+
+	*/
+	Limit *int64
+	/*Name
+	  If set, returned clusters are filtered to those whose name contains this string, ignoring case.
+
+	*/
+	Name *string
 	/*OpenshiftClusterID
 	  A specific cluster to retrieve.
 
 	*/
 	OpenshiftClusterID *strfmt.UUID
+	/*OpenshiftVersion
+	  If set, returned clusters are filtered to those with this OpenShift version.
+
+	*/
+	OpenshiftVersion *string
+	/*Order
+	  The sort order.
+
+	*/
+	Order *string
+	/*SortBy
+	  The field to sort the clusters by.
+
+	*/
+	SortBy *string
+	/*Status
+	  If non-empty, returned clusters are filtered to those with matching statuses.
+
+	*/
+	Status []string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -138,6 +199,39 @@ func (o *ListClustersParams) SetAmsSubscriptionIds(amsSubscriptionIds []string)
 	o.AmsSubscriptionIds = amsSubscriptionIds
 }
 
+// WithCreatedAfter adds the createdAfter to the list clusters params
+func (o *ListClustersParams) WithCreatedAfter(createdAfter *strfmt.DateTime) *ListClustersParams {
+	o.SetCreatedAfter(createdAfter)
+	return o
+}
+
+// SetCreatedAfter adds the createdAfter to the list clusters params
+func (o *ListClustersParams) SetCreatedAfter(createdAfter *strfmt.DateTime) {
+	o.CreatedAfter = createdAfter
+}
+
+// WithCreatedBefore adds the createdBefore to the list clusters params
+func (o *ListClustersParams) WithCreatedBefore(createdBefore *strfmt.DateTime) *ListClustersParams {
+	o.SetCreatedBefore(createdBefore)
+	return o
+}
+
+// SetCreatedBefore adds the createdBefore to the list clusters params
+func (o *ListClustersParams) SetCreatedBefore(createdBefore *strfmt.DateTime) {
+	o.CreatedBefore = createdBefore
+}
+
+// WithCursor adds the cursor to the list clusters params
+func (o *ListClustersParams) WithCursor(cursor *string) *ListClustersParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list clusters params
+func (o *ListClustersParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
 // WithGetUnregisteredClusters adds the getUnregisteredClusters to the list clusters params
 func (o *ListClustersParams) WithGetUnregisteredClusters(getUnregisteredClusters *bool) *ListClustersParams {
 	o.SetGetUnregisteredClusters(getUnregisteredClusters)
@@ -149,6 +243,28 @@ func (o *ListClustersParams) SetGetUnregisteredClusters(getUnregisteredClusters
 	o.GetUnregisteredClusters = getUnregisteredClusters
 }
 
+// WithLimit adds the limit to the list clusters params
+func (o *ListClustersParams) WithLimit(limit *int64) *ListClustersParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list clusters params
+func (o *ListClustersParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithName adds the name to the list clusters params
+func (o *ListClustersParams) WithName(name *string) *ListClustersParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the list clusters params
+func (o *ListClustersParams) SetName(name *string) {
+	o.Name = name
+}
+
 // WithOpenshiftClusterID adds the openshiftClusterID to the list clusters params
 func (o *ListClustersParams) WithOpenshiftClusterID(openshiftClusterID *strfmt.UUID) *ListClustersParams {
 	o.SetOpenshiftClusterID(openshiftClusterID)
@@ -160,6 +276,50 @@ func (o *ListClustersParams) SetOpenshiftClusterID(openshiftClusterID *strfmt.UU
 	o.OpenshiftClusterID = openshiftClusterID
 }
 
+// WithOpenshiftVersion adds the openshiftVersion to the list clusters params
+func (o *ListClustersParams) WithOpenshiftVersion(openshiftVersion *string) *ListClustersParams {
+	o.SetOpenshiftVersion(openshiftVersion)
+	return o
+}
+
+// SetOpenshiftVersion adds the openshiftVersion to the list clusters params
+func (o *ListClustersParams) SetOpenshiftVersion(openshiftVersion *string) {
+	o.OpenshiftVersion = openshiftVersion
+}
+
+// WithOrder adds the order to the list clusters params
+func (o *ListClustersParams) WithOrder(order *string) *ListClustersParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the list clusters params
+func (o *ListClustersParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithSortBy adds the sortBy to the list clusters params
+func (o *ListClustersParams) WithSortBy(sortBy *string) *ListClustersParams {
+	o.SetSortBy(sortBy)
+	return o
+}
+
+// SetSortBy adds the sortBy to the list clusters params
+func (o *ListClustersParams) SetSortBy(sortBy *string) {
+	o.SortBy = sortBy
+}
+
+// WithStatus adds the status to the list clusters params
+func (o *ListClustersParams) WithStatus(status []string) *ListClustersParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list clusters params
+func (o *ListClustersParams) SetStatus(status []string) {
+	o.Status = status
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -176,6 +336,54 @@ func (o *ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		return err
 	}
 
+	if o.CreatedAfter != nil {
+
+		// query param created_after
+		var qrCreatedAfter strfmt.DateTime
+		if o.CreatedAfter != nil {
+			qrCreatedAfter = *o.CreatedAfter
+		}
+		qCreatedAfter := qrCreatedAfter.String()
+		if qCreatedAfter != "" {
+			if err := r.SetQueryParam("created_after", qCreatedAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.CreatedBefore != nil {
+
+		// query param created_before
+		var qrCreatedBefore strfmt.DateTime
+		if o.CreatedBefore != nil {
+			qrCreatedBefore = *o.CreatedBefore
+		}
+		qCreatedBefore := qrCreatedBefore.String()
+		if qCreatedBefore != "" {
+			if err := r.SetQueryParam("created_before", qCreatedBefore); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.GetUnregisteredClusters != nil {
 
 		// header param get_unregistered_clusters
@@ -185,6 +393,38 @@ func (o *ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Name != nil {
+
+		// query param name
+		var qrName string
+		if o.Name != nil {
+			qrName = *o.Name
+		}
+		qName := qrName
+		if qName != "" {
+			if err := r.SetQueryParam("name", qName); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.OpenshiftClusterID != nil {
 
 		// query param openshift_cluster_id
@@ -201,6 +441,62 @@ func (o *ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 
 	}
 
+	if o.OpenshiftVersion != nil {
+
+		// query param openshift_version
+		var qrOpenshiftVersion string
+		if o.OpenshiftVersion != nil {
+			qrOpenshiftVersion = *o.OpenshiftVersion
+		}
+		qOpenshiftVersion := qrOpenshiftVersion
+		if qOpenshiftVersion != "" {
+			if err := r.SetQueryParam("openshift_version", qOpenshiftVersion); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.SortBy != nil {
+
+		// query param sort_by
+		var qrSortBy string
+		if o.SortBy != nil {
+			qrSortBy = *o.SortBy
+		}
+		qSortBy := qrSortBy
+		if qSortBy != "" {
+			if err := r.SetQueryParam("sort_by", qSortBy); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	valuesStatus := o.Status
+
+	joinedStatus := swag.JoinByFormat(valuesStatus, "")
+	// query array param status
+	if err := r.SetQueryParam("status", joinedStatus...); err != nil {
+		return err
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -29,6 +29,12 @@ func (o *ListClustersReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewListClustersBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 401:
 		result := NewListClustersUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -75,6 +81,10 @@ func NewListClustersOK() *ListClustersOK {
 Success.
 */
 type ListClustersOK struct {
+	/*The cursor of the next page. Not set for the last page.
+	 */
+	NextCursor string
+
 	Payload models.ClusterList
 }
 
@@ -88,6 +98,9 @@ func (o *ListClustersOK) GetPayload() models.ClusterList {
 
 func (o *ListClustersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header Next-Cursor
+	o.NextCursor = response.GetHeader("Next-Cursor")
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -96,6 +109,39 @@ func (o *ListClustersOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewListClustersBadRequest creates a ListClustersBadRequest with default headers values
+func NewListClustersBadRequest() *ListClustersBadRequest {
+	return &ListClustersBadRequest{}
+}
+
+/*ListClustersBadRequest handles this case with default header values.
+
+Error.
+*/
+type ListClustersBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *ListClustersBadRequest) Error() string {
+	return fmt.Sprintf("[GET /clusters][%d] listClustersBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListClustersBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListClustersBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListClustersUnauthorized creates a ListClustersUnauthorized with default headers values
 func NewListClustersUnauthorized() *ListClustersUnauthorized {
 	return &ListClustersUnauthorized{}
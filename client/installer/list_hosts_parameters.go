@@ -14,13 +14,19 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewListHostsParams creates a new ListHostsParams object
 // with the default values initialized.
 func NewListHostsParams() *ListHostsParams {
-	var ()
+	var (
+		orderDefault  = string("asc")
+		sortByDefault = string("created_at")
+	)
 	return &ListHostsParams{
+		Order:  &orderDefault,
+		SortBy: &sortByDefault,
 
 		timeout: cr.DefaultTimeout,
 	}
@@ -29,8 +35,13 @@ func NewListHostsParams() *ListHostsParams {
 // NewListHostsParamsWithTimeout creates a new ListHostsParams object
 // with the default values initialized, and the ability to set a timeout on a request
 func NewListHostsParamsWithTimeout(timeout time.Duration) *ListHostsParams {
-	var ()
+	var (
+		orderDefault  = string("asc")
+		sortByDefault = string("created_at")
+	)
 	return &ListHostsParams{
+		Order:  &orderDefault,
+		SortBy: &sortByDefault,
 
 		timeout: timeout,
 	}
@@ -39,8 +50,13 @@ func NewListHostsParamsWithTimeout(timeout time.Duration) *ListHostsParams {
 // NewListHostsParamsWithContext creates a new ListHostsParams object
 // with the default values initialized, and the ability to set a context for a request
 func NewListHostsParamsWithContext(ctx context.Context) *ListHostsParams {
-	var ()
+	var (
+		orderDefault  = string("asc")
+		sortByDefault = string("created_at")
+	)
 	return &ListHostsParams{
+		Order:  &orderDefault,
+		SortBy: &sortByDefault,
 
 		Context: ctx,
 	}
@@ -49,8 +65,13 @@ func NewListHostsParamsWithContext(ctx context.Context) *ListHostsParams {
 // NewListHostsParamsWithHTTPClient creates a new ListHostsParams object
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewListHostsParamsWithHTTPClient(client *http.Client) *ListHostsParams {
-	var ()
+	var (
+		orderDefault  = string("asc")
+		sortByDefault = string("created_at")
+	)
 	return &ListHostsParams{
+		Order:      &orderDefault,
+		SortBy:     &sortByDefault,
 		HTTPClient: client,
 	}
 }
@@ -65,11 +86,51 @@ type ListHostsParams struct {
 
 	*/
 	ClusterID strfmt.UUID
+	/*CreatedAfter
+	  If set, returned hosts are filtered to those created after this time.
+
+	*/
+	CreatedAfter *strfmt.DateTime
+	/*CreatedBefore
+	  If set, returned hosts are filtered to those created before this time.
+
+	*/
+	CreatedBefore *strfmt.DateTime
+	/*Cursor
+	  The cursor of the page to return, as returned in the Next-Cursor header of the previous page.
+
+	*/
+	Cursor *string
 	/*DiscoveryAgentVersion
 	  The software version of the discovery agent that is listing hosts.
 
 	*/
 	DiscoveryAgentVersion *string
+	/*Limit
+	  The maximal number of items to return. The cursor of the next page is returned in the Next-Cursor header.
+
+	*/
+	Limit *int64
+	/*Name
+	  If set, returned hosts are filtered to those whose requested hostname or inventory hostname contains this string, ignoring case.
+
+	*/
+	Name *string
+	/*Order
+	  The sort order.
+
+	*/
+	Order *string
+	/*SortBy
+	  The field to sort the hosts by.
+
+	*/
+	SortBy *string
+	/*Status
+	  If non-empty, returned hosts are filtered to those with matching statuses.
+
+	*/
+	Status []string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -120,6 +181,39 @@ func (o *ListHostsParams) SetClusterID(clusterID strfmt.UUID) {
 	o.ClusterID = clusterID
 }
 
+// WithCreatedAfter adds the createdAfter to the list hosts params
+func (o *ListHostsParams) WithCreatedAfter(createdAfter *strfmt.DateTime) *ListHostsParams {
+	o.SetCreatedAfter(createdAfter)
+	return o
+}
+
+// SetCreatedAfter adds the createdAfter to the list hosts params
+func (o *ListHostsParams) SetCreatedAfter(createdAfter *strfmt.DateTime) {
+	o.CreatedAfter = createdAfter
+}
+
+// WithCreatedBefore adds the createdBefore to the list hosts params
+func (o *ListHostsParams) WithCreatedBefore(createdBefore *strfmt.DateTime) *ListHostsParams {
+	o.SetCreatedBefore(createdBefore)
+	return o
+}
+
+// SetCreatedBefore adds the createdBefore to the list hosts params
+func (o *ListHostsParams) SetCreatedBefore(createdBefore *strfmt.DateTime) {
+	o.CreatedBefore = createdBefore
+}
+
+// WithCursor adds the cursor to the list hosts params
+func (o *ListHostsParams) WithCursor(cursor *string) *ListHostsParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list hosts params
+func (o *ListHostsParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
 // WithDiscoveryAgentVersion adds the discoveryAgentVersion to the list hosts params
 func (o *ListHostsParams) WithDiscoveryAgentVersion(discoveryAgentVersion *string) *ListHostsParams {
 	o.SetDiscoveryAgentVersion(discoveryAgentVersion)
@@ -131,6 +225,61 @@ func (o *ListHostsParams) SetDiscoveryAgentVersion(discoveryAgentVersion *string
 	o.DiscoveryAgentVersion = discoveryAgentVersion
 }
 
+// WithLimit adds the limit to the list hosts params
+func (o *ListHostsParams) WithLimit(limit *int64) *ListHostsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list hosts params
+func (o *ListHostsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithName adds the name to the list hosts params
+func (o *ListHostsParams) WithName(name *string) *ListHostsParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the list hosts params
+func (o *ListHostsParams) SetName(name *string) {
+	o.Name = name
+}
+
+// WithOrder adds the order to the list hosts params
+func (o *ListHostsParams) WithOrder(order *string) *ListHostsParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the list hosts params
+func (o *ListHostsParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithSortBy adds the sortBy to the list hosts params
+func (o *ListHostsParams) WithSortBy(sortBy *string) *ListHostsParams {
+	o.SetSortBy(sortBy)
+	return o
+}
+
+// SetSortBy adds the sortBy to the list hosts params
+func (o *ListHostsParams) SetSortBy(sortBy *string) {
+	o.SortBy = sortBy
+}
+
+// WithStatus adds the status to the list hosts params
+func (o *ListHostsParams) WithStatus(status []string) *ListHostsParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list hosts params
+func (o *ListHostsParams) SetStatus(status []string) {
+	o.Status = status
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListHostsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -144,6 +293,54 @@ func (o *ListHostsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		return err
 	}
 
+	if o.CreatedAfter != nil {
+
+		// query param created_after
+		var qrCreatedAfter strfmt.DateTime
+		if o.CreatedAfter != nil {
+			qrCreatedAfter = *o.CreatedAfter
+		}
+		qCreatedAfter := qrCreatedAfter.String()
+		if qCreatedAfter != "" {
+			if err := r.SetQueryParam("created_after", qCreatedAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.CreatedBefore != nil {
+
+		// query param created_before
+		var qrCreatedBefore strfmt.DateTime
+		if o.CreatedBefore != nil {
+			qrCreatedBefore = *o.CreatedBefore
+		}
+		qCreatedBefore := qrCreatedBefore.String()
+		if qCreatedBefore != "" {
+			if err := r.SetQueryParam("created_before", qCreatedBefore); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.DiscoveryAgentVersion != nil {
 
 		// header param discovery_agent_version
@@ -153,6 +350,78 @@ func (o *ListHostsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Name != nil {
+
+		// query param name
+		var qrName string
+		if o.Name != nil {
+			qrName = *o.Name
+		}
+		qName := qrName
+		if qName != "" {
+			if err := r.SetQueryParam("name", qName); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.SortBy != nil {
+
+		// query param sort_by
+		var qrSortBy string
+		if o.SortBy != nil {
+			qrSortBy = *o.SortBy
+		}
+		qSortBy := qrSortBy
+		if qSortBy != "" {
+			if err := r.SetQueryParam("sort_by", qSortBy); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	valuesStatus := o.Status
+
+	joinedStatus := swag.JoinByFormat(valuesStatus, "")
+	// query array param status
+	if err := r.SetQueryParam("status", joinedStatus...); err != nil {
+		return err
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
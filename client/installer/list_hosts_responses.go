@@ -29,6 +29,12 @@ func (o *ListHostsReader) ReadResponse(response runtime.ClientResponse, consumer
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewListHostsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 401:
 		result := NewListHostsUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -75,6 +81,10 @@ func NewListHostsOK() *ListHostsOK {
 Success.
 */
 type ListHostsOK struct {
+	/*The cursor of the next page. Not set for the last page.
+	 */
+	NextCursor string
+
 	Payload models.HostList
 }
 
@@ -88,6 +98,9 @@ func (o *ListHostsOK) GetPayload() models.HostList {
 
 func (o *ListHostsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header Next-Cursor
+	o.NextCursor = response.GetHeader("Next-Cursor")
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -96,6 +109,39 @@ func (o *ListHostsOK) readResponse(response runtime.ClientResponse, consumer run
 	return nil
 }
 
+// NewListHostsBadRequest creates a ListHostsBadRequest with default headers values
+func NewListHostsBadRequest() *ListHostsBadRequest {
+	return &ListHostsBadRequest{}
+}
+
+/*ListHostsBadRequest handles this case with default header values.
+
+Error.
+*/
+type ListHostsBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *ListHostsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts][%d] listHostsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListHostsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListHostsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListHostsUnauthorized creates a ListHostsUnauthorized with default headers values
 func NewListHostsUnauthorized() *ListHostsUnauthorized {
 	return &ListHostsUnauthorized{}
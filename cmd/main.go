@@ -16,16 +16,20 @@ import (
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/openshift/assisted-service/internal/access"
+	"github.com/openshift/assisted-service/internal/apitoken"
 	"github.com/openshift/assisted-service/internal/assistedserviceiso"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clusterexport"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/domains"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/eventsink"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host"
@@ -33,6 +37,7 @@ import (
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/imgexpirer"
 	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/installscheduler"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
@@ -40,10 +45,12 @@ import (
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/generic"
 	"github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/spec"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/internal/watch"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/app"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -131,6 +138,10 @@ var Options struct {
 	FileSystemUsageThreshold    int           `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
 	EnableElasticAPM            bool          `envconfig:"ENABLE_ELASTIC_APM" default:"false"`
 	WorkDir                     string        `envconfig:"WORK_DIR" default:"/data/"`
+	WebhooksConfig              eventsink.Config
+	WatchConfig                 watch.Config
+	InstallSchedulerConfig      installscheduler.Config
+	APITokenConfig              apitoken.Config
 }
 
 func InitLogs() *logrus.Entry {
@@ -205,7 +216,10 @@ func main() {
 	usageManager := usage.NewManager(log)
 
 	crdEventsHandler := createCRDEventsHandler()
-	eventsHandler := createEventsHandler(crdEventsHandler, db, log)
+	webhookSink := eventsink.NewWebhookSink(Options.WebhooksConfig, db, log.WithField("pkg", "webhook-sink"))
+	webhookSink.Start()
+	defer webhookSink.Stop()
+	eventsHandler := createEventsHandler(crdEventsHandler, webhookSink, db, log)
 
 	prometheusRegistry := prometheus.DefaultRegisterer
 	metricsManager := metrics.NewMetricsManager(prometheusRegistry, eventsHandler)
@@ -215,6 +229,11 @@ func main() {
 	Options.InstructionConfig.ReleaseImageMirror = Options.ReleaseImageMirror
 	Options.InstructionConfig.CheckClusterVersion = Options.CheckClusterVersion
 	Options.OperatorsConfig.CheckClusterVersion = Options.CheckClusterVersion
+	if Options.OperatorsConfig.CatalogFile != "" {
+		catalog, err := generic.LoadCatalog(Options.OperatorsConfig.CatalogFile)
+		failOnError(err, "failed to load the operators catalog")
+		Options.OperatorsConfig.Catalog = append(Options.OperatorsConfig.Catalog, catalog...)
+	}
 	Options.GeneratorConfig.ReleaseImageMirror = Options.ReleaseImageMirror
 
 	var lead leader.ElectorInterface
@@ -363,12 +382,29 @@ func main() {
 		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, ocpClient, ocmClient,
 		lead, pullSecretValidator, versionHandler, isoEditorFactory, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig)
 
+	installScheduler := installscheduler.NewScheduler(Options.InstallSchedulerConfig, db, log.WithField("pkg", "install-scheduler"),
+		bm, eventsHandler, lead)
+	installSchedulerWorker := thread.New(
+		log.WithField("pkg", "install-scheduler"), "Install Scheduler", Options.InstallSchedulerConfig.Interval, installScheduler.ScheduleTask)
+	installSchedulerWorker.Start()
+	defer installSchedulerWorker.Stop()
+
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
 	expirer := imgexpirer.NewManager(objectHandler, eventsHandler, Options.BMConfig.ImageExpirationTime, lead, Options.EnableKubeAPI)
 	imageExpirationMonitor := thread.New(
 		log.WithField("pkg", "image-expiration-monitor"), "Image Expiration Monitor", Options.ImageExpirationInterval, expirer.ExpirationTask)
 	imageExpirationMonitor.Start()
 	defer imageExpirationMonitor.Stop()
+	watchApi := watch.NewApi(db, Options.WatchConfig, log.WithField("pkg", "watchApi"))
+	webhooks := eventsink.NewApi(Options.WebhooksConfig, db, log.WithField("pkg", "webhooksApi"))
+	accessApi := access.NewApi(db, log.WithField("pkg", "accessApi"), eventsHandler)
+	exportApi := clusterexport.NewApi(db, log.WithField("pkg", "exportApi"), bm, manifestsApi, objectHandler)
+	tokensApi := apitoken.NewApi(Options.APITokenConfig, db, log.WithField("pkg", "tokensApi"), Options.Auth.AuthType == auth.TypeLocal)
+	webhookDeliverer := eventsink.NewDeliverer(Options.WebhooksConfig, db, log.WithField("pkg", "webhook-deliverer"), lead)
+	webhookDeliveryWorker := thread.New(
+		log.WithField("pkg", "webhook-deliverer"), "Webhook Delivery Worker", Options.WebhooksConfig.DeliveryInterval, webhookDeliverer.DeliveryTask)
+	webhookDeliveryWorker.Start()
+	defer webhookDeliveryWorker.Stop()
 	assistedServiceISO := assistedserviceiso.NewAssistedServiceISOApi(objectHandler, authHandler, logrus.WithField("pkg", "assistedserviceiso"), pullSecretValidator, Options.AssistedServiceISOConfig)
 
 	//Set inner handler chain. Inner handlers requires access to the Route
@@ -390,7 +426,7 @@ func main() {
 		}
 	}
 
-	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler)
+	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, metricsManager)
 	h, err := restapi.Handler(restapi.Config{
 		AuthAgentAuth:         authHandler.AuthAgentAuth,
 		AuthUserAuth:          authHandler.AuthUserAuth,
@@ -406,6 +442,11 @@ func main() {
 		InnerMiddleware:       innerHandler(),
 		ManifestsAPI:          manifestsApi,
 		OperatorsAPI:          operatorsHandler,
+		WatchAPI:              watchApi,
+		WebhooksAPI:           webhooks,
+		AccessAPI:             accessApi,
+		TokensAPI:             tokensApi,
+		ExportAPI:             exportApi,
 	})
 	failOnError(err, "Failed to init rest handler")
 
@@ -430,10 +471,10 @@ func main() {
 				"assisted-service-baseiso-helper",
 				log.WithField("pkg", "baseISOUploadLeader"))
 
-			uploadFunc := func() error { return uploadISOs(objectHandler, openshiftVersionsMap, log) }
+			uploadFunc := func() error { return uploadISOs(objectHandler, versionHandler, openshiftVersionsMap, log) }
 			failOnError(baseISOUploadLeader.RunWithLeader(context.Background(), uploadFunc), "Failed to upload boot files")
 		} else {
-			failOnError(uploadISOs(objectHandler, openshiftVersionsMap, log), "Failed to upload boot files")
+			failOnError(uploadISOs(objectHandler, versionHandler, openshiftVersionsMap, log), "Failed to upload boot files")
 		}
 
 		apiEnabler.Enable()
@@ -510,7 +551,7 @@ func generateAPMTransactionName(request *http.Request) string {
 	return route.Operation.ID
 }
 
-func uploadISOs(objectHandler s3wrapper.API, openshiftVersionsMap models.OpenshiftVersions, log logrus.FieldLogger) error {
+func uploadISOs(objectHandler s3wrapper.API, versionHandler versions.Handler, openshiftVersionsMap models.OpenshiftVersions, log logrus.FieldLogger) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	errs, _ := errgroup.WithContext(ctx)
 	//cancel the context in case this method ends
@@ -524,10 +565,17 @@ func uploadISOs(objectHandler s3wrapper.API, openshiftVersionsMap models.Openshi
 	haveLatestMinimalTemplate := s3wrapper.HaveLatestMinimalTemplate(uploadctx, log, objectHandler)
 	for version := range openshiftVersionsMap {
 		currVersion := version
-		errs.Go(func() error {
-			err := objectHandler.UploadISOs(uploadctx, currVersion, haveLatestMinimalTemplate)
-			return errors.Wrapf(err, "Failed uploading boot files for OCP version %s", currVersion)
-		})
+		cpuArchitectures, err := versionHandler.GetCPUArchitectures(currVersion)
+		if err != nil {
+			return errors.Wrapf(err, "Failed getting CPU architectures for OCP version %s", currVersion)
+		}
+		for _, cpuArchitecture := range cpuArchitectures {
+			currCPUArchitecture := cpuArchitecture
+			errs.Go(func() error {
+				err := objectHandler.UploadISOs(uploadctx, currVersion, currCPUArchitecture, haveLatestMinimalTemplate)
+				return errors.Wrapf(err, "Failed uploading boot files for OCP version %s and CPU architecture %s", currVersion, currCPUArchitecture)
+			})
+		}
 	}
 
 	return errs.Wait()
@@ -680,8 +728,8 @@ func autoMigrationWithLeader(migrationLeader leader.ElectorInterface, db *gorm.D
 	})
 }
 
-func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, db *gorm.DB, log logrus.FieldLogger) events.Handler {
-	eventsHandler := events.New(db, log.WithField("pkg", "events"))
+func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, webhookSink events.Sink, db *gorm.DB, log logrus.FieldLogger) events.Handler {
+	eventsHandler := events.New(db, log.WithField("pkg", "events"), webhookSink)
 
 	if crdEventsHandler != nil {
 		return controllers.NewControllerEventsWrapper(crdEventsHandler, eventsHandler, db, log)
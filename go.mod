@@ -35,9 +35,11 @@ require (
 	github.com/hashicorp/go-version v1.2.1
 	github.com/iancoleman/strcase v0.1.2
 	github.com/jinzhu/gorm v1.9.12
+	github.com/jmespath/go-jmespath v0.3.0
 	github.com/kelseyhightower/envconfig v1.4.0
 	github.com/kennygrant/sanitize v1.2.4
 	github.com/metal3-io/baremetal-operator v0.0.0-20210317131627-82fd2d7f8daa
+	github.com/miekg/dns v1.1.30
 	github.com/moby/moby v1.13.1
 	github.com/onsi/ginkgo v1.14.1
 	github.com/onsi/gomega v1.10.2
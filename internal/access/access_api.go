@@ -0,0 +1,116 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/access"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.AccessAPI = &Api{}
+
+type Api struct {
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	eventsHandler events.Handler
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger, eventsHandler events.Handler) *Api {
+	return &Api{
+		db:            db,
+		log:           log,
+		eventsHandler: eventsHandler,
+	}
+}
+
+func (a *Api) ListClusterAccess(ctx context.Context, params operations.ListClusterAccessParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	if _, apierr := cluster.GetCluster(ctx, a.log, a.db, params.ClusterID.String()); apierr != nil {
+		return apierr
+	}
+	var accesses models.ClusterAccessList
+	if err := a.db.Order("user_name").Find(&accesses, "cluster_id = ?", params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to list the access to cluster %s", params.ClusterID.String())
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewListClusterAccessOK().WithPayload(accesses)
+}
+
+func (a *Api) GrantClusterAccess(ctx context.Context, params operations.GrantClusterAccessParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	c, err := a.getOwnedCluster(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if params.UserName == c.UserName {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("user %s is the owner of cluster %s", params.UserName, params.ClusterID.String()))
+	}
+
+	access := models.ClusterAccess{
+		ClusterID: c.ID,
+		UserName:  swag.String(params.UserName),
+		Role:      params.ClusterAccessParams.Role,
+		GrantedBy: ocm.UserNameFromContext(ctx),
+		CreatedAt: strfmt.DateTime(time.Now()),
+	}
+	if err = a.db.Save(&access).Error; err != nil {
+		log.WithError(err).Errorf("failed to grant user %s access to cluster %s", params.UserName, params.ClusterID.String())
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	msg := fmt.Sprintf("Cluster was shared with user %s with role %s", params.UserName, access.Role)
+	log.Info(msg)
+	a.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, msg, time.Now())
+	return operations.NewGrantClusterAccessOK().WithPayload(&access)
+}
+
+func (a *Api) RevokeClusterAccess(ctx context.Context, params operations.RevokeClusterAccessParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	if _, err := a.getOwnedCluster(ctx, params.ClusterID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	reply := a.db.Where("cluster_id = ? and user_name = ?", params.ClusterID.String(), params.UserName).Delete(&models.ClusterAccess{})
+	if reply.Error != nil {
+		log.WithError(reply.Error).Errorf("failed to revoke the access of user %s to cluster %s", params.UserName, params.ClusterID.String())
+		return common.NewApiError(http.StatusInternalServerError, reply.Error)
+	}
+	if reply.RowsAffected == 0 {
+		return common.NewApiError(http.StatusNotFound,
+			errors.Errorf("cluster %s is not shared with user %s", params.ClusterID.String(), params.UserName))
+	}
+	msg := fmt.Sprintf("Cluster is no longer shared with user %s", params.UserName)
+	log.Info(msg)
+	a.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, msg, time.Now())
+	return operations.NewRevokeClusterAccessNoContent()
+}
+
+// getOwnedCluster returns the cluster if the user is its owner or an admin, since only they may share it. Read-only
+// admins may see the access to any cluster, but not change it.
+func (a *Api) getOwnedCluster(ctx context.Context, clusterID strfmt.UUID) (*common.Cluster, error) {
+	c, apierr := cluster.GetCluster(ctx, a.log, a.db, clusterID.String())
+	if apierr != nil {
+		return nil, apierr
+	}
+	if !identity.IsFullAdmin(ctx) && c.UserName != ocm.UserNameFromContext(ctx) {
+		return nil, common.NewApiError(http.StatusForbidden,
+			errors.Errorf("only the owner of cluster %s may share it", clusterID.String()))
+	}
+	return c, nil
+}
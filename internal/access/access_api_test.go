@@ -0,0 +1,127 @@
+package access
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/access"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Access API", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		ctrl       *gomock.Controller
+		mockEvents *events.MockHandler
+		api        *Api
+		clusterID  strfmt.UUID
+	)
+
+	userContext := func(username string) context.Context {
+		payload := &ocm.AuthPayload{Username: username, Role: ocm.UserRole}
+		return context.WithValue(context.Background(), restapi.AuthKey, payload)
+	}
+
+	grant := func(ctx context.Context, username string, role models.ClusterAccessRole) middleware.Responder {
+		return api.GrantClusterAccess(ctx, operations.GrantClusterAccessParams{
+			ClusterID:           clusterID,
+			UserName:            username,
+			ClusterAccessParams: &models.ClusterAccessParams{Role: role},
+		})
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		api = NewApi(db, logrus.New(), mockEvents)
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: "owner"}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("grants, lists and revokes access", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(3)
+		ctx := userContext("owner")
+
+		reply := grant(ctx, "user1", models.ClusterAccessRoleViewer)
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewGrantClusterAccessOK()))
+		access := reply.(*operations.GrantClusterAccessOK).Payload
+		Expect(access.Role).Should(Equal(models.ClusterAccessRoleViewer))
+		Expect(access.GrantedBy).Should(Equal("owner"))
+
+		By("changing the role of the user")
+		reply = grant(ctx, "user1", models.ClusterAccessRoleEditor)
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewGrantClusterAccessOK()))
+
+		reply = api.ListClusterAccess(ctx, operations.ListClusterAccessParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListClusterAccessOK()))
+		accesses := reply.(*operations.ListClusterAccessOK).Payload
+		Expect(accesses).Should(HaveLen(1))
+		Expect(*accesses[0].UserName).Should(Equal("user1"))
+		Expect(accesses[0].Role).Should(Equal(models.ClusterAccessRoleEditor))
+
+		By("listing the access as the user that the cluster is shared with")
+		reply = api.ListClusterAccess(userContext("user1"), operations.ListClusterAccessParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListClusterAccessOK()))
+
+		reply = api.RevokeClusterAccess(ctx, operations.RevokeClusterAccessParams{ClusterID: clusterID, UserName: "user1"})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewRevokeClusterAccessNoContent()))
+
+		reply = api.RevokeClusterAccess(ctx, operations.RevokeClusterAccessParams{ClusterID: clusterID, UserName: "user1"})
+		common.VerifyApiError(reply, http.StatusNotFound)
+
+		reply = api.ListClusterAccess(userContext("user1"), operations.ListClusterAccessParams{ClusterID: clusterID})
+		common.VerifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("only the owner or an admin may share the cluster", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+		reply := grant(context.Background(), "user1", models.ClusterAccessRoleEditor)
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewGrantClusterAccessOK()))
+
+		reply = grant(userContext("user1"), "user2", models.ClusterAccessRoleViewer)
+		common.VerifyApiError(reply, http.StatusForbidden)
+
+		reply = api.RevokeClusterAccess(userContext("user1"), operations.RevokeClusterAccessParams{ClusterID: clusterID, UserName: "user1"})
+		common.VerifyApiError(reply, http.StatusForbidden)
+
+		reply = grant(userContext("user2"), "user2", models.ClusterAccessRoleViewer)
+		common.VerifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("read-only admins may not share the cluster", func() {
+		ctx := context.WithValue(context.Background(), restapi.AuthKey,
+			&ocm.AuthPayload{Username: "auditor", Role: ocm.ReadOnlyAdminRole})
+		reply := grant(ctx, "user1", models.ClusterAccessRoleViewer)
+		common.VerifyApiError(reply, http.StatusForbidden)
+
+		reply = api.RevokeClusterAccess(ctx, operations.RevokeClusterAccessParams{ClusterID: clusterID, UserName: "user1"})
+		common.VerifyApiError(reply, http.StatusForbidden)
+
+		reply = api.ListClusterAccess(ctx, operations.ListClusterAccessParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListClusterAccessOK()))
+	})
+
+	It("rejects sharing the cluster with its owner", func() {
+		reply := grant(userContext("owner"), "owner", models.ClusterAccessRoleViewer)
+		common.VerifyApiError(reply, http.StatusBadRequest)
+	})
+})
@@ -0,0 +1,157 @@
+package apitoken
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/tokens"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	// The lifetime of API tokens that are created without an expiration time, or 0 for tokens that do not expire
+	DefaultLifetime time.Duration `envconfig:"API_TOKEN_DEFAULT_LIFETIME" default:"2160h"`
+}
+
+var _ restapi.TokensAPI = &Api{}
+
+type Api struct {
+	Config
+	db  *gorm.DB
+	log logrus.FieldLogger
+	// API tokens are only used by the local authentication
+	enabled bool
+}
+
+func NewApi(cfg Config, db *gorm.DB, log logrus.FieldLogger, enabled bool) *Api {
+	return &Api{
+		Config:  cfg,
+		db:      db,
+		log:     log,
+		enabled: enabled,
+	}
+}
+
+func (a *Api) CreateAPIToken(ctx context.Context, params operations.CreateAPITokenParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	if err := a.verifyEnabled(); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	payload := ocm.PayloadFromContext(ctx)
+	userName := params.NewAPITokenParams.UserName
+	if userName == "" {
+		userName = payload.Username
+	}
+	role := params.NewAPITokenParams.Role
+	if role == "" {
+		role = models.APITokenRoleUser
+	}
+	if payload.Role != ocm.AdminRole && (userName != payload.Username || role != models.APITokenRoleUser) {
+		return common.NewApiError(http.StatusForbidden,
+			errors.Errorf("only admins may create API tokens for other users or with role %s", role))
+	}
+
+	now := time.Now()
+	expiresAt := params.NewAPITokenParams.ExpiresAt
+	if expiresAt != nil && !time.Time(*expiresAt).After(now) {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("expiration time %s is in the past", expiresAt.String()))
+	}
+	if expiresAt == nil && a.DefaultLifetime > 0 {
+		defaultExpiresAt := strfmt.DateTime(now.Add(a.DefaultLifetime))
+		expiresAt = &defaultExpiresAt
+	}
+
+	token, err := gencrypto.NewAPIToken()
+	if err != nil {
+		log.WithError(err).Error("failed to generate API token")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	id := strfmt.UUID(uuid.New().String())
+	apiToken := common.APIToken{
+		APIToken: models.APIToken{
+			ID:        &id,
+			Name:      params.NewAPITokenParams.Name,
+			UserName:  swag.String(userName),
+			Role:      role,
+			CreatedBy: payload.Username,
+			CreatedAt: strfmt.DateTime(now),
+			ExpiresAt: expiresAt,
+		},
+		TokenHash: gencrypto.HashAPIToken(token),
+	}
+	if err = a.db.Create(&apiToken).Error; err != nil {
+		log.WithError(err).Errorf("failed to create API token for user %s", userName)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Created API token %s for user %s with role %s", id.String(), userName, role)
+	return operations.NewCreateAPITokenCreated().WithPayload(&models.APITokenCreated{
+		APIToken: apiToken.APIToken,
+		Token:    swag.String(token),
+	})
+}
+
+func (a *Api) ListAPITokens(ctx context.Context, params operations.ListAPITokensParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	if err := a.verifyEnabled(); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	var apiTokens []*common.APIToken
+	if err := a.userFilter(ctx).Order("created_at").Find(&apiTokens).Error; err != nil {
+		log.WithError(err).Error("failed to list API tokens")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.APITokenList, len(apiTokens))
+	for i, apiToken := range apiTokens {
+		ret[i] = &apiToken.APIToken
+	}
+	return operations.NewListAPITokensOK().WithPayload(ret)
+}
+
+func (a *Api) RevokeAPIToken(ctx context.Context, params operations.RevokeAPITokenParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	if err := a.verifyEnabled(); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	reply := a.userFilter(ctx).Where("id = ?", params.TokenID.String()).Delete(&common.APIToken{})
+	if reply.Error != nil {
+		log.WithError(reply.Error).Errorf("failed to revoke API token %s", params.TokenID.String())
+		return common.NewApiError(http.StatusInternalServerError, reply.Error)
+	}
+	if reply.RowsAffected == 0 {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("API token %s not found", params.TokenID.String()))
+	}
+	log.Infof("Revoked API token %s", params.TokenID.String())
+	return operations.NewRevokeAPITokenNoContent()
+}
+
+func (a *Api) verifyEnabled() error {
+	if !a.enabled {
+		return common.NewApiError(http.StatusBadRequest, errors.New("API tokens are only supported by the local authentication"))
+	}
+	return nil
+}
+
+// userFilter limits a query to the API tokens of the user, unless the user is an admin
+func (a *Api) userFilter(ctx context.Context) *gorm.DB {
+	if identity.IsAdmin(ctx) {
+		return a.db
+	}
+	return a.db.Where("user_name = ?", ocm.UserNameFromContext(ctx))
+}
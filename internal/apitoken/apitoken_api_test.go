@@ -0,0 +1,109 @@
+package apitoken
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/tokens"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("API tokens API", func() {
+	var (
+		db       *gorm.DB
+		dbName   string
+		api      *Api
+		adminCtx context.Context
+		userCtx  context.Context
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		api = NewApi(Config{DefaultLifetime: time.Hour}, db, logrus.New(), true)
+		adminCtx = context.WithValue(context.Background(), restapi.AuthKey, ocm.AdminPayload())
+		userCtx = context.WithValue(context.Background(), restapi.AuthKey, &ocm.AuthPayload{Username: "user1", Role: ocm.UserRole})
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	create := func(ctx context.Context, params *models.APITokenCreateParams) middleware.Responder {
+		return api.CreateAPIToken(ctx, operations.CreateAPITokenParams{NewAPITokenParams: params})
+	}
+
+	It("creates, lists and revokes API tokens", func() {
+		reply := create(userCtx, &models.APITokenCreateParams{Name: swag.String("laptop")})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewCreateAPITokenCreated()))
+		created := reply.(*operations.CreateAPITokenCreated).Payload
+		Expect(*created.UserName).Should(Equal("user1"))
+		Expect(created.Role).Should(Equal(models.APITokenRoleUser))
+		Expect(created.ExpiresAt).ShouldNot(BeNil())
+		Expect(time.Time(*created.ExpiresAt)).Should(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+		By("storing only the hash of the token")
+		var stored common.APIToken
+		Expect(db.Take(&stored, "id = ?", created.ID.String()).Error).ShouldNot(HaveOccurred())
+		Expect(stored.TokenHash).Should(Equal(gencrypto.HashAPIToken(*created.Token)))
+
+		reply = create(adminCtx, &models.APITokenCreateParams{
+			Name:     swag.String("ci"),
+			UserName: "ci-bot",
+			Role:     models.APITokenRoleAdmin,
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewCreateAPITokenCreated()))
+
+		reply = api.ListAPITokens(userCtx, operations.ListAPITokensParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListAPITokensOK()))
+		Expect(reply.(*operations.ListAPITokensOK).Payload).Should(HaveLen(1))
+
+		reply = api.ListAPITokens(adminCtx, operations.ListAPITokensParams{})
+		Expect(reply.(*operations.ListAPITokensOK).Payload).Should(HaveLen(2))
+
+		reply = api.RevokeAPIToken(userCtx, operations.RevokeAPITokenParams{TokenID: *created.ID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewRevokeAPITokenNoContent()))
+
+		reply = api.RevokeAPIToken(userCtx, operations.RevokeAPITokenParams{TokenID: *created.ID})
+		common.VerifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("users may not create API tokens for other users or with an admin role", func() {
+		reply := create(userCtx, &models.APITokenCreateParams{Name: swag.String("ci"), UserName: "ci-bot"})
+		common.VerifyApiError(reply, http.StatusForbidden)
+
+		reply = create(userCtx, &models.APITokenCreateParams{Name: swag.String("ci"), Role: models.APITokenRoleAdmin})
+		common.VerifyApiError(reply, http.StatusForbidden)
+	})
+
+	It("users may not revoke the API tokens of other users", func() {
+		reply := create(adminCtx, &models.APITokenCreateParams{Name: swag.String("ci"), UserName: "ci-bot"})
+		created := reply.(*operations.CreateAPITokenCreated).Payload
+
+		reply = api.RevokeAPIToken(userCtx, operations.RevokeAPITokenParams{TokenID: *created.ID})
+		common.VerifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("rejects an expiration time in the past", func() {
+		expiresAt := strfmt.DateTime(time.Now().Add(-time.Hour))
+		reply := create(userCtx, &models.APITokenCreateParams{Name: swag.String("laptop"), ExpiresAt: &expiresAt})
+		common.VerifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("fails when the local authentication is not used", func() {
+		api = NewApi(Config{}, db, logrus.New(), false)
+		reply := create(userCtx, &models.APITokenCreateParams{Name: swag.String("laptop")})
+		common.VerifyApiError(reply, http.StatusBadRequest)
+	})
+})
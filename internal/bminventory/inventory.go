@@ -105,6 +105,13 @@ type Config struct {
 
 const minimalOpenShiftVersionForSingleNode = "4.8.0-0.0"
 
+// schedulableClusterStatuses are the statuses in which the installation of a cluster may be scheduled
+var schedulableClusterStatuses = []string{
+	models.ClusterStatusInsufficient,
+	models.ClusterStatusReady,
+	models.ClusterStatusPendingForInput,
+}
+
 type OCPClusterAPI interface {
 	RegisterOCPCluster(ctx context.Context) error
 }
@@ -129,6 +136,9 @@ type InstallerInternals interface {
 	RegisterAddHostsClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, params installer.RegisterAddHostsClusterParams) (*common.Cluster, error)
 	InstallSingleDay2HostInternal(ctx context.Context, clusterId strfmt.UUID, hostId strfmt.UUID) error
 	UpdateClusterInstallConfigInternal(ctx context.Context, params installer.UpdateClusterInstallConfigParams) (*common.Cluster, error)
+	UpdateHostValidationRulesInternal(ctx context.Context, params installer.UpdateHostValidationRulesParams) (models.HostValidationRules, error)
+	CreateClusterStaticNetworkConfigInternal(ctx context.Context, params installer.CreateClusterStaticNetworkConfigParams) (*models.HostStaticNetworkConfig, error)
+	UpdateClusterIPPoolInternal(ctx context.Context, params installer.UpdateClusterIPPoolParams) (*models.IPPool, error)
 	CancelInstallationInternal(ctx context.Context, params installer.CancelInstallationParams) (*common.Cluster, error)
 	AddOpenshiftVersion(ctx context.Context, ocpReleaseImage, pullSecret string) (*models.OpenshiftVersion, error)
 	GetHostById(hostId string) (*common.Host, error)
@@ -271,7 +281,8 @@ func (b *bareMetalInventory) UpdateDiscoveryIgnitionInternal(ctx context.Context
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
-	err = b.db.Model(&common.Cluster{}).Where(identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Update("ignition_config_overrides", params.DiscoveryIgnitionParams.Config).Error
+	query, args := identity.AddUserFilter(ctx, "id = ?", params.ClusterID)
+	err = b.db.Model(&common.Cluster{}).Where(query, args...).Update("ignition_config_overrides", params.DiscoveryIgnitionParams.Config).Error
 	if err != nil {
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
@@ -317,6 +328,9 @@ func (b *bareMetalInventory) setDefaultRegisterClusterParams(_ context.Context,
 	if params.NewClusterParams.Hyperthreading == nil {
 		params.NewClusterParams.Hyperthreading = swag.String(models.ClusterHyperthreadingAll)
 	}
+	if params.NewClusterParams.CPUArchitecture == nil {
+		params.NewClusterParams.CPUArchitecture = swag.String(common.DefaultCPUArchitecture)
+	}
 
 	return params
 }
@@ -349,8 +363,14 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		}
 	}()
 
-	if err = validations.ValidateIPAddressFamily(b.IPv6Support, params.NewClusterParams.ClusterNetworkCidr, params.NewClusterParams.ServiceNetworkCidr,
-		&params.NewClusterParams.IngressVip); err != nil {
+	if err = validations.ValidateIPAddressFamily(b.IPv6Support, append([]*string{params.NewClusterParams.ClusterNetworkCidr,
+		params.NewClusterParams.ServiceNetworkCidr, &params.NewClusterParams.IngressVip},
+		addressesOf(clusterNetworkCidrs(params.NewClusterParams.ClusterNetworks), params.NewClusterParams.ServiceNetworks)...)...); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	var secondaryNetworks *common.Cluster
+	if secondaryNetworks, err = setDualStackRegisterParams(params.NewClusterParams); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
@@ -408,6 +428,20 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	releaseImage, err := b.versionsHandler.GetReleaseImage(swag.StringValue(params.NewClusterParams.OpenshiftVersion),
+		swag.StringValue(params.NewClusterParams.CPUArchitecture))
+	if err != nil {
+		if errors.Is(err, versions.ErrCPUArchitectureNotSupported) {
+			err = errors.Errorf("CPU architecture %s is not supported for Openshift version %s",
+				swag.StringValue(params.NewClusterParams.CPUArchitecture), swag.StringValue(params.NewClusterParams.OpenshiftVersion))
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		log.WithError(err).Errorf("failed to get the release image of Openshift version %s and CPU architecture %s",
+			swag.StringValue(params.NewClusterParams.OpenshiftVersion), swag.StringValue(params.NewClusterParams.CPUArchitecture))
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to get the release image of Openshift version %s", swag.StringValue(params.NewClusterParams.OpenshiftVersion)))
+	}
+
 	if kubeKey == nil {
 		kubeKey = &types.NamespacedName{}
 	}
@@ -416,7 +450,7 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 
 	if params.NewClusterParams.OlmOperators != nil {
 		var newOLMOperators []*models.MonitoredOperator
-		newOLMOperators, err = b.getOLMOperators(params.NewClusterParams.OlmOperators)
+		newOLMOperators, err = b.getOLMOperators(swag.StringValue(params.NewClusterParams.OpenshiftVersion), params.NewClusterParams.OlmOperators)
 		if err != nil {
 			return nil, err
 		}
@@ -435,7 +469,7 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			IngressVip:               params.NewClusterParams.IngressVip,
 			Name:                     swag.StringValue(params.NewClusterParams.Name),
 			OpenshiftVersion:         *openshiftVersion.ReleaseVersion,
-			OcpReleaseImage:          *openshiftVersion.ReleaseImage,
+			OcpReleaseImage:          releaseImage,
 			ServiceNetworkCidr:       swag.StringValue(params.NewClusterParams.ServiceNetworkCidr),
 			SSHPublicKey:             params.NewClusterParams.SSHPublicKey,
 			UpdatedAt:                strfmt.DateTime{},
@@ -451,9 +485,13 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			MonitoredOperators:       monitoredOperators,
 			HighAvailabilityMode:     params.NewClusterParams.HighAvailabilityMode,
 			Hyperthreading:           swag.StringValue(params.NewClusterParams.Hyperthreading),
+			CPUArchitecture:          swag.StringValue(params.NewClusterParams.CPUArchitecture),
 		},
-		KubeKeyName:      kubeKey.Name,
-		KubeKeyNamespace: kubeKey.Namespace,
+		KubeKeyName:                       kubeKey.Name,
+		KubeKeyNamespace:                  kubeKey.Namespace,
+		SecondaryClusterNetworkCidr:       secondaryNetworks.SecondaryClusterNetworkCidr,
+		SecondaryClusterNetworkHostPrefix: secondaryNetworks.SecondaryClusterNetworkHostPrefix,
+		SecondaryServiceNetworkCidr:       secondaryNetworks.SecondaryServiceNetworkCidr,
 	}
 
 	proxyHash, err := computeClusterProxyHash(params.NewClusterParams.HTTPProxy,
@@ -598,6 +636,21 @@ func (b *bareMetalInventory) RegisterAddHostsClusterInternal(ctx context.Context
 		return nil, common.NewApiError(http.StatusBadRequest, fmt.Errorf("failed to get opnshift version supported by versions map from version %s", inputOpenshiftVersion))
 	}
 
+	cpuArchitecture := swag.StringValue(params.NewAddHostsClusterParams.CPUArchitecture)
+	if cpuArchitecture == "" {
+		cpuArchitecture = common.DefaultCPUArchitecture
+	}
+	if _, err = b.versionsHandler.GetReleaseImage(inputOpenshiftVersion, cpuArchitecture); err != nil {
+		if errors.Is(err, versions.ErrCPUArchitectureNotSupported) {
+			return nil, common.NewApiError(http.StatusBadRequest,
+				errors.Errorf("CPU architecture %s is not supported for Openshift version %s", cpuArchitecture, inputOpenshiftVersion))
+		}
+		log.WithError(err).Errorf("failed to get the release image of Openshift version %s and CPU architecture %s",
+			inputOpenshiftVersion, cpuArchitecture)
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to get the release image of Openshift version %s", inputOpenshiftVersion))
+	}
+
 	if kubeKey == nil {
 		kubeKey = &types.NamespacedName{}
 	}
@@ -615,6 +668,7 @@ func (b *bareMetalInventory) RegisterAddHostsClusterInternal(ctx context.Context
 		APIVipDNSName:    swag.String(apivipDnsname),
 		HostNetworks:     []*models.HostNetwork{},
 		Hosts:            []*models.Host{},
+		CPUArchitecture:  cpuArchitecture,
 	},
 		KubeKeyName:      kubeKey.Name,
 		KubeKeyNamespace: kubeKey.Namespace,
@@ -812,7 +866,7 @@ func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, clus
 				return errors.New("Failed to generate image: error generating cluster ISO URL")
 			}
 			downloadURL = fmt.Sprintf("%s%s", b.Config.ServiceBaseURL, clusterISOURL.RequestURI())
-			if b.authHandler.AuthType() == auth.TypeLocal {
+			if b.authHandler.AuthType().HasLocalAgentAuth() {
 				downloadURL, err = gencrypto.SignURL(downloadURL, cluster.ID.String())
 				if err != nil {
 					return errors.Wrap(err, "Failed to sign cluster ISO URL")
@@ -850,6 +904,12 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 }
 
 func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, params installer.GenerateClusterISOParams) (*common.Cluster, error) {
+	return b.generateClusterISO(ctx, params, false)
+}
+
+// generateClusterISO generates the discovery ISO of the cluster. When the ISO is regenerated because its static
+// network configs changed, a new image is always generated and the window between requests is not enforced.
+func (b *bareMetalInventory) generateClusterISO(ctx context.Context, params installer.GenerateClusterISOParams, regenerate bool) (*common.Cluster, error) {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("prepare image for cluster %s", params.ClusterID)
 
@@ -904,7 +964,7 @@ func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, par
 	*/
 	now := time.Now()
 	previousCreatedAt := time.Time(cluster.ImageInfo.CreatedAt)
-	if previousCreatedAt.Add(WindowBetweenRequestsInSeconds).After(now) {
+	if !regenerate && previousCreatedAt.Add(WindowBetweenRequestsInSeconds).After(now) {
 		log.Error("request came too soon after previous request")
 		return nil, common.NewApiError(
 			http.StatusConflict,
@@ -927,10 +987,16 @@ func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, par
 		return nil, common.NewApiError(http.StatusInternalServerError, errors.New(msg))
 	}
 
-	staticNetworkConfig := b.staticNetworkConfig.FormatStaticNetworkConfigForDB(params.ImageCreateParams.StaticNetworkConfig)
+	hostsStaticNetworkConfig := params.ImageCreateParams.StaticNetworkConfig
+	if hostsStaticNetworkConfig == nil {
+		// Keep the static network configs of the cluster, that may have been set with the static network config API
+		hostsStaticNetworkConfig = staticnetworkconfig.ParseStaticNetworkConfigFromDB(cluster.ImageInfo.StaticNetworkConfig)
+	}
+	staticNetworkConfig := b.staticNetworkConfig.FormatStaticNetworkConfigForDB(hostsStaticNetworkConfig)
 
 	var imageExists bool
-	if cluster.ImageInfo.SSHPublicKey == params.ImageCreateParams.SSHPublicKey &&
+	if !regenerate &&
+		cluster.ImageInfo.SSHPublicKey == params.ImageCreateParams.SSHPublicKey &&
 		cluster.ProxyHash == clusterProxyHash &&
 		cluster.ImageInfo.StaticNetworkConfig == staticNetworkConfig &&
 		cluster.ImageGenerated &&
@@ -1026,9 +1092,10 @@ func (b *bareMetalInventory) createAndUploadNewImage(ctx context.Context, log lo
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 	} else {
-		baseISOName, err := b.objectHandler.GetBaseIsoObject(cluster.OpenshiftVersion)
+		baseISOName, err := b.objectHandler.GetBaseIsoObject(cluster.OpenshiftVersion, cluster.CPUArchitecture)
 		if err != nil {
-			log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
+			log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s and cpu architecture %s",
+				cluster.ID, cluster.OpenshiftVersion, cluster.CPUArchitecture)
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 
@@ -1074,9 +1141,10 @@ func (b *bareMetalInventory) getIgnitionConfigForLogging(cluster *common.Cluster
 func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log logrus.FieldLogger,
 	cluster *common.Cluster, ignitionConfig, objectPrefix string) error {
 
-	baseISOName, err := b.objectHandler.GetMinimalIsoObjectName(cluster.OpenshiftVersion)
+	baseISOName, err := b.objectHandler.GetMinimalIsoObjectName(cluster.OpenshiftVersion, cluster.CPUArchitecture)
 	if err != nil {
-		log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
+		log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s and cpu architecture %s",
+			cluster.ID, cluster.OpenshiftVersion, cluster.CPUArchitecture)
 		return err
 	}
 
@@ -1172,6 +1240,599 @@ func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installe
 	return installer.NewInstallClusterAccepted().WithPayload(&c.Cluster)
 }
 
+func (b *bareMetalInventory) AssignClusterRoles(ctx context.Context, params installer.AssignClusterRolesParams) middleware.Responder {
+	assignment, err := b.AssignClusterRolesInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewAssignClusterRolesOK().WithPayload(assignment)
+}
+
+func (b *bareMetalInventory) AssignClusterRolesInternal(ctx context.Context, params installer.AssignClusterRolesParams) (*models.RoleAssignment, error) {
+	log := logutil.FromContext(ctx, b.log)
+	dryRun := params.AssignRolesParams != nil && params.AssignRolesParams.DryRun
+
+	cluster, err := common.GetClusterFromDBWithoutDisabledHosts(b.db, params.ClusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+
+	var assignment *models.RoleAssignment
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		assignment, err = b.hostApi.AssignRoles(ctx, cluster, dryRun, tx)
+		return err
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to assign the roles of the hosts of cluster %s", params.ClusterID)
+		return nil, err
+	}
+	if !assignment.Applied {
+		return assignment, nil
+	}
+
+	// Reload the hosts with their new roles before refreshing them
+	if cluster, err = common.GetClusterFromDBWithoutDisabledHosts(b.db, params.ClusterID); err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+	for _, h := range cluster.Hosts {
+		if err = b.hostApi.RefreshStatus(ctx, h, b.db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err = b.clusterApi.RefreshStatus(ctx, cluster, b.db); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+func (b *bareMetalInventory) ListClusterStaticNetworkConfigs(ctx context.Context, params installer.ListClusterStaticNetworkConfigsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusNotFound, err))
+	}
+	return installer.NewListClusterStaticNetworkConfigsOK().WithPayload(
+		staticnetworkconfig.ParseStaticNetworkConfigFromDB(cluster.ImageInfo.StaticNetworkConfig))
+}
+
+func (b *bareMetalInventory) CreateClusterStaticNetworkConfig(ctx context.Context, params installer.CreateClusterStaticNetworkConfigParams) middleware.Responder {
+	hostConfig, err := b.CreateClusterStaticNetworkConfigInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewCreateClusterStaticNetworkConfigCreated().WithPayload(hostConfig)
+}
+
+func (b *bareMetalInventory) CreateClusterStaticNetworkConfigInternal(ctx context.Context, params installer.CreateClusterStaticNetworkConfigParams) (*models.HostStaticNetworkConfig, error) {
+	hostConfig := params.StaticNetworkConfig
+	err := b.updateClusterStaticNetworkConfigs(ctx, params.ClusterID, hostConfig,
+		func(_ *gorm.DB, _ *common.Cluster, configs []*models.HostStaticNetworkConfig) ([]*models.HostStaticNetworkConfig, int, error) {
+			for _, entry := range hostConfig.MacInterfaceMap {
+				if findStaticNetworkConfig(configs, entry.MacAddress) >= 0 {
+					return nil, 0, common.NewApiError(http.StatusConflict,
+						errors.Errorf("MAC address %s is already in the static network config of another host", entry.MacAddress))
+				}
+			}
+			return append(configs, hostConfig), len(configs), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return hostConfig, nil
+}
+
+func (b *bareMetalInventory) UpdateClusterStaticNetworkConfig(ctx context.Context, params installer.UpdateClusterStaticNetworkConfigParams) middleware.Responder {
+	hostConfig := params.StaticNetworkConfig
+	err := b.updateClusterStaticNetworkConfigs(ctx, params.ClusterID, hostConfig,
+		func(_ *gorm.DB, _ *common.Cluster, configs []*models.HostStaticNetworkConfig) ([]*models.HostStaticNetworkConfig, int, error) {
+			idx := findStaticNetworkConfig(configs, params.MacAddress)
+			if idx < 0 {
+				return nil, 0, common.NewApiError(http.StatusNotFound,
+					errors.Errorf("No static network config of cluster %s has MAC address %s", params.ClusterID, params.MacAddress))
+			}
+			for _, entry := range hostConfig.MacInterfaceMap {
+				if other := findStaticNetworkConfig(configs, entry.MacAddress); other >= 0 && other != idx {
+					return nil, 0, common.NewApiError(http.StatusConflict,
+						errors.Errorf("MAC address %s is already in the static network config of another host", entry.MacAddress))
+				}
+			}
+			configs[idx] = hostConfig
+			return configs, idx, nil
+		})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateClusterStaticNetworkConfigOK().WithPayload(hostConfig)
+}
+
+func (b *bareMetalInventory) DeleteClusterStaticNetworkConfig(ctx context.Context, params installer.DeleteClusterStaticNetworkConfigParams) middleware.Responder {
+	err := b.updateClusterStaticNetworkConfigs(ctx, params.ClusterID, nil,
+		func(tx *gorm.DB, _ *common.Cluster, configs []*models.HostStaticNetworkConfig) ([]*models.HostStaticNetworkConfig, int, error) {
+			idx := findStaticNetworkConfig(configs, params.MacAddress)
+			if idx < 0 {
+				return nil, 0, common.NewApiError(http.StatusNotFound,
+					errors.Errorf("No static network config of cluster %s has MAC address %s", params.ClusterID, params.MacAddress))
+			}
+			if err := releaseIPLeases(tx, params.ClusterID, configs[idx].MacInterfaceMap); err != nil {
+				return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+			}
+			return append(configs[:idx], configs[idx+1:]...), -1, nil
+		})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewDeleteClusterStaticNetworkConfigNoContent()
+}
+
+// updateClusterStaticNetworkConfigs applies a change to the static network configs of the cluster and regenerates its
+// discovery ISO if it was already generated. The update runs in the transaction that locks the cluster, and returns
+// the changed configs and the index of the added or replaced config, which is validated with the other configs of the
+// cluster, or -1 when a config was removed. Since the change is already saved, a failure to regenerate the ISO is
+// reported as a warning event of the cluster rather than as an error.
+func (b *bareMetalInventory) updateClusterStaticNetworkConfigs(ctx context.Context, clusterID strfmt.UUID, hostConfig *models.HostStaticNetworkConfig,
+	update func(*gorm.DB, *common.Cluster, []*models.HostStaticNetworkConfig) ([]*models.HostStaticNetworkConfig, int, error)) error {
+	log := logutil.FromContext(ctx, b.log)
+	if hostConfig != nil {
+		if len(hostConfig.MacInterfaceMap) == 0 {
+			return common.NewApiError(http.StatusBadRequest,
+				errors.New("The static network config must map at least one MAC address to an interface"))
+		}
+		if err := b.staticNetworkConfig.ValidateStaticConfigParams([]*models.HostStaticNetworkConfig{hostConfig}); err != nil {
+			log.WithError(err).Errorf("invalid static network config for cluster %s", clusterID)
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	var cluster *common.Cluster
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		cluster, err = common.GetClusterFromDB(transaction.AddForUpdateQueryOption(tx), clusterID, common.SkipEagerLoading)
+		if err != nil {
+			log.WithError(err).Errorf("failed to find cluster %s", clusterID)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.NewApiError(http.StatusNotFound, err)
+			}
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err = b.clusterApi.VerifyClusterUpdatability(cluster); err != nil {
+			log.WithError(err).Errorf("cluster %s can't be updated in current state", clusterID)
+			return common.NewApiError(http.StatusConflict, err)
+		}
+		configs, changed, err := update(tx, cluster, staticnetworkconfig.ParseStaticNetworkConfigFromDB(cluster.ImageInfo.StaticNetworkConfig))
+		if err != nil {
+			return err
+		}
+		if changed >= 0 {
+			validationErrors := staticnetworkconfig.ValidateStaticConfigSemantics(configs,
+				common.GetMachineNetworkCidrs(cluster), clusterVips(cluster))[changed]
+			if len(validationErrors) > 0 {
+				return common.NewApiError(http.StatusBadRequest, errors.New(strings.Join(validationErrors, "; ")))
+			}
+		}
+		return tx.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Update("image_static_network_config", b.staticNetworkConfig.FormatStaticNetworkConfigForDB(configs)).Error
+	})
+	if err != nil {
+		return err
+	}
+	if !cluster.ImageGenerated {
+		return nil
+	}
+
+	log.Infof("Regenerating the discovery ISO of cluster %s with its updated static network configs", clusterID)
+	if _, err = b.generateClusterISO(ctx, installer.GenerateClusterISOParams{
+		ClusterID: clusterID,
+		ImageCreateParams: &models.ImageCreateParams{
+			ImageType:    cluster.ImageInfo.Type,
+			SSHPublicKey: cluster.ImageInfo.SSHPublicKey,
+		},
+	}, true); err != nil {
+		log.WithError(err).Warnf("failed to regenerate the discovery ISO of cluster %s", clusterID)
+		b.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityWarning,
+			"Failed to regenerate the discovery ISO with the updated static network configs. Generate the ISO again to include them.", time.Now())
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) PreviewClusterStaticNetworkConfigs(ctx context.Context, params installer.PreviewClusterStaticNetworkConfigsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusNotFound, err))
+	}
+
+	configs := params.PreviewParams.StaticNetworkConfig
+	if configs == nil {
+		configs = staticnetworkconfig.ParseStaticNetworkConfigFromDB(cluster.ImageInfo.StaticNetworkConfig)
+	}
+	validationErrors := staticnetworkconfig.ValidateStaticConfigSemantics(configs, common.GetMachineNetworkCidrs(cluster), clusterVips(cluster))
+	preview := &models.StaticNetworkConfigPreview{
+		Valid: swag.Bool(true),
+		Hosts: make([]*models.HostStaticNetworkConfigPreview, 0, len(configs)),
+	}
+	for i, hostConfig := range configs {
+		hostPreview := &models.HostStaticNetworkConfigPreview{
+			MacInterfaceMap:  hostConfig.MacInterfaceMap,
+			Files:            make([]*models.StaticNetworkConfigFile, 0),
+			ValidationErrors: validationErrors[i],
+		}
+		files, err := b.staticNetworkConfig.GenerateHostStaticNetworkConfigData(hostConfig)
+		if err != nil {
+			hostPreview.ValidationErrors = append(hostPreview.ValidationErrors, err.Error())
+		}
+		for _, file := range files {
+			hostPreview.Files = append(hostPreview.Files, &models.StaticNetworkConfigFile{
+				Path:     file.FilePath,
+				Contents: file.FileContents,
+			})
+		}
+		if len(hostPreview.ValidationErrors) > 0 {
+			preview.Valid = swag.Bool(false)
+		}
+		preview.Hosts = append(preview.Hosts, hostPreview)
+	}
+	return installer.NewPreviewClusterStaticNetworkConfigsOK().WithPayload(preview)
+}
+
+func (b *bareMetalInventory) GetClusterIPPool(ctx context.Context, params installer.GetClusterIPPoolParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusNotFound, err))
+	}
+	if cluster.IPPool == "" {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusNotFound,
+			errors.Errorf("Cluster %s has no IP pool", params.ClusterID)))
+	}
+	pool, err := parseIPPool(cluster.IPPool)
+	if err != nil {
+		log.WithError(err).Errorf("failed to parse the IP pool of cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	return installer.NewGetClusterIPPoolOK().WithPayload(pool)
+}
+
+func (b *bareMetalInventory) UpdateClusterIPPool(ctx context.Context, params installer.UpdateClusterIPPoolParams) middleware.Responder {
+	pool, err := b.UpdateClusterIPPoolInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateClusterIPPoolOK().WithPayload(pool)
+}
+
+func (b *bareMetalInventory) UpdateClusterIPPoolInternal(ctx context.Context, params installer.UpdateClusterIPPoolParams) (*models.IPPool, error) {
+	log := logutil.FromContext(ctx, b.log)
+	pool := params.IPPool
+	if err := network.VerifyIPPool(pool); err != nil {
+		log.WithError(err).Errorf("invalid IP pool for cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	poolJSON, err := json.Marshal(pool)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		cluster, err := common.GetClusterFromDB(transaction.AddForUpdateQueryOption(tx), params.ClusterID, common.SkipEagerLoading)
+		if err != nil {
+			log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.NewApiError(http.StatusNotFound, err)
+			}
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err = b.clusterApi.VerifyClusterUpdatability(cluster); err != nil {
+			log.WithError(err).Errorf("cluster %s can't be updated in current state", params.ClusterID)
+			return common.NewApiError(http.StatusConflict, err)
+		}
+		var leases []*common.IPLease
+		if err = tx.Where("cluster_id = ?", params.ClusterID.String()).Find(&leases).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		for _, lease := range leases {
+			if !network.IPPoolContains(pool, lease.Address) {
+				return common.NewApiError(http.StatusConflict, errors.Errorf(
+					"Address %s that is leased to the host with MAC %s is not in the subnet %s of the IP pool",
+					lease.Address, lease.MacAddress, swag.StringValue(pool.Cidr)))
+			}
+		}
+		return tx.Model(&common.Cluster{}).Where("id = ?", params.ClusterID.String()).Update("ip_pool", string(poolJSON)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Updated the IP pool of cluster %s to %s", params.ClusterID, swag.StringValue(pool.Cidr))
+	return pool, nil
+}
+
+func (b *bareMetalInventory) ListClusterIPLeases(ctx context.Context, params installer.ListClusterIPLeasesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusNotFound, err))
+	}
+	var leases []*common.IPLease
+	if err := b.db.Where("cluster_id = ?", params.ClusterID.String()).Order("id").Find(&leases).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the IP leases of cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	ret := make(models.IPLeaseList, 0, len(leases))
+	for _, lease := range leases {
+		ret = append(ret, ipLeaseToModel(lease))
+	}
+	return installer.NewListClusterIPLeasesOK().WithPayload(ret)
+}
+
+func (b *bareMetalInventory) AllocateClusterIPLease(ctx context.Context, params installer.AllocateClusterIPLeaseParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	macInterfaceMap := params.IPLeaseParams.MacInterfaceMap
+	if len(macInterfaceMap) == 0 {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusBadRequest,
+			errors.New("The mac interface map must map at least one MAC address to an interface")))
+	}
+
+	var lease *common.IPLease
+	err := b.updateClusterStaticNetworkConfigs(ctx, params.ClusterID, nil,
+		func(tx *gorm.DB, cluster *common.Cluster, configs []*models.HostStaticNetworkConfig) ([]*models.HostStaticNetworkConfig, int, error) {
+			if cluster.IPPool == "" {
+				return nil, 0, common.NewApiError(http.StatusConflict, errors.Errorf("Cluster %s has no IP pool", params.ClusterID))
+			}
+			pool, err := parseIPPool(cluster.IPPool)
+			if err != nil {
+				return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+			}
+			for _, entry := range macInterfaceMap {
+				if findStaticNetworkConfig(configs, entry.MacAddress) >= 0 {
+					return nil, 0, common.NewApiError(http.StatusConflict,
+						errors.Errorf("MAC address %s is already in the static network config of a host", entry.MacAddress))
+				}
+			}
+
+			used := make([]string, 0)
+			for vip := range clusterVips(cluster) {
+				used = append(used, vip)
+			}
+			for _, config := range configs {
+				used = append(used, staticnetworkconfig.StaticAddresses(config)...)
+			}
+			var leased []string
+			if err = tx.Model(&common.IPLease{}).Where("cluster_id = ?", params.ClusterID.String()).Pluck("address", &leased).Error; err != nil {
+				return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+			}
+			used = append(used, leased...)
+			var hosts []*models.Host
+			if err = tx.Where("cluster_id = ?", params.ClusterID.String()).Find(&hosts).Error; err != nil {
+				return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+			}
+
+			address, err := network.AllocateIPPoolAddress(pool, used, hosts, log)
+			if err != nil {
+				return nil, 0, common.NewApiError(http.StatusConflict, err)
+			}
+			networkYaml, err := staticnetworkconfig.GenerateStaticIPNetworkYaml(macInterfaceMap[0].LogicalNicName, address,
+				swag.StringValue(pool.Cidr), swag.StringValue(pool.Gateway), pool.DNSServers)
+			if err != nil {
+				return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+			}
+			lease = &common.IPLease{
+				ClusterID:   params.ClusterID,
+				MacAddress:  strings.ToLower(macInterfaceMap[0].MacAddress),
+				Address:     address,
+				AllocatedAt: time.Now(),
+			}
+			if err = tx.Create(lease).Error; err != nil {
+				return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+			}
+			return append(configs, &models.HostStaticNetworkConfig{MacInterfaceMap: macInterfaceMap, NetworkYaml: networkYaml}), len(configs), nil
+		})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	log.Infof("Leased address %s of the IP pool of cluster %s to the host with MAC %s", lease.Address, params.ClusterID, lease.MacAddress)
+	return installer.NewAllocateClusterIPLeaseCreated().WithPayload(ipLeaseToModel(lease))
+}
+
+func (b *bareMetalInventory) ReleaseClusterIPLease(ctx context.Context, params installer.ReleaseClusterIPLeaseParams) middleware.Responder {
+	err := b.updateClusterStaticNetworkConfigs(ctx, params.ClusterID, nil,
+		func(tx *gorm.DB, _ *common.Cluster, configs []*models.HostStaticNetworkConfig) ([]*models.HostStaticNetworkConfig, int, error) {
+			var lease common.IPLease
+			if err := tx.Take(&lease, "cluster_id = ? and mac_address = ?", params.ClusterID.String(), strings.ToLower(params.MacAddress)).Error; err != nil {
+				if gorm.IsRecordNotFoundError(err) {
+					return nil, 0, common.NewApiError(http.StatusNotFound,
+						errors.Errorf("No address of cluster %s is leased to MAC address %s", params.ClusterID, params.MacAddress))
+				}
+				return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+			}
+			if err := tx.Delete(&lease).Error; err != nil {
+				return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+			}
+			if idx := findStaticNetworkConfig(configs, params.MacAddress); idx >= 0 {
+				configs = append(configs[:idx], configs[idx+1:]...)
+			}
+			return configs, -1, nil
+		})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewReleaseClusterIPLeaseNoContent()
+}
+
+// releaseHostIPLeases releases the addresses of the IP pool of the cluster that are leased to the interfaces of a
+// deregistered host, and removes its static network config. Hosts are deregistered in any state of the cluster, so the
+// leases are released without verifying that the cluster can be updated, and the discovery ISO is not regenerated.
+func (b *bareMetalInventory) releaseHostIPLeases(clusterID strfmt.UUID, macAddresses []string) error {
+	var count int
+	if err := b.db.Model(&common.IPLease{}).Where("cluster_id = ? and mac_address in (?)", clusterID.String(), macAddresses).
+		Count(&count).Error; err != nil || count == 0 {
+		return err
+	}
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		cluster, err := common.GetClusterFromDB(transaction.AddForUpdateQueryOption(tx), clusterID, common.SkipEagerLoading)
+		if err != nil {
+			return err
+		}
+		if err = tx.Where("cluster_id = ? and mac_address in (?)", clusterID.String(), macAddresses).Delete(&common.IPLease{}).Error; err != nil {
+			return err
+		}
+		configs := staticnetworkconfig.ParseStaticNetworkConfigFromDB(cluster.ImageInfo.StaticNetworkConfig)
+		for _, macAddress := range macAddresses {
+			if idx := findStaticNetworkConfig(configs, macAddress); idx >= 0 {
+				configs = append(configs[:idx], configs[idx+1:]...)
+			}
+		}
+		return tx.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Update("image_static_network_config", b.staticNetworkConfig.FormatStaticNetworkConfigForDB(configs)).Error
+	})
+}
+
+// releaseIPLeases releases the addresses of the IP pool of the cluster that are leased to the MAC addresses
+func releaseIPLeases(tx *gorm.DB, clusterID strfmt.UUID, macInterfaceMap models.MacInterfaceMap) error {
+	macAddresses := make([]string, 0, len(macInterfaceMap))
+	for _, entry := range macInterfaceMap {
+		macAddresses = append(macAddresses, strings.ToLower(entry.MacAddress))
+	}
+	return tx.Where("cluster_id = ? and mac_address in (?)", clusterID.String(), macAddresses).Delete(&common.IPLease{}).Error
+}
+
+func parseIPPool(poolJSON string) (*models.IPPool, error) {
+	var pool models.IPPool
+	if err := json.Unmarshal([]byte(poolJSON), &pool); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+func ipLeaseToModel(lease *common.IPLease) *models.IPLease {
+	return &models.IPLease{
+		MacAddress:  lease.MacAddress,
+		Address:     lease.Address,
+		AllocatedAt: strfmt.DateTime(lease.AllocatedAt),
+	}
+}
+
+// findStaticNetworkConfig returns the index of the static network config that has the MAC address, or -1
+func findStaticNetworkConfig(configs []*models.HostStaticNetworkConfig, macAddress string) int {
+	for i, config := range configs {
+		for _, entry := range config.MacInterfaceMap {
+			if strings.EqualFold(entry.MacAddress, macAddress) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// clusterVips returns the API and Ingress VIPs of the cluster with their descriptions
+func clusterVips(cluster *common.Cluster) map[string]string {
+	ret := make(map[string]string)
+	for _, vip := range common.GetAPIVips(cluster) {
+		ret[vip] = "API VIP"
+	}
+	for _, vip := range common.GetIngressVips(cluster) {
+		ret[vip] = "Ingress VIP"
+	}
+	return ret
+}
+
+func (b *bareMetalInventory) ScheduleClusterInstallation(ctx context.Context, params installer.ScheduleClusterInstallationParams) middleware.Responder {
+	c, err := b.ScheduleClusterInstallationInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewScheduleClusterInstallationOK().WithPayload(&c.Cluster)
+}
+
+func (b *bareMetalInventory) ScheduleClusterInstallationInternal(ctx context.Context, params installer.ScheduleClusterInstallationParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	schedule := params.InstallScheduleParams
+
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+	if !funk.ContainsString(schedulableClusterStatuses, swag.StringValue(cluster.Status)) {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Cannot schedule the installation of cluster %s in status %s", params.ClusterID, swag.StringValue(cluster.Status)))
+	}
+	if schedule.NotAfter != nil {
+		if time.Time(*schedule.NotAfter).Before(time.Now()) {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.New("The installation window must end in the future"))
+		}
+		if schedule.NotBefore != nil && !time.Time(*schedule.NotBefore).Before(time.Time(*schedule.NotAfter)) {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.New("The installation window must start before it ends"))
+		}
+	}
+
+	err = b.db.Model(&common.Cluster{}).Where("id = ?", params.ClusterID).Updates(map[string]interface{}{
+		"install_schedule_not_before":              schedule.NotBefore,
+		"install_schedule_not_after":               schedule.NotAfter,
+		"install_schedule_hosts_known_for_minutes": swag.Int64Value(schedule.HostsKnownForMinutes),
+		"install_schedule_status":                  models.InstallScheduleStatusPending,
+		"install_schedule_status_info":             "Waiting for the installation window and policy",
+	}).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to schedule the installation of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	msg := "Installation was scheduled"
+	if schedule.NotBefore != nil {
+		msg += fmt.Sprintf(" to start not before %s", schedule.NotBefore.String())
+	}
+	if schedule.NotAfter != nil {
+		msg += fmt.Sprintf(" and not after %s", schedule.NotAfter.String())
+	}
+	if swag.Int64Value(schedule.HostsKnownForMinutes) > 0 {
+		msg += fmt.Sprintf(", once all hosts have been known for %d minutes", swag.Int64Value(schedule.HostsKnownForMinutes))
+	}
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, msg, time.Now())
+	log.Infof("Installation of cluster %s was scheduled", params.ClusterID)
+
+	return common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading)
+}
+
+func (b *bareMetalInventory) UnscheduleClusterInstallation(ctx context.Context, params installer.UnscheduleClusterInstallationParams) middleware.Responder {
+	c, err := b.UnscheduleClusterInstallationInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUnscheduleClusterInstallationOK().WithPayload(&c.Cluster)
+}
+
+func (b *bareMetalInventory) UnscheduleClusterInstallationInternal(ctx context.Context, params installer.UnscheduleClusterInstallationParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+	if cluster.InstallSchedule == nil || cluster.InstallSchedule.Status != models.InstallScheduleStatusPending {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("The installation of cluster %s is not scheduled", params.ClusterID))
+	}
+
+	err = b.db.Model(&common.Cluster{}).Where("id = ?", params.ClusterID).Updates(map[string]interface{}{
+		"install_schedule_not_before":              nil,
+		"install_schedule_not_after":               nil,
+		"install_schedule_hosts_known_for_minutes": 0,
+		"install_schedule_status":                  "",
+		"install_schedule_status_info":             "",
+	}).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to remove the installation schedule of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Installation schedule was removed", time.Now())
+	log.Infof("Installation schedule of cluster %s was removed", params.ClusterID)
+
+	return common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading)
+}
+
 func (b *bareMetalInventory) integrateWithAMSClusterPreInstallation(ctx context.Context, amsSubscriptionID, openshiftClusterID strfmt.UUID) error {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Updating AMS subscription %s with openshift cluster ID %s", amsSubscriptionID, openshiftClusterID)
@@ -1233,7 +1894,14 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		if err = b.setBootstrapHost(ctx, *cluster, tx); err != nil {
 			return err
 		}
-		return nil
+
+		// installing the cluster, directly or by the scheduler, meets its pending installation schedule
+		return tx.Model(&common.Cluster{}).
+			Where("id = ? AND install_schedule_status = ?", params.ClusterID.String(), models.InstallScheduleStatusPending).
+			Updates(map[string]interface{}{
+				"install_schedule_status":      models.InstallScheduleStatusTriggered,
+				"install_schedule_status_info": "Installation was started",
+			}).Error
 	})
 	if err != nil {
 		return nil, err
@@ -1484,6 +2152,20 @@ func (b *bareMetalInventory) GetClusterInstallConfig(ctx context.Context, params
 	return installer.NewGetClusterInstallConfigOK().WithPayload(string(cfg))
 }
 
+func (b *bareMetalInventory) GetClusterInstallationReport(ctx context.Context, params installer.GetClusterInstallationReportParams) middleware.Responder {
+	c, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	report, err := clusterPkg.GetInstallationReport(b.db, c, time.Now())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	return installer.NewGetClusterInstallationReportOK().WithPayload(report)
+}
+
 func (b *bareMetalInventory) GetClusterDefaultConfig(_ context.Context, _ installer.GetClusterDefaultConfigParams) middleware.Responder {
 	body := models.ClusterDefaultConfig{}
 
@@ -1500,32 +2182,101 @@ func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, par
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewUpdateClusterInstallConfigCreated()
+	return installer.NewUpdateClusterInstallConfigCreated()
+}
+
+func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.UpdateClusterInstallConfigParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	query := "id = ?"
+
+	err := b.db.First(&cluster, query, params.ClusterID).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		return nil, err
+	}
+
+	if err = b.installConfigBuilder.ValidateInstallConfigPatch(&cluster, params.InstallConfigParams); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	err = b.db.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", params.InstallConfigParams).Error
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom install config was applied to the cluster", time.Now())
+	log.Infof("Custom install config was applied to cluster %s", params.ClusterID)
+	return &cluster, nil
+}
+
+func (b *bareMetalInventory) ListHostValidationRules(ctx context.Context, params installer.ListHostValidationRulesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	rules, err := host.GetClusterHostValidationRules(cluster)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return installer.NewListHostValidationRulesOK().WithPayload(models.HostValidationRules(rules))
+}
+
+func (b *bareMetalInventory) UpdateHostValidationRules(ctx context.Context, params installer.UpdateHostValidationRulesParams) middleware.Responder {
+	rules, err := b.UpdateHostValidationRulesInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateHostValidationRulesOK().WithPayload(rules)
 }
 
-func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.UpdateClusterInstallConfigParams) (*common.Cluster, error) {
+func (b *bareMetalInventory) UpdateHostValidationRulesInternal(ctx context.Context, params installer.UpdateHostValidationRulesParams) (models.HostValidationRules, error) {
 	log := logutil.FromContext(ctx, b.log)
-	var cluster common.Cluster
-	query := "id = ?"
-
-	err := b.db.First(&cluster, query, params.ClusterID).Error
-	if err != nil {
-		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
-		return nil, err
+	rules := params.HostValidationRules
+	if rules == nil {
+		rules = models.HostValidationRules{}
 	}
-
-	if err = b.installConfigBuilder.ValidateInstallConfigPatch(&cluster, params.InstallConfigParams); err != nil {
+	if err := host.ValidateHostValidationRules(rules); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
-
-	err = b.db.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", params.InstallConfigParams).Error
+	data, err := json.Marshal(rules)
 	if err != nil {
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom install config was applied to the cluster", time.Now())
-	log.Infof("Custom install config was applied to cluster %s", params.ClusterID)
-	return &cluster, nil
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		cluster, err := common.GetClusterFromDB(transaction.AddForUpdateQueryOption(tx), params.ClusterID, common.SkipEagerLoading)
+		if err != nil {
+			log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.NewApiError(http.StatusNotFound, err)
+			}
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err = b.clusterApi.VerifyClusterUpdatability(cluster); err != nil {
+			log.WithError(err).Errorf("cluster %s can't be updated in current state", params.ClusterID)
+			return common.NewApiError(http.StatusConflict, err)
+		}
+		if err = tx.Model(&common.Cluster{}).Where("id = ?", params.ClusterID.String()).
+			Update("host_validation_rules", string(data)).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		// Refresh the hosts, so that they are validated with the new rules
+		return b.updateHostsAndClusterStatus(ctx, cluster, tx, log)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo,
+		fmt.Sprintf("Host validation rules of the cluster were updated (%d rules)", len(rules)), time.Now())
+	log.Infof("Host validation rules of cluster %s were updated", params.ClusterID)
+	return rules, nil
 }
 
 func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster) error {
@@ -1537,7 +2288,7 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
 	}
 
-	releaseImage, err := b.versionsHandler.GetReleaseImage(cluster.OpenshiftVersion)
+	releaseImage, err := b.versionsHandler.GetReleaseImage(cluster.OpenshiftVersion, cluster.CPUArchitecture)
 
 	if err != nil {
 		log.WithError(err).Errorf("failed to get release image for cluster %s with openshift version %s", cluster.ID, cluster.OpenshiftVersion)
@@ -1650,8 +2401,11 @@ func (b *bareMetalInventory) validateAndUpdateClusterParams(ctx context.Context,
 		}
 	}
 
-	if err := validations.ValidateIPAddressFamily(b.IPv6Support, params.ClusterUpdateParams.ClusterNetworkCidr, params.ClusterUpdateParams.ServiceNetworkCidr,
-		params.ClusterUpdateParams.MachineNetworkCidr, params.ClusterUpdateParams.APIVip, params.ClusterUpdateParams.IngressVip); err != nil {
+	if err := validations.ValidateIPAddressFamily(b.IPv6Support, append([]*string{params.ClusterUpdateParams.ClusterNetworkCidr,
+		params.ClusterUpdateParams.ServiceNetworkCidr, params.ClusterUpdateParams.MachineNetworkCidr, params.ClusterUpdateParams.APIVip,
+		params.ClusterUpdateParams.IngressVip}, addressesOf(params.ClusterUpdateParams.MachineNetworks,
+		clusterNetworkCidrs(params.ClusterUpdateParams.ClusterNetworks), params.ClusterUpdateParams.ServiceNetworks,
+		params.ClusterUpdateParams.APIVips, params.ClusterUpdateParams.IngressVips)...)...); err != nil {
 		return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
 	}
 
@@ -1886,6 +2640,8 @@ func (b *bareMetalInventory) updateDhcpNetworkParams(updates map[string]interfac
 		setMachineNetworkCIDRForUpdate(updates, *machineCidr)
 		updates["api_vip"] = ""
 		updates["ingress_vip"] = ""
+		updates["secondary_api_vip"] = ""
+		updates["secondary_ingress_vip"] = ""
 		return network.VerifyMachineCIDR(swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr))
 	}
 	return nil
@@ -1912,6 +2668,10 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		return err
 	}
 
+	if err = b.updateValidationPolicy(params, updates, usages, log); err != nil {
+		return err
+	}
+
 	if params.ClusterUpdateParams.PullSecret != nil {
 		cluster.PullSecret = *params.ClusterUpdateParams.PullSecret
 		updates["pull_secret"] = *params.ClusterUpdateParams.PullSecret
@@ -1943,6 +2703,9 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 
 func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterParams, cluster *common.Cluster, updates map[string]interface{}, usages map[string]models.Usage, log logrus.FieldLogger) error {
 	var err error
+	if err = updateDualStackNetworkParams(params.ClusterUpdateParams, cluster, updates, log); err != nil {
+		return err
+	}
 	machineCidr := cluster.MachineNetworkCidr
 	serviceCidr := cluster.ServiceNetworkCidr
 	clusterCidr := cluster.ClusterNetworkCidr
@@ -1982,8 +2745,15 @@ func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterP
 		userManagedNetworking = swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking)
 		updates["user_managed_networking"] = userManagedNetworking
 		machineCidr = ""
+		if params.ClusterUpdateParams.MachineNetworks == nil {
+			updates["additional_machine_network_cidrs"] = ""
+		}
 	}
 	if userManagedNetworking && !common.IsSingleNodeCluster(cluster) {
+		// The machine networks of multi-subnet clusters are set by the user
+		if params.ClusterUpdateParams.MachineNetworks != nil {
+			machineCidr = swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr)
+		}
 		err, vipDhcpAllocation = setCommonUserNetworkManagedParams(params.ClusterUpdateParams, common.IsSingleNodeCluster(cluster), machineCidr, updates, log)
 		if err != nil {
 			return err
@@ -1995,8 +2765,11 @@ func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterP
 		updates["vip_dhcp_allocation"] = vipDhcpAllocation
 		updates["api_vip"] = ""
 		updates["ingress_vip"] = ""
+		updates["secondary_api_vip"] = ""
+		updates["secondary_ingress_vip"] = ""
 		machineCidr = ""
 		setMachineNetworkCIDRForUpdate(updates, machineCidr)
+		updates["secondary_machine_network_cidr"] = ""
 	}
 	if !userManagedNetworking {
 		if vipDhcpAllocation {
@@ -2021,7 +2794,7 @@ func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterP
 		}
 	}
 
-	if err = network.VerifyClusterCIDRsNotOverlap(machineCidr, clusterCidr, serviceCidr, userManagedNetworking); err != nil {
+	if err = verifyUpdatedDualStackNetworks(cluster, machineCidr, clusterCidr, serviceCidr, vipDhcpAllocation, userManagedNetworking, updates); err != nil {
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
@@ -2041,11 +2814,263 @@ func setCommonUserNetworkManagedParams(params *models.ClusterUpdateParams, singl
 	updates["vip_dhcp_allocation"] = false
 	updates["api_vip"] = ""
 	updates["ingress_vip"] = ""
+	updates["secondary_api_vip"] = ""
+	updates["secondary_ingress_vip"] = ""
 
 	setMachineNetworkCIDRForUpdate(updates, machineCidr)
+	if machineCidr == "" {
+		updates["secondary_machine_network_cidr"] = ""
+		updates["additional_machine_network_cidrs"] = ""
+	}
 	return nil, false
 }
 
+// updateDualStackNetworkParams applies the lists of networks and VIPs of dual-stack clusters. The first entry of each
+// list is applied as the matching single-stack parameter, and the second one as the secondary network or VIP.
+func updateDualStackNetworkParams(params *models.ClusterUpdateParams, cluster *common.Cluster, updates map[string]interface{}, log logrus.FieldLogger) error {
+	var (
+		secondary string
+		err       error
+	)
+	if params.MachineNetworks != nil {
+		// Multi-subnet clusters may have several machine networks of the same IP address family
+		if err = network.VerifyMachineNetworks(params.MachineNetworks); err != nil {
+			return dualStackParamError(err, log)
+		}
+		primary, secondaryMachineCidr, additional := network.SplitMachineNetworks(params.MachineNetworks)
+		if params.MachineNetworkCidr != nil && *params.MachineNetworkCidr != primary {
+			return dualStackParamError(errors.Errorf("Machine networks %s conflict with %s",
+				strings.Join(params.MachineNetworks, ", "), *params.MachineNetworkCidr), log)
+		}
+		params.MachineNetworkCidr = swag.String(primary)
+		updates["secondary_machine_network_cidr"] = secondaryMachineCidr
+		updates["additional_machine_network_cidrs"] = strings.Join(additional, ",")
+	}
+	if params.ClusterNetworks != nil {
+		if params.ClusterNetworkCidr, secondary, err = dualStackParam("Cluster networks", clusterNetworkCidrs(params.ClusterNetworks), params.ClusterNetworkCidr); err != nil {
+			return dualStackParamError(err, log)
+		}
+		var secondaryHostPrefix int64
+		for i, clusterNetwork := range params.ClusterNetworks {
+			switch {
+			case i > 0:
+				secondaryHostPrefix = clusterNetwork.HostPrefix
+			case clusterNetwork.HostPrefix == 0:
+			case params.ClusterNetworkHostPrefix != nil && *params.ClusterNetworkHostPrefix != clusterNetwork.HostPrefix:
+				return dualStackParamError(errors.Errorf("Cluster network host prefix %d conflicts with the host prefix %d of the cluster networks",
+					*params.ClusterNetworkHostPrefix, clusterNetwork.HostPrefix), log)
+			default:
+				params.ClusterNetworkHostPrefix = swag.Int64(clusterNetwork.HostPrefix)
+			}
+		}
+		if secondary != "" {
+			if err = network.VerifyClusterOrServiceCIDR(secondary); err != nil {
+				return dualStackParamError(errors.Wrap(err, "Secondary cluster network CIDR"), log)
+			}
+			if err = network.VerifyNetworkHostPrefix(secondaryHostPrefix); err != nil {
+				return dualStackParamError(err, log)
+			}
+			if err = network.VerifyClusterCidrSize(int(secondaryHostPrefix), secondary, len(cluster.Hosts)); err != nil {
+				return dualStackParamError(err, log)
+			}
+		}
+		updates["secondary_cluster_network_cidr"] = secondary
+		updates["secondary_cluster_network_host_prefix"] = secondaryHostPrefix
+	}
+	if params.ServiceNetworks != nil {
+		if params.ServiceNetworkCidr, secondary, err = dualStackParam("Service networks", params.ServiceNetworks, params.ServiceNetworkCidr); err != nil {
+			return dualStackParamError(err, log)
+		}
+		if secondary != "" {
+			if err = network.VerifyClusterOrServiceCIDR(secondary); err != nil {
+				return dualStackParamError(errors.Wrap(err, "Secondary service network CIDR"), log)
+			}
+		}
+		updates["secondary_service_network_cidr"] = secondary
+	}
+	if params.APIVips != nil {
+		if params.APIVip, secondary, err = dualStackParam("API VIPs", params.APIVips, params.APIVip); err != nil {
+			return dualStackParamError(err, log)
+		}
+		updates["secondary_api_vip"] = secondary
+	}
+	if params.IngressVips != nil {
+		if params.IngressVip, secondary, err = dualStackParam("Ingress VIPs", params.IngressVips, params.IngressVip); err != nil {
+			return dualStackParamError(err, log)
+		}
+		updates["secondary_ingress_vip"] = secondary
+	}
+	return nil
+}
+
+func dualStackParamError(err error, log logrus.FieldLogger) error {
+	log.WithError(err).Warnf("Set dual-stack networks")
+	return common.NewApiError(http.StatusBadRequest, err)
+}
+
+// dualStackParam returns the first of the addresses as the single-stack parameter and the second one as the
+// secondary address. The single-stack parameter, when set, must be the first of the addresses.
+func dualStackParam(name string, addresses []string, singleStackParam *string) (*string, string, error) {
+	if err := network.VerifyAddressFamilies(name, addresses); err != nil {
+		return nil, "", err
+	}
+	var primary, secondary string
+	if len(addresses) > 0 {
+		primary = addresses[0]
+	}
+	if len(addresses) > 1 {
+		secondary = addresses[1]
+	}
+	if singleStackParam != nil && *singleStackParam != primary {
+		return nil, "", errors.Errorf("%s %s conflict with %s", name, strings.Join(addresses, ", "), *singleStackParam)
+	}
+	return swag.String(primary), secondary, nil
+}
+
+// verifyUpdatedDualStackNetworks verifies the networks and VIPs of each IP address family the cluster has after the
+// update
+func verifyUpdatedDualStackNetworks(cluster *common.Cluster, machineCidr, clusterCidr, serviceCidr string, vipDhcpAllocation,
+	userManagedNetworking bool, updates map[string]interface{}) error {
+	updated := &common.Cluster{
+		Cluster: models.Cluster{
+			MachineNetworkCidr: machineCidr,
+			ClusterNetworkCidr: clusterCidr,
+			ServiceNetworkCidr: serviceCidr,
+			APIVip:             updatedValue(updates, "api_vip", cluster.APIVip),
+			IngressVip:         updatedValue(updates, "ingress_vip", cluster.IngressVip),
+		},
+		SecondaryMachineNetworkCidr:   updatedValue(updates, "secondary_machine_network_cidr", cluster.SecondaryMachineNetworkCidr),
+		SecondaryClusterNetworkCidr:   updatedValue(updates, "secondary_cluster_network_cidr", cluster.SecondaryClusterNetworkCidr),
+		SecondaryServiceNetworkCidr:   updatedValue(updates, "secondary_service_network_cidr", cluster.SecondaryServiceNetworkCidr),
+		SecondaryAPIVip:               updatedValue(updates, "secondary_api_vip", cluster.SecondaryAPIVip),
+		SecondaryIngressVip:           updatedValue(updates, "secondary_ingress_vip", cluster.SecondaryIngressVip),
+		AdditionalMachineNetworkCidrs: updatedValue(updates, "additional_machine_network_cidrs", cluster.AdditionalMachineNetworkCidrs),
+	}
+	clusterCidrs := clusterNetworkCidrs(common.GetClusterNetworks(updated))
+	serviceCidrs := common.GetServiceNetworkCidrs(updated)
+	for _, addresses := range []struct {
+		name  string
+		value []string
+	}{
+		{"Machine networks", funk.FilterString([]string{updated.MachineNetworkCidr, updated.SecondaryMachineNetworkCidr},
+			func(cidr string) bool { return cidr != "" })},
+		{"Cluster networks", clusterCidrs},
+		{"Service networks", serviceCidrs},
+		{"API VIPs", common.GetAPIVips(updated)},
+		{"Ingress VIPs", common.GetIngressVips(updated)},
+	} {
+		if err := network.VerifyAddressFamilies(addresses.name, addresses.value); err != nil {
+			return err
+		}
+	}
+	if vipDhcpAllocation && common.IsDualStackCluster(updated) {
+		return errors.Errorf("VIP DHCP allocation is unsupported with dual-stack networks")
+	}
+	if common.IsMultiSubnetCluster(updated) {
+		// The VIPs cannot move between the hosts of different subnets
+		if !userManagedNetworking {
+			return errors.Errorf("Multiple machine networks of the same IP address family require User Managed Networking")
+		}
+		if err := network.VerifyMachineNetworksNotOverlap(common.GetMachineNetworkCidrs(updated), clusterCidrs, serviceCidrs); err != nil {
+			return err
+		}
+	}
+	if err := verifyParsableVIPs(updated.SecondaryAPIVip, updated.SecondaryIngressVip); err != nil {
+		return err
+	}
+	if err := network.VerifyDifferentVipAddresses(updated.SecondaryAPIVip, updated.SecondaryIngressVip); err != nil {
+		return err
+	}
+	if err := network.VerifyDualStackFamilies(clusterCidrs, serviceCidrs); err != nil {
+		return err
+	}
+	return network.VerifyDualStackCIDRsNotOverlap(common.GetMachineNetworkCidrs(updated), clusterCidrs, serviceCidrs,
+		userManagedNetworking)
+}
+
+// setDualStackRegisterParams applies the first of the cluster and service networks of a new cluster as the
+// single-stack parameters, and returns a cluster holding the secondary networks
+func setDualStackRegisterParams(params *models.ClusterCreateParams) (*common.Cluster, error) {
+	var (
+		secondaryNetworks common.Cluster
+		err               error
+	)
+	if params.ClusterNetworks != nil {
+		if params.ClusterNetworkCidr, secondaryNetworks.SecondaryClusterNetworkCidr, err = dualStackParam("Cluster networks",
+			clusterNetworkCidrs(params.ClusterNetworks), params.ClusterNetworkCidr); err != nil {
+			return nil, err
+		}
+		for i, clusterNetwork := range params.ClusterNetworks {
+			switch {
+			case i > 0:
+				secondaryNetworks.SecondaryClusterNetworkHostPrefix = clusterNetwork.HostPrefix
+			case params.ClusterNetworkHostPrefix == 0:
+				params.ClusterNetworkHostPrefix = clusterNetwork.HostPrefix
+			case clusterNetwork.HostPrefix != 0 && params.ClusterNetworkHostPrefix != clusterNetwork.HostPrefix:
+				return nil, errors.Errorf("Cluster network host prefix %d conflicts with the host prefix %d of the cluster networks",
+					params.ClusterNetworkHostPrefix, clusterNetwork.HostPrefix)
+			}
+		}
+	}
+	if params.ServiceNetworks != nil {
+		if params.ServiceNetworkCidr, secondaryNetworks.SecondaryServiceNetworkCidr, err = dualStackParam("Service networks",
+			params.ServiceNetworks, params.ServiceNetworkCidr); err != nil {
+			return nil, err
+		}
+	}
+	if !common.IsDualStackCluster(&secondaryNetworks) {
+		return &secondaryNetworks, nil
+	}
+
+	if err = network.VerifyDualStackFamilies(clusterNetworkCidrs(params.ClusterNetworks), params.ServiceNetworks); err != nil {
+		return nil, err
+	}
+	if secondaryNetworks.SecondaryClusterNetworkCidr != "" {
+		if err = network.VerifyClusterOrServiceCIDR(secondaryNetworks.SecondaryClusterNetworkCidr); err != nil {
+			return nil, errors.Wrap(err, "Secondary cluster network CIDR")
+		}
+		if err = network.VerifyNetworkHostPrefix(secondaryNetworks.SecondaryClusterNetworkHostPrefix); err != nil {
+			return nil, errors.Wrap(err, "Secondary cluster network")
+		}
+	}
+	if secondaryNetworks.SecondaryServiceNetworkCidr != "" {
+		if err = network.VerifyClusterOrServiceCIDR(secondaryNetworks.SecondaryServiceNetworkCidr); err != nil {
+			return nil, errors.Wrap(err, "Secondary service network CIDR")
+		}
+	}
+	if swag.BoolValue(params.VipDhcpAllocation) {
+		return nil, errors.Errorf("VIP DHCP allocation is unsupported with dual-stack networks")
+	}
+	// The VIPs of dual-stack clusters are set by the user
+	params.VipDhcpAllocation = swag.Bool(false)
+	return &secondaryNetworks, nil
+}
+
+func updatedValue(updates map[string]interface{}, field string, current string) string {
+	if value, ok := updates[field]; ok {
+		return value.(string)
+	}
+	return current
+}
+
+func clusterNetworkCidrs(clusterNetworks []*models.ClusterNetwork) []string {
+	ret := make([]string, 0, len(clusterNetworks))
+	for _, clusterNetwork := range clusterNetworks {
+		ret = append(ret, clusterNetwork.Cidr)
+	}
+	return ret
+}
+
+func addressesOf(lists ...[]string) []*string {
+	ret := make([]*string, 0)
+	for _, list := range lists {
+		for i := range list {
+			ret = append(ret, &list[i])
+		}
+	}
+	return ret
+}
+
 func (b *bareMetalInventory) updateNtpSources(params installer.UpdateClusterParams, updates map[string]interface{}, usages map[string]models.Usage, log logrus.FieldLogger) error {
 	if params.ClusterUpdateParams.AdditionalNtpSource != nil {
 		ntpSource := swag.StringValue(params.ClusterUpdateParams.AdditionalNtpSource)
@@ -2065,6 +3090,67 @@ func (b *bareMetalInventory) updateNtpSources(params installer.UpdateClusterPara
 	return nil
 }
 
+func (b *bareMetalInventory) updateValidationPolicy(params installer.UpdateClusterParams, updates map[string]interface{}, usages map[string]models.Usage, log logrus.FieldLogger) error {
+	policy := params.ClusterUpdateParams.ValidationPolicy
+	if policy == nil {
+		return nil
+	}
+	if err := validateValidationPolicy(policy); err != nil {
+		log.WithError(err).Errorf("Invalid validation policy for cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	policyStr, err := common.MarshalValidationPolicy(policy)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	updates["validation_policy"] = policyStr
+
+	thresholds := validationPolicyOverriddenThresholds(policy)
+	policyDefined := len(policy.DisabledHostValidations) > 0 || len(policy.DisabledClusterValidations) > 0 || len(thresholds) > 0
+	b.setUsage(policyDefined, usage.ValidationPolicyUsage, &map[string]interface{}{
+		"disabled_host_validations":    policy.DisabledHostValidations,
+		"disabled_cluster_validations": policy.DisabledClusterValidations,
+		"overridden_thresholds":        thresholds}, usages)
+	return nil
+}
+
+func validateValidationPolicy(policy *models.ValidationPolicy) error {
+	if err := policy.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	for _, id := range policy.DisabledHostValidations {
+		if host.IsCustomValidationID(id) {
+			continue
+		}
+		if err := models.HostValidationID(id).Validate(strfmt.Default); err != nil {
+			return errors.Errorf("Unknown host validation ID %q in the validation policy", id)
+		}
+	}
+	for _, id := range policy.DisabledClusterValidations {
+		if err := models.ClusterValidationID(id).Validate(strfmt.Default); err != nil {
+			return errors.Errorf("Unknown cluster validation ID %q in the validation policy", id)
+		}
+	}
+	return nil
+}
+
+func validationPolicyOverriddenThresholds(policy *models.ValidationPolicy) []string {
+	thresholds := make([]string, 0)
+	if policy.InstallationDiskSpeedThresholdMs != nil {
+		thresholds = append(thresholds, "installation_disk_speed_threshold_ms")
+	}
+	if policy.NetworkLatencyThresholdMs != nil {
+		thresholds = append(thresholds, "network_latency_threshold_ms")
+	}
+	if policy.PacketLossPercentage != nil {
+		thresholds = append(thresholds, "packet_loss_percentage")
+	}
+	if policy.MaxTimeDiffMinutes != nil {
+		thresholds = append(thresholds, "max_time_diff_minutes")
+	}
+	return thresholds
+}
+
 func validateUserManagedNetworkConflicts(params *models.ClusterUpdateParams, singleNodeCluster bool, log logrus.FieldLogger) error {
 	if params.VipDhcpAllocation != nil && swag.BoolValue(params.VipDhcpAllocation) {
 		err := errors.Errorf("VIP DHCP Allocation cannot be enabled with User Managed Networking")
@@ -2081,7 +3167,7 @@ func validateUserManagedNetworkConflicts(params *models.ClusterUpdateParams, sin
 		log.WithError(err)
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
-	if params.MachineNetworkCidr != nil && !singleNodeCluster {
+	if params.MachineNetworkCidr != nil && params.MachineNetworks == nil && !singleNodeCluster {
 		err := errors.Errorf("Machine Network CIDR cannot be set with User Managed Networking")
 		log.WithError(err)
 		return common.NewApiError(http.StatusBadRequest, err)
@@ -2260,6 +3346,25 @@ func (b *bareMetalInventory) updateHostsMachineConfigPoolNames(ctx context.Conte
 	return nil
 }
 
+func (b *bareMetalInventory) updateHostsFailureDomains(params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
+	for i := range params.ClusterUpdateParams.HostsFailureDomains {
+		failureDomainConfig := params.ClusterUpdateParams.HostsFailureDomains[i]
+		log.Infof("Update host %s to failure domain %s", failureDomainConfig.ID, failureDomainConfig.FailureDomain)
+		host, err := common.GetHostFromDB(db, params.ClusterID.String(), failureDomainConfig.ID.String())
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				failureDomainConfig.ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		if err = db.Model(&host.Host).Update("failure_domain", failureDomainConfig.FailureDomain).Error; err != nil {
+			log.WithError(err).Errorf("failed to set failure domain <%s> host <%s> in cluster <%s>",
+				failureDomainConfig.FailureDomain, failureDomainConfig.ID, params.ClusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) updateHostsData(ctx context.Context, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
 	if err := b.updateHostRoles(ctx, params, db, log); err != nil {
 		return err
@@ -2277,6 +3382,10 @@ func (b *bareMetalInventory) updateHostsData(ctx context.Context, params install
 		return err
 	}
 
+	if err := b.updateHostsFailureDomains(params, db, log); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -2285,7 +3394,7 @@ func (b *bareMetalInventory) updateOperatorsData(_ context.Context, cluster *com
 		return nil
 	}
 
-	updateOLMOperators, err := b.getOLMOperators(params.ClusterUpdateParams.OlmOperators)
+	updateOLMOperators, err := b.getOLMOperators(cluster.OpenshiftVersion, params.ClusterUpdateParams.OlmOperators)
 	if err != nil {
 		return err
 	}
@@ -2320,8 +3429,9 @@ func (b *bareMetalInventory) updateOperatorsData(_ context.Context, cluster *com
 	return nil
 }
 
-func (b *bareMetalInventory) getOLMOperators(newOperators []*models.OperatorCreateParams) ([]*models.MonitoredOperator, error) {
+func (b *bareMetalInventory) getOLMOperators(openshiftVersion string, newOperators []*models.OperatorCreateParams) ([]*models.MonitoredOperator, error) {
 	monitoredOperators := make([]*models.MonitoredOperator, 0)
+	subscribedOperators := make([]*models.MonitoredOperator, 0)
 
 	for _, newOperator := range newOperators {
 		operator, err := b.operatorManagerApi.GetOperatorByName(newOperator.Name)
@@ -2329,10 +3439,21 @@ func (b *bareMetalInventory) getOLMOperators(newOperators []*models.OperatorCrea
 			return nil, common.NewApiError(http.StatusBadRequest, err)
 		}
 		operator.Properties = newOperator.Properties
+		operator.Channel = newOperator.Channel
+		operator.Csv = newOperator.Csv
+		if operator.Channel != "" || operator.Csv != "" {
+			subscribedOperators = append(subscribedOperators, operator)
+		}
 
 		monitoredOperators = append(monitoredOperators, operator)
 	}
 
+	if len(subscribedOperators) > 0 {
+		if err := b.operatorManagerApi.ValidateSubscriptions(openshiftVersion, subscribedOperators); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	return b.operatorManagerApi.ResolveDependencies(monitoredOperators)
 }
 
@@ -2411,18 +3532,46 @@ func (b *bareMetalInventory) ListClusters(ctx context.Context, params installer.
 	}
 	var dbClusters []*common.Cluster
 	var clusters []*models.Cluster
-	whereCondition := identity.AddUserFilter(ctx, "")
+	if query, args := identity.AddUserFilter(ctx, ""); query != "" {
+		db = db.Where(query, args...)
+	}
 
 	if params.OpenshiftClusterID != nil {
-		whereCondition += fmt.Sprintf(" AND openshift_cluster_id = '%s'", *params.OpenshiftClusterID)
+		db = db.Where("openshift_cluster_id = ?", *params.OpenshiftClusterID)
 	}
 
 	if len(params.AmsSubscriptionIds) > 0 {
-		whereCondition += fmt.Sprintf(" AND ams_subscription_id IN %s", common.ToSqlList(params.AmsSubscriptionIds))
+		db = db.Where("ams_subscription_id IN (?)", params.AmsSubscriptionIds)
+	}
+
+	if len(params.Status) > 0 {
+		db = db.Where("status IN (?)", params.Status)
+	}
+	if params.OpenshiftVersion != nil {
+		db = db.Where("openshift_version = ?", *params.OpenshiftVersion)
+	}
+	if params.Name != nil {
+		db = db.Where("name "+common.SubstringFilter, common.SubstringPattern(*params.Name))
+	}
+	if params.CreatedAfter != nil {
+		db = db.Where("created_at > ?", time.Time(*params.CreatedAfter))
+	}
+	if params.CreatedBefore != nil {
+		db = db.Where("created_at < ?", time.Time(*params.CreatedBefore))
+	}
+
+	cursor, err := common.DecodePageCursor(swag.StringValue(params.Cursor))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
+	sortBy := swag.StringValue(params.SortBy)
+	if sortBy == "" {
+		sortBy = "created_at"
+	}
+	db = common.Paginate(db, sortBy, "id", swag.StringValue(params.Order), cursor, params.Limit)
 
-	dbClusters, err := common.GetClustersFromDBWhere(db, common.UseEagerLoading,
-		common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters)), whereCondition)
+	dbClusters, err = common.GetClustersFromDBWhere(db, common.UseEagerLoading,
+		common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters)))
 	if err != nil {
 		log.WithError(err).Error("Failed to list clusters in db")
 		return common.NewApiError(http.StatusInternalServerError, err)
@@ -2433,7 +3582,22 @@ func (b *bareMetalInventory) ListClusters(ctx context.Context, params installer.
 		c.Hosts = []*models.Host{}
 		clusters = append(clusters, &c.Cluster)
 	}
-	return installer.NewListClustersOK().WithPayload(clusters)
+	nextCursor := common.NextPageCursor(len(clusters), params.Limit, func() *common.PageCursor {
+		last := clusters[len(clusters)-1]
+		return common.NewPageCursor(clusterSortValue(last, sortBy), last.ID.String())
+	})
+	return installer.NewListClustersOK().WithPayload(clusters).WithNextCursor(nextCursor)
+}
+
+func clusterSortValue(c *models.Cluster, sortBy string) interface{} {
+	switch sortBy {
+	case "name":
+		return c.Name
+	case "status_updated_at":
+		return c.StatusUpdatedAt
+	default:
+		return c.CreatedAt
+	}
 }
 
 func (b *bareMetalInventory) GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder {
@@ -2641,11 +3805,26 @@ func (b *bareMetalInventory) DeregisterHostInternal(ctx context.Context, params
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Deregister host: %s cluster %s", params.HostID, params.ClusterID)
 
+	var macAddresses []string
+	if host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String()); err == nil {
+		macAddresses = hostMacAddresses(&host.Host)
+	}
+
 	if err := b.db.Where("id = ? and cluster_id = ?", params.HostID, params.ClusterID).Delete(&common.Host{}).Error; err != nil {
 		// TODO: check error type
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if len(macAddresses) > 0 {
+		if err := b.releaseHostIPLeases(params.ClusterID, macAddresses); err != nil {
+			log.WithError(err).Warnf("Failed releasing the IP leases of host %s", params.HostID)
+		}
+	}
+
+	if err := b.db.Where("host_id = ? and cluster_id = ?", params.HostID, params.ClusterID).Delete(&common.InventorySnapshot{}).Error; err != nil {
+		log.WithError(err).Warnf("Failed deleting the inventory snapshots of host %s", params.HostID)
+	}
+
 	// TODO: need to check that host can be deleted from the cluster
 	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
 		fmt.Sprintf("Host %s: deregistered from cluster", params.HostID.String()), time.Now())
@@ -2685,11 +3864,42 @@ func (b *bareMetalInventory) GetHostById(hostId string) (*common.Host, error) {
 func (b *bareMetalInventory) ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	var hosts []*models.Host
-	if err := b.db.Find(&hosts, "cluster_id = ?", params.ClusterID).Error; err != nil {
+	db := b.db
+	if len(params.Status) > 0 {
+		db = db.Where("status IN (?)", params.Status)
+	}
+	if params.Name != nil {
+		// hosts without a requested hostname are named by the hostname in their inventory
+		name := common.SubstringPattern(*params.Name)
+		db = db.Where("requested_hostname "+common.SubstringFilter+" OR CAST(NULLIF(inventory, '') AS json)->>'hostname' "+
+			common.SubstringFilter, name, name)
+	}
+	if params.CreatedAfter != nil {
+		db = db.Where("created_at > ?", time.Time(*params.CreatedAfter))
+	}
+	if params.CreatedBefore != nil {
+		db = db.Where("created_at < ?", time.Time(*params.CreatedBefore))
+	}
+
+	cursor, err := common.DecodePageCursor(swag.StringValue(params.Cursor))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	sortBy := swag.StringValue(params.SortBy)
+	if sortBy == "" {
+		sortBy = "created_at"
+	}
+	db = common.Paginate(db, sortBy, "id", swag.StringValue(params.Order), cursor, params.Limit)
+
+	if err = db.Find(&hosts, "cluster_id = ?", params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to get list of hosts for cluster %s", params.ClusterID)
 		return installer.NewListHostsInternalServerError().
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
+	nextCursor := common.NextPageCursor(len(hosts), params.Limit, func() *common.PageCursor {
+		last := hosts[len(hosts)-1]
+		return common.NewPageCursor(hostSortValue(last, sortBy), last.ID.String())
+	})
 
 	for _, host := range hosts {
 		if err := b.customizeHost(host); err != nil {
@@ -2699,7 +3909,18 @@ func (b *bareMetalInventory) ListHosts(ctx context.Context, params installer.Lis
 		host.FreeAddresses = ""
 	}
 
-	return installer.NewListHostsOK().WithPayload(hosts)
+	return installer.NewListHostsOK().WithPayload(hosts).WithNextCursor(nextCursor)
+}
+
+func hostSortValue(h *models.Host, sortBy string) interface{} {
+	switch sortBy {
+	case "requested_hostname":
+		return h.RequestedHostname
+	case "status_updated_at":
+		return h.StatusUpdatedAt
+	default:
+		return h.CreatedAt
+	}
 }
 
 func (b *bareMetalInventory) UpdateHostInstallerArgsInternal(ctx context.Context, params installer.UpdateHostInstallerArgsParams) (*models.Host, error) {
@@ -2721,7 +3942,8 @@ func (b *bareMetalInventory) UpdateHostInstallerArgsInternal(ctx context.Context
 		return nil, err
 	}
 
-	err = b.db.Model(&common.Host{}).Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).Update("installer_args", string(argsBytes)).Error
+	query, args := identity.AddHostUserFilter(ctx, "id = ? and cluster_id = ?", params.HostID, params.ClusterID)
+	err = b.db.Model(&common.Host{}).Where(query, args...).Update("installer_args", string(argsBytes)).Error
 	if err != nil {
 		log.WithError(err).Errorf("failed to update host %s", params.HostID)
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
@@ -3063,7 +4285,7 @@ func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInvento
 	var err error
 	switch params.Reply.StepType {
 	case models.StepTypeInventory:
-		err = b.hostApi.UpdateInventory(ctx, &host, stepReply)
+		err = b.updateInventory(ctx, &host, stepReply)
 	case models.StepTypeConnectivityCheck:
 		err = b.hostApi.UpdateConnectivityReport(ctx, &host, stepReply)
 	case models.StepTypeAPIVipConnectivityCheck:
@@ -3082,6 +4304,84 @@ func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInvento
 	return err
 }
 
+// updateInventory saves the inventory of the host. The imported settings of the host are applied with its first
+// inventory in the same transaction, so that the inventory isn't saved without them and they are applied again
+// when the host sends its inventory again.
+func (b *bareMetalInventory) updateInventory(ctx context.Context, host *models.Host, inventoryStr string) error {
+	firstInventory := host.Inventory == ""
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		if err := b.hostApi.UpdateInventory(ctx, host, inventoryStr, tx); err != nil {
+			return err
+		}
+		if !firstInventory {
+			return nil
+		}
+		return b.applyHostAssignment(ctx, tx, host, inventoryStr)
+	})
+}
+
+// applyHostAssignment applies the settings of an imported host to the host, if one of its interfaces has one of
+// the MAC addresses of the imported host. The settings are applied when the first inventory of the host is
+// received, so that the user may change them afterwards.
+func (b *bareMetalInventory) applyHostAssignment(ctx context.Context, db *gorm.DB, host *models.Host, inventoryStr string) error {
+	log := logutil.FromContext(ctx, b.log)
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(inventoryStr), &inventory); err != nil {
+		return err
+	}
+	macAddresses := make([]string, 0, len(inventory.Interfaces))
+	for _, intf := range inventory.Interfaces {
+		if intf.MacAddress != "" {
+			macAddresses = append(macAddresses, strings.ToLower(intf.MacAddress))
+		}
+	}
+	if len(macAddresses) == 0 {
+		return nil
+	}
+
+	var assignment common.HostAssignment
+	if err := db.Take(&assignment, "cluster_id = ? and mac_address in (?)", host.ClusterID.String(), macAddresses).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get the imported settings of host %s", host.ID.String())
+	}
+
+	log.Infof("Applying the imported settings to host %s of cluster %s", host.ID.String(), host.ClusterID.String())
+	if assignment.Role != "" && assignment.Role != host.Role {
+		if err := b.hostApi.UpdateRole(ctx, host, assignment.Role, db); err != nil {
+			log.WithError(err).Warnf("failed to set the imported role %s of host %s", assignment.Role, host.ID.String())
+		}
+	}
+	if assignment.RequestedHostname != "" {
+		if err := b.hostApi.UpdateHostname(ctx, host, assignment.RequestedHostname, db); err != nil {
+			log.WithError(err).Warnf("failed to set the imported hostname %s of host %s", assignment.RequestedHostname, host.ID.String())
+		}
+	}
+	if assignment.MachineConfigPoolName != "" && hostutil.IsDay2Host(host) {
+		if err := b.hostApi.UpdateMachineConfigPoolName(ctx, db, host, assignment.MachineConfigPoolName); err != nil {
+			log.WithError(err).Warnf("failed to set the imported machine config pool %s of host %s",
+				assignment.MachineConfigPoolName, host.ID.String())
+		}
+	}
+	return nil
+}
+
+// hostMacAddresses returns the lowercase MAC addresses of the interfaces in the inventory of the host
+func hostMacAddresses(host *models.Host) []string {
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
+		return nil
+	}
+	ret := make([]string, 0, len(inventory.Interfaces))
+	for _, intf := range inventory.Interfaces {
+		if intf.MacAddress != "" {
+			ret = append(ret, strings.ToLower(intf.MacAddress))
+		}
+	}
+	return ret
+}
+
 func logReplyReceived(params installer.PostStepReplyParams, log logrus.FieldLogger) {
 	message := fmt.Sprintf("Received step reply <%s> from cluster <%s> host <%s> exit-code <%d> stderr <%s>",
 		params.Reply.StepID, params.ClusterID, params.HostID, params.Reply.ExitCode, params.Reply.Error)
@@ -3505,7 +4805,8 @@ func (b *bareMetalInventory) UpdateHostIgnitionInternal(ctx context.Context, par
 		}
 	}
 
-	err = b.db.Model(&common.Host{}).Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).Update("ignition_config_overrides", params.HostIgnitionParams.Config).Error
+	query, args := identity.AddHostUserFilter(ctx, "id = ? and cluster_id = ?", params.HostID, params.ClusterID)
+	err = b.db.Model(&common.Host{}).Where(query, args...).Update("ignition_config_overrides", params.HostIgnitionParams.Config).Error
 	if err != nil {
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
@@ -3546,6 +4847,41 @@ func (b *bareMetalInventory) GetHostIgnition(ctx context.Context, params install
 	return installer.NewGetHostIgnitionOK().WithPayload(&models.HostIgnitionParams{Config: string(respBytes)})
 }
 
+func (b *bareMetalInventory) GetHostInventoryHistory(ctx context.Context, params installer.GetHostInventoryHistoryParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	if _, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String()); err != nil {
+		log.WithError(err).Errorf("failed to find host %s", params.HostID)
+		return common.NewApiError(http.StatusNotFound, err)
+	}
+
+	var snapshots []*common.InventorySnapshot
+	if err := b.db.Where("cluster_id = ? and host_id = ?", params.ClusterID.String(), params.HostID.String()).
+		Order("id").Find(&snapshots).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the inventory snapshots of host %s", params.HostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	history := models.HostInventoryHistory{}
+	var previous *models.Inventory
+	for _, snapshot := range snapshots {
+		changes := []*models.InventoryChange{}
+		inventory, err := hostutil.UnmarshalInventory(snapshot.Inventory)
+		if err != nil {
+			log.WithError(err).Warnf("failed to parse an inventory snapshot of host %s", params.HostID)
+		} else if previous != nil {
+			changes = hostutil.DiffInventories(previous, inventory)
+		}
+		previous = inventory
+		history = append(history, &models.HostInventorySnapshot{
+			ReportedAt: strfmt.DateTime(snapshot.ReportedAt),
+			Inventory:  snapshot.Inventory,
+			Changes:    changes,
+		})
+	}
+	return installer.NewGetHostInventoryHistoryOK().WithPayload(history)
+}
+
 func (b *bareMetalInventory) DownloadHostIgnition(ctx context.Context, params installer.DownloadHostIgnitionParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	fileName, respBody, contentLength, err := b.downloadHostIgnition(ctx, params.ClusterID.String(), params.HostID.String())
@@ -4337,7 +5673,8 @@ func (b *bareMetalInventory) UpdateHostApprovedInternal(ctx context.Context, clu
 	if err != nil {
 		return err
 	}
-	err = b.db.Model(&common.Host{}).Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), hostId, clusterId).Update("approved", approved).Error
+	query, args := identity.AddHostUserFilter(ctx, "id = ? and cluster_id = ?", hostId, clusterId)
+	err = b.db.Model(&common.Host{}).Where(query, args...).Update("approved", approved).Error
 	if err != nil {
 		log.WithError(err).Errorf("failed to update 'approved' in host: %s", hostId)
 		return err
@@ -40,6 +40,7 @@ import (
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
@@ -152,7 +153,7 @@ func strToUUID(s string) *strfmt.UUID {
 
 func mockGenerateInstallConfigSuccess(mockGenerator *generator.MockISOInstallConfigGenerator, mockVersions *versions.MockHandler) {
 	if mockGenerator != nil {
-		mockVersions.EXPECT().GetReleaseImage(gomock.Any()).Return("releaseImage", nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return("releaseImage", nil).Times(1)
 		mockGenerator.EXPECT().GenerateInstallConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	}
 }
@@ -216,7 +217,7 @@ var _ = Describe("GenerateClusterISO", func() {
 
 	mockUploadIso := func(cluster *common.Cluster, returnValue error) {
 		srcIso := "rhcos"
-		mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return(srcIso, nil).Times(1)
+		mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion, cluster.CPUArchitecture).Return(srcIso, nil).Times(1)
 		mockS3Client.EXPECT().UploadISO(gomock.Any(), gomock.Any(), srcIso,
 			fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, cluster.ID.String())).Return(returnValue).Times(1)
 	}
@@ -654,7 +655,7 @@ var _ = Describe("GenerateClusterISO", func() {
 
 		It("Creates the iso successfully", func() {
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
-			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion, cluster.CPUArchitecture).Return("rhcos-minimal.iso", nil)
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			editor := isoeditor.NewMockEditor(ctrl)
 			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any()).Return(isoFilePath, nil)
@@ -699,7 +700,7 @@ var _ = Describe("GenerateClusterISO", func() {
 			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any()).Return(isoFilePath, nil)
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, fmt.Sprintf("discovery-image-%s.iso", cluster.ID))
-			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion, cluster.CPUArchitecture).Return("rhcos-minimal.iso", nil)
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, "Generated image (Image type is \"minimal-iso\", SSH public key is not set)", gomock.Any())
 			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
@@ -716,7 +717,7 @@ var _ = Describe("GenerateClusterISO", func() {
 
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
-			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("", errors.New(expectedErrMsg))
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion, cluster.CPUArchitecture).Return("", errors.New(expectedErrMsg))
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", gomock.Any())
 			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
 			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(0)
@@ -731,7 +732,7 @@ var _ = Describe("GenerateClusterISO", func() {
 
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
-			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion, cluster.CPUArchitecture).Return("rhcos-minimal.iso", nil)
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(nil, int64(0), errors.New(expectedErrMsg))
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", gomock.Any())
 			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
@@ -747,7 +748,7 @@ var _ = Describe("GenerateClusterISO", func() {
 
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
-			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion, cluster.CPUArchitecture).Return("rhcos-minimal.iso", nil)
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			mockIsoEditorFactory.EXPECT().WithEditor(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New(expectedErrMsg))
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", gomock.Any())
@@ -764,7 +765,7 @@ var _ = Describe("GenerateClusterISO", func() {
 
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
-			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion, cluster.CPUArchitecture).Return("rhcos-minimal.iso", nil)
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			editor := isoeditor.NewMockEditor(ctrl)
 			stubWithEditor(mockIsoEditorFactory, editor)
@@ -783,7 +784,7 @@ var _ = Describe("GenerateClusterISO", func() {
 
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
-			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion, cluster.CPUArchitecture).Return("rhcos-minimal.iso", nil)
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			editor := isoeditor.NewMockEditor(ctrl)
 			stubWithEditor(mockIsoEditorFactory, editor)
@@ -1166,6 +1167,92 @@ var _ = Describe("PostStepReply", func() {
 		common.DeleteTestDB(db, dbName)
 	})
 
+	Context("Imported host settings", func() {
+		var (
+			clusterId strfmt.UUID
+			hostId    strfmt.UUID
+			params    installer.PostStepReplyParams
+		)
+
+		BeforeEach(func() {
+			clusterId = strfmt.UUID(uuid.New().String())
+			hostId = strfmt.UUID(uuid.New().String())
+			addHost(hostId, models.HostRoleAutoAssign, models.HostStatusDiscovering, models.HostKindHost, clusterId, "", db)
+			inventory, err := json.Marshal(&models.Inventory{
+				Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "52:54:00:AA:BB:CC"}},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			params = installer.PostStepReplyParams{
+				ClusterID: clusterId,
+				HostID:    hostId,
+				Reply: &models.StepReply{
+					Output:   string(inventory),
+					StepType: models.StepTypeInventory,
+				},
+			}
+		})
+
+		It("applies the settings of the imported host with the MAC address", func() {
+			Expect(db.Create(&common.HostAssignment{
+				ClusterID:         clusterId,
+				MacAddress:        "52:54:00:aa:bb:cc",
+				Role:              models.HostRoleMaster,
+				RequestedHostname: "master-0",
+			}).Error).ShouldNot(HaveOccurred())
+			mockHostApi.EXPECT().UpdateInventory(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockHostApi.EXPECT().UpdateRole(ctx, gomock.Any(), models.HostRoleMaster, gomock.Any()).Return(nil)
+			mockHostApi.EXPECT().UpdateHostname(ctx, gomock.Any(), "master-0", gomock.Any()).Return(nil)
+
+			Expect(bm.PostStepReply(ctx, params)).Should(BeAssignableToTypeOf(installer.NewPostStepReplyNoContent()))
+		})
+
+		It("applies the settings in the transaction that saves the inventory", func() {
+			Expect(db.Create(&common.HostAssignment{
+				ClusterID:  clusterId,
+				MacAddress: "52:54:00:aa:bb:cc",
+				Role:       models.HostRoleMaster,
+			}).Error).ShouldNot(HaveOccurred())
+			var inventoryDB *gorm.DB
+			mockHostApi.EXPECT().UpdateInventory(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *models.Host, _ string, tx *gorm.DB) error {
+					inventoryDB = tx
+					return nil
+				})
+			mockHostApi.EXPECT().UpdateRole(ctx, gomock.Any(), models.HostRoleMaster, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *models.Host, _ models.HostRole, tx *gorm.DB) error {
+					Expect(tx).ShouldNot(BeIdenticalTo(db))
+					Expect(tx).Should(BeIdenticalTo(inventoryDB))
+					return nil
+				})
+
+			Expect(bm.PostStepReply(ctx, params)).Should(BeAssignableToTypeOf(installer.NewPostStepReplyNoContent()))
+		})
+
+		It("does not apply settings of other MAC addresses", func() {
+			Expect(db.Create(&common.HostAssignment{
+				ClusterID:  clusterId,
+				MacAddress: "52:54:00:aa:bb:dd",
+				Role:       models.HostRoleMaster,
+			}).Error).ShouldNot(HaveOccurred())
+			mockHostApi.EXPECT().UpdateInventory(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			Expect(bm.PostStepReply(ctx, params)).Should(BeAssignableToTypeOf(installer.NewPostStepReplyNoContent()))
+		})
+
+		It("does not apply the settings again once the host has an inventory", func() {
+			Expect(db.Model(&models.Host{}).Where("id = ?", hostId.String()).
+				Update("inventory", params.Reply.Output).Error).ShouldNot(HaveOccurred())
+			Expect(db.Create(&common.HostAssignment{
+				ClusterID:  clusterId,
+				MacAddress: "52:54:00:aa:bb:cc",
+				Role:       models.HostRoleMaster,
+			}).Error).ShouldNot(HaveOccurred())
+			mockHostApi.EXPECT().UpdateInventory(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			Expect(bm.PostStepReply(ctx, params)).Should(BeAssignableToTypeOf(installer.NewPostStepReplyNoContent()))
+		})
+	})
+
 	Context("Media disconnection", func() {
 		var (
 			clusterId *strfmt.UUID
@@ -2649,6 +2736,65 @@ var _ = Describe("cluster", func() {
 					Expect(actual.Payload.MonitoredOperators).To(ContainElement(&expectedMonitoredOperator))
 				})
 
+				It("OLM register with a selected channel and ClusterServiceVersion", func() {
+					newOperatorName := testOLMOperators[0].Name
+
+					mockClusterRegisterSuccess(bm, true)
+					mockGetOperatorByName(newOperatorName)
+					mockOperatorManager.EXPECT().ValidateSubscriptions(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(nil).Times(1)
+					mockOperatorManager.EXPECT().ResolveDependencies(gomock.Any()).
+						DoAndReturn(func(operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+							return operators, nil
+						}).Times(1)
+
+					reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+						NewClusterParams: &models.ClusterCreateParams{
+							Name:             swag.String("some-cluster-name"),
+							OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+							PullSecret:       swag.String("{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"),
+							OlmOperators: []*models.OperatorCreateParams{
+								{Name: newOperatorName, Channel: "stable", Csv: "operator.v1.0.0"},
+							},
+						},
+					})
+					Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewRegisterClusterCreated())))
+					actual := reply.(*installer.RegisterClusterCreated)
+
+					expectedMonitoredOperator := models.MonitoredOperator{
+						Name:             newOperatorName,
+						Channel:          "stable",
+						Csv:              "operator.v1.0.0",
+						OperatorType:     testOLMOperators[0].OperatorType,
+						TimeoutSeconds:   testOLMOperators[0].TimeoutSeconds,
+						Namespace:        testOLMOperators[0].Namespace,
+						SubscriptionName: testOLMOperators[0].SubscriptionName,
+						ClusterID:        *actual.Payload.ID,
+					}
+					Expect(actual.Payload.MonitoredOperators).To(ContainElement(&expectedMonitoredOperator))
+				})
+
+				It("OLM register with an incompatible channel", func() {
+					newOperatorName := testOLMOperators[0].Name
+
+					mockVersions.EXPECT().GetVersion(gomock.Any()).Return(common.TestDefaultConfig.Version, nil).Times(1)
+					mockOperatorManager.EXPECT().GetSupportedOperatorsByType(models.OperatorTypeBuiltin).Return([]*models.MonitoredOperator{&common.TestDefaultConfig.MonitoredOperator}).Times(1)
+					mockGetOperatorByName(newOperatorName)
+					mockOperatorManager.EXPECT().ValidateSubscriptions(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).
+						Return(errors.Errorf("Channel unknown of operator %s is not compatible", newOperatorName)).Times(1)
+
+					reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+						NewClusterParams: &models.ClusterCreateParams{
+							Name:             swag.String("some-cluster-name"),
+							OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+							PullSecret:       swag.String("{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"),
+							OlmOperators: []*models.OperatorCreateParams{
+								{Name: newOperatorName, Channel: "unknown"},
+							},
+						},
+					})
+					verifyApiError(reply, http.StatusBadRequest)
+				})
+
 				It("Resolve OLM dependencies", func() {
 					newOperatorName := testOLMOperators[1].Name
 
@@ -3287,6 +3433,54 @@ var _ = Describe("cluster", func() {
 			})
 		})
 
+		Context("Failure domains", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID: &clusterID,
+				}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				addHost(masterHostId1, models.HostRoleMaster, "known", models.HostKindHost, clusterID, getInventoryStr("1.2.3.4/24", "10.11.50.90/16"), db)
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+			})
+
+			It("Valid failure domain", func() {
+				mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Cluster{}, nil).Times(1)
+				mockHostApi.EXPECT().RefreshInventory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						HostsFailureDomains: []*models.ClusterUpdateParamsHostsFailureDomainsItems0{
+							{
+								FailureDomain: "rack-a",
+								ID:            masterHostId1,
+							},
+						},
+					}})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				h, err := common.GetHostFromDB(db, clusterID.String(), masterHostId1.String())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(h.FailureDomain).To(Equal("rack-a"))
+			})
+
+			It("Unknown host", func() {
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						HostsFailureDomains: []*models.ClusterUpdateParamsHostsFailureDomainsItems0{
+							{
+								FailureDomain: "rack-a",
+								ID:            strfmt.UUID(uuid.New().String()),
+							},
+						},
+					}})
+				verifyApiError(reply, http.StatusNotFound)
+			})
+		})
+
 		Context("Installation Disk Path", func() {
 			BeforeEach(func() {
 				clusterID = strfmt.UUID(uuid.New().String())
@@ -3904,6 +4098,104 @@ var _ = Describe("cluster", func() {
 				})
 			})
 
+			Context("Validation policy", func() {
+				It("Set validation policy", func() {
+					mockSuccess(1)
+
+					policy := &models.ValidationPolicy{
+						DisabledHostValidations:          []string{string(models.HostValidationIDNtpSynced)},
+						DisabledClusterValidations:       []string{string(models.ClusterValidationIDNtpServerConfigured)},
+						InstallationDiskSpeedThresholdMs: swag.Int64(40),
+						MaxTimeDiffMinutes:               swag.Int64(10),
+					}
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ValidationPolicy: policy,
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+					actual := reply.(*installer.UpdateClusterCreated)
+					var stored models.ValidationPolicy
+					Expect(json.Unmarshal([]byte(actual.Payload.ValidationPolicy), &stored)).ToNot(HaveOccurred())
+					Expect(stored).To(Equal(*policy))
+				})
+
+				It("Clear validation policy", func() {
+					mockSuccess(2)
+
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ValidationPolicy: &models.ValidationPolicy{MaxTimeDiffMinutes: swag.Int64(10)},
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+					reply = bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ValidationPolicy: &models.ValidationPolicy{},
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+					policy, err := common.GetValidationPolicy(&common.Cluster{Cluster: *reply.(*installer.UpdateClusterCreated).Payload})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(policy.MaxTimeDiffMinutes).To(BeNil())
+				})
+
+				It("Invalid validation policy", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ValidationPolicy: &models.ValidationPolicy{DisabledHostValidations: []string{""}},
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+					Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+				})
+
+				for _, policy := range []*models.ValidationPolicy{
+					{DisabledHostValidations: []string{"ntp-sync"}},
+					{DisabledHostValidations: []string{"custom-"}},
+					{DisabledHostValidations: []string{string(models.ClusterValidationIDPullSecretSet)}},
+					{DisabledClusterValidations: []string{string(models.HostValidationIDNtpSynced)}},
+					{DisabledClusterValidations: []string{"custom-two-nics"}},
+				} {
+					policy := policy
+					It(fmt.Sprintf("Unknown validation ID in the validation policy %v %v", policy.DisabledHostValidations, policy.DisabledClusterValidations), func() {
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID:           clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{ValidationPolicy: policy},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+				}
+
+				It("Disable the validation of a host validation rule", func() {
+					mockSuccess(1)
+
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ValidationPolicy: &models.ValidationPolicy{DisabledHostValidations: []string{"custom-two-nics"}},
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				})
+
+				It("Validation policy with an out of range threshold", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ValidationPolicy: &models.ValidationPolicy{PacketLossPercentage: swag.Float64(101)},
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+					Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+				})
+			})
+
 			Context("VIP DHCP allocation with IPv6", func() {
 
 				It("Fail to set IPv6 machine CIDR and VIP DHCP true", func() {
@@ -3966,6 +4258,105 @@ var _ = Describe("cluster", func() {
 
 				})
 			})
+
+			Context("Dual-stack", func() {
+				It("Update dual-stack networks and VIPs", func() {
+					mockSuccess(1)
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ClusterNetworks: []*models.ClusterNetwork{
+								{Cidr: "10.128.0.0/14", HostPrefix: 23},
+								{Cidr: "fd01::/48", HostPrefix: 64},
+							},
+							ServiceNetworks: []string{"172.30.0.0/16", "fd02::/112"},
+							APIVips:         []string{"10.11.12.15", "1001:db8::64"},
+							IngressVips:     []string{"10.11.12.16", "1001:db8::65"},
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+					actual := reply.(*installer.UpdateClusterCreated)
+					Expect(actual.Payload.APIVip).To(Equal("10.11.12.15"))
+					Expect(actual.Payload.APIVips).To(Equal([]string{"10.11.12.15", "1001:db8::64"}))
+					Expect(actual.Payload.IngressVips).To(Equal([]string{"10.11.12.16", "1001:db8::65"}))
+					Expect(actual.Payload.ClusterNetworkCidr).To(Equal("10.128.0.0/14"))
+					Expect(actual.Payload.ClusterNetworkHostPrefix).To(Equal(int64(23)))
+					Expect(actual.Payload.ClusterNetworks).To(Equal([]*models.ClusterNetwork{
+						{Cidr: "10.128.0.0/14", HostPrefix: 23},
+						{Cidr: "fd01::/48", HostPrefix: 64},
+					}))
+					Expect(actual.Payload.ServiceNetworks).To(Equal([]string{"172.30.0.0/16", "fd02::/112"}))
+				})
+				It("Fail on a VIP conflicting with the VIPs list", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							APIVip:  swag.String("10.11.12.15"),
+							APIVips: []string{"10.11.12.16", "1001:db8::64"},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest, "API VIPs 10.11.12.16, 1001:db8::64 conflict with 10.11.12.15")
+				})
+				It("Fail on an IPv6 VIP before the IPv4 one", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							APIVips: []string{"1001:db8::64", "10.11.12.15"},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest, "API VIPs of a dual-stack cluster must be an IPv4 address followed by an IPv6 address")
+				})
+				It("Fail on cluster and service networks of different families", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ClusterNetworks: []*models.ClusterNetwork{
+								{Cidr: "10.128.0.0/14", HostPrefix: 23},
+								{Cidr: "fd01::/48", HostPrefix: 64},
+							},
+							ServiceNetworks: []string{"172.30.0.0/16"},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest,
+						"Cluster networks 10.128.0.0/14, fd01::/48 and service networks 172.30.0.0/16 must be of the same IP address families")
+				})
+				It("Fail to enable VIP DHCP allocation with dual-stack networks", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							VipDhcpAllocation: swag.Bool(true),
+							ServiceNetworks:   []string{"172.30.0.0/16", "fd02::/112"},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest, "VIP DHCP allocation is unsupported with dual-stack networks")
+				})
+			})
+			Context("Multi-subnet", func() {
+				It("Fail on multiple machine networks without user-managed networking", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							UserManagedNetworking: swag.Bool(false),
+							VipDhcpAllocation:     swag.Bool(false),
+							MachineNetworks:       []string{"10.11.0.0/16", "10.12.0.0/16"},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest,
+						"Multiple machine networks of the same IP address family require User Managed Networking")
+				})
+				It("Fail on overlapping machine networks", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							UserManagedNetworking: swag.Bool(true),
+							VipDhcpAllocation:     swag.Bool(false),
+							MachineNetworks:       []string{"10.11.0.0/16", "10.11.12.0/24"},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest,
+						"Machine networks: CIDRS 10.11.0.0/16 and 10.11.12.0/24 overlap")
+				})
+			})
 		})
 	})
 
@@ -4050,6 +4441,42 @@ var _ = Describe("cluster", func() {
 			Expect(count).To(Equal(int64(1)))
 		})
 
+		It("marks the pending installation schedule as triggered", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(map[string]interface{}{
+				"install_schedule_status":      models.InstallScheduleStatusPending,
+				"install_schedule_status_info": "Waiting for the installation window and policy",
+			}).Error).ShouldNot(HaveOccurred())
+			mockAutoAssignSuccess(3)
+			mockClusterRefreshStatusSuccess()
+			mockClusterIsReadyForInstallationSuccess()
+			mockGenerateAdditionalManifestsSuccess()
+			mockGetInstallConfigSuccess(mockInstallConfigBuilder)
+			mockGenerateInstallConfigSuccess(mockGenerator, mockVersions)
+			mockClusterPrepareForInstallationSuccess(mockClusterApi)
+			mockHostPrepareForRefresh(mockHostApi)
+			mockHandlePreInstallationSuccess(mockClusterApi, DoneChannel)
+			setDefaultGetMasterNodesIds(mockClusterApi)
+			setDefaultHostSetBootstrap(mockClusterApi)
+			setIsReadyForInstallationTrue(mockClusterApi)
+			mockClusterRefreshStatus(mockClusterApi)
+			mockClusterDeleteLogsSuccess(mockClusterApi)
+			mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+			mockEvents.EXPECT().
+				AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				MinTimes(0)
+
+			reply := bm.InstallCluster(ctx, installer.InstallClusterParams{
+				ClusterID: clusterID,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewInstallClusterAccepted()))
+			waitForDoneChannel()
+
+			c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c.InstallSchedule.Status).To(Equal(models.InstallScheduleStatusTriggered))
+			Expect(c.InstallSchedule.StatusInfo).To(Equal("Installation was started"))
+		})
+
 		It("cluster doesn't exists", func() {
 			reply := bm.InstallCluster(ctx, installer.InstallClusterParams{
 				ClusterID: strfmt.UUID(uuid.New().String()),
@@ -4701,6 +5128,154 @@ var _ = Describe("List clusters", func() {
 			Expect(len(payload)).Should(Equal(1))
 		})
 	})
+
+	Context("filter and paginate", func() {
+		BeforeEach(func() {
+			for i, name := range []string{"other1", "other2", "other3"} {
+				id := strfmt.UUID(uuid.New().String())
+				Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID:               &id,
+					OpenshiftVersion: "4.7",
+					Name:             name,
+					Status:           swag.String(models.ClusterStatusInstalled),
+					CreatedAt:        strfmt.DateTime(time.Now().Add(time.Duration(i+1) * time.Hour)),
+				}}).Error).ShouldNot(HaveOccurred())
+			}
+		})
+
+		It("filters by status, version and name", func() {
+			resp := bm.ListClusters(ctx, installer.ListClustersParams{Status: []string{models.ClusterStatusInstalled}})
+			Expect(resp.(*installer.ListClustersOK).Payload).Should(HaveLen(3))
+			resp = bm.ListClusters(ctx, installer.ListClustersParams{OpenshiftVersion: swag.String("4.7")})
+			Expect(resp.(*installer.ListClustersOK).Payload).Should(HaveLen(3))
+			resp = bm.ListClusters(ctx, installer.ListClustersParams{Name: swag.String("ER2")})
+			payload := resp.(*installer.ListClustersOK).Payload
+			Expect(payload).Should(HaveLen(1))
+			Expect(payload[0].Name).Should(Equal("other2"))
+		})
+
+		It("filters by creation time", func() {
+			after := strfmt.DateTime(time.Now().Add(90 * time.Minute))
+			resp := bm.ListClusters(ctx, installer.ListClustersParams{CreatedAfter: &after})
+			Expect(resp.(*installer.ListClustersOK).Payload).Should(HaveLen(2))
+			resp = bm.ListClusters(ctx, installer.ListClustersParams{CreatedBefore: &after})
+			Expect(resp.(*installer.ListClustersOK).Payload).Should(HaveLen(2))
+		})
+
+		It("paginates sorted by name", func() {
+			var names []string
+			cursor := ""
+			for pages := 0; pages == 0 || cursor != ""; pages++ {
+				Expect(pages).Should(BeNumerically("<", 3))
+				resp := bm.ListClusters(ctx, installer.ListClustersParams{
+					SortBy: swag.String("name"),
+					Order:  swag.String(common.SortOrderDesc),
+					Limit:  swag.Int64(2),
+					Cursor: swag.String(cursor),
+				})
+				ok := resp.(*installer.ListClustersOK)
+				for _, cluster := range ok.Payload {
+					names = append(names, cluster.Name)
+				}
+				cursor = ok.NextCursor
+			}
+			Expect(names).Should(Equal([]string{"other3", "other2", "other1", "mycluster"}))
+		})
+
+		It("paginates over clusters without a status update time", func() {
+			Expect(db.Exec("UPDATE clusters SET status_updated_at = NULL WHERE name IN (?)", []string{"other1", "other3"}).Error).
+				ShouldNot(HaveOccurred())
+			var names []string
+			cursor := ""
+			for pages := 0; pages == 0 || cursor != ""; pages++ {
+				Expect(pages).Should(BeNumerically("<", 4))
+				resp := bm.ListClusters(ctx, installer.ListClustersParams{
+					SortBy: swag.String("status_updated_at"),
+					Limit:  swag.Int64(1),
+					Cursor: swag.String(cursor),
+				})
+				ok := resp.(*installer.ListClustersOK)
+				for _, cluster := range ok.Payload {
+					names = append(names, cluster.Name)
+				}
+				cursor = ok.NextCursor
+			}
+			Expect(names).Should(ConsistOf("mycluster", "other1", "other2", "other3"))
+		})
+
+		It("rejects an invalid cursor", func() {
+			resp := bm.ListClusters(ctx, installer.ListClustersParams{Cursor: swag.String("not a cursor")})
+			verifyApiError(resp, http.StatusBadRequest)
+		})
+	})
+})
+
+var _ = Describe("List hosts", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		dbName    string
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		for _, name := range []string{"master-0", "master-1", "worker-0"} {
+			addHost(strfmt.UUID(uuid.New().String()), models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost,
+				clusterID, getInventoryStr(name, "bios", "1.2.3.4/24"), db)
+		}
+		requested := addHost(strfmt.UUID(uuid.New().String()), models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost,
+			clusterID, getInventoryStr("localhost", "bios", "1.2.3.5/24"), db)
+		Expect(db.Model(&requested).Update("requested_hostname", "worker-1").Error).ShouldNot(HaveOccurred())
+		addHost(strfmt.UUID(uuid.New().String()), models.HostRoleAutoAssign, models.HostStatusDiscovering, models.HostKindHost,
+			clusterID, "", db)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	names := func(resp middleware.Responder) []string {
+		ret := make([]string, 0)
+		for _, h := range resp.(*installer.ListHostsOK).Payload {
+			ret = append(ret, h.RequestedHostname)
+		}
+		return ret
+	}
+
+	It("filters by the requested hostname and by the inventory hostname", func() {
+		resp := bm.ListHosts(ctx, installer.ListHostsParams{ClusterID: clusterID, Name: swag.String("MASTER")})
+		Expect(names(resp)).Should(ConsistOf("master-0", "master-1"))
+		resp = bm.ListHosts(ctx, installer.ListHostsParams{ClusterID: clusterID, Name: swag.String("worker")})
+		Expect(names(resp)).Should(ConsistOf("worker-0", "worker-1"))
+	})
+
+	It("paginates sorted by the requested hostname", func() {
+		var listed []string
+		cursor := ""
+		for pages := 0; pages == 0 || cursor != ""; pages++ {
+			Expect(pages).Should(BeNumerically("<", 5))
+			resp := bm.ListHosts(ctx, installer.ListHostsParams{
+				ClusterID: clusterID,
+				SortBy:    swag.String("requested_hostname"),
+				Limit:     swag.Int64(2),
+				Cursor:    swag.String(cursor),
+			})
+			listed = append(listed, names(resp)...)
+			cursor = resp.(*installer.ListHostsOK).NextCursor
+		}
+		Expect(listed).Should(HaveLen(5))
+		Expect(listed[4]).Should(Equal("worker-1"))
+	})
 })
 
 var _ = Describe("Upload and Download logs test", func() {
@@ -5136,6 +5711,167 @@ var _ = Describe("GetClusterInstallConfig", func() {
 	})
 })
 
+var _ = Describe("Installation schedule", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		c := common.Cluster{
+			Cluster: models.Cluster{
+				ID:               &clusterID,
+				OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+				Status:           swag.String(models.ClusterStatusInsufficient),
+			},
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	dateTime := func(d time.Duration) *strfmt.DateTime {
+		t := strfmt.DateTime(time.Now().Add(d))
+		return &t
+	}
+
+	schedule := func(params *models.InstallScheduleParams) middleware.Responder {
+		return bm.ScheduleClusterInstallation(ctx, installer.ScheduleClusterInstallationParams{
+			ClusterID:             clusterID,
+			InstallScheduleParams: params,
+		})
+	}
+
+	It("schedules the installation", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+		response := schedule(&models.InstallScheduleParams{
+			NotBefore:            dateTime(time.Hour),
+			NotAfter:             dateTime(2 * time.Hour),
+			HostsKnownForMinutes: swag.Int64(10),
+		})
+		Expect(response).To(BeAssignableToTypeOf(&installer.ScheduleClusterInstallationOK{}))
+		installSchedule := response.(*installer.ScheduleClusterInstallationOK).Payload.InstallSchedule
+		Expect(installSchedule.Status).To(Equal(models.InstallScheduleStatusPending))
+		Expect(installSchedule.HostsKnownForMinutes).To(Equal(int64(10)))
+		Expect(installSchedule.NotBefore).NotTo(BeNil())
+	})
+
+	It("rejects a window that ends in the past", func() {
+		verifyApiError(schedule(&models.InstallScheduleParams{NotAfter: dateTime(-time.Hour)}), http.StatusBadRequest)
+	})
+
+	It("rejects a window that ends before it starts", func() {
+		verifyApiError(schedule(&models.InstallScheduleParams{
+			NotBefore: dateTime(2 * time.Hour),
+			NotAfter:  dateTime(time.Hour),
+		}), http.StatusBadRequest)
+	})
+
+	It("rejects a cluster that is already installing", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).
+			Update("status", models.ClusterStatusInstalling).Error).ShouldNot(HaveOccurred())
+		verifyApiError(schedule(&models.InstallScheduleParams{}), http.StatusConflict)
+	})
+
+	It("removes the schedule", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(2)
+		Expect(schedule(&models.InstallScheduleParams{})).To(BeAssignableToTypeOf(&installer.ScheduleClusterInstallationOK{}))
+		response := bm.UnscheduleClusterInstallation(ctx, installer.UnscheduleClusterInstallationParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(&installer.UnscheduleClusterInstallationOK{}))
+		installSchedule := response.(*installer.UnscheduleClusterInstallationOK).Payload.InstallSchedule
+		Expect(installSchedule == nil || installSchedule.Status == "").To(BeTrue())
+	})
+
+	It("fails to remove a schedule that doesn't exist", func() {
+		response := bm.UnscheduleClusterInstallation(ctx, installer.UnscheduleClusterInstallationParams{ClusterID: clusterID})
+		verifyApiError(response, http.StatusConflict)
+	})
+})
+
+var _ = Describe("Host validation rules", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+		rules     models.HostValidationRules
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		rules = models.HostValidationRules{{
+			ID:          swag.String("bios-vendor"),
+			Description: "BIOS vendor is Dell",
+			Expression:  swag.String("system_vendor.manufacturer == 'Dell Inc.'"),
+		}}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("has no rules by default", func() {
+		response := bm.ListHostValidationRules(ctx, installer.ListHostValidationRulesParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewListHostValidationRulesOK()))
+		Expect(response.(*installer.ListHostValidationRulesOK).Payload).To(BeEmpty())
+	})
+
+	It("updates the rules and refreshes the hosts", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil)
+		mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo,
+			"Host validation rules of the cluster were updated (1 rules)", gomock.Any())
+
+		response := bm.UpdateHostValidationRules(ctx, installer.UpdateHostValidationRulesParams{
+			ClusterID:           clusterID,
+			HostValidationRules: rules,
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewUpdateHostValidationRulesOK()))
+
+		response = bm.ListHostValidationRules(ctx, installer.ListHostValidationRulesParams{ClusterID: clusterID})
+		Expect(response.(*installer.ListHostValidationRulesOK).Payload).To(Equal(rules))
+	})
+
+	It("rejects invalid rules", func() {
+		rules[0].Expression = swag.String("length(")
+		response := bm.UpdateHostValidationRules(ctx, installer.UpdateHostValidationRulesParams{
+			ClusterID:           clusterID,
+			HostValidationRules: rules,
+		})
+		verifyApiError(response, http.StatusBadRequest)
+	})
+
+	It("rejects updates of clusters that can't be updated", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(errors.Errorf("wrong state"))
+		response := bm.UpdateHostValidationRules(ctx, installer.UpdateHostValidationRulesParams{
+			ClusterID:           clusterID,
+			HostValidationRules: rules,
+		})
+		verifyApiError(response, http.StatusConflict)
+	})
+
+	It("fails for a cluster that does not exist", func() {
+		response := bm.ListHostValidationRules(ctx, installer.ListHostValidationRulesParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("UpdateClusterInstallConfig", func() {
 	var (
 		bm        *bareMetalInventory
@@ -5507,14 +6243,56 @@ var _ = Describe("Register AddHostsCluster test", func() {
 		mockClusterApi.EXPECT().RegisterAddHostsCluster(ctx, gomock.Any()).Return(nil).Times(1)
 		mockMetric.EXPECT().ClusterRegistered(common.TestDefaultConfig.ReleaseVersion, clusterID, "Unknown").Times(1)
 		mockVersions.EXPECT().GetVersion(gomock.Any()).Return(common.TestDefaultConfig.Version, nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), common.DefaultCPUArchitecture).Return("releaseImage", nil).Times(1)
 		res := bm.RegisterAddHostsCluster(ctx, params)
 		actual := res.(*installer.RegisterAddHostsClusterCreated)
 
 		Expect(actual.Payload.HostNetworks).To(Equal(defaultHostNetworks))
 		Expect(actual.Payload.Hosts).To(Equal(defaultHosts))
+		Expect(actual.Payload.CPUArchitecture).To(Equal(common.DefaultCPUArchitecture))
 		Expect(res).Should(BeAssignableToTypeOf(installer.NewRegisterAddHostsClusterCreated()))
 	})
 
+	Context("CPU architecture", func() {
+		var params installer.RegisterAddHostsClusterParams
+
+		BeforeEach(func() {
+			params = installer.RegisterAddHostsClusterParams{
+				HTTPRequest: request,
+				NewAddHostsClusterParams: &models.AddHostsClusterCreateParams{
+					APIVipDnsname:    &apiVIPDnsname,
+					ID:               &clusterID,
+					Name:             &clusterName,
+					OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+					CPUArchitecture:  swag.String("arm64"),
+				},
+			}
+			mockVersions.EXPECT().GetVersion(gomock.Any()).Return(common.TestDefaultConfig.Version, nil).Times(1)
+		})
+
+		It("registers the cluster with the requested CPU architecture", func() {
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), "arm64").Return("releaseImage", nil).Times(1)
+			mockClusterApi.EXPECT().RegisterAddHostsCluster(ctx, gomock.Any()).Return(nil).Times(1)
+			mockMetric.EXPECT().ClusterRegistered(common.TestDefaultConfig.ReleaseVersion, clusterID, "Unknown").Times(1)
+			res := bm.RegisterAddHostsCluster(ctx, params)
+			Expect(res).Should(BeAssignableToTypeOf(installer.NewRegisterAddHostsClusterCreated()))
+			Expect(res.(*installer.RegisterAddHostsClusterCreated).Payload.CPUArchitecture).To(Equal("arm64"))
+		})
+
+		It("fails with bad request when the CPU architecture is not supported", func() {
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), "arm64").
+				Return("", errors.Wrap(versions.ErrCPUArchitectureNotSupported, "no arm64 images")).Times(1)
+			res := bm.RegisterAddHostsCluster(ctx, params)
+			verifyApiError(res, http.StatusBadRequest)
+		})
+
+		It("fails with internal error when the release image cannot be found", func() {
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), "arm64").Return("", errors.New("release image is missing")).Times(1)
+			res := bm.RegisterAddHostsCluster(ctx, params)
+			verifyApiError(res, http.StatusInternalServerError)
+		})
+	})
+
 	It("Create AddHosts cluster -  cluster id already exists", func() {
 		params := installer.RegisterAddHostsClusterParams{
 			HTTPRequest: request,
@@ -6057,6 +6835,36 @@ var _ = Describe("TestRegisterCluster", func() {
 		Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
 	})
 
+	It("CPU architecture not supported", func() {
+		mockVersions.EXPECT().GetVersion(gomock.Any()).Return(common.TestDefaultConfig.Version, nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), "s390x").
+			Return("", errors.Wrap(versions.ErrCPUArchitectureNotSupported, "no s390x images")).Times(1)
+
+		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("some-cluster-name"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				CPUArchitecture:  swag.String("s390x"),
+				PullSecret:       swag.String(""),
+			},
+		})
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("release image lookup failure", func() {
+		mockVersions.EXPECT().GetVersion(gomock.Any()).Return(common.TestDefaultConfig.Version, nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return("", errors.New("release image is missing")).Times(1)
+
+		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("some-cluster-name"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:       swag.String(""),
+			},
+		})
+		verifyApiError(reply, http.StatusInternalServerError)
+	})
+
 	It("openshift release image and version successfully defined", func() {
 		mockClusterRegisterSuccess(bm, true)
 		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
@@ -7109,3 +7917,488 @@ var _ = Describe("AddOpenshiftVersion", func() {
 		Expect(err).Should(HaveOccurred())
 	})
 })
+
+var _ = Describe("GetClusterInstallationReport", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:     &clusterID,
+			Status: swag.String(models.ClusterStatusInstalling),
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("reports the installation timeline of the cluster", func() {
+		startedAt := time.Now().Add(-time.Hour)
+		Expect(common.AddInstallationTimelineEntry(db, clusterID, "", models.ClusterStatusPreparingForInstallation, "", startedAt)).To(Succeed())
+		Expect(common.AddInstallationTimelineEntry(db, clusterID, "", models.ClusterStatusInstalling, "", startedAt.Add(time.Minute))).To(Succeed())
+
+		response := bm.GetClusterInstallationReport(ctx, installer.GetClusterInstallationReportParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewGetClusterInstallationReportOK()))
+		report := response.(*installer.GetClusterInstallationReportOK).Payload
+		Expect(report.ClusterID).To(Equal(clusterID))
+		Expect(report.Phases).To(HaveLen(2))
+		Expect(report.Phases[0].DurationSeconds).To(BeEquivalentTo(60))
+	})
+
+	It("fails for unknown clusters", func() {
+		response := bm.GetClusterInstallationReport(ctx, installer.GetClusterInstallationReportParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
+var _ = Describe("GetHostInventoryHistory", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, clusterID, common.GenerateTestDefaultInventory(), db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("lists the inventory snapshots of the host with their changes", func() {
+		inventory, err := hostutil.UnmarshalInventory(common.GenerateTestDefaultInventory())
+		Expect(err).ToNot(HaveOccurred())
+		first, err := hostutil.MarshalInventory(inventory)
+		Expect(err).ToNot(HaveOccurred())
+		inventory.Memory.PhysicalBytes *= 2
+		second, err := hostutil.MarshalInventory(inventory)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(common.AddInventorySnapshot(db, clusterID, hostID, first, time.Now().Add(-time.Minute))).To(Succeed())
+		Expect(common.AddInventorySnapshot(db, clusterID, hostID, second, time.Now())).To(Succeed())
+
+		response := bm.GetHostInventoryHistory(ctx, installer.GetHostInventoryHistoryParams{ClusterID: clusterID, HostID: hostID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewGetHostInventoryHistoryOK()))
+		history := response.(*installer.GetHostInventoryHistoryOK).Payload
+		Expect(history).To(HaveLen(2))
+		Expect(history[0].Inventory).To(Equal(first))
+		Expect(history[0].Changes).To(BeEmpty())
+		Expect(history[1].Changes).To(HaveLen(1))
+		Expect(history[1].Changes[0].Kind).To(Equal(models.InventoryChangeKindMemoryChanged))
+	})
+
+	It("fails for unknown hosts", func() {
+		response := bm.GetHostInventoryHistory(ctx, installer.GetHostInventoryHistoryParams{ClusterID: clusterID, HostID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
+var _ = Describe("AssignClusterRoles", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		addHost(hostID, models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost, clusterID, common.GenerateTestDefaultInventory(), db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	proposal := func(applied bool) *models.RoleAssignment {
+		return &models.RoleAssignment{
+			Applied: applied,
+			Hosts:   []*models.HostRoleAssignment{{HostID: hostID, CurrentRole: models.HostRoleAutoAssign, Role: models.HostRoleMaster}},
+		}
+	}
+
+	It("proposes the roles on dry run without refreshing the hosts", func() {
+		mockHostApi.EXPECT().AssignRoles(gomock.Any(), gomock.Any(), true, gomock.Any()).Return(proposal(false), nil).Times(1)
+		response := bm.AssignClusterRoles(ctx, installer.AssignClusterRolesParams{
+			ClusterID:         clusterID,
+			AssignRolesParams: &models.RoleAssignmentParams{DryRun: true},
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewAssignClusterRolesOK()))
+		Expect(response.(*installer.AssignClusterRolesOK).Payload.Hosts[0].Role).To(Equal(models.HostRoleMaster))
+	})
+
+	It("refreshes the hosts and the cluster after applying the roles", func() {
+		mockHostApi.EXPECT().AssignRoles(gomock.Any(), gomock.Any(), false, gomock.Any()).Return(proposal(true), nil).Times(1)
+		mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Cluster{}, nil).Times(1)
+		response := bm.AssignClusterRoles(ctx, installer.AssignClusterRolesParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewAssignClusterRolesOK()))
+		Expect(response.(*installer.AssignClusterRolesOK).Payload.Applied).To(BeTrue())
+	})
+
+	It("fails for unknown clusters", func() {
+		response := bm.AssignClusterRoles(ctx, installer.AssignClusterRolesParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("reports the errors of the role assignment", func() {
+		mockHostApi.EXPECT().AssignRoles(gomock.Any(), gomock.Any(), false, gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("bad request"))).Times(1)
+		response := bm.AssignClusterRoles(ctx, installer.AssignClusterRolesParams{ClusterID: clusterID})
+		verifyApiError(response, http.StatusBadRequest)
+	})
+})
+
+var _ = Describe("Cluster static network configs", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+		generator = staticnetworkconfig.New(common.GetTestLog())
+	)
+
+	hostConfig := func(mac, ip string) *models.HostStaticNetworkConfig {
+		return common.FormatStaticConfigHostYAML("eth0", "eth1", ip, "192.168.141.30", "192.168.126.1",
+			models.MacInterfaceMap{{MacAddress: mac, LogicalNicName: "eth0"}})
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:                 &clusterID,
+			MachineNetworkCidr: "192.168.126.0/24",
+			APIVip:             "192.168.126.100",
+			IngressVip:         "192.168.126.101",
+			ImageInfo: &models.ImageInfo{
+				StaticNetworkConfig: generator.FormatStaticNetworkConfigForDB(
+					[]*models.HostStaticNetworkConfig{hostConfig("02:00:00:00:00:10", "192.168.126.30")}),
+			},
+		}}).Error).ShouldNot(HaveOccurred())
+		mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).
+			DoAndReturn(generator.FormatStaticNetworkConfigForDB).AnyTimes()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	listConfigs := func() models.HostStaticNetworkConfigList {
+		response := bm.ListClusterStaticNetworkConfigs(ctx, installer.ListClusterStaticNetworkConfigsParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewListClusterStaticNetworkConfigsOK()))
+		return response.(*installer.ListClusterStaticNetworkConfigsOK).Payload
+	}
+
+	It("lists the static network configs of the discovery ISO", func() {
+		Expect(listConfigs()).To(Equal(models.HostStaticNetworkConfigList{hostConfig("02:00:00:00:00:10", "192.168.126.30")}))
+	})
+
+	It("adds a static network config", func() {
+		config := hostConfig("02:00:00:00:00:20", "192.168.126.31")
+		mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParams([]*models.HostStaticNetworkConfig{config}).Return(nil).Times(1)
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		response := bm.CreateClusterStaticNetworkConfig(ctx, installer.CreateClusterStaticNetworkConfigParams{
+			ClusterID:           clusterID,
+			StaticNetworkConfig: config,
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewCreateClusterStaticNetworkConfigCreated()))
+		Expect(listConfigs()).To(HaveLen(2))
+	})
+
+	It("rejects a static network config whose address is configured for another host", func() {
+		config := hostConfig("02:00:00:00:00:20", "192.168.126.30")
+		mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParams([]*models.HostStaticNetworkConfig{config}).Return(nil).Times(1)
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		response := bm.CreateClusterStaticNetworkConfig(ctx, installer.CreateClusterStaticNetworkConfigParams{
+			ClusterID:           clusterID,
+			StaticNetworkConfig: config,
+		})
+		verifyApiErrorString(response, http.StatusBadRequest,
+			"Address 192.168.126.30 is also configured for the host with MAC 02:00:00:00:00:10")
+		Expect(listConfigs()).To(HaveLen(1))
+	})
+
+	It("rejects a static network config whose MAC address is used by another host", func() {
+		config := hostConfig("02:00:00:00:00:10", "192.168.126.31")
+		mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParams([]*models.HostStaticNetworkConfig{config}).Return(nil).Times(1)
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		response := bm.CreateClusterStaticNetworkConfig(ctx, installer.CreateClusterStaticNetworkConfigParams{
+			ClusterID:           clusterID,
+			StaticNetworkConfig: config,
+		})
+		verifyApiError(response, http.StatusConflict)
+	})
+
+	It("replaces a static network config by any of its MAC addresses", func() {
+		config := hostConfig("02:00:00:00:00:10", "192.168.126.40")
+		mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParams([]*models.HostStaticNetworkConfig{config}).Return(nil).Times(1)
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		response := bm.UpdateClusterStaticNetworkConfig(ctx, installer.UpdateClusterStaticNetworkConfigParams{
+			ClusterID:           clusterID,
+			MacAddress:          "02:00:00:00:00:10",
+			StaticNetworkConfig: config,
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewUpdateClusterStaticNetworkConfigOK()))
+		Expect(listConfigs()).To(Equal(models.HostStaticNetworkConfigList{config}))
+	})
+
+	It("removes a static network config", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		response := bm.DeleteClusterStaticNetworkConfig(ctx, installer.DeleteClusterStaticNetworkConfigParams{
+			ClusterID:  clusterID,
+			MacAddress: "02:00:00:00:00:10",
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewDeleteClusterStaticNetworkConfigNoContent()))
+		Expect(listConfigs()).To(BeEmpty())
+	})
+
+	It("saves the static network configs when the discovery ISO fails to be regenerated", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(map[string]interface{}{
+			"image_generated":      true,
+			"image_ssh_public_key": "invalid key",
+		}).Error).ShouldNot(HaveOccurred())
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityWarning,
+			"Failed to regenerate the discovery ISO with the updated static network configs. Generate the ISO again to include them.",
+			gomock.Any()).Times(1)
+		response := bm.DeleteClusterStaticNetworkConfig(ctx, installer.DeleteClusterStaticNetworkConfigParams{
+			ClusterID:  clusterID,
+			MacAddress: "02:00:00:00:00:10",
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewDeleteClusterStaticNetworkConfigNoContent()))
+		Expect(listConfigs()).To(BeEmpty())
+	})
+
+	It("fails to remove an unknown static network config", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		response := bm.DeleteClusterStaticNetworkConfig(ctx, installer.DeleteClusterStaticNetworkConfigParams{
+			ClusterID:  clusterID,
+			MacAddress: "02:00:00:00:00:99",
+		})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("fails to change the static network configs of a cluster that can't be updated", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(errors.Errorf("wrong state")).Times(1)
+		response := bm.DeleteClusterStaticNetworkConfig(ctx, installer.DeleteClusterStaticNetworkConfigParams{
+			ClusterID:  clusterID,
+			MacAddress: "02:00:00:00:00:10",
+		})
+		verifyApiError(response, http.StatusConflict)
+	})
+
+	It("previews the static network configs", func() {
+		config := hostConfig("02:00:00:00:00:20", "192.168.126.100")
+		mockStaticNetworkConfig.EXPECT().GenerateHostStaticNetworkConfigData(config).Return([]staticnetworkconfig.StaticNetworkConfigData{
+			{FilePath: "eth0.nmconnection", FileContents: "[connection]"},
+			{FilePath: "mac_interface.ini", FileContents: "02:00:00:00:00:20=eth0"},
+		}, nil).Times(1)
+		response := bm.PreviewClusterStaticNetworkConfigs(ctx, installer.PreviewClusterStaticNetworkConfigsParams{
+			ClusterID:     clusterID,
+			PreviewParams: &models.StaticNetworkConfigPreviewParams{StaticNetworkConfig: []*models.HostStaticNetworkConfig{config}},
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewPreviewClusterStaticNetworkConfigsOK()))
+		preview := response.(*installer.PreviewClusterStaticNetworkConfigsOK).Payload
+		Expect(swag.BoolValue(preview.Valid)).To(BeFalse())
+		Expect(preview.Hosts).To(HaveLen(1))
+		Expect(preview.Hosts[0].Files).To(HaveLen(2))
+		Expect(preview.Hosts[0].ValidationErrors).To(ConsistOf("Address 192.168.126.100 is the API VIP of the cluster"))
+	})
+})
+
+var _ = Describe("Cluster IP pool", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+		generator = staticnetworkconfig.New(common.GetTestLog())
+		pool      = &models.IPPool{
+			Cidr:           swag.String("192.168.126.0/24"),
+			Gateway:        swag.String("192.168.126.1"),
+			DNSServers:     []string{"192.168.126.2"},
+			ExcludedRanges: []*models.IPRange{{Start: swag.String("192.168.126.3"), End: swag.String("192.168.126.9")}},
+		}
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:                 &clusterID,
+			MachineNetworkCidr: "192.168.126.0/24",
+			APIVip:             "192.168.126.10",
+			IngressVip:         "192.168.126.11",
+			ImageInfo:          &models.ImageInfo{},
+		}}).Error).ShouldNot(HaveOccurred())
+		mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).
+			DoAndReturn(generator.FormatStaticNetworkConfigForDB).AnyTimes()
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	updatePool := func() {
+		response := bm.UpdateClusterIPPool(ctx, installer.UpdateClusterIPPoolParams{ClusterID: clusterID, IPPool: pool})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewUpdateClusterIPPoolOK()))
+	}
+
+	allocate := func(mac string) middleware.Responder {
+		return bm.AllocateClusterIPLease(ctx, installer.AllocateClusterIPLeaseParams{
+			ClusterID:     clusterID,
+			IPLeaseParams: &models.IPLeaseParams{MacInterfaceMap: models.MacInterfaceMap{{MacAddress: mac, LogicalNicName: "eth0"}}},
+		})
+	}
+
+	listLeases := func() models.IPLeaseList {
+		response := bm.ListClusterIPLeases(ctx, installer.ListClusterIPLeasesParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewListClusterIPLeasesOK()))
+		return response.(*installer.ListClusterIPLeasesOK).Payload
+	}
+
+	listConfigs := func() models.HostStaticNetworkConfigList {
+		response := bm.ListClusterStaticNetworkConfigs(ctx, installer.ListClusterStaticNetworkConfigsParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewListClusterStaticNetworkConfigsOK()))
+		return response.(*installer.ListClusterStaticNetworkConfigsOK).Payload
+	}
+
+	It("gets the IP pool of the cluster", func() {
+		verifyApiError(bm.GetClusterIPPool(ctx, installer.GetClusterIPPoolParams{ClusterID: clusterID}), http.StatusNotFound)
+		updatePool()
+		response := bm.GetClusterIPPool(ctx, installer.GetClusterIPPoolParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewGetClusterIPPoolOK()))
+		Expect(response.(*installer.GetClusterIPPoolOK).Payload).To(Equal(pool))
+	})
+
+	It("rejects a gateway outside of the subnet of the pool", func() {
+		response := bm.UpdateClusterIPPool(ctx, installer.UpdateClusterIPPoolParams{ClusterID: clusterID, IPPool: &models.IPPool{
+			Cidr:    swag.String("192.168.126.0/24"),
+			Gateway: swag.String("192.168.127.1"),
+		}})
+		verifyApiError(response, http.StatusBadRequest)
+	})
+
+	It("fails to allocate an address without an IP pool", func() {
+		verifyApiError(allocate("02:00:00:00:00:10"), http.StatusConflict)
+	})
+
+	It("allocates addresses that are not reserved and configures them in the static network configs", func() {
+		updatePool()
+		response := allocate("02:00:00:00:00:10")
+		Expect(response).To(BeAssignableToTypeOf(installer.NewAllocateClusterIPLeaseCreated()))
+		Expect(response.(*installer.AllocateClusterIPLeaseCreated).Payload.Address).To(Equal("192.168.126.12"))
+		response = allocate("02:00:00:00:00:20")
+		Expect(response).To(BeAssignableToTypeOf(installer.NewAllocateClusterIPLeaseCreated()))
+		Expect(response.(*installer.AllocateClusterIPLeaseCreated).Payload.Address).To(Equal("192.168.126.13"))
+
+		leases := listLeases()
+		Expect(leases).To(HaveLen(2))
+		Expect(leases[0].MacAddress).To(Equal("02:00:00:00:00:10"))
+		configs := listConfigs()
+		Expect(configs).To(HaveLen(2))
+		Expect(staticnetworkconfig.StaticAddresses(configs[1])).To(Equal([]string{"192.168.126.13"}))
+
+		By("rejecting a MAC address that already has a static network config")
+		verifyApiError(allocate("02:00:00:00:00:10"), http.StatusConflict)
+	})
+
+	It("skips the addresses that the hosts report busy", func() {
+		updatePool()
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Host{
+			ID:            &hostID,
+			ClusterID:     clusterID,
+			Status:        swag.String(models.HostStatusKnown),
+			FreeAddresses: "[{\"network\":\"192.168.126.0/24\",\"free_addresses\":[\"192.168.126.14\"]}]",
+		}).Error).ShouldNot(HaveOccurred())
+		response := allocate("02:00:00:00:00:10")
+		Expect(response).To(BeAssignableToTypeOf(installer.NewAllocateClusterIPLeaseCreated()))
+		Expect(response.(*installer.AllocateClusterIPLeaseCreated).Payload.Address).To(Equal("192.168.126.14"))
+	})
+
+	It("releases a lease and removes its static network config", func() {
+		updatePool()
+		Expect(allocate("02:00:00:00:00:10")).To(BeAssignableToTypeOf(installer.NewAllocateClusterIPLeaseCreated()))
+		response := bm.ReleaseClusterIPLease(ctx, installer.ReleaseClusterIPLeaseParams{ClusterID: clusterID, MacAddress: "02:00:00:00:00:10"})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewReleaseClusterIPLeaseNoContent()))
+		Expect(listLeases()).To(BeEmpty())
+		Expect(listConfigs()).To(BeEmpty())
+
+		response = bm.ReleaseClusterIPLease(ctx, installer.ReleaseClusterIPLeaseParams{ClusterID: clusterID, MacAddress: "02:00:00:00:00:10"})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("rejects an IP pool that does not contain the leased addresses", func() {
+		updatePool()
+		Expect(allocate("02:00:00:00:00:10")).To(BeAssignableToTypeOf(installer.NewAllocateClusterIPLeaseCreated()))
+		response := bm.UpdateClusterIPPool(ctx, installer.UpdateClusterIPPoolParams{ClusterID: clusterID, IPPool: &models.IPPool{
+			Cidr:    swag.String("192.168.127.0/24"),
+			Gateway: swag.String("192.168.127.1"),
+		}})
+		verifyApiError(response, http.StatusConflict)
+	})
+
+	It("releases the leases of a deregistered host", func() {
+		updatePool()
+		Expect(allocate("02:00:00:00:00:10")).To(BeAssignableToTypeOf(installer.NewAllocateClusterIPLeaseCreated()))
+		hostID := strfmt.UUID(uuid.New().String())
+		inventory, err := json.Marshal(&models.Inventory{Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "02:00:00:00:00:10"}}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Create(&models.Host{
+			ID:        &hostID,
+			ClusterID: clusterID,
+			Status:    swag.String(models.HostStatusKnown),
+			Inventory: string(inventory),
+		}).Error).ShouldNot(HaveOccurred())
+		// The discovery ISO is not regenerated, which would add a warning event for the invalid SSH key
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(map[string]interface{}{
+			"image_generated":      true,
+			"image_ssh_public_key": "invalid key",
+		}).Error).ShouldNot(HaveOccurred())
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+		Expect(bm.DeregisterHostInternal(ctx, installer.DeregisterHostParams{ClusterID: clusterID, HostID: hostID})).To(Succeed())
+		Expect(listLeases()).To(BeEmpty())
+		Expect(listConfigs()).To(BeEmpty())
+	})
+})
@@ -69,6 +69,21 @@ func (mr *MockInstallerInternalsMockRecorder) CancelInstallationInternal(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelInstallationInternal", reflect.TypeOf((*MockInstallerInternals)(nil).CancelInstallationInternal), arg0, arg1)
 }
 
+// CreateClusterStaticNetworkConfigInternal mocks base method
+func (m *MockInstallerInternals) CreateClusterStaticNetworkConfigInternal(arg0 context.Context, arg1 installer.CreateClusterStaticNetworkConfigParams) (*models.HostStaticNetworkConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterStaticNetworkConfigInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.HostStaticNetworkConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterStaticNetworkConfigInternal indicates an expected call of CreateClusterStaticNetworkConfigInternal
+func (mr *MockInstallerInternalsMockRecorder) CreateClusterStaticNetworkConfigInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterStaticNetworkConfigInternal", reflect.TypeOf((*MockInstallerInternals)(nil).CreateClusterStaticNetworkConfigInternal), arg0, arg1)
+}
+
 // DeregisterClusterInternal mocks base method
 func (m *MockInstallerInternals) DeregisterClusterInternal(arg0 context.Context, arg1 installer.DeregisterClusterParams) error {
 	m.ctrl.T.Helper()
@@ -262,6 +277,21 @@ func (mr *MockInstallerInternalsMockRecorder) RegisterClusterInternal(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterClusterInternal", reflect.TypeOf((*MockInstallerInternals)(nil).RegisterClusterInternal), arg0, arg1, arg2)
 }
 
+// UpdateClusterIPPoolInternal mocks base method
+func (m *MockInstallerInternals) UpdateClusterIPPoolInternal(arg0 context.Context, arg1 installer.UpdateClusterIPPoolParams) (*models.IPPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterIPPoolInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.IPPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterIPPoolInternal indicates an expected call of UpdateClusterIPPoolInternal
+func (mr *MockInstallerInternalsMockRecorder) UpdateClusterIPPoolInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterIPPoolInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterIPPoolInternal), arg0, arg1)
+}
+
 // UpdateClusterInstallConfigInternal mocks base method
 func (m *MockInstallerInternals) UpdateClusterInstallConfigInternal(arg0 context.Context, arg1 installer.UpdateClusterInstallConfigParams) (*common.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -349,3 +379,18 @@ func (mr *MockInstallerInternalsMockRecorder) UpdateHostInstallerArgsInternal(ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostInstallerArgsInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateHostInstallerArgsInternal), arg0, arg1)
 }
+
+// UpdateHostValidationRulesInternal mocks base method
+func (m *MockInstallerInternals) UpdateHostValidationRulesInternal(arg0 context.Context, arg1 installer.UpdateHostValidationRulesParams) (models.HostValidationRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHostValidationRulesInternal", arg0, arg1)
+	ret0, _ := ret[0].(models.HostValidationRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHostValidationRulesInternal indicates an expected call of UpdateHostValidationRulesInternal
+func (mr *MockInstallerInternalsMockRecorder) UpdateHostValidationRulesInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostValidationRulesInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateHostValidationRulesInternal), arg0, arg1)
+}
@@ -12,7 +12,7 @@ func AgentToken(c *common.Cluster, authType auth.AuthType) (token string, err er
 	switch authType {
 	case auth.TypeRHSSO:
 		token, err = cloudPullSecretToken(c.PullSecret)
-	case auth.TypeLocal:
+	case auth.TypeLocal, auth.TypeOIDC:
 		token, err = gencrypto.LocalJWT(c.ID.String())
 	case auth.TypeNone:
 		token = ""
@@ -418,19 +418,29 @@ func (m *Manager) tryAssignMachineCidrDHCPMode(cluster *common.Cluster) error {
 }
 
 func (m *Manager) tryAssignMachineCidrNonDHCPMode(cluster *common.Cluster) error {
-	machineCidr, err := network.CalculateMachineNetworkCIDR(
-		cluster.APIVip, cluster.IngressVip, cluster.Hosts, false)
-
+	machineCidrs, err := network.CalculateMachineNetworkCIDRs(
+		common.GetAPIVips(cluster), common.GetIngressVips(cluster), cluster.Hosts, false)
 	if err != nil {
 		return err
-	} else if machineCidr == cluster.MachineNetworkCidr {
+	}
+
+	// In dual-stack clusters the VIPs of the second IP address family determine the secondary machine network
+	var machineCidr, secondaryMachineCidr string
+	if len(machineCidrs) > 0 {
+		machineCidr = machineCidrs[0]
+	}
+	if len(machineCidrs) > 1 {
+		secondaryMachineCidr = machineCidrs[1]
+	}
+	if machineCidr == cluster.MachineNetworkCidr && secondaryMachineCidr == cluster.SecondaryMachineNetworkCidr {
 		return nil
 	}
 
-	return m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update(
-		"machine_network_cidr", machineCidr,
-		"machine_network_cidr_updated_at", time.Now(),
-	).Error
+	return m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(map[string]interface{}{
+		"machine_network_cidr":            machineCidr,
+		"secondary_machine_network_cidr":  secondaryMachineCidr,
+		"machine_network_cidr_updated_at": time.Now(),
+	}).Error
 }
 
 func (m *Manager) autoAssignMachineNetworkCidr(c *common.Cluster) error {
@@ -875,6 +885,15 @@ func (m *Manager) setConnectivityMajorityGroupsForClusterInternal(cluster *commo
 		}
 		majorityGroups[cidr] = majorityGroup
 	}
+	if common.IsMultiSubnetCluster(cluster) {
+		machineNetworkCidrs := common.GetMachineNetworkCidrs(cluster)
+		majorityGroup, err := network.CreateL3MajorityGroup(machineNetworkCidrs, hosts)
+		if err != nil {
+			m.log.WithError(err).Warnf("Create L3 majority group for %s", strings.Join(machineNetworkCidrs, ", "))
+		} else {
+			majorityGroups[network.L3MajorityGroupKey(machineNetworkCidrs)] = majorityGroup
+		}
+	}
 	b, err := json.Marshal(&majorityGroups)
 	if err != nil {
 		return common.NewApiError(http.StatusInternalServerError, err)
@@ -1015,9 +1034,42 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 			m.log.WithError(err).Warnf("Failed deleting events from db for cluster %s", c.ID.String())
 		}
 
+		if err := db.Where("webhook_id IN (SELECT id FROM webhooks WHERE cluster_id = ?)", c.ID.String()).
+			Delete(&common.WebhookDelivery{}).Error; err != nil {
+			m.log.WithError(err).Warnf("Failed deleting webhook deliveries from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.Webhook{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting webhooks from db for cluster %s", c.ID.String())
+		}
+
 		if err := common.DeleteRecordsByClusterID(db, *c.ID, models.MonitoredOperator{}); err != nil {
 			m.log.WithError(err).Warnf("Failed deleting operators from db for cluster %s", c.ID.String())
 		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, models.ClusterAccess{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting cluster accesses from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.HostAssignment{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting host assignments from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.InstallationTimelineEntry{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting the installation timeline from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.InventorySnapshot{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting inventory snapshots from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.IPLease{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting IP leases from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.StatusChange{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting status changes from db for cluster %s", c.ID.String())
+		}
 	}
 	return nil
 }
@@ -1062,6 +1114,12 @@ func (m *Manager) CompleteInstallation(ctx context.Context, db *gorm.DB,
 
 	if successfullyFinished {
 		destStatus = models.ClusterStatusInstalled
+		// The cluster is installed, but some of its OLM operators failed
+		if strings.HasPrefix(reason, StatusInfoDegraded) {
+			result = resultDegraded
+			severity = models.EventSeverityWarning
+			eventMsg = fmt.Sprintf("%s. %s", eventMsg, reason)
+		}
 
 		// Update AMS subscription only if configured and installation succeeded
 		if m.ocmClient != nil && m.ocmClient.Config.WithAMSSubscriptions {
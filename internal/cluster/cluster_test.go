@@ -76,6 +76,8 @@ var _ = Describe("stateMachine", func() {
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDOcsRequirementsSatisfied)},
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied)},
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
+				{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+				{Status: api.Success, ValidationId: string(models.ClusterValidationIDLvmRequirementsSatisfied)},
 			}, nil)
 		})
 
@@ -143,6 +145,8 @@ var _ = Describe("TestClusterMonitoring", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLvmRequirementsSatisfied)},
 		}, nil)
 	})
 	Context("single cluster monitoring", func() {
@@ -660,6 +664,8 @@ var _ = Describe("lease timeout event", func() {
 		mockMetric.EXPECT().Duration("ClusterMonitoring", gomock.Any()).AnyTimes()
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLvmRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied)},
 		}, nil)
@@ -772,6 +778,8 @@ var _ = Describe("Auto assign machine CIDR", func() {
 		mockMetric.EXPECT().Duration("ClusterMonitoring", gomock.Any()).AnyTimes()
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLvmRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied)},
 		}, nil)
@@ -1896,6 +1904,8 @@ var _ = Describe("Majority groups", func() {
 		mockMetricApi.EXPECT().Duration("ClusterMonitoring", gomock.Any()).AnyTimes()
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLvmRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied)},
 		}, nil)
@@ -2003,6 +2013,8 @@ var _ = Describe("ready_state", func() {
 
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLvmRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied)},
 		}, nil)
@@ -2532,6 +2544,28 @@ var _ = Describe("Permanently delete clusters", func() {
 		Expect(operators).Should(HaveLen(0))
 	})
 
+	It("permanently delete the webhooks and deliveries of clusters", func() {
+		addWebhook := func(c common.Cluster) strfmt.UUID {
+			id := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Webhook{Webhook: models.Webhook{ID: &id, ClusterID: c.ID, URL: swag.String("https://example.com")}}).
+				Error).ShouldNot(HaveOccurred())
+			Expect(db.Create(&common.WebhookDelivery{WebhookID: id, NextAttemptAt: time.Now()}).Error).ShouldNot(HaveOccurred())
+			return id
+		}
+		c1Webhook := addWebhook(c1)
+		c3Webhook := addWebhook(c3)
+		Expect(db.Delete(&c1).RowsAffected).Should(Equal(int64(1)))
+
+		mockS3Api.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+		mockS3Api.EXPECT().ListObjectsByPrefix(gomock.Any(), gomock.Any()).Return([]string{}, nil).AnyTimes()
+		Expect(state.PermanentClustersDeletion(ctx, strfmt.DateTime(time.Now()), mockS3Api)).ShouldNot(HaveOccurred())
+
+		Expect(db.Where("id = ?", c1Webhook.String()).Find(&common.Webhook{}).RowsAffected).Should(Equal(int64(0)))
+		Expect(db.Where("webhook_id = ?", c1Webhook.String()).Find(&common.WebhookDelivery{}).RowsAffected).Should(Equal(int64(0)))
+		Expect(db.Where("id = ?", c3Webhook.String()).Find(&common.Webhook{}).RowsAffected).Should(Equal(int64(1)))
+		Expect(db.Where("webhook_id = ?", c3Webhook.String()).Find(&common.WebhookDelivery{}).RowsAffected).Should(Equal(int64(1)))
+	})
+
 	It("permanently delete clusters - nothing to delete", func() {
 		deletedAt := strfmt.DateTime(time.Now().Add(-time.Hour))
 		Expect(state.PermanentClustersDeletion(ctx, deletedAt, mockS3Api)).ShouldNot(HaveOccurred())
@@ -41,19 +41,27 @@ const (
 	statusInfoClusterFailedToPrepare          = "Cluster failed to prepare for installation"
 )
 
+// installationStatuses are the statuses of the cluster during its installation, whose changes are recorded in the
+// installation timeline of the cluster
+var installationStatuses = []string{models.ClusterStatusPreparingForInstallation, models.ClusterStatusInstalling,
+	models.ClusterStatusInstallingPendingUserAction, models.ClusterStatusFinalizing}
+
+// resultDegraded is the installation result of clusters that are installed with failed OLM operators
+const resultDegraded = "degraded"
+
 func updateClusterStatus(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, clusterId strfmt.UUID, srcStatus string,
 	newStatus string, statusInfo string, events events.Handler, extra ...interface{}) (*common.Cluster, error) {
 	var cluster *common.Cluster
 	var err error
 	extra = append(append(make([]interface{}, 0), "status", newStatus, "status_info", statusInfo), extra...)
 
+	now := time.Now()
 	if newStatus != srcStatus {
-		now := strfmt.DateTime(time.Now())
-		extra = append(extra, "status_updated_at", now)
+		extra = append(extra, "status_updated_at", strfmt.DateTime(now))
 
 		installationCompletedStatuses := []string{models.ClusterStatusInstalled, models.ClusterStatusError, models.ClusterStatusCancelled}
 		if funk.ContainsString(installationCompletedStatuses, swag.StringValue(&newStatus)) {
-			extra = append(extra, "install_completed_at", now)
+			extra = append(extra, "install_completed_at", strfmt.DateTime(now))
 		}
 	}
 
@@ -67,6 +75,16 @@ func updateClusterStatus(ctx context.Context, log logrus.FieldLogger, db *gorm.D
 		msg := fmt.Sprintf("Updated status of cluster %s to %s", cluster.Name, *cluster.Status)
 		events.AddEvent(ctx, clusterId, nil, models.EventSeverityInfo, msg, time.Now())
 		log.Infof("cluster %s has been updated with the following updates %+v", clusterId, extra)
+
+		if err = common.AddStatusChange(db, clusterId, "", newStatus, statusInfo, now); err != nil {
+			log.WithError(err).Warnf("failed to record status %s of cluster %s", newStatus, clusterId)
+		}
+
+		if funk.ContainsString(installationStatuses, srcStatus) || funk.ContainsString(installationStatuses, newStatus) {
+			if err = common.AddInstallationTimelineEntry(db, clusterId, "", newStatus, statusInfo, now); err != nil {
+				log.WithError(err).Warnf("failed to record status %s of cluster %s in the installation timeline", newStatus, clusterId)
+			}
+		}
 	}
 
 	return cluster, nil
@@ -189,7 +207,8 @@ func MapHostsByStatus(c *common.Cluster) map[string][]*models.Host {
 func GetCluster(ctx context.Context, logger logrus.FieldLogger, db *gorm.DB, clusterID string) (*common.Cluster, *common.ApiErrorResponse) {
 	log := logutil.FromContext(ctx, logger)
 	var cluster common.Cluster
-	if err := db.First(&cluster, identity.AddUserFilter(ctx, "id = ?"), clusterID).Error; err != nil {
+	query, args := identity.AddUserFilter(ctx, "id = ?", clusterID)
+	if err := db.Where(query, args...).First(&cluster).Error; err != nil {
 		log.WithError(err).Errorf("failed to find cluster %s", clusterID)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, common.NewApiError(http.StatusNotFound, err)
@@ -14,11 +14,12 @@ type condition struct {
 }
 
 const (
-	VipDhcpAllocationSet         = conditionId("vip-dhcp-allocation-set")
-	AllHostsPreparedSuccessfully = conditionId("all-hosts-prepared-successfully")
-	UnPreparingtHostsExist       = conditionId("unpreparing-hosts-exist")
-	ClusterPreparationSucceeded  = conditionId("cluster-preparation-succeeded")
-	ClusterPreparationFailed     = conditionId("cluster-preparation-failed")
+	VipDhcpAllocationSet           = conditionId("vip-dhcp-allocation-set")
+	AllHostsPreparedSuccessfully   = conditionId("all-hosts-prepared-successfully")
+	UnPreparingtHostsExist         = conditionId("unpreparing-hosts-exist")
+	ClusterPreparationSucceeded    = conditionId("cluster-preparation-succeeded")
+	ClusterPreparationFailed       = conditionId("cluster-preparation-failed")
+	OperatorsRequirementsSatisfied = conditionId("operators-requirements-satisfied")
 )
 
 func (c conditionId) String() string {
@@ -0,0 +1,208 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// GetInstallationReport returns the timeline of the latest installation of the cluster, that starts when the cluster
+// was last prepared for installation. The hosts of the cluster must be loaded.
+func GetInstallationReport(db *gorm.DB, c *common.Cluster, now time.Time) (*models.InstallationReport, error) {
+	var entries []*common.InstallationTimelineEntry
+	if err := db.Where("cluster_id = ?", c.ID.String()).Order("started_at, id").Find(&entries).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get the installation timeline of cluster %s", c.ID)
+	}
+
+	report := &models.InstallationReport{
+		ClusterID:        *c.ID,
+		OpenshiftVersion: c.OpenshiftVersion,
+		Status:           swag.StringValue(c.Status),
+		StatusInfo:       swag.StringValue(c.StatusInfo),
+		Phases:           []*models.InstallationStage{},
+		Hosts:            []*models.HostInstallationReport{},
+		Failures:         []*models.InstallationFailure{},
+	}
+
+	start := -1
+	for i, entry := range entries {
+		if entry.HostID == "" && entry.Stage == models.ClusterStatusPreparingForInstallation {
+			start = i
+		}
+	}
+	if start == -1 {
+		return report, nil
+	}
+	entries = entries[start:]
+	startedAt := entries[0].StartedAt
+
+	var completedAt *time.Time
+	hostEntries := make(map[strfmt.UUID][]*common.InstallationTimelineEntry)
+	for _, entry := range entries {
+		if entry.HostID != "" {
+			hostEntries[entry.HostID] = append(hostEntries[entry.HostID], entry)
+			continue
+		}
+		if completedAt != nil {
+			continue
+		}
+		if !funk.ContainsString(installationStatuses, entry.Stage) {
+			completedAt = timePtr(entry.StartedAt)
+			continue
+		}
+		report.Phases = append(report.Phases, &models.InstallationStage{
+			Name:      entry.Stage,
+			Info:      entry.Info,
+			StartedAt: strfmt.DateTime(entry.StartedAt),
+		})
+	}
+	for i, phase := range report.Phases {
+		if i+1 < len(report.Phases) {
+			completeStage(phase, timePtr(time.Time(report.Phases[i+1].StartedAt)), now)
+		} else {
+			completeStage(phase, completedAt, now)
+		}
+	}
+	report.StartedAt = dateTimePtr(startedAt)
+	report.CompletedAt = dateTimePtrOrNil(completedAt)
+	report.DurationSeconds = durationSeconds(startedAt, completedAt, now)
+
+	if swag.StringValue(c.Status) == models.ClusterStatusError && len(report.Phases) > 0 {
+		report.Failures = append(report.Failures, &models.InstallationFailure{
+			Stage:  report.Phases[len(report.Phases)-1].Name,
+			Reason: swag.StringValue(c.StatusInfo),
+		})
+	}
+
+	for _, h := range c.Hosts {
+		failed := swag.StringValue(h.Status) == models.HostStatusError && !time.Time(h.StatusUpdatedAt).Before(startedAt)
+		if len(hostEntries[*h.ID]) == 0 && !failed {
+			continue
+		}
+		hostReport := hostInstallationReport(h, hostEntries[*h.ID], completedAt, now)
+		report.Hosts = append(report.Hosts, hostReport)
+
+		if h.Bootstrap {
+			report.Bootstrap = bootstrapReport(h, hostReport)
+		}
+		if failed {
+			failure := &models.InstallationFailure{
+				HostID:   *h.ID,
+				Hostname: hostReport.Hostname,
+				Reason:   swag.StringValue(h.StatusInfo),
+			}
+			if len(hostReport.Stages) > 0 {
+				failure.Stage = hostReport.Stages[len(hostReport.Stages)-1].Name
+			}
+			report.Failures = append(report.Failures, failure)
+		}
+	}
+	return report, nil
+}
+
+// hostInstallationReport returns the installation report of the host, from the entries of the host in the
+// installation timeline. The last stage of a host that did not report it is done completes when the host stopped
+// installing, or else when the installation of the cluster completed.
+func hostInstallationReport(h *models.Host, entries []*common.InstallationTimelineEntry, clusterCompletedAt *time.Time,
+	now time.Time) *models.HostInstallationReport {
+	hostReport := &models.HostInstallationReport{
+		HostID:    *h.ID,
+		Hostname:  hostutil.GetHostnameForMsg(h),
+		Role:      h.Role,
+		Bootstrap: h.Bootstrap,
+		Status:    swag.StringValue(h.Status),
+		Stages:    []*models.InstallationStage{},
+	}
+
+	var completedAt *time.Time
+	for _, entry := range entries {
+		if entry.Stage == string(models.HostStageDone) {
+			completedAt = timePtr(entry.StartedAt)
+			break
+		}
+		hostReport.Stages = append(hostReport.Stages, &models.InstallationStage{
+			Name:      entry.Stage,
+			Info:      entry.Info,
+			StartedAt: strfmt.DateTime(entry.StartedAt),
+		})
+	}
+	if completedAt == nil {
+		switch swag.StringValue(h.Status) {
+		case models.HostStatusError, models.HostStatusCancelled, models.HostStatusInstalled:
+			completedAt = timePtr(time.Time(h.StatusUpdatedAt))
+		default:
+			completedAt = clusterCompletedAt
+		}
+	}
+
+	for i, stage := range hostReport.Stages {
+		if i+1 < len(hostReport.Stages) {
+			completeStage(stage, timePtr(time.Time(hostReport.Stages[i+1].StartedAt)), now)
+		} else {
+			completeStage(stage, completedAt, now)
+		}
+	}
+	if len(hostReport.Stages) > 0 {
+		startedAt := time.Time(hostReport.Stages[0].StartedAt)
+		hostReport.StartedAt = dateTimePtr(startedAt)
+		hostReport.CompletedAt = dateTimePtrOrNil(completedAt)
+		hostReport.DurationSeconds = durationSeconds(startedAt, completedAt, now)
+	}
+	return hostReport
+}
+
+// bootstrapReport returns the time the bootstrap host waited for the control plane
+func bootstrapReport(h *models.Host, hostReport *models.HostInstallationReport) *models.InstallationBootstrap {
+	bootstrap := &models.InstallationBootstrap{
+		HostID:   *h.ID,
+		Hostname: hostReport.Hostname,
+	}
+	for _, stage := range hostReport.Stages {
+		if stage.Name == string(models.HostStageWaitingForControlPlane) {
+			bootstrap.StartedAt = dateTimePtr(time.Time(stage.StartedAt))
+			bootstrap.CompletedAt = stage.CompletedAt
+			bootstrap.DurationSeconds = stage.DurationSeconds
+		}
+	}
+	return bootstrap
+}
+
+func completeStage(stage *models.InstallationStage, completedAt *time.Time, now time.Time) {
+	stage.CompletedAt = dateTimePtrOrNil(completedAt)
+	stage.DurationSeconds = durationSeconds(time.Time(stage.StartedAt), completedAt, now)
+}
+
+// durationSeconds returns the duration until completedAt, or until now if it did not complete yet
+func durationSeconds(startedAt time.Time, completedAt *time.Time, now time.Time) int64 {
+	end := now
+	if completedAt != nil {
+		end = *completedAt
+	}
+	if end.Before(startedAt) {
+		return 0
+	}
+	return int64(end.Sub(startedAt).Seconds())
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func dateTimePtr(t time.Time) *strfmt.DateTime {
+	dt := strfmt.DateTime(t)
+	return &dt
+}
+
+func dateTimePtrOrNil(t *time.Time) *strfmt.DateTime {
+	if t == nil {
+		return nil
+	}
+	return dateTimePtr(*t)
+}
@@ -0,0 +1,144 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("GetInstallationReport", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		clusterID strfmt.UUID
+		masterID  strfmt.UUID
+		workerID  strfmt.UUID
+		startedAt time.Time
+	)
+
+	addEntry := func(hostID strfmt.UUID, stage string, info string, offset time.Duration) {
+		Expect(common.AddInstallationTimelineEntry(db, clusterID, hostID, stage, info, startedAt.Add(offset))).To(Succeed())
+	}
+
+	getCluster := func() *common.Cluster {
+		c, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		masterID = strfmt.UUID(uuid.New().String())
+		workerID = strfmt.UUID(uuid.New().String())
+		startedAt = time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			Status:           swag.String(models.ClusterStatusInstalling),
+			StatusInfo:       swag.String(statusInfoInstalling),
+		}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&models.Host{
+			ID:        &masterID,
+			ClusterID: clusterID,
+			Role:      models.HostRoleMaster,
+			Bootstrap: true,
+			Status:    swag.String(models.HostStatusInstallingInProgress),
+		}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&models.Host{
+			ID:              &workerID,
+			ClusterID:       clusterID,
+			Role:            models.HostRoleWorker,
+			Status:          swag.String(models.HostStatusError),
+			StatusInfo:      swag.String("Host failed to install"),
+			StatusUpdatedAt: strfmt.DateTime(startedAt.Add(30 * time.Minute)),
+		}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("reports an empty timeline for clusters that were not installed", func() {
+		report, err := GetInstallationReport(db, getCluster(), time.Now())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.StartedAt).To(BeNil())
+		Expect(report.Phases).To(BeEmpty())
+		Expect(report.Hosts).To(BeEmpty())
+	})
+
+	It("reports the durations of the latest installation", func() {
+		// A previous installation attempt, that failed to prepare
+		addEntry("", models.ClusterStatusPreparingForInstallation, "", -time.Hour)
+		addEntry("", models.ClusterStatusReady, "", -50*time.Minute)
+
+		addEntry("", models.ClusterStatusPreparingForInstallation, statusInfoPreparingForInstallation, 0)
+		addEntry("", models.ClusterStatusInstalling, statusInfoInstalling, 2*time.Minute)
+		addEntry(masterID, string(models.HostStageStartingInstallation), "", 3*time.Minute)
+		addEntry(masterID, string(models.HostStageWaitingForControlPlane), "", 10*time.Minute)
+		addEntry(masterID, string(models.HostStageRebooting), "", 40*time.Minute)
+		addEntry(workerID, string(models.HostStageStartingInstallation), "", 3*time.Minute)
+		addEntry(workerID, string(models.HostStageWritingImageToDisk), "50%", 5*time.Minute)
+
+		report, err := GetInstallationReport(db, getCluster(), startedAt.Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(time.Time(*report.StartedAt)).To(BeTemporally("==", startedAt))
+		Expect(report.CompletedAt).To(BeNil())
+		Expect(report.DurationSeconds).To(BeEquivalentTo(3600))
+
+		Expect(report.Phases).To(HaveLen(2))
+		Expect(report.Phases[0].Name).To(Equal(models.ClusterStatusPreparingForInstallation))
+		Expect(report.Phases[0].DurationSeconds).To(BeEquivalentTo(120))
+		Expect(report.Phases[1].Name).To(Equal(models.ClusterStatusInstalling))
+		Expect(report.Phases[1].CompletedAt).To(BeNil())
+
+		Expect(report.Hosts).To(HaveLen(2))
+		Expect(report.Bootstrap.HostID).To(Equal(masterID))
+		Expect(report.Bootstrap.DurationSeconds).To(BeEquivalentTo(30 * 60))
+
+		Expect(report.Failures).To(HaveLen(1))
+		Expect(report.Failures[0].HostID).To(Equal(workerID))
+		Expect(report.Failures[0].Stage).To(Equal(string(models.HostStageWritingImageToDisk)))
+		Expect(report.Failures[0].Reason).To(Equal("Host failed to install"))
+		for _, h := range report.Hosts {
+			if h.HostID == workerID {
+				Expect(h.Stages).To(HaveLen(2))
+				Expect(h.Stages[1].Info).To(Equal("50%"))
+				Expect(h.DurationSeconds).To(BeEquivalentTo(27 * 60))
+			}
+		}
+	})
+
+	It("completes the installation when the cluster leaves the installation statuses", func() {
+		addEntry("", models.ClusterStatusPreparingForInstallation, "", 0)
+		addEntry("", models.ClusterStatusInstalling, "", time.Minute)
+		addEntry("", models.ClusterStatusFinalizing, "", 50*time.Minute)
+		addEntry("", models.ClusterStatusInstalled, "", 60*time.Minute)
+		addEntry(masterID, string(models.HostStageStartingInstallation), "", 2*time.Minute)
+		addEntry(masterID, string(models.HostStageJoined), "", 45*time.Minute)
+		addEntry(masterID, string(models.HostStageDone), "", 48*time.Minute)
+
+		report, err := GetInstallationReport(db, getCluster(), time.Now())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(time.Time(*report.CompletedAt)).To(BeTemporally("==", startedAt.Add(time.Hour)))
+		Expect(report.DurationSeconds).To(BeEquivalentTo(3600))
+		Expect(report.Phases).To(HaveLen(3))
+		Expect(report.Phases[2].DurationSeconds).To(BeEquivalentTo(600))
+
+		for _, h := range report.Hosts {
+			if h.HostID == masterID {
+				Expect(h.Stages).To(HaveLen(2))
+				Expect(time.Time(*h.CompletedAt)).To(BeTemporally("==", startedAt.Add(48*time.Minute)))
+				Expect(h.Stages[1].DurationSeconds).To(BeEquivalentTo(180))
+			}
+		}
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
@@ -47,6 +48,8 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsA
 	}
 }
 
+const validationDisabledByPolicy = "Validation disabled by the validation policy of the cluster"
+
 func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreprocessContext) (map[string]bool, map[string][]ValidationResult, error) {
 	stateMachineInput := make(map[string]bool)
 	validationsOutput := make(map[string][]ValidationResult)
@@ -57,10 +60,22 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	if !funk.ContainsString(checkValidationsInStatuses, swag.StringValue(c.cluster.Status)) {
 		return stateMachineInput, validationsOutput, nil
 	}
+	policy, err := common.GetValidationPolicy(c.cluster)
+	if err != nil {
+		return nil, nil, err
+	}
 	for _, v := range r.validations {
-		st := v.condition(c)
-		stateMachineInput[v.id.String()] = st == ValidationSuccess
-		message := v.formatter(c, st)
+		var st ValidationStatus
+		var message string
+		if common.IsValidationDisabledByPolicy(policy.DisabledClusterValidations, v.id.String()) {
+			st = ValidationDisabled
+			message = validationDisabledByPolicy
+			stateMachineInput[v.id.String()] = true
+		} else {
+			st = v.condition(c)
+			stateMachineInput[v.id.String()] = st == ValidationSuccess
+			message = v.formatter(c, st)
+		}
 		category, err := v.id.Category()
 		if err != nil {
 			logrus.WithError(err).Warn("id.category()")
@@ -77,8 +92,10 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	if err != nil {
 		return nil, nil, err
 	}
+	operatorsSucceeded := true
 	for _, result := range results {
 		stateMachineInput[result.ValidationId] = result.Status == api.Success
+		operatorsSucceeded = operatorsSucceeded && result.Status == api.Success
 		id := ValidationID(result.ValidationId)
 		category, err := id.Category()
 		if err != nil {
@@ -94,6 +111,8 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 		})
 	}
 
+	stateMachineInput[OperatorsRequirementsSatisfied.String()] = operatorsSucceeded
+
 	for _, condition := range r.conditions {
 		stateMachineInput[condition.id.String()] = condition.fn(c)
 	}
@@ -138,6 +138,9 @@ func (th *transitionHandler) PostCompleteInstallation(sw stateswitch.StateSwitch
 	}
 
 	log := logutil.FromContext(params.ctx, th.log)
+	if err := failTimedOutOperators(log, params, sCluster.cluster, time.Now()); err != nil {
+		return err
+	}
 	if cluster, err := params.clusterAPI.CompleteInstallation(params.ctx, params.db, sCluster.cluster,
 		true, createClusterCompletionStatusInfo(log, sCluster.cluster)); err != nil {
 		return err
@@ -148,6 +151,42 @@ func (th *transitionHandler) PostCompleteInstallation(sw stateswitch.StateSwitch
 	}
 }
 
+// failTimedOutOperators marks the OLM operators that did not become available in time as failed
+func failTimedOutOperators(log logrus.FieldLogger, params *TransitionArgsRefreshCluster, cluster *common.Cluster, now time.Time) error {
+	for _, operator := range cluster.MonitoredOperators {
+		if !isOperatorTimedOut(cluster, operator, now) {
+			continue
+		}
+		timeout := time.Duration(operator.TimeoutSeconds) * time.Second
+		statusInfo := fmt.Sprintf("Operator did not become available within %s", timeout)
+		log.Warnf("Operator %s of cluster %s timed out", operator.Name, *cluster.ID)
+		operators.SetStatus(operator, models.OperatorStatusFailed, statusInfo, now)
+		if err := params.db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? and name = ?", *cluster.ID, operator.Name).
+			Updates(map[string]interface{}{
+				"status":            operator.Status,
+				"status_info":       operator.StatusInfo,
+				"status_updated_at": operator.StatusUpdatedAt,
+				"status_history":    operator.StatusHistory,
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to update operator %s of cluster %s", operator.Name, *cluster.ID)
+		}
+		params.eventHandler.AddEvent(params.ctx, *cluster.ID, nil, models.EventSeverityWarning,
+			fmt.Sprintf("Operator %s timed out: %s", operator.Name, statusInfo), now)
+		params.metricApi.MonitoredOperatorTimedOut(cluster.OpenshiftVersion, operator.Name)
+		params.metricApi.MonitoredOperatorStatusChanged(cluster.OpenshiftVersion, operator.Name, operator.Status)
+	}
+	return nil
+}
+
+// isOperatorTimedOut reports whether the operator did not become available within its timeout since the cluster
+// started finalizing, that is when the operators are being installed
+func isOperatorTimedOut(cluster *common.Cluster, operator *models.MonitoredOperator, now time.Time) bool {
+	if swag.StringValue(cluster.Status) != models.ClusterStatusFinalizing {
+		return false
+	}
+	return operators.IsTimedOut(operator, time.Time(cluster.StatusUpdatedAt), now)
+}
+
 func createClusterCompletionStatusInfo(log logrus.FieldLogger, cluster *common.Cluster) string {
 	_, statuses := getClusterMonitoringOperatorsStatus(cluster)
 	log.Infof("Cluster %s Monitoring status: %s", *cluster.ID, statuses)
@@ -208,8 +247,14 @@ func getClusterMonitoringOperatorsStatus(cluster *common.Cluster) (bool, Monitor
 		},
 	}
 
+	now := time.Now()
 	for _, operator := range cluster.MonitoredOperators {
-		operatorsStatuses[operator.OperatorType][operator.Status] = append(operatorsStatuses[operator.OperatorType][operator.Status], operator.Name)
+		status := operator.Status
+		if isOperatorTimedOut(cluster, operator, now) {
+			// OLM operators that did not become available in time are failed, so they do not block the installation
+			status = models.OperatorStatusFailed
+		}
+		operatorsStatuses[operator.OperatorType][status] = append(operatorsStatuses[operator.OperatorType][status], operator.Name)
 	}
 
 	return haveBuiltinOperatorsComplete(operatorsStatuses[models.OperatorTypeBuiltin]) &&
@@ -110,6 +110,9 @@ var _ = Describe("Transition tests", func() {
 			updateAMSSubscriptionSuccess bool
 			errorExpected                bool
 			updateSuccessfullyFinished   bool
+			degraded                     bool
+			finalizingSince              time.Duration
+			timedOutOperators            []string
 			destState                    string
 			destStatusInfo               string
 		}{
@@ -204,9 +207,51 @@ var _ = Describe("Transition tests", func() {
 						Status: models.OperatorStatusFailed,
 					},
 				},
+				degraded:       true,
 				destState:      models.ClusterStatusInstalled,
 				destStatusInfo: StatusInfoDegraded + ". Failed OLM operators: dummy2, dummy4",
 			},
+			{
+				name:                       "available builtin operators, progressing OLM within its timeout -> finalizing",
+				uploadKubeConfig:           true,
+				updateSuccessfullyFinished: true,
+				finalizingSince:            30 * time.Minute,
+				operators: []*models.MonitoredOperator{
+					{
+						Name: common.TestDefaultConfig.MonitoredOperator.Name, OperatorType: models.OperatorTypeBuiltin,
+						Status: models.OperatorStatusAvailable,
+					},
+					{
+						Name: common.TestDefaultConfig.MonitoredOperator.Name + "2", OperatorType: models.OperatorTypeOlm,
+						Status: models.OperatorStatusProgressing, TimeoutSeconds: 60 * 60,
+					},
+				},
+				destState: models.ClusterStatusFinalizing,
+			},
+			{
+				name:                       "available builtin operators, timed out OLM -> installed (degraded)",
+				uploadKubeConfig:           true,
+				updateSuccessfullyFinished: true,
+				finalizingSince:            2 * time.Hour,
+				operators: []*models.MonitoredOperator{
+					{
+						Name: common.TestDefaultConfig.MonitoredOperator.Name, OperatorType: models.OperatorTypeBuiltin,
+						Status: models.OperatorStatusAvailable,
+					},
+					{
+						Name: common.TestDefaultConfig.MonitoredOperator.Name + "2", OperatorType: models.OperatorTypeOlm,
+						Status: models.OperatorStatusProgressing, TimeoutSeconds: 60 * 60,
+					},
+					{
+						Name: common.TestDefaultConfig.MonitoredOperator.Name + "3", OperatorType: models.OperatorTypeOlm,
+						Status: models.OperatorStatusAvailable, TimeoutSeconds: 60 * 60,
+					},
+				},
+				degraded:          true,
+				timedOutOperators: []string{"dummy2"},
+				destState:         models.ClusterStatusInstalled,
+				destStatusInfo:    StatusInfoDegraded + ". Failed OLM operators: dummy2",
+			},
 			{
 				name:                       "available builtin operators, available OLM -> installed",
 				uploadKubeConfig:           true,
@@ -269,6 +314,7 @@ var _ = Describe("Transition tests", func() {
 					Cluster: models.Cluster{
 						ID:                 &clusterId,
 						Status:             swag.String(models.ClusterStatusFinalizing),
+						StatusUpdatedAt:    strfmt.DateTime(time.Now().Add(-t.finalizingSince)),
 						MonitoredOperators: t.operators,
 					},
 					IsAmsSubscriptionConsoleUrlSet: true,
@@ -293,9 +339,17 @@ var _ = Describe("Transition tests", func() {
 
 				if t.destState != *c.Status {
 					if !t.errorExpected {
-						mockMetric.EXPECT().ClusterInstallationFinished(gomock.Any(), t.destState, c.OpenshiftVersion, *c.ID, c.EmailDomain, c.InstallStartedAt).Times(1)
+						result := t.destState
+						if t.degraded {
+							result = resultDegraded
+						}
+						mockMetric.EXPECT().ClusterInstallationFinished(gomock.Any(), result, c.OpenshiftVersion, *c.ID, c.EmailDomain, c.InstallStartedAt).Times(1)
 					}
 				}
+				for _, operatorName := range t.timedOutOperators {
+					mockMetric.EXPECT().MonitoredOperatorTimedOut(c.OpenshiftVersion, operatorName).Times(1)
+					mockMetric.EXPECT().MonitoredOperatorStatusChanged(c.OpenshiftVersion, operatorName, models.OperatorStatusFailed).Times(1)
+				}
 
 				capi = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, mockMetric, nil, nil, operatorsManager, ocmClient, mockS3Api, nil)
 
@@ -309,7 +363,9 @@ var _ = Describe("Transition tests", func() {
 					Expect(swag.StringValue(clusterAfterRefresh.StatusInfo)).To(Equal(t.destStatusInfo))
 
 					if t.destState != *c.Status {
-						if t.updateSuccessfullyFinished {
+						if t.degraded {
+							checkCompleteInstallationUpdate(models.EventSeverityWarning, "Successfully finished installing cluster")
+						} else if t.updateSuccessfullyFinished {
 							checkCompleteInstallationUpdate(models.EventSeverityInfo, "Successfully finished installing cluster")
 						} else {
 							checkCompleteInstallationUpdate(models.EventSeverityCritical, fmt.Sprintf("Operator %s failed", t.operators[0].Name))
@@ -321,6 +377,12 @@ var _ = Describe("Transition tests", func() {
 				clusterFromDB := getClusterFromDB(clusterId, db)
 				Expect(swag.StringValue(clusterFromDB.Status)).To(Equal(t.destState))
 				Expect(swag.StringValue(clusterFromDB.StatusInfo)).To(Equal(t.destStatusInfo))
+				for _, operatorName := range t.timedOutOperators {
+					var operator models.MonitoredOperator
+					Expect(db.First(&operator, "cluster_id = ? and name = ?", clusterId, operatorName).Error).ToNot(HaveOccurred())
+					Expect(operator.Status).To(Equal(models.OperatorStatusFailed))
+					Expect(operator.StatusHistory).NotTo(BeEmpty())
+				}
 			})
 		}
 	})
@@ -3401,6 +3463,7 @@ var _ = Describe("NTP refresh cluster", func() {
 			validationsChecker      *validationsChecker
 			setMachineCidrUpdatedAt bool
 			errorExpected           bool
+			validationPolicy        string
 		}{
 			{
 				name:               "pending-for-input to insufficient - ntp problem",
@@ -3432,6 +3495,68 @@ var _ = Describe("NTP refresh cluster", func() {
 				}),
 				errorExpected: false,
 			},
+			{
+				name:               "pending-for-input to ready - time difference within the limit of the validation policy",
+				srcState:           models.ClusterStatusPendingForInput,
+				dstState:           models.ClusterStatusReady,
+				machineNetworkCidr: "1.2.3.0/24",
+				apiVip:             "1.2.3.5",
+				ingressVip:         "1.2.3.6",
+				dnsDomain:          "test.com",
+				pullSecretSet:      true,
+				validationPolicy:   `{"max_time_diff_minutes": 10}`,
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239), Role: models.HostRoleMaster},
+					{ID: &hid2, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239 - 400), Role: models.HostRoleMaster},
+					{ID: &hid3, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239), Role: models.HostRoleMaster},
+				},
+				statusInfoChecker: makeValueChecker(StatusInfoReady),
+				validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+					IsMachineCidrDefined:                {status: ValidationSuccess, messagePattern: "The Machine Network CIDR is defined"},
+					IsMachineCidrEqualsToCalculatedCidr: {status: ValidationSuccess, messagePattern: "The Cluster Machine CIDR is equivalent to the calculated CIDR"},
+					IsApiVipDefined:                     {status: ValidationSuccess, messagePattern: "The API virtual IP is defined"},
+					IsApiVipValid:                       {status: ValidationSuccess, messagePattern: "belongs to the Machine CIDR and is not in use."},
+					IsIngressVipDefined:                 {status: ValidationSuccess, messagePattern: "The Ingress virtual IP is defined"},
+					IsIngressVipValid:                   {status: ValidationSuccess, messagePattern: "belongs to the Machine CIDR and is not in use."},
+					AllHostsAreReadyToInstall:           {status: ValidationSuccess, messagePattern: "All hosts in the cluster are ready to install"},
+					IsDNSDomainDefined:                  {status: ValidationSuccess, messagePattern: "The base domain is defined"},
+					IsPullSecretSet:                     {status: ValidationSuccess, messagePattern: "The pull secret is set"},
+					SufficientMastersCount:              {status: ValidationSuccess, messagePattern: "The cluster has a sufficient number of master candidates"},
+					IsNtpServerConfigured:               {status: ValidationSuccess, messagePattern: "No ntp problems found"},
+				}),
+				errorExpected: false,
+			},
+			{
+				name:               "pending-for-input to ready - ntp validation disabled by the validation policy",
+				srcState:           models.ClusterStatusPendingForInput,
+				dstState:           models.ClusterStatusReady,
+				machineNetworkCidr: "1.2.3.0/24",
+				apiVip:             "1.2.3.5",
+				ingressVip:         "1.2.3.6",
+				dnsDomain:          "test.com",
+				pullSecretSet:      true,
+				validationPolicy:   `{"disabled_cluster_validations": ["ntp-server-configured"]}`,
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239), Role: models.HostRoleMaster},
+					{ID: &hid2, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239 - 400), Role: models.HostRoleMaster},
+					{ID: &hid3, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239), Role: models.HostRoleMaster},
+				},
+				statusInfoChecker: makeValueChecker(StatusInfoReady),
+				validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+					IsMachineCidrDefined:                {status: ValidationSuccess, messagePattern: "The Machine Network CIDR is defined"},
+					IsMachineCidrEqualsToCalculatedCidr: {status: ValidationSuccess, messagePattern: "The Cluster Machine CIDR is equivalent to the calculated CIDR"},
+					IsApiVipDefined:                     {status: ValidationSuccess, messagePattern: "The API virtual IP is defined"},
+					IsApiVipValid:                       {status: ValidationSuccess, messagePattern: "belongs to the Machine CIDR and is not in use."},
+					IsIngressVipDefined:                 {status: ValidationSuccess, messagePattern: "The Ingress virtual IP is defined"},
+					IsIngressVipValid:                   {status: ValidationSuccess, messagePattern: "belongs to the Machine CIDR and is not in use."},
+					AllHostsAreReadyToInstall:           {status: ValidationSuccess, messagePattern: "All hosts in the cluster are ready to install"},
+					IsDNSDomainDefined:                  {status: ValidationSuccess, messagePattern: "The base domain is defined"},
+					IsPullSecretSet:                     {status: ValidationSuccess, messagePattern: "The pull secret is set"},
+					SufficientMastersCount:              {status: ValidationSuccess, messagePattern: "The cluster has a sufficient number of master candidates"},
+					IsNtpServerConfigured:               {status: ValidationDisabled, messagePattern: "Validation disabled by the validation policy of the cluster"},
+				}),
+				errorExpected: false,
+			},
 			{
 				name:               "pending-for-input to ready",
 				srcState:           models.ClusterStatusPendingForInput,
@@ -3669,6 +3794,7 @@ var _ = Describe("NTP refresh cluster", func() {
 						ClusterNetworkCidr:       "1.3.0.0/16",
 						ServiceNetworkCidr:       "1.4.0.0/16",
 						ClusterNetworkHostPrefix: 24,
+						ValidationPolicy:         t.validationPolicy,
 					},
 				}
 				Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
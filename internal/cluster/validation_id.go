@@ -2,8 +2,10 @@ package cluster
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 )
@@ -29,6 +31,8 @@ const (
 	IsOcsRequirementsSatisfied          = ValidationID(models.ClusterValidationIDOcsRequirementsSatisfied)
 	IsLsoRequirementsSatisfied          = ValidationID(models.ClusterValidationIDLsoRequirementsSatisfied)
 	IsCnvRequirementsSatisfied          = ValidationID(models.ClusterValidationIDCnvRequirementsSatisfied)
+	IsSriovRequirementsSatisfied        = ValidationID(models.ClusterValidationIDSriovRequirementsSatisfied)
+	IsLvmRequirementsSatisfied          = ValidationID(models.ClusterValidationIDLvmRequirementsSatisfied)
 )
 
 func (v ValidationID) Category() (string, error) {
@@ -40,7 +44,10 @@ func (v ValidationID) Category() (string, error) {
 		return "hosts-data", nil
 	case IsPullSecretSet:
 		return "configuration", nil
-	case IsOcsRequirementsSatisfied, IsLsoRequirementsSatisfied, IsCnvRequirementsSatisfied:
+	case IsOcsRequirementsSatisfied, IsLsoRequirementsSatisfied, IsCnvRequirementsSatisfied, IsSriovRequirementsSatisfied, IsLvmRequirementsSatisfied:
+		return "operators", nil
+	}
+	if strings.HasSuffix(string(v), api.ValidationIDSuffix) {
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected cluster validation id %s", string(v)))
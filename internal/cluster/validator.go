@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -18,10 +19,11 @@ import (
 type ValidationStatus string
 
 const (
-	ValidationSuccess ValidationStatus = "success"
-	ValidationFailure ValidationStatus = "failure"
-	ValidationPending ValidationStatus = "pending"
-	ValidationError   ValidationStatus = "error"
+	ValidationSuccess  ValidationStatus = "success"
+	ValidationFailure  ValidationStatus = "failure"
+	ValidationPending  ValidationStatus = "pending"
+	ValidationError    ValidationStatus = "error"
+	ValidationDisabled ValidationStatus = "disabled"
 )
 
 const (
@@ -138,9 +140,9 @@ func (v *clusterValidator) isMachineCidrEqualsToCalculatedCidr(c *clusterPreproc
 	if c.cluster.APIVip == "" && c.cluster.IngressVip == "" {
 		return ValidationPending
 	}
-	cidr, err := network.CalculateMachineNetworkCIDR(c.cluster.APIVip, c.cluster.IngressVip, c.cluster.Hosts, true)
-	c.calculateCidr = cidr
-	return boolValue(err == nil && cidr == c.cluster.MachineNetworkCidr)
+	cidrs, err := network.CalculateMachineNetworkCIDRs(common.GetAPIVips(c.cluster), common.GetIngressVips(c.cluster), c.cluster.Hosts, true)
+	c.calculateCidr = strings.Join(cidrs, ", ")
+	return boolValue(err == nil && c.calculateCidr == strings.Join(common.GetMachineNetworkCidrs(c.cluster), ", "))
 }
 
 func (v *clusterValidator) printIsMachineCidrEqualsToCalculatedCidr(context *clusterPreprocessContext, status ValidationStatus) string {
@@ -153,7 +155,8 @@ func (v *clusterValidator) printIsMachineCidrEqualsToCalculatedCidr(context *clu
 		}
 		return "The Cluster Machine CIDR is equivalent to the calculated CIDR."
 	case ValidationFailure:
-		return fmt.Sprintf("The Cluster Machine CIDR %s is different than the calculated CIDR %s.", context.cluster.MachineNetworkCidr, context.calculateCidr)
+		return fmt.Sprintf("The Cluster Machine CIDR %s is different than the calculated CIDR %s.",
+			strings.Join(common.GetMachineNetworkCidrs(context.cluster), ", "), context.calculateCidr)
 	default:
 		return fmt.Sprintf("Unexpected status %s.", status)
 	}
@@ -200,7 +203,7 @@ func (v *clusterValidator) isApiVipValid(c *clusterPreprocessContext) Validation
 	if c.cluster.APIVip == "" {
 		return ValidationPending
 	}
-	err := network.VerifyVip(c.cluster.Hosts, c.cluster.MachineNetworkCidr, c.cluster.APIVip, ApiVipName,
+	err := network.VerifyDualStackVip(c.cluster.Hosts, common.GetMachineNetworkCidrs(c.cluster), common.GetAPIVips(c.cluster), ApiVipName,
 		true, v.log)
 	return boolValue(err == nil)
 }
@@ -213,9 +216,9 @@ func (v *clusterValidator) printIsApiVipValid(context *clusterPreprocessContext,
 		if swag.BoolValue(context.cluster.UserManagedNetworking) {
 			return "The API virtual IP is not required: User Managed Networking"
 		}
-		return fmt.Sprintf("%s %s belongs to the Machine CIDR and is not in use.", ApiVipName, context.cluster.APIVip)
+		return fmt.Sprintf("%s %s belongs to the Machine CIDR and is not in use.", ApiVipName, strings.Join(common.GetAPIVips(context.cluster), ", "))
 	case ValidationFailure:
-		return fmt.Sprintf("%s %s does not belong to the Machine CIDR or is already in use.", ApiVipName, context.cluster.APIVip)
+		return fmt.Sprintf("%s %s does not belong to the Machine CIDR or is already in use.", ApiVipName, strings.Join(common.GetAPIVips(context.cluster), ", "))
 	default:
 		return fmt.Sprintf("Unexpected status %s.", status)
 	}
@@ -261,7 +264,7 @@ func (v *clusterValidator) isIngressVipValid(c *clusterPreprocessContext) Valida
 	if c.cluster.IngressVip == "" {
 		return ValidationPending
 	}
-	err := network.VerifyVip(c.cluster.Hosts, c.cluster.MachineNetworkCidr, c.cluster.IngressVip, IngressVipName,
+	err := network.VerifyDualStackVip(c.cluster.Hosts, common.GetMachineNetworkCidrs(c.cluster), common.GetIngressVips(c.cluster), IngressVipName,
 		true, v.log)
 	return boolValue(err == nil)
 }
@@ -274,9 +277,9 @@ func (v *clusterValidator) printIsIngressVipValid(context *clusterPreprocessCont
 		if swag.BoolValue(context.cluster.UserManagedNetworking) {
 			return "The Ingress virtual IP is not required: User Managed Networking"
 		}
-		return fmt.Sprintf("%s %s belongs to the Machine CIDR and is not in use.", IngressVipName, context.cluster.IngressVip)
+		return fmt.Sprintf("%s %s belongs to the Machine CIDR and is not in use.", IngressVipName, strings.Join(common.GetIngressVips(context.cluster), ", "))
 	case ValidationFailure:
-		return fmt.Sprintf("%s %s does not belong to the Machine CIDR or is already in use.", IngressVipName, context.cluster.IngressVip)
+		return fmt.Sprintf("%s %s does not belong to the Machine CIDR or is already in use.", IngressVipName, strings.Join(common.GetIngressVips(context.cluster), ", "))
 	default:
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
@@ -419,7 +422,22 @@ func (v *clusterValidator) noCidrsOverlapping(c *clusterPreprocessContext) Valid
 			return ValidationPending
 		}
 	}
-	return boolValue(network.VerifyClusterCIDRsNotOverlap(c.cluster.MachineNetworkCidr, c.cluster.ClusterNetworkCidr, c.cluster.ServiceNetworkCidr, swag.BoolValue(c.cluster.UserManagedNetworking)) == nil)
+	return boolValue(verifyClusterCIDRsNotOverlap(c.cluster) == nil)
+}
+
+func verifyClusterCIDRsNotOverlap(c *common.Cluster) error {
+	clusterNetworkCidrs := make([]string, 0)
+	for _, clusterNetwork := range common.GetClusterNetworks(c) {
+		clusterNetworkCidrs = append(clusterNetworkCidrs, clusterNetwork.Cidr)
+	}
+	if common.IsMultiSubnetCluster(c) {
+		if err := network.VerifyMachineNetworksNotOverlap(common.GetMachineNetworkCidrs(c), clusterNetworkCidrs,
+			common.GetServiceNetworkCidrs(c)); err != nil {
+			return err
+		}
+	}
+	return network.VerifyDualStackCIDRsNotOverlap(common.GetMachineNetworkCidrs(c), clusterNetworkCidrs,
+		common.GetServiceNetworkCidrs(c), swag.BoolValue(c.UserManagedNetworking))
 }
 
 func (v *clusterValidator) printNoCidrsOverlapping(c *clusterPreprocessContext, status ValidationStatus) string {
@@ -427,7 +445,7 @@ func (v *clusterValidator) printNoCidrsOverlapping(c *clusterPreprocessContext,
 	case ValidationSuccess:
 		return "No CIDRS are overlapping."
 	case ValidationFailure:
-		if err := network.VerifyClusterCIDRsNotOverlap(c.cluster.MachineNetworkCidr, c.cluster.ClusterNetworkCidr, c.cluster.ServiceNetworkCidr, swag.BoolValue(c.cluster.UserManagedNetworking)); err != nil {
+		if err := verifyClusterCIDRsNotOverlap(c.cluster); err != nil {
 			return fmt.Sprintf("CIDRS Overlapping: %s.", err.Error())
 		}
 		return ""
@@ -0,0 +1,453 @@
+package clusterexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/internal/manifests"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/export"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsoperations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// DocumentVersion is the version of the format of the exported documents
+const DocumentVersion = models.ClusterExportVersionV1
+
+const formatJSON = "json"
+
+var _ restapi.ExportAPI = &Api{}
+
+type Api struct {
+	db                 *gorm.DB
+	log                logrus.FieldLogger
+	installer          bminventory.InstallerInternals
+	manifestsInternals manifests.ClusterManifestsInternals
+	objectHandler      s3wrapper.API
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger, installer bminventory.InstallerInternals,
+	manifestsInternals manifests.ClusterManifestsInternals, objectHandler s3wrapper.API) *Api {
+	return &Api{
+		db:                 db,
+		log:                log,
+		installer:          installer,
+		manifestsInternals: manifestsInternals,
+		objectHandler:      objectHandler,
+	}
+}
+
+func (a *Api) ExportCluster(ctx context.Context, params operations.ExportClusterParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	document, err := a.ExportClusterInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	data, err := json.MarshalIndent(document, "", "  ")
+	fileName := fmt.Sprintf("%s.json", params.ClusterID.String())
+	if err == nil && swag.StringValue(params.Format) != formatJSON {
+		data, err = yaml.JSONToYAML(data)
+		fileName = fmt.Sprintf("%s.yaml", params.ClusterID.String())
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to marshal the exported document of cluster %s", params.ClusterID.String())
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	return filemiddleware.NewResponder(operations.NewExportClusterOK().WithPayload(ioutil.NopCloser(bytes.NewReader(data))),
+		fileName, int64(len(data)))
+}
+
+// ExportClusterInternal returns the portable document of the configuration of the cluster
+func (a *Api) ExportClusterInternal(ctx context.Context, clusterID strfmt.UUID) (*models.ClusterExport, error) {
+	query, args := identity.AddUserFilter(ctx, "id = ?", clusterID.String())
+	c, err := common.GetClusterFromDBWhere(a.db, common.UseEagerLoading, common.SkipDeletedRecords,
+		append([]interface{}{query}, args...)...)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(errors.Cause(err)) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	settings, err := exportSettings(c)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	manifestsList, err := a.exportManifests(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ClusterExport{
+		Version:                    swag.String(DocumentVersion),
+		Cluster:                    settings,
+		InstallConfigOverrides:     c.InstallConfigOverrides,
+		DiscoveryIgnitionOverrides: c.IgnitionConfigOverrides,
+		Manifests:                  manifestsList,
+		Hosts:                      exportHosts(c),
+	}, nil
+}
+
+func exportSettings(c *common.Cluster) (*models.ClusterExportSettings, error) {
+	settings := &models.ClusterExportSettings{
+		Name:                     swag.String(c.Name),
+		OpenshiftVersion:         swag.String(c.OpenshiftVersion),
+		OcpReleaseImage:          c.OcpReleaseImage,
+		HighAvailabilityMode:     swag.StringValue(c.HighAvailabilityMode),
+		BaseDNSDomain:            c.BaseDNSDomain,
+		ClusterNetworkCidr:       c.ClusterNetworkCidr,
+		ClusterNetworkHostPrefix: c.ClusterNetworkHostPrefix,
+		ServiceNetworkCidr:       c.ServiceNetworkCidr,
+		MachineNetworkCidr:       c.MachineNetworkCidr,
+		APIVip:                   c.APIVip,
+		IngressVip:               c.IngressVip,
+		VipDhcpAllocation:        swag.BoolValue(c.VipDhcpAllocation),
+		UserManagedNetworking:    swag.BoolValue(c.UserManagedNetworking),
+		HTTPProxy:                c.HTTPProxy,
+		HTTPSProxy:               c.HTTPSProxy,
+		NoProxy:                  c.NoProxy,
+		AdditionalNtpSource:      c.AdditionalNtpSource,
+		SSHPublicKey:             c.SSHPublicKey,
+		Hyperthreading:           c.Hyperthreading,
+		CPUArchitecture:          c.CPUArchitecture,
+	}
+	if common.IsDualStackCluster(c) || common.IsMultiSubnetCluster(c) {
+		settings.MachineNetworks = common.GetMachineNetworkCidrs(c)
+	}
+	if common.IsDualStackCluster(c) {
+		settings.ClusterNetworks = common.GetClusterNetworks(c)
+		settings.ServiceNetworks = common.GetServiceNetworkCidrs(c)
+		settings.APIVips = common.GetAPIVips(c)
+		settings.IngressVips = common.GetIngressVips(c)
+	}
+	for _, operator := range c.MonitoredOperators {
+		if operator.OperatorType != models.OperatorTypeOlm {
+			continue
+		}
+		settings.OlmOperators = append(settings.OlmOperators,
+			&models.OperatorCreateParams{Name: operator.Name, Properties: operator.Properties, Channel: operator.Channel, Csv: operator.Csv})
+	}
+	rules, err := host.GetClusterHostValidationRules(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) > 0 {
+		settings.HostValidationRules = models.HostValidationRules(rules)
+	}
+	policy, err := common.GetValidationPolicy(c)
+	if err != nil {
+		return nil, err
+	}
+	if !swag.IsZero(*policy) {
+		settings.ValidationPolicy = policy
+	}
+	if c.ImageInfo != nil && c.ImageInfo.StaticNetworkConfig != "" {
+		settings.StaticNetworkConfig = staticnetworkconfig.ParseStaticNetworkConfigFromDB(c.ImageInfo.StaticNetworkConfig)
+	}
+	if c.IPPool != "" {
+		var pool models.IPPool
+		if err = json.Unmarshal([]byte(c.IPPool), &pool); err != nil {
+			return nil, err
+		}
+		settings.IPPool = &pool
+	}
+	return settings, nil
+}
+
+func exportHosts(c *common.Cluster) []*models.ClusterExportHost {
+	hosts := make([]*models.ClusterExportHost, 0, len(c.Hosts))
+	for _, h := range c.Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		var inventory models.Inventory
+		if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+			continue
+		}
+		macAddresses := make([]string, 0, len(inventory.Interfaces))
+		for _, intf := range inventory.Interfaces {
+			if intf.MacAddress != "" {
+				macAddresses = append(macAddresses, strings.ToLower(intf.MacAddress))
+			}
+		}
+		if len(macAddresses) == 0 {
+			continue
+		}
+		host := &models.ClusterExportHost{
+			MacAddresses:          macAddresses,
+			Hostname:              h.RequestedHostname,
+			MachineConfigPoolName: h.MachineConfigPoolName,
+		}
+		switch h.Role {
+		case models.HostRoleMaster, models.HostRoleBootstrap:
+			host.Role = models.HostRoleUpdateParamsMaster
+		case models.HostRoleWorker:
+			host.Role = models.HostRoleUpdateParamsWorker
+		}
+		if host.Role == "" && host.Hostname == "" && host.MachineConfigPoolName == "" {
+			continue
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
+
+func (a *Api) exportManifests(ctx context.Context, c *common.Cluster) ([]*models.ClusterExportManifest, error) {
+	log := logutil.FromContext(ctx, a.log)
+	list, err := a.manifestsInternals.ListClusterManifestsInternal(ctx,
+		manifestsoperations.ListClusterManifestsParams{ClusterID: *c.ID})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.ClusterExportManifest, 0, len(list))
+	for _, manifest := range list {
+		objectName := manifests.GetManifestObjectName(*c.ID, filepath.Join(manifest.Folder, manifest.FileName))
+		reader, _, err := a.objectHandler.Download(ctx, objectName)
+		if err != nil {
+			log.WithError(err).Errorf("failed to download manifest %s of cluster %s", objectName, c.ID.String())
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		content, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			log.WithError(err).Errorf("failed to read manifest %s of cluster %s", objectName, c.ID.String())
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		result = append(result, &models.ClusterExportManifest{
+			Folder:   swag.String(manifest.Folder),
+			FileName: swag.String(manifest.FileName),
+			Content:  swag.String(string(content)),
+		})
+	}
+	return result, nil
+}
+
+func (a *Api) ImportCluster(ctx context.Context, params operations.ImportClusterParams) middleware.Responder {
+	c, err := a.ImportClusterInternal(ctx, params.ClusterImportParams)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewImportClusterCreated().WithPayload(&c.Cluster)
+}
+
+// ImportClusterInternal creates a cluster from the exported document of another cluster
+func (a *Api) ImportClusterInternal(ctx context.Context, params *models.ClusterImportParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, a.log)
+	document, err := ParseDocument(swag.StringValue(params.Document))
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	settings := document.Cluster
+	if params.Name != "" {
+		settings.Name = swag.String(params.Name)
+	}
+
+	c, err := a.installer.RegisterClusterInternal(ctx, nil, installer.RegisterClusterParams{
+		NewClusterParams: createParams(settings, params.PullSecret),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = a.configureCluster(ctx, *c.ID, document); err != nil {
+		log.WithError(err).Errorf("failed to import the configuration of cluster %s, deregistering it", c.ID.String())
+		if deregisterErr := a.installer.DeregisterClusterInternal(ctx,
+			installer.DeregisterClusterParams{ClusterID: *c.ID}); deregisterErr != nil {
+			log.WithError(deregisterErr).Errorf("failed to deregister cluster %s", c.ID.String())
+		}
+		return nil, err
+	}
+	log.Infof("Imported cluster %s from a document of version %s", c.ID.String(), swag.StringValue(document.Version))
+	return a.installer.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *c.ID})
+}
+
+// ParseDocument parses and validates an exported document in YAML or JSON
+func ParseDocument(data string) (*models.ClusterExport, error) {
+	jsonData, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the document")
+	}
+	var document models.ClusterExport
+	if err = json.Unmarshal(jsonData, &document); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the document")
+	}
+	if err = document.Validate(strfmt.Default); err != nil {
+		return nil, errors.Wrap(err, "invalid document")
+	}
+	return &document, nil
+}
+
+func createParams(settings *models.ClusterExportSettings, pullSecret *string) *models.ClusterCreateParams {
+	return &models.ClusterCreateParams{
+		Name:                     settings.Name,
+		OpenshiftVersion:         settings.OpenshiftVersion,
+		OcpReleaseImage:          settings.OcpReleaseImage,
+		PullSecret:               pullSecret,
+		HighAvailabilityMode:     optionalString(settings.HighAvailabilityMode),
+		BaseDNSDomain:            settings.BaseDNSDomain,
+		ClusterNetworkCidr:       optionalString(settings.ClusterNetworkCidr),
+		ClusterNetworkHostPrefix: settings.ClusterNetworkHostPrefix,
+		ServiceNetworkCidr:       optionalString(settings.ServiceNetworkCidr),
+		ClusterNetworks:          settings.ClusterNetworks,
+		ServiceNetworks:          settings.ServiceNetworks,
+		VipDhcpAllocation:        swag.Bool(settings.VipDhcpAllocation),
+		UserManagedNetworking:    swag.Bool(settings.UserManagedNetworking),
+		HTTPProxy:                optionalString(settings.HTTPProxy),
+		HTTPSProxy:               optionalString(settings.HTTPSProxy),
+		NoProxy:                  optionalString(settings.NoProxy),
+		AdditionalNtpSource:      optionalString(settings.AdditionalNtpSource),
+		SSHPublicKey:             settings.SSHPublicKey,
+		Hyperthreading:           optionalString(settings.Hyperthreading),
+		CPUArchitecture:          optionalString(settings.CPUArchitecture),
+		OlmOperators:             settings.OlmOperators,
+	}
+}
+
+// optionalString returns nil for an empty string, so that the default of the parameter is used
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return swag.String(value)
+}
+
+// configureCluster applies the configuration of the document that is not set when the cluster is registered
+func (a *Api) configureCluster(ctx context.Context, clusterID strfmt.UUID, document *models.ClusterExport) error {
+	settings := document.Cluster
+	updateParams := &models.ClusterUpdateParams{ValidationPolicy: settings.ValidationPolicy}
+	if !settings.UserManagedNetworking {
+		if settings.VipDhcpAllocation {
+			if settings.MachineNetworkCidr != "" {
+				updateParams.MachineNetworkCidr = swag.String(settings.MachineNetworkCidr)
+			}
+		} else {
+			if settings.APIVip != "" {
+				updateParams.APIVip = swag.String(settings.APIVip)
+			}
+			if settings.IngressVip != "" {
+				updateParams.IngressVip = swag.String(settings.IngressVip)
+			}
+			updateParams.APIVips = settings.APIVips
+			updateParams.IngressVips = settings.IngressVips
+		}
+	} else {
+		updateParams.MachineNetworks = settings.MachineNetworks
+	}
+	if !swag.IsZero(*updateParams) {
+		if _, err := a.installer.UpdateClusterInternal(ctx, installer.UpdateClusterParams{
+			ClusterID:           clusterID,
+			ClusterUpdateParams: updateParams,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if settings.IPPool != nil {
+		if _, err := a.installer.UpdateClusterIPPoolInternal(ctx, installer.UpdateClusterIPPoolParams{
+			ClusterID: clusterID,
+			IPPool:    settings.IPPool,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, hostConfig := range settings.StaticNetworkConfig {
+		if _, err := a.installer.CreateClusterStaticNetworkConfigInternal(ctx, installer.CreateClusterStaticNetworkConfigParams{
+			ClusterID:           clusterID,
+			StaticNetworkConfig: hostConfig,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(settings.HostValidationRules) > 0 {
+		if _, err := a.installer.UpdateHostValidationRulesInternal(ctx, installer.UpdateHostValidationRulesParams{
+			ClusterID:           clusterID,
+			HostValidationRules: settings.HostValidationRules,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if document.InstallConfigOverrides != "" {
+		if _, err := a.installer.UpdateClusterInstallConfigInternal(ctx, installer.UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: document.InstallConfigOverrides,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if document.DiscoveryIgnitionOverrides != "" {
+		if err := a.installer.UpdateDiscoveryIgnitionInternal(ctx, installer.UpdateDiscoveryIgnitionParams{
+			ClusterID:               clusterID,
+			DiscoveryIgnitionParams: &models.DiscoveryIgnitionParams{Config: document.DiscoveryIgnitionOverrides},
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, manifest := range document.Manifests {
+		content := base64.StdEncoding.EncodeToString([]byte(swag.StringValue(manifest.Content)))
+		if _, err := a.manifestsInternals.CreateClusterManifestInternal(ctx, manifestsoperations.CreateClusterManifestParams{
+			ClusterID: clusterID,
+			CreateManifestParams: &models.CreateManifestParams{
+				Folder:   manifest.Folder,
+				FileName: manifest.FileName,
+				Content:  &content,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return a.createHostAssignments(clusterID, document.Hosts)
+}
+
+func (a *Api) createHostAssignments(clusterID strfmt.UUID, hosts []*models.ClusterExportHost) error {
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		for _, host := range hosts {
+			role := models.HostRole(host.Role)
+			if role == models.HostRoleAutoAssign {
+				role = ""
+			}
+			for _, macAddress := range host.MacAddresses {
+				if err := tx.Create(&common.HostAssignment{
+					ClusterID:             clusterID,
+					MacAddress:            strings.ToLower(macAddress),
+					Role:                  role,
+					RequestedHostname:     host.Hostname,
+					MachineConfigPoolName: host.MachineConfigPoolName,
+				}).Error; err != nil {
+					return common.NewApiError(http.StatusInternalServerError,
+						errors.Wrapf(err, "failed to save the settings of the hosts of cluster %s", clusterID.String()))
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,399 @@
+package clusterexport
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/manifests"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	operations "github.com/openshift/assisted-service/restapi/operations/export"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsoperations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const manifestContent = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n"
+
+var ipPool = &models.IPPool{
+	Cidr:       swag.String("1.2.3.0/24"),
+	Gateway:    swag.String("1.2.3.1"),
+	DNSServers: []string{"1.2.3.2"},
+}
+
+var hostStaticNetworkConfig = &models.HostStaticNetworkConfig{
+	NetworkYaml:     "interfaces:\n- name: eth0\n  type: ethernet\n  state: up\n",
+	MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "52:54:00:aa:bb:cc", LogicalNicName: "eth0"}},
+}
+
+var _ = Describe("Cluster export API", func() {
+	var (
+		db                *gorm.DB
+		dbName            string
+		ctrl              *gomock.Controller
+		mockInstaller     *bminventory.MockInstallerInternals
+		mockManifests     *manifests.MockClusterManifestsInternals
+		mockObjectHandler *s3wrapper.MockAPI
+		api               *Api
+		ctx               = context.Background()
+		clusterID         strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstaller = bminventory.NewMockInstallerInternals(ctrl)
+		mockManifests = manifests.NewMockClusterManifestsInternals(ctrl)
+		mockObjectHandler = s3wrapper.NewMockAPI(ctrl)
+		api = NewApi(db, logrus.New(), mockInstaller, mockManifests, mockObjectHandler)
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("ExportCluster", func() {
+		BeforeEach(func() {
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+				ID:                       &clusterID,
+				Name:                     "test-cluster",
+				OpenshiftVersion:         common.TestDefaultConfig.OpenShiftVersion,
+				HighAvailabilityMode:     swag.String(models.ClusterHighAvailabilityModeFull),
+				BaseDNSDomain:            "example.com",
+				ClusterNetworkCidr:       "10.128.0.0/14",
+				ClusterNetworkHostPrefix: 23,
+				ServiceNetworkCidr:       "172.30.0.0/16",
+				MachineNetworkCidr:       "1.2.3.0/24",
+				APIVip:                   "1.2.3.5",
+				IngressVip:               "1.2.3.6",
+				VipDhcpAllocation:        swag.Bool(false),
+				InstallConfigOverrides:   `{"fips":true}`,
+				HostValidationRules:      `[{"id":"two-nics","expression":"length(interfaces) >= ` + "`2`" + `"}]`,
+				ValidationPolicy:         `{"disabled_host_validations":["ntp-synced"]}`,
+				IPPool:                   `{"cidr":"1.2.3.0/24","gateway":"1.2.3.1","dns_servers":["1.2.3.2"]}`,
+				ImageInfo: &models.ImageInfo{
+					StaticNetworkConfig: staticnetworkconfig.New(logrus.New()).FormatStaticNetworkConfigForDB(
+						[]*models.HostStaticNetworkConfig{hostStaticNetworkConfig}),
+				},
+				MonitoredOperators: []*models.MonitoredOperator{
+					{Name: "console", OperatorType: models.OperatorTypeBuiltin},
+					{Name: "lso", OperatorType: models.OperatorTypeOlm, Channel: "4.8", Csv: "local-storage-operator.4.8.0-202106291913"},
+				},
+			},
+				PullSecret:                        "secret",
+				SecondaryMachineNetworkCidr:       "1001:db8::/120",
+				SecondaryClusterNetworkCidr:       "fd01::/48",
+				SecondaryClusterNetworkHostPrefix: 64,
+				SecondaryServiceNetworkCidr:       "fd02::/112",
+				SecondaryAPIVip:                   "1001:db8::64",
+				SecondaryIngressVip:               "1001:db8::65",
+			}).Error).ShouldNot(HaveOccurred())
+
+			inventory, err := json.Marshal(&models.Inventory{
+				Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "52:54:00:AA:BB:CC"}},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			hostID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&models.Host{
+				ID:                &hostID,
+				ClusterID:         clusterID,
+				Status:            swag.String(models.HostStatusKnown),
+				Role:              models.HostRoleMaster,
+				RequestedHostname: "master-0",
+				Inventory:         string(inventory),
+			}).Error).ShouldNot(HaveOccurred())
+
+			mockManifests.EXPECT().ListClusterManifestsInternal(gomock.Any(),
+				manifestsoperations.ListClusterManifestsParams{ClusterID: clusterID}).
+				Return(models.ListManifests{{Folder: "openshift", FileName: "99-test.yaml"}}, nil)
+			mockObjectHandler.EXPECT().Download(gomock.Any(),
+				manifests.GetManifestObjectName(clusterID, "openshift/99-test.yaml")).
+				Return(ioutil.NopCloser(strings.NewReader(manifestContent)), int64(len(manifestContent)), nil)
+		})
+
+		exportDocument := func(format string) string {
+			responder := api.ExportCluster(ctx, operations.ExportClusterParams{ClusterID: clusterID, Format: swag.String(format)})
+			recorder := httptest.NewRecorder()
+			responder.WriteResponse(recorder, runtime.ByteStreamProducer())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			return recorder.Body.String()
+		}
+
+		It("exports the cluster as YAML", func() {
+			data := exportDocument("yaml")
+			Expect(data).To(ContainSubstring("version: v1"))
+			Expect(data).ToNot(ContainSubstring("secret"))
+
+			document, err := ParseDocument(data)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(swag.StringValue(document.Cluster.Name)).To(Equal("test-cluster"))
+			Expect(document.Cluster.APIVip).To(Equal("1.2.3.5"))
+			Expect(document.Cluster.MachineNetworks).To(Equal([]string{"1.2.3.0/24", "1001:db8::/120"}))
+			Expect(document.Cluster.ClusterNetworks).To(Equal([]*models.ClusterNetwork{
+				{Cidr: "10.128.0.0/14", HostPrefix: 23},
+				{Cidr: "fd01::/48", HostPrefix: 64},
+			}))
+			Expect(document.Cluster.ServiceNetworks).To(Equal([]string{"172.30.0.0/16", "fd02::/112"}))
+			Expect(document.Cluster.APIVips).To(Equal([]string{"1.2.3.5", "1001:db8::64"}))
+			Expect(document.Cluster.IngressVips).To(Equal([]string{"1.2.3.6", "1001:db8::65"}))
+			Expect(document.Cluster.StaticNetworkConfig).To(Equal([]*models.HostStaticNetworkConfig{hostStaticNetworkConfig}))
+			Expect(document.Cluster.IPPool).To(Equal(ipPool))
+			Expect(document.Cluster.OlmOperators).To(Equal([]*models.OperatorCreateParams{{
+				Name:    "lso",
+				Channel: "4.8",
+				Csv:     "local-storage-operator.4.8.0-202106291913",
+			}}))
+			Expect(document.Cluster.HostValidationRules).To(Equal(models.HostValidationRules{{
+				ID:         swag.String("two-nics"),
+				Expression: swag.String("length(interfaces) >= `2`"),
+			}}))
+			Expect(document.Cluster.ValidationPolicy).To(Equal(&models.ValidationPolicy{
+				DisabledHostValidations: []string{"ntp-synced"},
+			}))
+			Expect(document.InstallConfigOverrides).To(Equal(`{"fips":true}`))
+			Expect(document.Manifests).To(HaveLen(1))
+			Expect(swag.StringValue(document.Manifests[0].Content)).To(Equal(manifestContent))
+			Expect(document.Hosts).To(Equal([]*models.ClusterExportHost{{
+				MacAddresses: []string{"52:54:00:aa:bb:cc"},
+				Role:         models.HostRoleUpdateParamsMaster,
+				Hostname:     "master-0",
+			}}))
+		})
+
+		It("exports the cluster as JSON", func() {
+			var document models.ClusterExport
+			Expect(json.Unmarshal([]byte(exportDocument("json")), &document)).ShouldNot(HaveOccurred())
+			Expect(swag.StringValue(document.Version)).To(Equal(DocumentVersion))
+			Expect(swag.StringValue(document.Cluster.Name)).To(Equal("test-cluster"))
+		})
+
+		It("imports the exported settings", func() {
+			data := exportDocument("yaml")
+			importedID := strfmt.UUID(uuid.New().String())
+			c := &common.Cluster{Cluster: models.Cluster{ID: &importedID}}
+			mockInstaller.EXPECT().RegisterClusterInternal(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *types.NamespacedName, params installer.RegisterClusterParams) (*common.Cluster, error) {
+					Expect(swag.StringValue(params.NewClusterParams.Name)).To(Equal("test-cluster"))
+					Expect(params.NewClusterParams.BaseDNSDomain).To(Equal("example.com"))
+					Expect(params.NewClusterParams.ClusterNetworks).To(Equal([]*models.ClusterNetwork{
+						{Cidr: "10.128.0.0/14", HostPrefix: 23},
+						{Cidr: "fd01::/48", HostPrefix: 64},
+					}))
+					Expect(params.NewClusterParams.ServiceNetworks).To(Equal([]string{"172.30.0.0/16", "fd02::/112"}))
+					Expect(params.NewClusterParams.OlmOperators).To(Equal([]*models.OperatorCreateParams{{
+						Name:    "lso",
+						Channel: "4.8",
+						Csv:     "local-storage-operator.4.8.0-202106291913",
+					}}))
+					return c, nil
+				})
+			mockInstaller.EXPECT().UpdateClusterInternal(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, params installer.UpdateClusterParams) (*common.Cluster, error) {
+					Expect(params.ClusterID).To(Equal(importedID))
+					Expect(swag.StringValue(params.ClusterUpdateParams.APIVip)).To(Equal("1.2.3.5"))
+					Expect(swag.StringValue(params.ClusterUpdateParams.IngressVip)).To(Equal("1.2.3.6"))
+					Expect(params.ClusterUpdateParams.APIVips).To(Equal([]string{"1.2.3.5", "1001:db8::64"}))
+					Expect(params.ClusterUpdateParams.IngressVips).To(Equal([]string{"1.2.3.6", "1001:db8::65"}))
+					// The machine networks are derived from the VIPs without user-managed networking
+					Expect(params.ClusterUpdateParams.MachineNetworks).To(BeNil())
+					Expect(params.ClusterUpdateParams.ValidationPolicy).To(Equal(&models.ValidationPolicy{
+						DisabledHostValidations: []string{"ntp-synced"},
+					}))
+					return c, nil
+				})
+			mockInstaller.EXPECT().UpdateClusterIPPoolInternal(gomock.Any(), installer.UpdateClusterIPPoolParams{
+				ClusterID: importedID,
+				IPPool:    ipPool,
+			}).Return(ipPool, nil)
+			mockInstaller.EXPECT().CreateClusterStaticNetworkConfigInternal(gomock.Any(), installer.CreateClusterStaticNetworkConfigParams{
+				ClusterID:           importedID,
+				StaticNetworkConfig: hostStaticNetworkConfig,
+			}).Return(hostStaticNetworkConfig, nil)
+			mockInstaller.EXPECT().UpdateHostValidationRulesInternal(gomock.Any(), installer.UpdateHostValidationRulesParams{
+				ClusterID: importedID,
+				HostValidationRules: models.HostValidationRules{{
+					ID:         swag.String("two-nics"),
+					Expression: swag.String("length(interfaces) >= `2`"),
+				}},
+			}).Return(nil, nil)
+			mockInstaller.EXPECT().UpdateClusterInstallConfigInternal(gomock.Any(), gomock.Any()).Return(c, nil)
+			mockManifests.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).Return(&models.Manifest{}, nil)
+			mockInstaller.EXPECT().GetClusterInternal(gomock.Any(), installer.GetClusterParams{ClusterID: importedID}).Return(c, nil)
+
+			Expect(api.ImportCluster(ctx, operations.ImportClusterParams{ClusterImportParams: &models.ClusterImportParams{
+				PullSecret: swag.String("secret"),
+				Document:   swag.String(data),
+			}})).To(BeAssignableToTypeOf(operations.NewImportClusterCreated()))
+		})
+
+		It("imports the machine networks of a multi-subnet cluster", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(map[string]interface{}{
+				"user_managed_networking":          true,
+				"api_vip":                          "",
+				"ingress_vip":                      "",
+				"secondary_api_vip":                "",
+				"secondary_ingress_vip":            "",
+				"additional_machine_network_cidrs": "1.2.4.0/24,1.2.5.0/24",
+			}).Error).ShouldNot(HaveOccurred())
+			data := exportDocument("yaml")
+			document, err := ParseDocument(data)
+			Expect(err).ShouldNot(HaveOccurred())
+			machineNetworks := []string{"1.2.3.0/24", "1001:db8::/120", "1.2.4.0/24", "1.2.5.0/24"}
+			Expect(document.Cluster.MachineNetworks).To(Equal(machineNetworks))
+
+			importedID := strfmt.UUID(uuid.New().String())
+			c := &common.Cluster{Cluster: models.Cluster{ID: &importedID}}
+			mockInstaller.EXPECT().RegisterClusterInternal(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *types.NamespacedName, params installer.RegisterClusterParams) (*common.Cluster, error) {
+					Expect(swag.BoolValue(params.NewClusterParams.UserManagedNetworking)).To(BeTrue())
+					return c, nil
+				})
+			mockInstaller.EXPECT().UpdateClusterInternal(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, params installer.UpdateClusterParams) (*common.Cluster, error) {
+					Expect(params.ClusterUpdateParams.MachineNetworks).To(Equal(machineNetworks))
+					Expect(params.ClusterUpdateParams.APIVips).To(BeNil())
+					Expect(params.ClusterUpdateParams.IngressVips).To(BeNil())
+					return c, nil
+				})
+			mockInstaller.EXPECT().UpdateClusterIPPoolInternal(gomock.Any(), gomock.Any()).Return(ipPool, nil)
+			mockInstaller.EXPECT().CreateClusterStaticNetworkConfigInternal(gomock.Any(), gomock.Any()).Return(hostStaticNetworkConfig, nil)
+			mockInstaller.EXPECT().UpdateHostValidationRulesInternal(gomock.Any(), gomock.Any()).Return(nil, nil)
+			mockInstaller.EXPECT().UpdateClusterInstallConfigInternal(gomock.Any(), gomock.Any()).Return(c, nil)
+			mockManifests.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).Return(&models.Manifest{}, nil)
+			mockInstaller.EXPECT().GetClusterInternal(gomock.Any(), installer.GetClusterParams{ClusterID: importedID}).Return(c, nil)
+
+			Expect(api.ImportCluster(ctx, operations.ImportClusterParams{ClusterImportParams: &models.ClusterImportParams{
+				PullSecret: swag.String("secret"),
+				Document:   swag.String(data),
+			}})).To(BeAssignableToTypeOf(operations.NewImportClusterCreated()))
+		})
+	})
+
+	It("fails to export a cluster that does not exist", func() {
+		common.VerifyApiError(api.ExportCluster(ctx, operations.ExportClusterParams{ClusterID: clusterID}), http.StatusNotFound)
+	})
+
+	Context("ImportCluster", func() {
+		const document = `
+version: v1
+cluster:
+  name: imported
+  openshift_version: "4.8"
+  base_dns_domain: example.com
+  api_vip: 1.2.3.5
+  ingress_vip: 1.2.3.6
+install_config_overrides: '{"fips":true}'
+manifests:
+  - folder: openshift
+    file_name: 99-test.yaml
+    content: |
+      apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: test
+hosts:
+  - mac_addresses: ["52:54:00:AA:BB:CC", "52:54:00:aa:bb:dd"]
+    role: master
+    hostname: master-0
+`
+		importParams := func(document string) operations.ImportClusterParams {
+			return operations.ImportClusterParams{ClusterImportParams: &models.ClusterImportParams{
+				PullSecret: swag.String("secret"),
+				Document:   swag.String(document),
+			}}
+		}
+
+		It("creates an equivalent cluster", func() {
+			c := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+			mockInstaller.EXPECT().RegisterClusterInternal(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *types.NamespacedName, params installer.RegisterClusterParams) (*common.Cluster, error) {
+					Expect(swag.StringValue(params.NewClusterParams.Name)).To(Equal("imported"))
+					Expect(swag.StringValue(params.NewClusterParams.PullSecret)).To(Equal("secret"))
+					Expect(params.NewClusterParams.BaseDNSDomain).To(Equal("example.com"))
+					return c, nil
+				})
+			mockInstaller.EXPECT().UpdateClusterInternal(gomock.Any(), installer.UpdateClusterParams{
+				ClusterID: clusterID,
+				ClusterUpdateParams: &models.ClusterUpdateParams{
+					APIVip:     swag.String("1.2.3.5"),
+					IngressVip: swag.String("1.2.3.6"),
+				},
+			}).Return(c, nil)
+			mockInstaller.EXPECT().UpdateClusterInstallConfigInternal(gomock.Any(), installer.UpdateClusterInstallConfigParams{
+				ClusterID:           clusterID,
+				InstallConfigParams: `{"fips":true}`,
+			}).Return(c, nil)
+			mockManifests.EXPECT().CreateClusterManifestInternal(gomock.Any(), manifestsoperations.CreateClusterManifestParams{
+				ClusterID: clusterID,
+				CreateManifestParams: &models.CreateManifestParams{
+					Folder:   swag.String("openshift"),
+					FileName: swag.String("99-test.yaml"),
+					Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(manifestContent))),
+				},
+			}).Return(&models.Manifest{}, nil)
+			mockInstaller.EXPECT().GetClusterInternal(gomock.Any(), installer.GetClusterParams{ClusterID: clusterID}).Return(c, nil)
+
+			Expect(api.ImportCluster(ctx, importParams(document))).To(BeAssignableToTypeOf(operations.NewImportClusterCreated()))
+
+			var assignments []*common.HostAssignment
+			Expect(db.Order("mac_address").Find(&assignments, "cluster_id = ?", clusterID.String()).Error).ShouldNot(HaveOccurred())
+			Expect(assignments).To(HaveLen(2))
+			Expect(assignments[0].MacAddress).To(Equal("52:54:00:aa:bb:cc"))
+			Expect(assignments[1].MacAddress).To(Equal("52:54:00:aa:bb:dd"))
+			for _, assignment := range assignments {
+				Expect(assignment.Role).To(Equal(models.HostRoleMaster))
+				Expect(assignment.RequestedHostname).To(Equal("master-0"))
+			}
+		})
+
+		It("uses the name of the parameters", func() {
+			params := importParams(document)
+			params.ClusterImportParams.Name = "renamed"
+			mockInstaller.EXPECT().RegisterClusterInternal(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *types.NamespacedName, params installer.RegisterClusterParams) (*common.Cluster, error) {
+					Expect(swag.StringValue(params.NewClusterParams.Name)).To(Equal("renamed"))
+					return nil, common.NewApiError(http.StatusBadRequest, errors.New("invalid"))
+				})
+
+			common.VerifyApiError(api.ImportCluster(ctx, params), http.StatusBadRequest)
+		})
+
+		It("deregisters the cluster when the configuration fails", func() {
+			mockInstaller.EXPECT().RegisterClusterInternal(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, nil)
+			mockInstaller.EXPECT().UpdateClusterInternal(gomock.Any(), gomock.Any()).
+				Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("invalid vip")))
+			mockInstaller.EXPECT().DeregisterClusterInternal(gomock.Any(), installer.DeregisterClusterParams{ClusterID: clusterID}).
+				Return(nil)
+
+			common.VerifyApiError(api.ImportCluster(ctx, importParams(document)), http.StatusBadRequest)
+		})
+
+		It("rejects invalid documents", func() {
+			common.VerifyApiError(api.ImportCluster(ctx, importParams("not: [valid")), http.StatusBadRequest)
+			common.VerifyApiError(api.ImportCluster(ctx, importParams("version: v2\ncluster:\n  name: a\n  openshift_version: '4.8'\n")),
+				http.StatusBadRequest)
+			common.VerifyApiError(api.ImportCluster(ctx, importParams("version: v1\n")), http.StatusBadRequest)
+		})
+	})
+})
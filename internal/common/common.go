@@ -26,6 +26,8 @@ const (
 	MirrorRegistriesConfigFile      = "registries.conf"
 	MirrorRegistriesConfigPath      = MirrorRegistriesConfigDir + "/" + MirrorRegistriesConfigFile
 	MaximumAllowedTimeDiffMinutes   = 4
+
+	DefaultCPUArchitecture = "x86_64"
 )
 
 // Configuration to be injected by discovery ignition.  It will cause IPv6 DHCP client identifier to be the same
@@ -111,5 +113,5 @@ func IsNtpSynced(c *Cluster) (bool, error) {
 			max = inventory.Timestamp
 		}
 	}
-	return (max-min)/60 <= MaximumAllowedTimeDiffMinutes, nil
+	return (max-min)/60 <= MaxTimeDiffMinutes(c), nil
 }
@@ -0,0 +1,13 @@
+package common
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	. "github.com/onsi/gomega"
+)
+
+// VerifyApiError expects the responder to be an API error with the HTTP status
+func VerifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
@@ -51,6 +51,19 @@ type Cluster struct {
 	// and the generation failed, the value of ImageGenerated will be set to 'false'. In that case, providing the
 	// same request with the same custom parameters will re-attempt to generate the image.
 	ImageGenerated bool `json:"image_generated"`
+
+	// The IPv6 networks and virtual IPs of dual-stack clusters, whose IPv4 ones are the machine, cluster and service
+	// network CIDRs and the virtual IPs of the cluster
+	SecondaryMachineNetworkCidr       string `json:"secondary_machine_network_cidr"`
+	SecondaryClusterNetworkCidr       string `json:"secondary_cluster_network_cidr"`
+	SecondaryClusterNetworkHostPrefix int64  `json:"secondary_cluster_network_host_prefix"`
+	SecondaryServiceNetworkCidr       string `json:"secondary_service_network_cidr"`
+	SecondaryAPIVip                   string `json:"secondary_api_vip"`
+	SecondaryIngressVip               string `json:"secondary_ingress_vip"`
+
+	// Comma separated machine networks of multi-subnet clusters, whose hosts are in several routed subnets, in addition
+	// to the first machine network of each IP address family
+	AdditionalMachineNetworkCidrs string `json:"additional_machine_network_cidrs"`
 }
 
 type Event struct {
@@ -58,8 +71,197 @@ type Event struct {
 	models.Event
 }
 
+type Webhook struct {
+	models.Webhook
+
+	// The shared secret used to sign the payloads delivered to the webhook
+	Secret string `json:"-" gorm:"type:text"`
+}
+
+type APIToken struct {
+	models.APIToken
+
+	// The hash of the token, since the token itself is not stored
+	TokenHash string `json:"-" gorm:"unique_index"`
+}
+
+// WebhookDelivery is a pending delivery of a single event to a webhook. Deliveries are persisted
+// so that events are not lost when the service restarts before they are delivered.
+type WebhookDelivery struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+
+	WebhookID strfmt.UUID `gorm:"index"`
+
+	// The JSON encoded event that is posted to the webhook
+	Payload string `gorm:"type:text"`
+
+	// The number of failed delivery attempts
+	Attempts int
+
+	// The time of the next delivery attempt
+	NextAttemptAt time.Time `gorm:"type:timestamp with time zone;index"`
+
+	// The error of the last failed delivery attempt
+	LastError string `gorm:"type:text"`
+}
+
+// HostAssignment holds the settings of an imported host, that are applied to the host whose interface has the
+// MAC address once it registers to the cluster.
+type HostAssignment struct {
+	ID        uint        `gorm:"primary_key"`
+	ClusterID strfmt.UUID `gorm:"index"`
+
+	// The lowercase MAC address of an interface of the host
+	MacAddress string
+
+	Role                  models.HostRole
+	RequestedHostname     string
+	MachineConfigPoolName string
+}
+
+// InstallationTimelineEntry records the start of a stage of the installation of a host, or of a change of the status
+// of a cluster during its installation.
+type InstallationTimelineEntry struct {
+	ID        uint        `gorm:"primary_key"`
+	ClusterID strfmt.UUID `gorm:"index"`
+
+	// The host whose stage started, empty for the status changes of the cluster
+	HostID strfmt.UUID
+
+	// The stage of the host, or the status of the cluster
+	Stage string
+
+	// The progress info of the host stage, or the status info of the cluster
+	Info string `gorm:"type:text"`
+
+	StartedAt time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// InventorySnapshot holds an inventory reported by a host, that differs from the previous inventory of the host.
+type InventorySnapshot struct {
+	ID        uint        `gorm:"primary_key"`
+	ClusterID strfmt.UUID `gorm:"index"`
+	HostID    strfmt.UUID `gorm:"index"`
+
+	// The JSON-formatted inventory of the host
+	Inventory string `gorm:"type:text"`
+
+	ReportedAt time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// IPLease is an address of the IP pool of a cluster that is allocated to the interface of a host with the MAC
+// address.
+type IPLease struct {
+	ID        uint        `gorm:"primary_key"`
+	ClusterID strfmt.UUID `gorm:"index"`
+
+	// The lowercase MAC address of the interface
+	MacAddress string
+
+	Address string
+
+	AllocatedAt time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// StatusChange records a change of the status of a cluster or of one of its hosts. Its ID orders the changes as they
+// were recorded, and is the position of the watch clients in the status changes of the cluster.
+type StatusChange struct {
+	ID        uint        `gorm:"primary_key"`
+	ClusterID strfmt.UUID `gorm:"index"`
+
+	// The host whose status changed, empty for the status changes of the cluster
+	HostID strfmt.UUID
+
+	Status string
+
+	StatusInfo string `gorm:"type:text"`
+
+	StatusUpdatedAt time.Time `gorm:"type:timestamp with time zone"`
+
+	// The time the status change was recorded, which may be later than the time of the status change
+	CreatedAt time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// MaxInventorySnapshotsPerHost is the number of inventory snapshots that are retained for each host
+const MaxInventorySnapshotsPerHost = 10
+
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}).Error
+	if err := db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}, &Webhook{}, &WebhookDelivery{},
+		&models.ClusterAccess{}, &APIToken{}, &HostAssignment{}, &InstallationTimelineEntry{},
+		&InventorySnapshot{}, &IPLease{}, &StatusChange{}).Error; err != nil {
+		return err
+	}
+	return addListIndexes(db)
+}
+
+// addListIndexes adds the indexes of the expressions that the lists of clusters, hosts and events are sorted by, and
+// of the columns that they are filtered by
+func addListIndexes(db *gorm.DB) error {
+	sortIndexes := []struct {
+		model  interface{}
+		name   string
+		column string
+	}{
+		{&Cluster{}, "idx_clusters_sort_name", "name"},
+		{&Cluster{}, "idx_clusters_sort_created_at", "created_at"},
+		{&Cluster{}, "idx_clusters_sort_status_updated_at", "status_updated_at"},
+		{&Host{}, "idx_hosts_sort_created_at", "created_at"},
+		{&Host{}, "idx_hosts_sort_requested_hostname", "requested_hostname"},
+		{&Host{}, "idx_hosts_sort_status_updated_at", "status_updated_at"},
+		{&Event{}, "idx_events_sort_event_time", "event_time"},
+	}
+	for _, index := range sortIndexes {
+		if err := db.Model(index.model).AddIndex(index.name, fmt.Sprintf("(%s)", SortExpression(index.column)), "id").Error; err != nil {
+			return err
+		}
+	}
+	return db.Model(&Event{}).AddIndex("idx_events_severity", "severity").Error
+}
+
+// AddInstallationTimelineEntry records the start of a stage of the installation of the cluster, or of the host when
+// hostID is not empty
+func AddInstallationTimelineEntry(db *gorm.DB, clusterID, hostID strfmt.UUID, stage, info string, startedAt time.Time) error {
+	return db.Create(&InstallationTimelineEntry{
+		ClusterID: clusterID,
+		HostID:    hostID,
+		Stage:     stage,
+		Info:      info,
+		StartedAt: startedAt,
+	}).Error
+}
+
+// AddStatusChange records the status change of the cluster, or of the host when hostID is not empty
+func AddStatusChange(db *gorm.DB, clusterID, hostID strfmt.UUID, status, statusInfo string, updatedAt time.Time) error {
+	return db.Create(&StatusChange{
+		ClusterID:       clusterID,
+		HostID:          hostID,
+		Status:          status,
+		StatusInfo:      statusInfo,
+		StatusUpdatedAt: updatedAt,
+	}).Error
+}
+
+// AddInventorySnapshot retains the inventory reported by the host, and deletes the oldest snapshots of the host beyond
+// MaxInventorySnapshotsPerHost
+func AddInventorySnapshot(db *gorm.DB, clusterID, hostID strfmt.UUID, inventory string, reportedAt time.Time) error {
+	if err := db.Create(&InventorySnapshot{
+		ClusterID:  clusterID,
+		HostID:     hostID,
+		Inventory:  inventory,
+		ReportedAt: reportedAt,
+	}).Error; err != nil {
+		return err
+	}
+	var expired []uint
+	if err := db.Model(&InventorySnapshot{}).Where("cluster_id = ? and host_id = ?", clusterID.String(), hostID.String()).
+		Order("id desc").Offset(MaxInventorySnapshotsPerHost).Pluck("id", &expired).Error; err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	return db.Where("id in (?)", expired).Delete(&InventorySnapshot{}).Error
 }
 
 type Host struct {
@@ -68,6 +270,9 @@ type Host struct {
 
 	// Namespace of the KubeAPI resource
 	KubeKeyNamespace string `json:"kube_key_namespace"`
+
+	// Whether the role of the host was selected automatically, so it may be reassigned by the role assignment
+	RoleAutoAssigned bool `json:"role_auto_assigned"`
 }
 
 type EagerLoadingState bool
@@ -187,6 +392,11 @@ func (c *Cluster) AfterFind(db *gorm.DB) error {
 		}
 	}
 	c.TotalHostCount = int64(len(c.Hosts))
+	c.MachineNetworks = GetMachineNetworkCidrs(c)
+	c.ClusterNetworks = GetClusterNetworks(c)
+	c.ServiceNetworks = GetServiceNetworkCidrs(c)
+	c.APIVips = GetAPIVips(c)
+	c.IngressVips = GetIngressVips(c)
 	return nil
 }
 
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetMachineNetworkCidrs returns the machine networks of the cluster: the first network of each IP address family, the
+// IPv4 one first in dual-stack clusters, followed by the additional networks of multi-subnet clusters
+func GetMachineNetworkCidrs(c *Cluster) []string {
+	return nonEmptyStrings(append([]string{c.MachineNetworkCidr, c.SecondaryMachineNetworkCidr},
+		strings.Split(c.AdditionalMachineNetworkCidrs, ",")...)...)
+}
+
+// GetClusterNetworks returns the cluster networks of the cluster, the IPv4 one first in dual-stack clusters
+func GetClusterNetworks(c *Cluster) []*models.ClusterNetwork {
+	ret := make([]*models.ClusterNetwork, 0)
+	if c.ClusterNetworkCidr != "" {
+		ret = append(ret, &models.ClusterNetwork{Cidr: c.ClusterNetworkCidr, HostPrefix: c.ClusterNetworkHostPrefix})
+	}
+	if c.SecondaryClusterNetworkCidr != "" {
+		ret = append(ret, &models.ClusterNetwork{Cidr: c.SecondaryClusterNetworkCidr, HostPrefix: c.SecondaryClusterNetworkHostPrefix})
+	}
+	return ret
+}
+
+// GetServiceNetworkCidrs returns the service networks of the cluster, the IPv4 one first in dual-stack clusters
+func GetServiceNetworkCidrs(c *Cluster) []string {
+	return nonEmptyStrings(c.ServiceNetworkCidr, c.SecondaryServiceNetworkCidr)
+}
+
+// GetAPIVips returns the API virtual IPs of the cluster, the IPv4 one first in dual-stack clusters
+func GetAPIVips(c *Cluster) []string {
+	return nonEmptyStrings(c.APIVip, c.SecondaryAPIVip)
+}
+
+// GetIngressVips returns the Ingress virtual IPs of the cluster, the IPv4 one first in dual-stack clusters
+func GetIngressVips(c *Cluster) []string {
+	return nonEmptyStrings(c.IngressVip, c.SecondaryIngressVip)
+}
+
+// IsDualStackCluster returns whether the cluster has networks of both IP address families
+func IsDualStackCluster(c *Cluster) bool {
+	return c.SecondaryMachineNetworkCidr != "" || c.SecondaryClusterNetworkCidr != "" || c.SecondaryServiceNetworkCidr != ""
+}
+
+// IsMultiSubnetCluster returns whether the hosts of the cluster are in several machine networks of the same IP address
+// family
+func IsMultiSubnetCluster(c *Cluster) bool {
+	return c.AdditionalMachineNetworkCidrs != ""
+}
+
+func nonEmptyStrings(strs ...string) []string {
+	ret := make([]string, 0, len(strs))
+	for _, s := range strs {
+		if s != "" {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
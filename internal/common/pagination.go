@@ -0,0 +1,122 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// PageCursor points at the last item of a page: its value of the sort column and its unique key.
+// The next page starts right after this item.
+type PageCursor struct {
+	SortValue string `json:"s"`
+	Key       string `json:"k"`
+}
+
+func NewPageCursor(sortValue interface{}, key string) *PageCursor {
+	var value string
+	switch v := sortValue.(type) {
+	case strfmt.DateTime:
+		value = time.Time(v).UTC().Format(time.RFC3339Nano)
+	case time.Time:
+		value = v.UTC().Format(time.RFC3339Nano)
+	default:
+		value = fmt.Sprintf("%v", v)
+	}
+	return &PageCursor{SortValue: value, Key: key}
+}
+
+func (c *PageCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodePageCursor parses a cursor returned by Encode. A nil cursor is returned for an empty string.
+func DecodePageCursor(cursor string) (*PageCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	var c PageCursor
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil {
+		err = json.Unmarshal(b, &c)
+	}
+	if err != nil {
+		return nil, NewApiError(http.StatusBadRequest, errors.Errorf("invalid cursor %s", cursor))
+	}
+	return &c, nil
+}
+
+// sortColumnNullValues are the values that NULLs of the sort columns are sorted as. They are the zero values that
+// NULLs are read as, so that the cursor of an item whose sort value is NULL points at the position of the item.
+var sortColumnNullValues = map[string]string{
+	"created_at":         "'0001-01-01 00:00:00+00'",
+	"event_time":         "'0001-01-01 00:00:00+00'",
+	"name":               "''",
+	"requested_hostname": "''",
+	"status_updated_at":  "'0001-01-01 00:00:00+00'",
+}
+
+// SortExpression returns the expression that lists sorted by the column are ordered by, which is the column with its
+// NULLs replaced by their zero value
+func SortExpression(sortColumn string) string {
+	nullValue, ok := sortColumnNullValues[sortColumn]
+	if !ok {
+		return sortColumn
+	}
+	return fmt.Sprintf("COALESCE(%s, %s)", sortColumn, nullValue)
+}
+
+// SubstringFilter is the ILIKE condition of a list filter that matches the column values containing the filter
+const SubstringFilter = "ILIKE ? ESCAPE '\\'"
+
+// likeEscaper escapes the wildcards of LIKE patterns, and the escape character itself
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// SubstringPattern returns the pattern of SubstringFilter that matches the values containing the filter as is
+func SubstringPattern(filter string) string {
+	return "%" + likeEscaper.Replace(filter) + "%"
+}
+
+// Paginate orders the query by the sort column and then by the key column, so that the order is stable,
+// and skips the items up to and including the cursor. The sort expressions are expected to be indexed, and the
+// sort column must not be user input as it is formatted into the query.
+func Paginate(db *gorm.DB, sortColumn, keyColumn, order string, cursor *PageCursor, limit *int64) *gorm.DB {
+	if order != SortOrderDesc {
+		order = SortOrderAsc
+	}
+	sortExpression := SortExpression(sortColumn)
+	if cursor != nil {
+		op := ">"
+		if order == SortOrderDesc {
+			op = "<"
+		}
+		db = db.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", sortExpression, keyColumn, op), cursor.SortValue, cursor.Key)
+	}
+	db = db.Order(fmt.Sprintf("%s %s", sortExpression, order)).Order(fmt.Sprintf("%s %s", keyColumn, order))
+	if limit != nil {
+		db = db.Limit(*limit)
+	}
+	return db
+}
+
+// NextPageCursor returns the encoded cursor of the page that follows a page of the given size, or an empty
+// string if this is the last page
+func NextPageCursor(pageSize int, limit *int64, last func() *PageCursor) string {
+	if limit == nil || pageSize == 0 || int64(pageSize) < *limit {
+		return ""
+	}
+	return last().Encode()
+}
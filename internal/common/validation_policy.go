@@ -0,0 +1,48 @@
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// GetValidationPolicy returns the validation policy of the cluster, or an empty policy if the cluster has none
+func GetValidationPolicy(c *Cluster) (*models.ValidationPolicy, error) {
+	policy := &models.ValidationPolicy{}
+	if c == nil || c.ValidationPolicy == "" {
+		return policy, nil
+	}
+	if err := json.Unmarshal([]byte(c.ValidationPolicy), policy); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the validation policy of cluster %s", c.ID.String())
+	}
+	return policy, nil
+}
+
+// MarshalValidationPolicy returns the JSON-formatted validation policy, as stored on the cluster
+func MarshalValidationPolicy(policy *models.ValidationPolicy) (string, error) {
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// IsValidationDisabledByPolicy returns whether the validation id is in the list of disabled validations of the policy
+func IsValidationDisabledByPolicy(disabledValidations []string, id string) bool {
+	for _, disabled := range disabledValidations {
+		if disabled == id {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxTimeDiffMinutes returns the maximum allowed time difference between the hosts of the cluster
+func MaxTimeDiffMinutes(c *Cluster) int64 {
+	policy, err := GetValidationPolicy(c)
+	if err != nil || policy.MaxTimeDiffMinutes == nil {
+		return MaximumAllowedTimeDiffMinutes
+	}
+	return *policy.MaxTimeDiffMinutes
+}
@@ -3,6 +3,7 @@ package connectivity
 import (
 	"encoding/json"
 
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -23,13 +24,17 @@ type validator struct {
 	log logrus.FieldLogger
 }
 
+// GetHostValidInterfaces returns the interfaces of the host that the connectivity to the host is checked over, which
+// are the interfaces that carry the addresses of the host, such as bonds and VLAN interfaces, rather than the ports of
+// bonds and bridges
 func (v *validator) GetHostValidInterfaces(host *models.Host) ([]*models.Interface, error) {
 	var inventory models.Inventory
 	if err := json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
 		return nil, err
 	}
-	if len(inventory.Interfaces) == 0 {
+	interfaces := hostutil.GetL3Interfaces(&inventory)
+	if len(interfaces) == 0 {
 		return nil, errors.Errorf("host %s doesn't have interfaces", host.ID)
 	}
-	return inventory.Interfaces, nil
+	return interfaces, nil
 }
@@ -48,6 +48,22 @@ var _ = Describe("get valid interfaces", func() {
 		Expect(len(interfaces)).Should(Equal(1))
 	})
 
+	It("valid interfaces of a bond", func() {
+		inventory.Interfaces = []*models.Interface{
+			{Name: "eth0", MacAddress: "52:54:00:00:00:10"},
+			{Name: "eth1", MacAddress: "52:54:00:00:00:10"},
+			{Name: "bond0", Type: "bond", MacAddress: "52:54:00:00:00:10", LowerInterfaces: []string{"eth0", "eth1"},
+				IPV4Addresses: []string{"1.2.3.4/24"}},
+		}
+		hw, err := json.Marshal(&inventory)
+		Expect(err).NotTo(HaveOccurred())
+		host.Inventory = string(hw)
+		interfaces, err := connectivityValidator.GetHostValidInterfaces(host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interfaces).To(HaveLen(1))
+		Expect(interfaces[0].Name).To(Equal("bond0"))
+	})
+
 	It("invalid interfaces", func() {
 
 		host.Inventory = ""
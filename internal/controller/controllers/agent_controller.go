@@ -290,7 +290,7 @@ func (r *AgentReconciler) populateEventsURL(log logrus.FieldLogger, agent *aiv1b
 
 func (r *AgentReconciler) eventsURL(log logrus.FieldLogger, clusterId, agentId string) (string, error) {
 	eventsURL := fmt.Sprintf("%s%s/clusters/%s/events?host_id=%s", r.ServiceBaseURL, restclient.DefaultBasePath, clusterId, agentId)
-	if r.AuthType != auth.TypeLocal {
+	if !r.AuthType.HasLocalAgentAuth() {
 		return eventsURL, nil
 	}
 	eventsURL, err := gencrypto.SignURL(eventsURL, clusterId)
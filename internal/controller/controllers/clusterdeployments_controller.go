@@ -1122,7 +1122,7 @@ func (r *ClusterDeploymentsReconciler) populateLogsURL(log logrus.FieldLogger, c
 
 func (r *ClusterDeploymentsReconciler) eventsURL(log logrus.FieldLogger, clusterId string) (string, error) {
 	eventsURL := fmt.Sprintf("%s%s/clusters/%s/events", r.ServiceBaseURL, restclient.DefaultBasePath, clusterId)
-	if r.AuthType != auth.TypeLocal {
+	if !r.AuthType.HasLocalAgentAuth() {
 		return eventsURL, nil
 	}
 	eventsURL, err := gencrypto.SignURL(eventsURL, clusterId)
@@ -1469,7 +1469,7 @@ func (r *ClusterDeploymentsReconciler) generateControllerLogsDownloadURL(cluster
 	downloadURL := fmt.Sprintf("%s%s/clusters/%s/logs",
 		r.ServiceBaseURL, restclient.DefaultBasePath, cluster.ID.String())
 
-	if r.AuthType != auth.TypeLocal {
+	if !r.AuthType.HasLocalAgentAuth() {
 		return downloadURL, nil
 	}
 
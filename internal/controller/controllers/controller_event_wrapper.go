@@ -53,3 +53,7 @@ func (c *controllerEventsWrapper) AddMetricsEvent(ctx context.Context, clusterID
 func (c *controllerEventsWrapper) GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories ...string) ([]*common.Event, error) {
 	return c.events.GetEvents(clusterID, hostID, categories...)
 }
+
+func (c *controllerEventsWrapper) QueryEvents(query *events.Query) ([]*common.Event, error) {
+	return c.events.QueryEvents(query)
+}
@@ -174,7 +174,7 @@ func (r *InfraEnvReconciler) buildMacInterfaceMap(log logrus.FieldLogger, nmStat
 }
 
 func (r *InfraEnvReconciler) processNMStateConfig(ctx context.Context, log logrus.FieldLogger, infraEnv *aiv1beta1.InfraEnv) ([]*models.HostStaticNetworkConfig, error) {
-	var staticNetworkConfig []*models.HostStaticNetworkConfig
+	staticNetworkConfig := make([]*models.HostStaticNetworkConfig, 0)
 
 	if infraEnv.Spec.NMStateConfigLabelSelector.MatchLabels == nil {
 		return staticNetworkConfig, nil
@@ -284,8 +284,9 @@ func (r *InfraEnvReconciler) ensureISO(ctx context.Context, log logrus.FieldLogg
 	}
 	if len(staticNetworkConfig) > 0 {
 		log.Infof("the amount of nmStateConfigs included in the image is: %d", len(staticNetworkConfig))
-		isoParams.ImageCreateParams.StaticNetworkConfig = staticNetworkConfig
 	}
+	// The NMStateConfigs replace the static network configs of the cluster, also when there are none
+	isoParams.ImageCreateParams.StaticNetworkConfig = staticNetworkConfig
 
 	// Add openshift version to ensure it isn't missing in versions cache
 	_, err = r.Installer.AddOpenshiftVersion(ctx, cluster.OcpReleaseImage, cluster.PullSecret)
@@ -3,8 +3,10 @@ package dns
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
 	"github.com/go-openapi/swag"
@@ -24,12 +26,25 @@ const (
 	dnsDomainLabelLen      = 63
 	dnsDomainTotalLen      = 255
 	dnsDomainPrefixMaxLen  = dnsDomainTotalLen - dnsDomainLabelLen - 1 // reserve for another label and the separating '.'
+	dnsRecordTTL           = 60
+	dnsProviderTimeout     = 10 * time.Second
 )
 
+const (
+	ProviderRoute53  = "route53"
+	ProviderRFC2136  = "rfc2136"
+	ProviderPowerDNS = "powerdns"
+)
+
+// SupportedProviders are the DNS providers that may be specified for a base DNS domain
+var SupportedProviders = []string{ProviderRoute53, ProviderRFC2136, ProviderPowerDNS}
+
 type DNSDomain struct {
 	Name              string
 	ID                string
 	Provider          string
+	RFC2136           RFC2136Config
+	PowerDNS          PowerDNSConfig
 	APIDomainName     string
 	APIINTDomainName  string
 	IngressDomainName string
@@ -61,7 +76,7 @@ type handler struct {
 }
 
 func NewDNSHandler(baseDNSDomains map[string]string, log logrus.FieldLogger) DNSApi {
-	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log})
+	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log: log})
 }
 
 func NewDNSHandlerWithProviders(baseDNSDomains map[string]string, log logrus.FieldLogger, providers DNSProviderFactory) DNSApi {
@@ -163,16 +178,15 @@ func (h *handler) deleteDNSRecord(log logrus.FieldLogger, domain *DNSDomain, nam
 func (h *handler) GetDNSDomain(clusterName, baseDNSDomainName string) (*DNSDomain, error) {
 	var dnsDomainID string
 	var dnsProvider string
+	var options map[string]string
 
 	// Parse base domains from config
 	if val, ok := h.baseDNSDomains[baseDNSDomainName]; ok {
-		re := regexp.MustCompile("/")
-		if !re.MatchString(val) {
-			return nil, errors.New(fmt.Sprintf("Invalid DNS domain: %s", val))
+		var err error
+		dnsDomainID, dnsProvider, options, err = ParseDomainEntry(val)
+		if err != nil {
+			return nil, err
 		}
-		s := re.Split(val, 2)
-		dnsDomainID = s[0]
-		dnsProvider = s[1]
 	} else {
 		h.log.Debugf("No DNS configuration for base domain '%s'", baseDNSDomainName)
 		return nil, nil
@@ -182,17 +196,62 @@ func (h *handler) GetDNSDomain(clusterName, baseDNSDomainName string) (*DNSDomai
 		return nil, nil
 	}
 
-	return &DNSDomain{
+	domain := &DNSDomain{
 		Name:              baseDNSDomainName,
 		ID:                dnsDomainID,
 		Provider:          dnsProvider,
 		APIDomainName:     fmt.Sprintf(apiDomainNameFormat, clusterName, baseDNSDomainName),
 		APIINTDomainName:  fmt.Sprintf(apiINTDomainNameFormat, clusterName, baseDNSDomainName),
 		IngressDomainName: fmt.Sprintf("*.%s", fmt.Sprintf(appsDomainNameFormat, clusterName, baseDNSDomainName)),
-	}, nil
+	}
+	var err error
+	switch dnsProvider {
+	case ProviderRFC2136:
+		domain.RFC2136, err = newRFC2136Config(options)
+	case ProviderPowerDNS:
+		domain.PowerDNS, err = newPowerDNSConfig(options)
+	default:
+		if len(options) > 0 {
+			err = errors.Errorf("DNS provider %s takes no options", dnsProvider)
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid DNS configuration of base domain %s", baseDNSDomainName)
+	}
+	return domain, nil
 }
 
-//ValidateDNSName checks if a combination of cluster name and base DNS domain
+// ParseDomainEntry parses the "id/provider[?name=value&...]" value of a base DNS domain entry. The option
+// values are percent-encoded, since ':' and ',' separate the entries.
+func ParseDomainEntry(val string) (string, string, map[string]string, error) {
+	re := regexp.MustCompile("/")
+	if !re.MatchString(val) {
+		return "", "", nil, errors.New(fmt.Sprintf("Invalid DNS domain: %s", val))
+	}
+	s := re.Split(val, 2)
+	provider := s[1]
+	options := make(map[string]string)
+	if i := strings.Index(provider, "?"); i >= 0 {
+		for _, option := range strings.Split(provider[i+1:], "&") {
+			if option == "" {
+				continue
+			}
+			kv := strings.SplitN(option, "=", 2)
+			if len(kv) != 2 {
+				return "", "", nil, errors.Errorf("Invalid DNS provider option: %s", option)
+			}
+			value, err := url.PathUnescape(kv[1])
+			if err != nil {
+				return "", "", nil, errors.Wrapf(err, "Invalid DNS provider option: %s", option)
+			}
+			options[kv[0]] = value
+		}
+		provider = provider[:i]
+	}
+	return s[0], provider, options, nil
+}
+
+// ValidateDNSName checks if a combination of cluster name and base DNS domain
 // leaves enough room for automatically added domain names,
 // e.g. "alertmanager-main-openshift-monitoring.apps.test-infra-cluster-assisted-installer.example.com").
 // The max total length of a domain name is 255 bytes, including the dots. An individual label can be
@@ -264,16 +323,29 @@ func (h *handler) checkDNSRecordsExists(names []string, domain *DNSDomain, recor
 }
 
 func (f *defaultDNSProviderFactory) GetProviderByRecordType(domain *DNSDomain, recordType string) dnsproviders.Provider {
+	recordSet := dnsproviders.RecordSet{
+		RecordSetType: recordType,
+		TTL:           dnsRecordTTL,
+	}
 	switch domain.Provider {
-	case "route53":
+	case ProviderRoute53:
 		return dnsproviders.Route53{
-			RecordSet: dnsproviders.RecordSet{
-				RecordSetType: recordType,
-				TTL:           60,
-			},
+			RecordSet:    recordSet,
 			HostedZoneID: domain.ID,
 			SharedCreds:  true,
 		}
+	case ProviderRFC2136:
+		return RFC2136{
+			RecordSet: recordSet,
+			Zone:      domain.ID,
+			Config:    domain.RFC2136,
+		}
+	case ProviderPowerDNS:
+		return PowerDNS{
+			RecordSet: recordSet,
+			Zone:      domain.ID,
+			Config:    domain.PowerDNS,
+		}
 	}
 	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
 	return nil
@@ -281,11 +353,21 @@ func (f *defaultDNSProviderFactory) GetProviderByRecordType(domain *DNSDomain, r
 
 func (f *defaultDNSProviderFactory) GetProvider(domain *DNSDomain) dnsproviders.Provider {
 	switch domain.Provider {
-	case "route53":
+	case ProviderRoute53:
 		return dnsproviders.Route53{
 			HostedZoneID: domain.ID,
 			SharedCreds:  true,
 		}
+	case ProviderRFC2136:
+		return RFC2136{
+			Zone:   domain.ID,
+			Config: domain.RFC2136,
+		}
+	case ProviderPowerDNS:
+		return PowerDNS{
+			Zone:   domain.ID,
+			Config: domain.PowerDNS,
+		}
 	}
 	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
 	"github.com/go-openapi/swag"
@@ -41,6 +42,57 @@ var _ = Describe("DNS tests", func() {
 		_, err := dnsApi.GetDNSDomain("test-cluster", "dns.example.com")
 		Expect(err).To(HaveOccurred())
 	})
+	It("get DNS domain with RFC 2136 options", func() {
+		baseDNSDomains["dns.example.com"] = "dns.example.com/rfc2136?server=10.0.0.1%3A53&tsig_key_name=assisted&tsig_secret=c2Vj%2BcmV0&timeout=5s"
+		dnsDomain, err := dnsApi.GetDNSDomain("test-cluster", "dns.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dnsDomain.ID).Should(Equal("dns.example.com"))
+		Expect(dnsDomain.Provider).Should(Equal(ProviderRFC2136))
+		Expect(dnsDomain.RFC2136).Should(Equal(RFC2136Config{
+			Server:        "10.0.0.1:53",
+			TSIGKeyName:   "assisted",
+			TSIGSecret:    "c2Vj+cmV0",
+			TSIGAlgorithm: "hmac-sha256.",
+			Timeout:       5 * time.Second,
+		}))
+	})
+	It("get DNS domain with PowerDNS options", func() {
+		baseDNSDomains["dns.example.com"] = "dns.example.com/powerdns?api_url=http%3A//pdns%3A8081&api_key=secret"
+		dnsDomain, err := dnsApi.GetDNSDomain("test-cluster", "dns.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dnsDomain.Provider).Should(Equal(ProviderPowerDNS))
+		Expect(dnsDomain.PowerDNS).Should(Equal(PowerDNSConfig{
+			APIURL:   "http://pdns:8081",
+			APIKey:   "secret",
+			ServerID: "localhost",
+			Timeout:  10 * time.Second,
+		}))
+	})
+	It("each base domain has its own provider options", func() {
+		baseDNSDomains["a.example.com"] = "a.example.com/rfc2136?server=10.0.0.1%3A53"
+		baseDNSDomains["b.example.com"] = "b.example.com/rfc2136?server=10.0.0.2%3A53"
+		a, err := dnsApi.GetDNSDomain("test-cluster", "a.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		b, err := dnsApi.GetDNSDomain("test-cluster", "b.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(a.RFC2136.Server).Should(Equal("10.0.0.1:53"))
+		Expect(b.RFC2136.Server).Should(Equal("10.0.0.2:53"))
+	})
+	for _, entry := range []string{
+		"dns.example.com/rfc2136",
+		"dns.example.com/rfc2136?server=10.0.0.1%3A53&unknown=1",
+		"dns.example.com/rfc2136?server=10.0.0.1%3A53&timeout=soon",
+		"dns.example.com/powerdns?api_key=secret",
+		"dns.example.com/rfc2136?server",
+		"abc/route53?server=10.0.0.1",
+	} {
+		entry := entry
+		It(fmt.Sprintf("get DNS domain with invalid options %s", entry), func() {
+			baseDNSDomains["dns.example.com"] = entry
+			_, err := dnsApi.GetDNSDomain("test-cluster", "dns.example.com")
+			Expect(err).To(HaveOccurred())
+		})
+	}
 	It("get DNS domain undefined", func() {
 		dnsDomain, err := dnsApi.GetDNSDomain("test-cluster", "dns.example.com")
 		Expect(err).NotTo(HaveOccurred())
@@ -297,7 +349,7 @@ var _ = Describe("Default DNS provider tests", func() {
 		domain = &DNSDomain{
 			Provider: "route53",
 		}
-		providers = &defaultDNSProviderFactory{logrus.New()}
+		providers = &defaultDNSProviderFactory{log: logrus.New()}
 	})
 
 	It("default provider is used when no provider factory specified", func() {
@@ -322,6 +374,23 @@ var _ = Describe("Default DNS provider tests", func() {
 		Expect(ok).To(BeTrue())
 		Expect(r53.RecordSet.RecordSetType).To(Equal("A"))
 	})
+	It("provider for RFC 2136 domains", func() {
+		cfg := RFC2136Config{Server: "10.0.0.1:53"}
+		p := providers.GetProviderByRecordType(&DNSDomain{ID: "example.com", Provider: ProviderRFC2136, RFC2136: cfg}, "A")
+		rfc2136, ok := p.(RFC2136)
+		Expect(ok).To(BeTrue())
+		Expect(rfc2136.Zone).To(Equal("example.com"))
+		Expect(rfc2136.Config).To(Equal(cfg))
+		Expect(rfc2136.RecordSet.RecordSetType).To(Equal("A"))
+	})
+	It("provider for PowerDNS domains", func() {
+		cfg := PowerDNSConfig{APIURL: "http://pdns:8081"}
+		p := providers.GetProvider(&DNSDomain{ID: "example.com", Provider: ProviderPowerDNS, PowerDNS: cfg})
+		pdns, ok := p.(PowerDNS)
+		Expect(ok).To(BeTrue())
+		Expect(pdns.Zone).To(Equal("example.com"))
+		Expect(pdns.Config).To(Equal(cfg))
+	})
 })
 
 var _ = Describe("Base DNS domain validation", func() {
@@ -0,0 +1,218 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+// PowerDNSConfig holds the connection settings of a PowerDNS base DNS domain, given as the options of its entry
+type PowerDNSConfig struct {
+	APIURL   string
+	APIKey   string
+	ServerID string
+	Timeout  time.Duration
+}
+
+func newPowerDNSConfig(options map[string]string) (PowerDNSConfig, error) {
+	cfg := PowerDNSConfig{
+		ServerID: "localhost",
+		Timeout:  dnsProviderTimeout,
+	}
+	for name, value := range options {
+		switch name {
+		case "api_url":
+			cfg.APIURL = value
+		case "api_key":
+			cfg.APIKey = value
+		case "server_id":
+			cfg.ServerID = value
+		case "timeout":
+			timeout, err := time.ParseDuration(value)
+			if err != nil {
+				return cfg, errors.Wrapf(err, "invalid timeout %s", value)
+			}
+			cfg.Timeout = timeout
+		default:
+			return cfg, errors.Errorf("unknown PowerDNS option %s", name)
+		}
+	}
+	if cfg.APIURL == "" {
+		return cfg, errors.New("the api_url option is required")
+	}
+	return cfg, nil
+}
+
+var _ dnsproviders.Provider = PowerDNS{}
+
+// PowerDNS manages the records of a zone with the PowerDNS authoritative server HTTP API
+type PowerDNS struct {
+	RecordSet dnsproviders.RecordSet
+	Zone      string
+	Config    PowerDNSConfig
+}
+
+type powerDNSRecord struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+type powerDNSRRSet struct {
+	Name       string           `json:"name"`
+	Type       string           `json:"type"`
+	TTL        int64            `json:"ttl,omitempty"`
+	ChangeType string           `json:"changetype,omitempty"`
+	Records    []powerDNSRecord `json:"records"`
+}
+
+type powerDNSZone struct {
+	Name   string          `json:"name"`
+	RRSets []powerDNSRRSet `json:"rrsets"`
+}
+
+// CreateRecordSet adds the value to the record set
+func (p PowerDNS) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rrset, err := p.getRRSet(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	records := []powerDNSRecord{{Content: recordSetValue}}
+	if rrset != nil {
+		for _, record := range rrset.Records {
+			if record.Content != recordSetValue {
+				records = append(records, record)
+			}
+		}
+	}
+	return p.patch(recordSetName, "REPLACE", records)
+}
+
+// DeleteRecordSet removes the value from the record set, and the record set once it is empty
+func (p PowerDNS) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rrset, err := p.getRRSet(recordSetName)
+	if err != nil || rrset == nil {
+		return "", err
+	}
+	records := make([]powerDNSRecord, 0, len(rrset.Records))
+	for _, record := range rrset.Records {
+		if record.Content != recordSetValue {
+			records = append(records, record)
+		}
+	}
+	if len(records) == 0 {
+		return p.patch(recordSetName, "DELETE", nil)
+	}
+	return p.patch(recordSetName, "REPLACE", records)
+}
+
+// UpdateRecordSet replaces the values of the record set with the value
+func (p PowerDNS) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return p.patch(recordSetName, "REPLACE", []powerDNSRecord{{Content: recordSetValue}})
+}
+
+// GetRecordSet returns the values of the record set, or an empty string if it doesn't exist
+func (p PowerDNS) GetRecordSet(recordSetName string) (string, error) {
+	rrset, err := p.getRRSet(recordSetName)
+	if err != nil || rrset == nil {
+		return "", err
+	}
+	values := make([]string, 0, len(rrset.Records))
+	for _, record := range rrset.Records {
+		values = append(values, record.Content)
+	}
+	return strings.Join(values, ","), nil
+}
+
+// GetDomainName returns the name of the zone
+func (p PowerDNS) GetDomainName() (string, error) {
+	zone, err := p.getZone()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(zone.Name, "."), nil
+}
+
+func (p PowerDNS) getRRSet(name string) (*powerDNSRRSet, error) {
+	zone, err := p.getZone()
+	if err != nil {
+		return nil, err
+	}
+	for i := range zone.RRSets {
+		rrset := &zone.RRSets[i]
+		if rrset.Name == dns.Fqdn(name) && rrset.Type == p.RecordSet.RecordSetType {
+			return rrset, nil
+		}
+	}
+	return nil, nil
+}
+
+func (p PowerDNS) getZone() (*powerDNSZone, error) {
+	var zone powerDNSZone
+	body, err := p.do(http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &zone); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse PowerDNS zone %s", p.Zone)
+	}
+	return &zone, nil
+}
+
+func (p PowerDNS) patch(name, changeType string, records []powerDNSRecord) (string, error) {
+	if records == nil {
+		records = []powerDNSRecord{}
+	}
+	payload, err := json.Marshal(map[string][]powerDNSRRSet{
+		"rrsets": {{
+			Name:       dns.Fqdn(name),
+			Type:       p.RecordSet.RecordSetType,
+			TTL:        p.RecordSet.TTL,
+			ChangeType: changeType,
+			Records:    records,
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	body, err := p.do(http.MethodPatch, payload)
+	return string(body), err
+}
+
+func (p PowerDNS) do(method string, payload []byte) ([]byte, error) {
+	if p.Config.APIURL == "" {
+		return nil, errors.New("no PowerDNS API URL is configured")
+	}
+	zoneURL := fmt.Sprintf("%s/api/v1/servers/%s/zones/%s", strings.TrimSuffix(p.Config.APIURL, "/"),
+		url.PathEscape(p.Config.ServerID), url.PathEscape(dns.Fqdn(p.Zone)))
+	req, err := http.NewRequest(method, zoneURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-API-Key", p.Config.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: p.Config.Timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send %s request to PowerDNS", method)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errors.Errorf("PowerDNS %s request of zone %s failed with status %d: %s",
+			method, p.Zone, resp.StatusCode, string(body))
+	}
+	return body, nil
+}
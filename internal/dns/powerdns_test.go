@@ -0,0 +1,98 @@
+package dns
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PowerDNS provider", func() {
+	var (
+		server  *httptest.Server
+		zone    powerDNSZone
+		patches []powerDNSRRSet
+		pdns    PowerDNS
+	)
+
+	BeforeEach(func() {
+		zone = powerDNSZone{
+			Name: "example.com.",
+			RRSets: []powerDNSRRSet{
+				{Name: "api.test.example.com.", Type: "A", TTL: 60, Records: []powerDNSRecord{{Content: "1.2.3.4"}}},
+			},
+		}
+		patches = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/api/v1/servers/localhost/zones/example.com."))
+			Expect(r.Header.Get("X-API-Key")).To(Equal("secret"))
+			switch r.Method {
+			case http.MethodGet:
+				Expect(json.NewEncoder(w).Encode(&zone)).To(Succeed())
+			case http.MethodPatch:
+				var body map[string][]powerDNSRRSet
+				b, err := ioutil.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(json.Unmarshal(b, &body)).To(Succeed())
+				patches = append(patches, body["rrsets"]...)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		}))
+		pdns = PowerDNS{
+			RecordSet: dnsproviders.RecordSet{RecordSetType: "A", TTL: 60},
+			Zone:      "example.com",
+			Config:    PowerDNSConfig{APIURL: server.URL, APIKey: "secret", ServerID: "localhost"},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("gets the domain name", func() {
+		name, err := pdns.GetDomainName()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("example.com"))
+	})
+
+	It("gets a record set", func() {
+		value, err := pdns.GetRecordSet("api.test.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal("1.2.3.4"))
+		value, err = pdns.GetRecordSet("*.apps.test.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(BeEmpty())
+	})
+
+	It("creates a record set", func() {
+		_, err := pdns.CreateRecordSet("*.apps.test.example.com", "1.2.3.5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patches).To(Equal([]powerDNSRRSet{{
+			Name: "*.apps.test.example.com.", Type: "A", TTL: 60, ChangeType: "REPLACE",
+			Records: []powerDNSRecord{{Content: "1.2.3.5"}},
+		}}))
+	})
+
+	It("deletes a record set", func() {
+		_, err := pdns.DeleteRecordSet("api.test.example.com", "1.2.3.4")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patches).To(HaveLen(1))
+		Expect(patches[0].ChangeType).To(Equal("DELETE"))
+		Expect(patches[0].Name).To(Equal("api.test.example.com."))
+	})
+
+	It("fails on an API error", func() {
+		pdns.Config.APIKey = "wrong"
+		server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		_, err := pdns.GetDomainName()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,186 @@
+package dns
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+// RFC2136Config holds the connection settings of an RFC 2136 base DNS domain, given as the options of its entry
+type RFC2136Config struct {
+	Server        string
+	TSIGKeyName   string
+	TSIGSecret    string
+	TSIGAlgorithm string
+	Timeout       time.Duration
+}
+
+func newRFC2136Config(options map[string]string) (RFC2136Config, error) {
+	cfg := RFC2136Config{
+		TSIGAlgorithm: dns.HmacSHA256,
+		Timeout:       dnsProviderTimeout,
+	}
+	for name, value := range options {
+		switch name {
+		case "server":
+			cfg.Server = value
+		case "tsig_key_name":
+			cfg.TSIGKeyName = value
+		case "tsig_secret":
+			cfg.TSIGSecret = value
+		case "tsig_algorithm":
+			cfg.TSIGAlgorithm = value
+		case "timeout":
+			timeout, err := time.ParseDuration(value)
+			if err != nil {
+				return cfg, errors.Wrapf(err, "invalid timeout %s", value)
+			}
+			cfg.Timeout = timeout
+		default:
+			return cfg, errors.Errorf("unknown RFC 2136 option %s", name)
+		}
+	}
+	if cfg.Server == "" {
+		return cfg, errors.New("the server option is required")
+	}
+	return cfg, nil
+}
+
+var _ dnsproviders.Provider = RFC2136{}
+
+// RFC2136 manages the records of a zone with DNS UPDATE messages (RFC 2136), signed with a TSIG key
+// when one is configured, for example on BIND
+type RFC2136 struct {
+	RecordSet dnsproviders.RecordSet
+	Zone      string
+	Config    RFC2136Config
+}
+
+// CreateRecordSet adds the value to the record set
+func (r RFC2136) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rr, err := r.record(recordSetName, recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(r.Zone))
+	msg.Insert([]dns.RR{rr})
+	return r.update(msg)
+}
+
+// DeleteRecordSet removes the value from the record set
+func (r RFC2136) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rr, err := r.record(recordSetName, recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(r.Zone))
+	msg.Remove([]dns.RR{rr})
+	return r.update(msg)
+}
+
+// UpdateRecordSet replaces the values of the record set with the value
+func (r RFC2136) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rr, err := r.record(recordSetName, recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(r.Zone))
+	msg.RemoveRRset([]dns.RR{rr})
+	msg.Insert([]dns.RR{rr})
+	return r.update(msg)
+}
+
+// GetRecordSet returns the values of the record set, or an empty string if it doesn't exist
+func (r RFC2136) GetRecordSet(recordSetName string) (string, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(recordSetName), dns.StringToType[r.RecordSet.RecordSetType])
+	resp, err := r.exchange(msg)
+	if err != nil {
+		return "", err
+	}
+	if resp.Rcode == dns.RcodeNameError {
+		return "", nil
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return "", errors.Errorf("failed to query record set %s: %s", recordSetName, dns.RcodeToString[resp.Rcode])
+	}
+	values := make([]string, 0, len(resp.Answer))
+	for _, rr := range resp.Answer {
+		switch v := rr.(type) {
+		case *dns.A:
+			values = append(values, v.A.String())
+		case *dns.AAAA:
+			values = append(values, v.AAAA.String())
+		}
+	}
+	return strings.Join(values, ","), nil
+}
+
+// GetDomainName returns the name of the zone, after verifying that the server is authoritative for it
+func (r RFC2136) GetDomainName() (string, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(r.Zone), dns.TypeSOA)
+	resp, err := r.exchange(msg)
+	if err != nil {
+		return "", err
+	}
+	if resp.Rcode != dns.RcodeSuccess || !resp.Authoritative || len(resp.Answer) == 0 {
+		return "", errors.Errorf("DNS server %s is not authoritative for zone %s", r.Config.Server, r.Zone)
+	}
+	return strings.TrimSuffix(resp.Answer[0].Header().Name, "."), nil
+}
+
+func (r RFC2136) record(name, value string) (dns.RR, error) {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, errors.Errorf("invalid IP address %s", value)
+	}
+	header := dns.RR_Header{
+		Name:   dns.Fqdn(name),
+		Rrtype: dns.StringToType[r.RecordSet.RecordSetType],
+		Class:  dns.ClassINET,
+		Ttl:    uint32(r.RecordSet.TTL),
+	}
+	switch header.Rrtype {
+	case dns.TypeA:
+		return &dns.A{Hdr: header, A: ip}, nil
+	case dns.TypeAAAA:
+		return &dns.AAAA{Hdr: header, AAAA: ip}, nil
+	}
+	return nil, errors.Errorf("unsupported record type %s", r.RecordSet.RecordSetType)
+}
+
+func (r RFC2136) update(msg *dns.Msg) (string, error) {
+	resp, err := r.exchange(msg)
+	if err != nil {
+		return "", err
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return "", errors.Errorf("DNS update of zone %s was rejected: %s", r.Zone, dns.RcodeToString[resp.Rcode])
+	}
+	return dns.RcodeToString[resp.Rcode], nil
+}
+
+func (r RFC2136) exchange(msg *dns.Msg) (*dns.Msg, error) {
+	if r.Config.Server == "" {
+		return nil, errors.New("no DNS server is configured for RFC 2136 updates")
+	}
+	client := &dns.Client{Timeout: r.Config.Timeout}
+	if r.Config.TSIGKeyName != "" {
+		keyName := dns.Fqdn(r.Config.TSIGKeyName)
+		client.TsigSecret = map[string]string{keyName: r.Config.TSIGSecret}
+		msg.SetTsig(keyName, dns.Fqdn(r.Config.TSIGAlgorithm), 300, time.Now().Unix())
+	}
+	resp, _, err := client.Exchange(msg, r.Config.Server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send DNS message to %s", r.Config.Server)
+	}
+	return resp, nil
+}
@@ -0,0 +1,128 @@
+package dns
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/miekg/dns"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RFC 2136 provider", func() {
+	const (
+		keyName = "assisted."
+		secret  = "c2VjcmV0LWtleS1mb3ItdGVzdHM="
+	)
+
+	var (
+		server  *dns.Server
+		lock    sync.Mutex
+		records map[string]string
+		updates []*dns.Msg
+		rfc2136 RFC2136
+	)
+
+	handle := func(w dns.ResponseWriter, req *dns.Msg) {
+		lock.Lock()
+		defer lock.Unlock()
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		resp.Authoritative = true
+		if req.IsTsig() == nil || w.TsigStatus() != nil {
+			resp.Rcode = dns.RcodeNotAuth
+		} else if req.Opcode == dns.OpcodeUpdate {
+			updates = append(updates, req)
+		} else {
+			q := req.Question[0]
+			switch {
+			case q.Qtype == dns.TypeSOA && q.Name == "example.com.":
+				soa, _ := dns.NewRR("example.com. 60 IN SOA ns.example.com. admin.example.com. 1 60 60 60 60")
+				resp.Answer = []dns.RR{soa}
+			case records[q.Name] != "" && q.Qtype == dns.TypeA:
+				a, _ := dns.NewRR(q.Name + " 60 IN A " + records[q.Name])
+				resp.Answer = []dns.RR{a}
+			case records[q.Name] == "":
+				resp.Rcode = dns.RcodeNameError
+			}
+		}
+		resp.SetTsig(keyName, dns.HmacSHA256, 300, time.Now().Unix())
+		Expect(w.WriteMsg(resp)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		records = map[string]string{"api.test.example.com.": "1.2.3.4"}
+		updates = nil
+
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		started := make(chan struct{})
+		server = &dns.Server{
+			PacketConn:        conn,
+			Handler:           dns.HandlerFunc(handle),
+			TsigSecret:        map[string]string{keyName: secret},
+			NotifyStartedFunc: func() { close(started) },
+			// the default accept function rejects updates
+			MsgAcceptFunc: func(dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+		}
+		go func() { _ = server.ActivateAndServe() }()
+		<-started
+
+		rfc2136 = RFC2136{
+			RecordSet: dnsproviders.RecordSet{RecordSetType: "A", TTL: 60},
+			Zone:      "example.com",
+			Config: RFC2136Config{
+				Server:        conn.LocalAddr().String(),
+				TSIGKeyName:   keyName,
+				TSIGSecret:    secret,
+				TSIGAlgorithm: dns.HmacSHA256,
+				Timeout:       5 * time.Second,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(server.Shutdown()).To(Succeed())
+	})
+
+	It("gets the domain name", func() {
+		name, err := rfc2136.GetDomainName()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("example.com"))
+	})
+
+	It("gets a record set", func() {
+		value, err := rfc2136.GetRecordSet("api.test.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal("1.2.3.4"))
+		value, err = rfc2136.GetRecordSet("*.apps.test.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(BeEmpty())
+	})
+
+	It("creates a record set", func() {
+		_, err := rfc2136.CreateRecordSet("*.apps.test.example.com", "1.2.3.5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updates).To(HaveLen(1))
+		Expect(updates[0].Question[0].Name).To(Equal("example.com."))
+		Expect(updates[0].Ns).To(HaveLen(1))
+		Expect(updates[0].Ns[0].String()).To(Equal("*.apps.test.example.com.\t60\tIN\tA\t1.2.3.5"))
+	})
+
+	It("deletes a record set", func() {
+		_, err := rfc2136.DeleteRecordSet("api.test.example.com", "1.2.3.4")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updates).To(HaveLen(1))
+		Expect(updates[0].Ns).To(HaveLen(1))
+		Expect(updates[0].Ns[0].Header().Class).To(Equal(uint16(dns.ClassNONE)))
+	})
+
+	It("fails with a wrong TSIG key", func() {
+		rfc2136.Config.TSIGSecret = "d3Jvbmc="
+		_, err := rfc2136.CreateRecordSet("api.test.example.com", "1.2.3.5")
+		Expect(err).To(HaveOccurred())
+		Expect(updates).To(BeEmpty())
+	})
+})
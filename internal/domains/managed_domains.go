@@ -2,14 +2,15 @@ package domains
 
 import (
 	"context"
-	"regexp"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/restapi"
 	operations "github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
 )
 
 // NewHandler returns managed domains handler
@@ -25,12 +26,14 @@ type Handler struct {
 }
 
 func (h *Handler) parseDomainProvider(val string) (string, error) {
-	re := regexp.MustCompile("/")
-	if !re.MatchString(val) {
-		return "", errors.Errorf("Invalid format: %s", val)
+	_, provider, _, err := dns.ParseDomainEntry(val)
+	if err != nil {
+		return "", err
 	}
-	s := re.Split(val, 2)
-	return s[1], nil
+	if !funk.ContainsString(dns.SupportedProviders, provider) {
+		return "", errors.Errorf("Unsupported DNS provider: %s", provider)
+	}
+	return provider, nil
 }
 
 func (h *Handler) ListManagedDomains(ctx context.Context, params operations.ListManagedDomainsParams) middleware.Responder {
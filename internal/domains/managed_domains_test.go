@@ -32,6 +32,34 @@ var _ = Describe("list base domains", func() {
 		Expect(domains[0].Domain).Should(Equal("example.com"))
 		Expect(domains[0].Provider).Should(Equal("route53"))
 	})
+	It("all providers", func() {
+		baseDNSDomains = map[string]string{
+			"example.com":      "abc/route53",
+			"bind.example.com": "bind.example.com/rfc2136?server=10.0.0.1%3A53",
+			"pdns.example.com": "pdns.example.com/powerdns?api_url=http%3A//pdns%3A8081&api_key=secret",
+		}
+		h = NewHandler(baseDNSDomains)
+		reply := h.ListManagedDomains(context.Background(), operations.ListManagedDomainsParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListManagedDomainsOK()))
+		val, _ := reply.(*operations.ListManagedDomainsOK)
+		providers := make(map[string]string)
+		for _, domain := range val.Payload {
+			providers[domain.Domain] = domain.Provider
+		}
+		Expect(providers).Should(Equal(map[string]string{
+			"example.com":      "route53",
+			"bind.example.com": "rfc2136",
+			"pdns.example.com": "powerdns",
+		}))
+	})
+	It("unsupported provider", func() {
+		baseDNSDomains = map[string]string{
+			"example.com": "abc/unknown",
+		}
+		h = NewHandler(baseDNSDomains)
+		reply := h.ListManagedDomains(context.Background(), operations.ListManagedDomainsParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListManagedDomainsInternalServerError()))
+	})
 	It("empty", func() {
 		baseDNSDomains = map[string]string{}
 		h = NewHandler(baseDNSDomains)
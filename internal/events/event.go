@@ -25,6 +25,28 @@ type Handler interface {
 	//Get a list of events. Events can be filtered by category. if no filter is specified,
 	//events with the default category are returned
 	GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories ...string) ([]*common.Event, error)
+	//Get a page of the events that match the query, ordered by their time
+	QueryEvents(query *Query) ([]*common.Event, error)
+}
+
+// Query selects the events of a cluster, or of one of its hosts. Unset fields are not filtered on.
+type Query struct {
+	ClusterID  strfmt.UUID
+	HostID     *strfmt.UUID
+	Categories []string
+	Severities []string
+	// Message is matched as a case-insensitive substring
+	Message *string
+	After   *strfmt.DateTime
+	Before  *strfmt.DateTime
+	Order   string
+	Cursor  *common.PageCursor
+	Limit   *int64
+}
+
+// Sink receives the user events saved by the events handler, for example in order to push them to external consumers
+type Sink interface {
+	Publish(ctx context.Context, event *common.Event)
 }
 
 var _ Handler = &Events{}
@@ -34,18 +56,20 @@ var DefaultEventCategories = []string{
 }
 
 type Events struct {
-	db  *gorm.DB
-	log logrus.FieldLogger
+	db    *gorm.DB
+	log   logrus.FieldLogger
+	sinks []Sink
 }
 
-func New(db *gorm.DB, log logrus.FieldLogger) *Events {
+func New(db *gorm.DB, log logrus.FieldLogger, sinks ...Sink) *Events {
 	return &Events{
-		db:  db,
-		log: log,
+		db:    db,
+		log:   log,
+		sinks: sinks,
 	}
 }
 
-func (e *Events) saveEvent(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, category string, severity string, message string, t time.Time, requestID string, props ...interface{}) error {
+func (e *Events) saveEvent(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, category string, severity string, message string, t time.Time, requestID string, props ...interface{}) (*common.Event, error) {
 	log := logutil.FromContext(ctx, e.log)
 	tt := strfmt.DateTime(t)
 	uid := clusterID
@@ -85,17 +109,23 @@ func (e *Events) saveEvent(ctx context.Context, clusterID strfmt.UUID, hostID *s
 	if dberr = tx.Create(&event).Error; err != nil {
 		log.WithError(err).Error("Error adding event")
 	}
-	return dberr
+	return &event, dberr
 }
 
 func (e *Events) AddEvent(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, severity string, msg string, eventTime time.Time, props ...interface{}) {
 	requestID := requestid.FromContext(ctx)
-	_ = e.saveEvent(ctx, clusterID, hostID, models.EventCategoryUser, severity, msg, eventTime, requestID, props...)
+	event, err := e.saveEvent(ctx, clusterID, hostID, models.EventCategoryUser, severity, msg, eventTime, requestID, props...)
+	if err != nil {
+		return
+	}
+	for _, sink := range e.sinks {
+		sink.Publish(ctx, event)
+	}
 }
 
 func (e *Events) AddMetricsEvent(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, severity string, msg string, eventTime time.Time, props ...interface{}) {
 	requestID := requestid.FromContext(ctx)
-	_ = e.saveEvent(ctx, clusterID, hostID, models.EventCategoryMetrics, severity, msg, eventTime, requestID, props...)
+	_, _ = e.saveEvent(ctx, clusterID, hostID, models.EventCategoryMetrics, severity, msg, eventTime, requestID, props...)
 }
 
 func (e Events) GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories ...string) ([]*common.Event, error) {
@@ -118,6 +148,33 @@ func (e Events) GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories
 	return events, err
 }
 
+func (e Events) QueryEvents(query *Query) ([]*common.Event, error) {
+	var events []*common.Event
+
+	categories := query.Categories
+	if len(categories) == 0 {
+		categories = DefaultEventCategories
+	}
+	db := e.db.Where("cluster_id = ?", query.ClusterID.String()).Where("category IN (?)", categories)
+	if query.HostID != nil {
+		db = db.Where("host_id = ?", query.HostID.String())
+	}
+	if len(query.Severities) > 0 {
+		db = db.Where("severity IN (?)", query.Severities)
+	}
+	if query.Message != nil {
+		db = db.Where("message "+common.SubstringFilter, common.SubstringPattern(*query.Message))
+	}
+	if query.After != nil {
+		db = db.Where("event_time > ?", time.Time(*query.After))
+	}
+	if query.Before != nil {
+		db = db.Where("event_time < ?", time.Time(*query.Before))
+	}
+	err := common.Paginate(db, "event_time", "id", query.Order, query.Cursor, query.Limit).Find(&events).Error
+	return events, err
+}
+
 func (e Events) clusterEventsQuery(events *[]*common.Event, selectedCategories []string, clusterID strfmt.UUID) *gorm.DB {
 	return e.db.Where("category IN (?)", selectedCategories).Order("event_time").
 		Find(events, "cluster_id = ?", clusterID.String())
@@ -3,11 +3,13 @@ package events_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
@@ -175,6 +177,87 @@ var _ = Describe("Events library", func() {
 		})
 	})
 
+	Context("query events", func() {
+		var base time.Time
+		BeforeEach(func() {
+			base = time.Now().Truncate(time.Second)
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "cluster installed", base)
+			theEvents.AddEvent(context.TODO(), cluster1, &host, models.EventSeverityError, "host failed", base.Add(time.Minute))
+			theEvents.AddEvent(context.TODO(), cluster1, &host, models.EventSeverityWarning, "host warning", base.Add(2*time.Minute))
+			theEvents.AddEvent(context.TODO(), cluster2, nil, models.EventSeverityError, "other cluster", base)
+		})
+		It("filters by host, severity, message and time", func() {
+			evs, err := theEvents.QueryEvents(&events.Query{ClusterID: cluster1, HostID: &host})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(HaveLen(2))
+			evs, err = theEvents.QueryEvents(&events.Query{ClusterID: cluster1, Severities: []string{models.EventSeverityError}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(HaveLen(1))
+			Expect(evs[0]).Should(WithMessage(swag.String("host failed")))
+			evs, err = theEvents.QueryEvents(&events.Query{ClusterID: cluster1, Message: swag.String("HOST")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(HaveLen(2))
+			after := strfmt.DateTime(base.Add(30 * time.Second))
+			before := strfmt.DateTime(base.Add(90 * time.Second))
+			evs, err = theEvents.QueryEvents(&events.Query{ClusterID: cluster1, After: &after, Before: &before})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(HaveLen(1))
+			Expect(evs[0]).Should(WithMessage(swag.String("host failed")))
+		})
+		It("filters by the message as a literal substring", func() {
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, `50% of host_1 installed in C:\\`, base)
+			for _, message := range []string{"%", "_", `\\`, "0% of host_"} {
+				evs, err := theEvents.QueryEvents(&events.Query{ClusterID: cluster1, Message: swag.String(message)})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(evs).Should(HaveLen(1))
+			}
+			evs, err := theEvents.QueryEvents(&events.Query{ClusterID: cluster1, Message: swag.String("host%failed")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(BeEmpty())
+		})
+		It("paginates in descending order", func() {
+			evs, err := theEvents.QueryEvents(&events.Query{ClusterID: cluster1, Order: common.SortOrderDesc, Limit: swag.Int64(2)})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(HaveLen(2))
+			Expect(evs[0]).Should(WithMessage(swag.String("host warning")))
+			Expect(evs[1]).Should(WithMessage(swag.String("host failed")))
+			cursor := common.NewPageCursor(*evs[1].EventTime, fmt.Sprint(evs[1].ID))
+			evs, err = theEvents.QueryEvents(&events.Query{ClusterID: cluster1, Order: common.SortOrderDesc, Limit: swag.Int64(2), Cursor: cursor})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(HaveLen(1))
+			Expect(evs[0]).Should(WithMessage(swag.String("cluster installed")))
+		})
+	})
+
+	Context("event sinks", func() {
+		var (
+			ctrl *gomock.Controller
+			sink *events.MockSink
+		)
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			sink = events.NewMockSink(ctrl)
+			theEvents = events.New(db, logrus.WithField("pkg", "events"), sink)
+		})
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+		It("user events are published", func() {
+			sink.EXPECT().Publish(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, event *common.Event) {
+				Expect(event).Should(WithMessage(swag.String("event1")))
+				Expect(*event.ClusterID).Should(Equal(cluster1))
+			}).Times(1)
+			theEvents.AddEvent(context.TODO(), cluster1, &host, models.EventSeverityInfo, "event1", time.Now())
+			Expect(numOfEvents(cluster1, &host)).Should(Equal(1))
+		})
+		It("metrics events are not published", func() {
+			theEvents.AddMetricsEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "metrics", time.Now())
+			evs, err := theEvents.GetEvents(cluster1, nil, models.EventCategoryMetrics)
+			Expect(err).Should(BeNil())
+			Expect(len(evs)).Should(Equal(1))
+		})
+	})
+
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
 	})
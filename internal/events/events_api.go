@@ -3,8 +3,10 @@ package events
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -30,7 +32,22 @@ func NewApi(handler Handler, log logrus.FieldLogger) *Api {
 func (a *Api) ListEvents(ctx context.Context, params events.ListEventsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, a.log)
 
-	evs, err := a.handler.GetEvents(params.ClusterID, params.HostID, params.Categories...)
+	cursor, err := common.DecodePageCursor(swag.StringValue(params.Cursor))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	evs, err := a.handler.QueryEvents(&Query{
+		ClusterID:  params.ClusterID,
+		HostID:     params.HostID,
+		Categories: params.Categories,
+		Severities: params.Severities,
+		Message:    params.Message,
+		After:      params.EventTimeAfter,
+		Before:     params.EventTimeBefore,
+		Order:      swag.StringValue(params.Order),
+		Cursor:     cursor,
+		Limit:      params.Limit,
+	})
 	if err != nil {
 		log.WithError(err).Errorf("failed to get events")
 		return common.NewApiError(http.StatusInternalServerError, err)
@@ -46,5 +63,9 @@ func (a *Api) ListEvents(ctx context.Context, params events.ListEventsParams) mi
 			Props:     ev.Props,
 		}
 	}
-	return events.NewListEventsOK().WithPayload(ret)
+	nextCursor := common.NextPageCursor(len(evs), params.Limit, func() *common.PageCursor {
+		last := evs[len(evs)-1]
+		return common.NewPageCursor(*last.EventTime, strconv.FormatUint(uint64(last.ID), 10))
+	})
+	return events.NewListEventsOK().WithPayload(ret).WithNextCursor(nextCursor)
 }
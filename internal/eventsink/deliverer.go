@@ -0,0 +1,290 @@
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SignatureHeader holds the HMAC-SHA256 signature of the delivered payload, signed with the webhook secret
+	SignatureHeader = "X-Assisted-Signature"
+	// DeliveryHeader holds a unique identifier of the delivery, which is kept across delivery retries
+	DeliveryHeader = "X-Assisted-Delivery"
+)
+
+type Config struct {
+	DeliveryInterval time.Duration `envconfig:"WEBHOOK_DELIVERY_INTERVAL" default:"5s"`
+	DeliveryTimeout  time.Duration `envconfig:"WEBHOOK_DELIVERY_TIMEOUT" default:"10s"`
+	DeliveryBatch    int           `envconfig:"WEBHOOK_DELIVERY_BATCH" default:"100"`
+	DeliveryWorkers  int           `envconfig:"WEBHOOK_DELIVERY_WORKERS" default:"10"`
+	MaxAttempts      int           `envconfig:"WEBHOOK_MAX_DELIVERY_ATTEMPTS" default:"10"`
+	RetryDelay       time.Duration `envconfig:"WEBHOOK_RETRY_DELAY" default:"10s"`
+	MaxRetryDelay    time.Duration `envconfig:"WEBHOOK_MAX_RETRY_DELAY" default:"30m"`
+	PublishQueueSize int           `envconfig:"WEBHOOK_PUBLISH_QUEUE_SIZE" default:"1000"`
+
+	// BlockedNetworks are the loopback, link-local, private and other special purpose networks that webhooks may not
+	// target, so that registering a webhook cannot be used to reach the service's own network or the cloud metadata
+	// endpoints
+	BlockedNetworks WebhookNetworks `envconfig:"WEBHOOK_BLOCKED_NETWORKS" default:"0.0.0.0/8,10.0.0.0/8,100.64.0.0/10,127.0.0.0/8,169.254.0.0/16,172.16.0.0/12,192.0.0.0/24,192.168.0.0/16,198.18.0.0/15,224.0.0.0/3,::/128,::1/128,fc00::/7,fe80::/10,ff00::/8"`
+	// AllowedNetworks are the networks within the blocked ones that webhooks may target nevertheless, e.g. the network
+	// of the webhook receivers of an on-premise deployment
+	AllowedNetworks WebhookNetworks `envconfig:"WEBHOOK_ALLOWED_NETWORKS"`
+}
+
+// Deliverer posts the events queued by the WebhookSink to the webhooks
+type Deliverer struct {
+	Config
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	client        *http.Client
+	leaderElector leader.Leader
+}
+
+func NewDeliverer(cfg Config, db *gorm.DB, log logrus.FieldLogger, leaderElector leader.Leader) *Deliverer {
+	return &Deliverer{
+		Config:        cfg,
+		db:            db,
+		log:           log,
+		client:        newDeliveryClient(cfg),
+		leaderElector: leaderElector,
+	}
+}
+
+// proxiedKey marks the context of a delivery that is sent through the proxy of the environment
+type proxiedKey struct{}
+
+// newDeliveryClient returns an HTTP client that refuses to connect to the addresses that webhooks may not target,
+// so that a webhook host that resolves to such an address after its registration is not reached either. Deliveries
+// use the HTTP(S)_PROXY of the environment, which may be in a blocked network, so the addresses of their webhook
+// hosts are validated before they are sent to the proxy.
+func newDeliveryClient(cfg Config) *http.Client {
+	guardedDialer := &net.Dialer{
+		Timeout: cfg.DeliveryTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !cfg.allowsWebhookIP(ip) {
+				return errors.Errorf("webhook address %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+	proxyDialer := &net.Dialer{Timeout: cfg.DeliveryTimeout}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		if ctx.Value(proxiedKey{}) != nil {
+			return proxyDialer.DialContext(ctx, network, address)
+		}
+		return guardedDialer.DialContext(ctx, network, address)
+	}
+	return &http.Client{Timeout: cfg.DeliveryTimeout, Transport: &deliveryTransport{cfg: cfg, next: transport}}
+}
+
+// deliveryTransport validates the webhook hosts of the deliveries that are sent through a proxy, which connects to
+// the hosts on behalf of the service
+type deliveryTransport struct {
+	cfg  Config
+	next *http.Transport
+}
+
+func (t *deliveryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	proxyURL, err := t.next.Proxy(req)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL == nil {
+		return t.next.RoundTrip(req)
+	}
+	if err = t.cfg.validateWebhookURL(req.Context(), req.URL.String()); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.next.RoundTrip(req.WithContext(context.WithValue(req.Context(), proxiedKey{}, true)))
+}
+
+// DeliveryTask delivers the queued events whose delivery time has come. The deliveries of every webhook are posted
+// in order by a single worker out of a bounded pool, so that a slow or failing webhook delays only its own
+// deliveries. Failed deliveries are retried with an exponential backoff until the maximal number of attempts is
+// reached.
+func (d *Deliverer) DeliveryTask() {
+	if !d.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	log := logutil.FromContext(ctx, d.log)
+
+	var deliveries []*common.WebhookDelivery
+	if err := d.db.Where("next_attempt_at <= ?", time.Now()).Order("id").Limit(d.DeliveryBatch).
+		Find(&deliveries).Error; err != nil {
+		log.WithError(err).Error("failed to get queued webhook deliveries")
+		return
+	}
+
+	var webhookIDs []strfmt.UUID
+	webhookDeliveries := make(map[strfmt.UUID][]*common.WebhookDelivery)
+	for _, delivery := range deliveries {
+		if _, ok := webhookDeliveries[delivery.WebhookID]; !ok {
+			webhookIDs = append(webhookIDs, delivery.WebhookID)
+		}
+		webhookDeliveries[delivery.WebhookID] = append(webhookDeliveries[delivery.WebhookID], delivery)
+	}
+
+	workers := d.DeliveryWorkers
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(webhookIDs) {
+		workers = len(webhookIDs)
+	}
+	queue := make(chan strfmt.UUID, len(webhookIDs))
+	for _, webhookID := range webhookIDs {
+		queue <- webhookID
+	}
+	close(queue)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for webhookID := range queue {
+				d.deliverWebhook(ctx, webhookID, webhookDeliveries[webhookID])
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// deliverWebhook posts the deliveries of the webhook in order, and stops at the first delivery that fails
+func (d *Deliverer) deliverWebhook(ctx context.Context, webhookID strfmt.UUID, deliveries []*common.WebhookDelivery) {
+	log := logutil.FromContext(ctx, d.log)
+
+	var webhook common.Webhook
+	if err := d.db.First(&webhook, "id = ?", webhookID.String()).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			log.Infof("Dropping deliveries of deregistered webhook %s", webhookID.String())
+			if err = d.db.Where("webhook_id = ?", webhookID.String()).Delete(&common.WebhookDelivery{}).Error; err != nil {
+				log.WithError(err).Errorf("failed to delete deliveries of webhook %s", webhookID.String())
+			}
+			return
+		}
+		log.WithError(err).Errorf("failed to get webhook %s", webhookID.String())
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if !d.deliver(ctx, &webhook, delivery) {
+			return
+		}
+	}
+}
+
+// deliver posts the delivery to the webhook and returns whether it succeeded. When it fails, the later deliveries of
+// the webhook are postponed until the next attempt of the failed one, so that they are not attempted meanwhile.
+func (d *Deliverer) deliver(ctx context.Context, webhook *common.Webhook, delivery *common.WebhookDelivery) bool {
+	log := logutil.FromContext(ctx, d.log)
+
+	err := d.post(ctx, webhook, delivery)
+	if err == nil {
+		d.deleteDelivery(ctx, delivery)
+		return true
+	}
+
+	attempts := delivery.Attempts + 1
+	nextAttemptAt := time.Now().Add(d.retryDelay(attempts))
+	if attempts >= d.MaxAttempts {
+		log.WithError(err).Errorf("Giving up delivery %d to webhook %s after %d attempts",
+			delivery.ID, webhook.ID.String(), attempts)
+		d.deleteDelivery(ctx, delivery)
+	} else {
+		log.WithError(err).Warnf("Delivery %d to webhook %s failed, attempt %d out of %d",
+			delivery.ID, webhook.ID.String(), attempts, d.MaxAttempts)
+		if dberr := d.db.Model(delivery).Updates(map[string]interface{}{
+			"attempts":        attempts,
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      err.Error(),
+		}).Error; dberr != nil {
+			log.WithError(dberr).Errorf("failed to update delivery %d", delivery.ID)
+		}
+	}
+
+	if dberr := d.db.Model(&common.WebhookDelivery{}).
+		Where("webhook_id = ? AND id > ? AND next_attempt_at < ?", webhook.ID.String(), delivery.ID, nextAttemptAt).
+		Update("next_attempt_at", nextAttemptAt).Error; dberr != nil {
+		log.WithError(dberr).Errorf("failed to postpone the deliveries of webhook %s", webhook.ID.String())
+	}
+	return false
+}
+
+func (d *Deliverer) post(ctx context.Context, webhook *common.Webhook, delivery *common.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *webhook.URL, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request to %s", *webhook.URL)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, fmt.Sprintf("%d", delivery.ID))
+	if webhook.Secret != "" {
+		req.Header.Set(SignatureHeader, SignPayload(webhook.Secret, []byte(delivery.Payload)))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to post to %s", *webhook.URL)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("%s responded with status code %d", *webhook.URL, resp.StatusCode)
+	}
+	return nil
+}
+
+func (d *Deliverer) deleteDelivery(ctx context.Context, delivery *common.WebhookDelivery) {
+	if err := d.db.Delete(delivery).Error; err != nil {
+		logutil.FromContext(ctx, d.log).WithError(err).Errorf("failed to delete delivery %d", delivery.ID)
+	}
+}
+
+// retryDelay doubles the configured retry delay for every failed attempt, up to the configured maximum
+func (d *Deliverer) retryDelay(attempts int) time.Duration {
+	delay := d.RetryDelay
+	for i := 1; i < attempts && delay < d.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > d.MaxRetryDelay {
+		delay = d.MaxRetryDelay
+	}
+	return delay
+}
+
+// SignPayload returns the value of the signature header of a payload delivered to a webhook with the given secret
+func SignPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,84 @@
+package eventsink
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookNetworks is a list of networks, decoded from comma separated CIDRs
+type WebhookNetworks []*net.IPNet
+
+func (n *WebhookNetworks) Decode(value string) error {
+	networks := WebhookNetworks{}
+	for _, cidr := range strings.Split(value, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid webhook network %s", cidr)
+		}
+		networks = append(networks, ipNet)
+	}
+	*n = networks
+	return nil
+}
+
+func (n WebhookNetworks) contains(ip net.IP) bool {
+	for _, ipNet := range n {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsWebhookIP returns whether webhooks may target the address: addresses of the blocked networks are refused,
+// unless they are in one of the allowed networks
+func (c Config) allowsWebhookIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	return c.AllowedNetworks.contains(ip) || !c.BlockedNetworks.contains(ip)
+}
+
+// lookupIPAddr resolves the host of a webhook URL, replaced by tests
+var lookupIPAddr = net.DefaultResolver.LookupIPAddr
+
+// validateWebhookURL checks that the webhook URL uses HTTP and that its host resolves only to allowed addresses
+func (c Config) validateWebhookURL(ctx context.Context, webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook URL %s", webhookURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("webhook URL %s must use the http or https scheme", webhookURL)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return errors.Errorf("webhook URL %s is missing a host", webhookURL)
+	}
+
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = append(ips, ip)
+	} else {
+		addrs, err := lookupIPAddr(ctx, host)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve the host of webhook URL %s", webhookURL)
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+	for _, ip := range ips {
+		if !c.allowsWebhookIP(ip) {
+			return errors.Errorf("webhook URL %s must not target the blocked address %s", webhookURL, ip.String())
+		}
+	}
+	return nil
+}
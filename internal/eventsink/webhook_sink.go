@@ -0,0 +1,126 @@
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/sirupsen/logrus"
+)
+
+var _ events.Sink = &WebhookSink{}
+
+type publishedEvent struct {
+	ctx   context.Context
+	event *common.Event
+}
+
+// WebhookSink queues the published events for delivery to the webhooks registered for the event's cluster
+// or for the cluster's organization. The queued events are delivered by the Deliverer.
+//
+// The webhooks of an event are matched in the background once the sink is started, so that publishing does not
+// query the database on behalf of the caller. When the sink is not running or its queue is full, the event is
+// matched by the caller so that it is not lost.
+type WebhookSink struct {
+	db        *gorm.DB
+	log       logrus.FieldLogger
+	queueSize int
+
+	mu      sync.RWMutex
+	running bool
+	queue   chan publishedEvent
+	done    chan struct{}
+}
+
+func NewWebhookSink(cfg Config, db *gorm.DB, log logrus.FieldLogger) *WebhookSink {
+	return &WebhookSink{
+		db:        db,
+		log:       log,
+		queueSize: cfg.PublishQueueSize,
+	}
+}
+
+// Start starts matching the published events in the background
+func (s *WebhookSink) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return
+	}
+	s.running = true
+	s.queue = make(chan publishedEvent, s.queueSize)
+	s.done = make(chan struct{})
+	go func(queue chan publishedEvent, done chan struct{}) {
+		defer close(done)
+		for published := range queue {
+			s.queueDeliveries(published.ctx, published.event)
+		}
+	}(s.queue, s.done)
+}
+
+// Stop matches the events that were already published and stops the background matching
+func (s *WebhookSink) Stop() {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = false
+	close(s.queue)
+	done := s.done
+	s.mu.Unlock()
+	<-done
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, event *common.Event) {
+	s.mu.RLock()
+	if s.running {
+		select {
+		case s.queue <- publishedEvent{ctx: ctx, event: event}:
+			s.mu.RUnlock()
+			return
+		default:
+			logutil.FromContext(ctx, s.log).Warnf("Webhook queue is full, matching event of cluster %s synchronously",
+				event.ClusterID.String())
+		}
+	}
+	s.mu.RUnlock()
+	s.queueDeliveries(ctx, event)
+}
+
+func (s *WebhookSink) queueDeliveries(ctx context.Context, event *common.Event) {
+	log := logutil.FromContext(ctx, s.log)
+
+	var webhooks []*common.Webhook
+	if err := s.db.Where("cluster_id = ?", event.ClusterID.String()).
+		Or("cluster_id IS NULL AND org_id <> '' AND org_id = (SELECT org_id FROM clusters WHERE id = ?)", event.ClusterID.String()).
+		Find(&webhooks).Error; err != nil {
+		log.WithError(err).Errorf("failed to get webhooks of cluster %s", event.ClusterID.String())
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(&event.Event)
+	if err != nil {
+		log.WithError(err).Errorf("failed to marshal event of cluster %s", event.ClusterID.String())
+		return
+	}
+	for _, webhook := range webhooks {
+		delivery := common.WebhookDelivery{
+			WebhookID:     *webhook.ID,
+			Payload:       string(payload),
+			NextAttemptAt: time.Now(),
+		}
+		if err := s.db.Create(&delivery).Error; err != nil {
+			log.WithError(err).Errorf("failed to queue event of cluster %s for webhook %s",
+				event.ClusterID.String(), webhook.ID.String())
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Webhook sink", func() {
+	var (
+		db            *gorm.DB
+		dbName        string
+		eventsHandler *events.Events
+		sink          *WebhookSink
+		deliverer     *Deliverer
+		server        *httptest.Server
+		received      []*http.Request
+		payloads      [][]byte
+		statusCode    int
+		mu            sync.Mutex
+		cfg           Config
+		clusterID     strfmt.UUID
+		otherID       strfmt.UUID
+	)
+
+	addWebhook := func(clusterID *strfmt.UUID, orgID string, secret string) *common.Webhook {
+		id := strfmt.UUID(uuid.New().String())
+		webhook := &common.Webhook{
+			Webhook: models.Webhook{
+				ID:        &id,
+				ClusterID: clusterID,
+				OrgID:     orgID,
+				URL:       swag.String(server.URL),
+			},
+			Secret: secret,
+		}
+		Expect(db.Create(webhook).Error).ShouldNot(HaveOccurred())
+		return webhook
+	}
+
+	queuedDeliveries := func() []*common.WebhookDelivery {
+		var deliveries []*common.WebhookDelivery
+		Expect(db.Order("id").Find(&deliveries).Error).ShouldNot(HaveOccurred())
+		return deliveries
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		received = nil
+		payloads = nil
+		statusCode = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).ShouldNot(HaveOccurred())
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, r)
+			payloads = append(payloads, body)
+			w.WriteHeader(statusCode)
+		}))
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		otherID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org1"}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherID, OrgID: "org2"}}).Error).ShouldNot(HaveOccurred())
+
+		cfg = Config{}
+		Expect(envconfig.Process("", &cfg)).ShouldNot(HaveOccurred())
+		// the test server listens on the loopback address, which is blocked by default
+		Expect(cfg.AllowedNetworks.Decode("127.0.0.0/8,::1/128")).ShouldNot(HaveOccurred())
+		cfg = Config{
+			BlockedNetworks:  cfg.BlockedNetworks,
+			AllowedNetworks:  cfg.AllowedNetworks,
+			DeliveryTimeout:  time.Second,
+			DeliveryBatch:    100,
+			DeliveryWorkers:  2,
+			MaxAttempts:      3,
+			RetryDelay:       time.Minute,
+			MaxRetryDelay:    time.Hour,
+			PublishQueueSize: 10,
+		}
+		sink = NewWebhookSink(cfg, db, logrus.New())
+		eventsHandler = events.New(db, logrus.New(), sink)
+		deliverer = NewDeliverer(cfg, db, logrus.New(), &leader.DummyElector{})
+	})
+
+	AfterEach(func() {
+		server.Close()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("queues events for cluster and organization webhooks", func() {
+		addWebhook(&clusterID, "org1", "")
+		addWebhook(nil, "org1", "")
+		addWebhook(&otherID, "org2", "")
+		addWebhook(nil, "org2", "")
+
+		eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "event1", time.Now())
+		Expect(queuedDeliveries()).Should(HaveLen(2))
+	})
+
+	It("queues events in the background once started", func() {
+		addWebhook(&clusterID, "org1", "")
+		sink.Start()
+		for i := 0; i < 20; i++ {
+			eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "event", time.Now())
+		}
+		sink.Stop()
+		Expect(queuedDeliveries()).Should(HaveLen(20))
+	})
+
+	It("doesn't queue events of organizations without an organization", func() {
+		noOrgID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &noOrgID}}).Error).ShouldNot(HaveOccurred())
+		addWebhook(nil, "", "")
+
+		eventsHandler.AddEvent(context.TODO(), noOrgID, nil, models.EventSeverityInfo, "event1", time.Now())
+		Expect(queuedDeliveries()).Should(BeEmpty())
+	})
+
+	It("doesn't queue metrics events", func() {
+		addWebhook(&clusterID, "org1", "")
+		eventsHandler.AddMetricsEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "metrics", time.Now())
+		Expect(queuedDeliveries()).Should(BeEmpty())
+	})
+
+	It("delivers signed events", func() {
+		addWebhook(&clusterID, "org1", "secret")
+		eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityWarning, "event1", time.Now())
+
+		deliverer.DeliveryTask()
+		Expect(received).Should(HaveLen(1))
+		Expect(received[0].Header.Get(SignatureHeader)).Should(Equal(SignPayload("secret", payloads[0])))
+
+		var event models.Event
+		Expect(json.Unmarshal(payloads[0], &event)).ShouldNot(HaveOccurred())
+		Expect(*event.ClusterID).Should(Equal(clusterID))
+		Expect(*event.Message).Should(Equal("event1"))
+		Expect(*event.Severity).Should(Equal(models.EventSeverityWarning))
+		Expect(queuedDeliveries()).Should(BeEmpty())
+	})
+
+	It("retries failed deliveries with backoff", func() {
+		addWebhook(&clusterID, "org1", "")
+		eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "event1", time.Now())
+		statusCode = http.StatusInternalServerError
+
+		deliverer.DeliveryTask()
+		Expect(received).Should(HaveLen(1))
+		deliveries := queuedDeliveries()
+		Expect(deliveries).Should(HaveLen(1))
+		Expect(deliveries[0].Attempts).Should(Equal(1))
+		Expect(deliveries[0].NextAttemptAt).Should(BeTemporally("~", time.Now().Add(time.Minute), 5*time.Second))
+
+		// not yet time for another attempt
+		deliverer.DeliveryTask()
+		Expect(received).Should(HaveLen(1))
+
+		Expect(db.Model(deliveries[0]).Update("next_attempt_at", time.Now()).Error).ShouldNot(HaveOccurred())
+		statusCode = http.StatusOK
+		deliverer.DeliveryTask()
+		Expect(received).Should(HaveLen(2))
+		Expect(received[0].Header.Get(DeliveryHeader)).Should(Equal(received[1].Header.Get(DeliveryHeader)))
+		Expect(queuedDeliveries()).Should(BeEmpty())
+	})
+
+	It("gives up after the maximal number of attempts", func() {
+		addWebhook(&clusterID, "org1", "")
+		eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "event1", time.Now())
+		statusCode = http.StatusInternalServerError
+
+		for i := 0; i < deliverer.MaxAttempts; i++ {
+			Expect(db.Model(&common.WebhookDelivery{}).Update("next_attempt_at", time.Now()).Error).ShouldNot(HaveOccurred())
+			deliverer.DeliveryTask()
+		}
+		Expect(received).Should(HaveLen(deliverer.MaxAttempts))
+		Expect(queuedDeliveries()).Should(BeEmpty())
+	})
+
+	It("drops deliveries of deregistered webhooks", func() {
+		webhook := addWebhook(&clusterID, "org1", "")
+		eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "event1", time.Now())
+		Expect(db.Delete(webhook).Error).ShouldNot(HaveOccurred())
+
+		deliverer.DeliveryTask()
+		Expect(received).Should(BeEmpty())
+		Expect(queuedDeliveries()).Should(BeEmpty())
+	})
+
+	It("postpones the later deliveries of a failing webhook without delaying other webhooks", func() {
+		failing := addWebhook(&clusterID, "org1", "")
+		failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer failingServer.Close()
+		Expect(db.Model(failing).Update("url", failingServer.URL).Error).ShouldNot(HaveOccurred())
+		addWebhook(nil, "org1", "")
+
+		for i := 0; i < 3; i++ {
+			eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "event", time.Now())
+		}
+		deliverer.DeliveryTask()
+		Expect(received).Should(HaveLen(3))
+
+		deliveries := queuedDeliveries()
+		Expect(deliveries).Should(HaveLen(3))
+		Expect(deliveries[0].Attempts).Should(Equal(1))
+		for _, delivery := range deliveries {
+			Expect(delivery.WebhookID).Should(Equal(*failing.ID))
+			Expect(delivery.NextAttemptAt).Should(BeTemporally("~", time.Now().Add(time.Minute), 5*time.Second))
+		}
+		Expect(deliveries[1].Attempts).Should(Equal(0))
+		Expect(deliveries[2].Attempts).Should(Equal(0))
+	})
+
+	It("refuses to deliver to loopback addresses", func() {
+		addWebhook(&clusterID, "org1", "")
+		eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "event1", time.Now())
+
+		cfg.AllowedNetworks = nil
+		deliverer.client = newDeliveryClient(cfg)
+		deliverer.DeliveryTask()
+		Expect(received).Should(BeEmpty())
+		deliveries := queuedDeliveries()
+		Expect(deliveries).Should(HaveLen(1))
+		Expect(deliveries[0].LastError).Should(ContainSubstring("is not allowed"))
+	})
+
+	Context("through a proxy", func() {
+		var (
+			proxy   *httptest.Server
+			proxied []*http.Request
+		)
+
+		BeforeEach(func() {
+			proxied = nil
+			proxy = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				proxied = append(proxied, r)
+			}))
+			proxyURL, err := url.Parse(proxy.URL)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the proxy listens on the loopback address, which remains blocked for the webhooks
+			cfg.AllowedNetworks = nil
+			deliverer.client = newDeliveryClient(cfg)
+			deliverer.client.Transport.(*deliveryTransport).next.Proxy = http.ProxyURL(proxyURL)
+
+			clusterWebhook := addWebhook(&clusterID, "org1", "")
+			Expect(db.Model(clusterWebhook).Update("url", "http://webhook.example.com/hook").Error).ShouldNot(HaveOccurred())
+			eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "event1", time.Now())
+		})
+
+		AfterEach(func() {
+			proxy.Close()
+			lookupIPAddr = net.DefaultResolver.LookupIPAddr
+		})
+
+		It("delivers to allowed addresses", func() {
+			lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+				return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil
+			}
+			deliverer.DeliveryTask()
+			Expect(proxied).Should(HaveLen(1))
+			Expect(proxied[0].Host).Should(Equal("webhook.example.com"))
+			Expect(queuedDeliveries()).Should(BeEmpty())
+		})
+
+		It("refuses to deliver to blocked addresses", func() {
+			lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+				return []net.IPAddr{{IP: net.ParseIP("169.254.169.254")}}, nil
+			}
+			deliverer.DeliveryTask()
+			Expect(proxied).Should(BeEmpty())
+			deliveries := queuedDeliveries()
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(deliveries[0].LastError).Should(ContainSubstring("blocked address"))
+		})
+	})
+
+	It("retry delay", func() {
+		Expect(deliverer.retryDelay(1)).Should(Equal(time.Minute))
+		Expect(deliverer.retryDelay(2)).Should(Equal(2 * time.Minute))
+		Expect(deliverer.retryDelay(3)).Should(Equal(4 * time.Minute))
+		Expect(deliverer.retryDelay(100)).Should(Equal(time.Hour))
+	})
+})
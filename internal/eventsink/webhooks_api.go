@@ -0,0 +1,157 @@
+package eventsink
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.WebhooksAPI = &Api{}
+
+type Api struct {
+	cfg Config
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewApi(cfg Config, db *gorm.DB, log logrus.FieldLogger) *Api {
+	return &Api{
+		cfg: cfg,
+		db:  db,
+		log: log,
+	}
+}
+
+func (a *Api) RegisterClusterWebhook(ctx context.Context, params operations.RegisterClusterWebhookParams) middleware.Responder {
+	c, apierr := cluster.GetCluster(ctx, a.log, a.db, params.ClusterID.String())
+	if apierr != nil {
+		return apierr
+	}
+	webhook, err := a.registerWebhook(ctx, params.NewWebhookParams, c.ID, c.OrgID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewRegisterClusterWebhookCreated().WithPayload(webhook)
+}
+
+func (a *Api) ListClusterWebhooks(ctx context.Context, params operations.ListClusterWebhooksParams) middleware.Responder {
+	if _, apierr := cluster.GetCluster(ctx, a.log, a.db, params.ClusterID.String()); apierr != nil {
+		return apierr
+	}
+	webhooks, err := a.listWebhooks(ctx, a.db.Where("cluster_id = ?", params.ClusterID.String()))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewListClusterWebhooksOK().WithPayload(webhooks)
+}
+
+func (a *Api) DeregisterClusterWebhook(ctx context.Context, params operations.DeregisterClusterWebhookParams) middleware.Responder {
+	if _, apierr := cluster.GetCluster(ctx, a.log, a.db, params.ClusterID.String()); apierr != nil {
+		return apierr
+	}
+	err := a.deregisterWebhook(ctx, params.WebhookID,
+		a.db.Where("id = ? AND cluster_id = ?", params.WebhookID.String(), params.ClusterID.String()))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewDeregisterClusterWebhookNoContent()
+}
+
+func (a *Api) RegisterWebhook(ctx context.Context, params operations.RegisterWebhookParams) middleware.Responder {
+	orgID := ocm.OrgIDFromContext(ctx)
+	if orgID == "" {
+		return common.NewApiError(http.StatusBadRequest, errors.New("organization webhooks require an organization"))
+	}
+	webhook, err := a.registerWebhook(ctx, params.NewWebhookParams, nil, orgID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewRegisterWebhookCreated().WithPayload(webhook)
+}
+
+func (a *Api) ListWebhooks(ctx context.Context, params operations.ListWebhooksParams) middleware.Responder {
+	webhooks, err := a.listWebhooks(ctx, a.db.Where("cluster_id IS NULL AND org_id = ?", ocm.OrgIDFromContext(ctx)))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewListWebhooksOK().WithPayload(webhooks)
+}
+
+func (a *Api) DeregisterWebhook(ctx context.Context, params operations.DeregisterWebhookParams) middleware.Responder {
+	err := a.deregisterWebhook(ctx, params.WebhookID,
+		a.db.Where("id = ? AND cluster_id IS NULL AND org_id = ?", params.WebhookID.String(), ocm.OrgIDFromContext(ctx)))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewDeregisterWebhookNoContent()
+}
+
+func (a *Api) registerWebhook(ctx context.Context, params *models.WebhookCreateParams, clusterID *strfmt.UUID, orgID string) (*models.Webhook, error) {
+	log := logutil.FromContext(ctx, a.log)
+
+	if err := a.cfg.validateWebhookURL(ctx, *params.URL); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	webhook := common.Webhook{
+		Webhook: models.Webhook{
+			ID:        &id,
+			ClusterID: clusterID,
+			OrgID:     orgID,
+			UserName:  ocm.UserNameFromContext(ctx),
+			URL:       params.URL,
+			CreatedAt: strfmt.DateTime(time.Now()),
+		},
+		Secret: params.Secret,
+	}
+	if err := a.db.Create(&webhook).Error; err != nil {
+		log.WithError(err).Errorf("failed to register webhook %s", *params.URL)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Registered webhook %s", id.String())
+	return &webhook.Webhook, nil
+}
+
+func (a *Api) listWebhooks(ctx context.Context, query *gorm.DB) (models.WebhookList, error) {
+	var webhooks []*common.Webhook
+	if err := query.Order("created_at").Find(&webhooks).Error; err != nil {
+		logutil.FromContext(ctx, a.log).WithError(err).Error("failed to list webhooks")
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.WebhookList, len(webhooks))
+	for i, webhook := range webhooks {
+		ret[i] = &webhook.Webhook
+	}
+	return ret, nil
+}
+
+func (a *Api) deregisterWebhook(ctx context.Context, webhookID strfmt.UUID, query *gorm.DB) error {
+	log := logutil.FromContext(ctx, a.log)
+
+	reply := query.Delete(&common.Webhook{})
+	if reply.Error != nil {
+		log.WithError(reply.Error).Errorf("failed to deregister webhook %s", webhookID.String())
+		return common.NewApiError(http.StatusInternalServerError, reply.Error)
+	}
+	if reply.RowsAffected == 0 {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("webhook %s not found", webhookID.String()))
+	}
+	// queued deliveries of the webhook are dropped by the delivery task
+	log.Infof("Deregistered webhook %s", webhookID.String())
+	return nil
+}
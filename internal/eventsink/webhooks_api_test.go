@@ -0,0 +1,154 @@
+package eventsink
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Webhooks API", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		cfg       Config
+		api       *Api
+		ctx       context.Context
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		cfg = Config{}
+		Expect(envconfig.Process("", &cfg)).ShouldNot(HaveOccurred())
+		api = NewApi(cfg, db, logrus.New())
+		lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+			return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil
+		}
+		payload := &ocm.AuthPayload{Username: "user1", Organization: "org1", Role: ocm.UserRole}
+		ctx = context.WithValue(context.Background(), restapi.AuthKey, payload)
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org1", UserName: "user1"}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		lookupIPAddr = net.DefaultResolver.LookupIPAddr
+		common.DeleteTestDB(db, dbName)
+	})
+
+	registerClusterWebhook := func(url string) middleware.Responder {
+		return api.RegisterClusterWebhook(ctx, operations.RegisterClusterWebhookParams{
+			ClusterID:        clusterID,
+			NewWebhookParams: &models.WebhookCreateParams{URL: swag.String(url), Secret: "secret"},
+		})
+	}
+
+	It("registers, lists and deregisters cluster webhooks", func() {
+		reply := registerClusterWebhook("https://example.com/hook")
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewRegisterClusterWebhookCreated()))
+		webhook := reply.(*operations.RegisterClusterWebhookCreated).Payload
+		Expect(*webhook.ClusterID).Should(Equal(clusterID))
+		Expect(webhook.OrgID).Should(Equal("org1"))
+		Expect(webhook.UserName).Should(Equal("user1"))
+
+		var stored common.Webhook
+		Expect(db.First(&stored, "id = ?", webhook.ID.String()).Error).ShouldNot(HaveOccurred())
+		Expect(stored.Secret).Should(Equal("secret"))
+
+		reply = api.ListClusterWebhooks(ctx, operations.ListClusterWebhooksParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListClusterWebhooksOK()))
+		Expect(reply.(*operations.ListClusterWebhooksOK).Payload).Should(HaveLen(1))
+
+		reply = api.ListWebhooks(ctx, operations.ListWebhooksParams{})
+		Expect(reply.(*operations.ListWebhooksOK).Payload).Should(BeEmpty())
+
+		reply = api.DeregisterClusterWebhook(ctx, operations.DeregisterClusterWebhookParams{ClusterID: clusterID, WebhookID: *webhook.ID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewDeregisterClusterWebhookNoContent()))
+
+		reply = api.DeregisterClusterWebhook(ctx, operations.DeregisterClusterWebhookParams{ClusterID: clusterID, WebhookID: *webhook.ID})
+		common.VerifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("registers, lists and deregisters organization webhooks", func() {
+		reply := api.RegisterWebhook(ctx, operations.RegisterWebhookParams{
+			NewWebhookParams: &models.WebhookCreateParams{URL: swag.String("http://example.com/hook")},
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewRegisterWebhookCreated()))
+		webhook := reply.(*operations.RegisterWebhookCreated).Payload
+		Expect(webhook.ClusterID).Should(BeNil())
+		Expect(webhook.OrgID).Should(Equal("org1"))
+
+		reply = api.ListWebhooks(ctx, operations.ListWebhooksParams{})
+		Expect(reply.(*operations.ListWebhooksOK).Payload).Should(HaveLen(1))
+
+		otherOrgCtx := context.WithValue(context.Background(), restapi.AuthKey, &ocm.AuthPayload{Username: "user2", Organization: "org2"})
+		reply = api.DeregisterWebhook(otherOrgCtx, operations.DeregisterWebhookParams{WebhookID: *webhook.ID})
+		common.VerifyApiError(reply, http.StatusNotFound)
+
+		reply = api.DeregisterWebhook(ctx, operations.DeregisterWebhookParams{WebhookID: *webhook.ID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewDeregisterWebhookNoContent()))
+	})
+
+	It("rejects invalid URLs", func() {
+		for _, url := range []string{"example.com/hook", "ftp://example.com/hook", "http://", ":invalid"} {
+			common.VerifyApiError(registerClusterWebhook(url), http.StatusBadRequest)
+		}
+	})
+
+	It("rejects URLs of loopback, link-local and private addresses", func() {
+		for _, url := range []string{"http://127.0.0.1/hook", "http://[::1]:8080/hook", "http://169.254.169.254/latest/meta-data",
+			"http://10.1.2.3/hook", "https://192.168.1.1/hook", "http://[fd00:ec2::254]/hook", "http://0.0.0.0/hook"} {
+			common.VerifyApiError(registerClusterWebhook(url), http.StatusBadRequest)
+		}
+	})
+
+	It("rejects URLs whose host resolves to a private address", func() {
+		lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+			return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}, {IP: net.ParseIP("172.16.0.1")}}, nil
+		}
+		common.VerifyApiError(registerClusterWebhook("https://internal.example.com/hook"), http.StatusBadRequest)
+	})
+
+	It("accepts URLs of blocked addresses within the allowed networks", func() {
+		Expect(cfg.AllowedNetworks.Decode("10.1.0.0/16")).ShouldNot(HaveOccurred())
+		api = NewApi(cfg, db, logrus.New())
+		reply := registerClusterWebhook("http://10.1.2.3/hook")
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewRegisterClusterWebhookCreated()))
+		common.VerifyApiError(registerClusterWebhook("http://10.2.2.3/hook"), http.StatusBadRequest)
+	})
+
+	It("rejects URLs whose host cannot be resolved", func() {
+		lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+			return nil, errors.New("no such host")
+		}
+		common.VerifyApiError(registerClusterWebhook("https://missing.example.com/hook"), http.StatusBadRequest)
+	})
+
+	It("requires an organization for organization webhooks", func() {
+		noOrgCtx := context.WithValue(context.Background(), restapi.AuthKey, &ocm.AuthPayload{Username: "user1"})
+		reply := api.RegisterWebhook(noOrgCtx, operations.RegisterWebhookParams{
+			NewWebhookParams: &models.WebhookCreateParams{URL: swag.String("http://example.com/hook")},
+		})
+		common.VerifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("fails for a missing cluster", func() {
+		reply := api.ListClusterWebhooks(ctx, operations.ListClusterWebhooksParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		common.VerifyApiError(reply, http.StatusNotFound)
+	})
+})
@@ -0,0 +1,27 @@
+package gencrypto
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// apiTokenPrefix identifies API tokens, for example in leaked secret scanners
+const apiTokenPrefix = "ait_"
+
+// NewAPIToken returns a new random API token
+func NewAPIToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return apiTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashAPIToken returns the hash of an API token that is stored instead of the token itself. Since API tokens
+// are random, a fast hash is sufficient.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
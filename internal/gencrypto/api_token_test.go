@@ -0,0 +1,27 @@
+package gencrypto
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API tokens", func() {
+	It("creates random tokens", func() {
+		token1, err := NewAPIToken()
+		Expect(err).ToNot(HaveOccurred())
+		token2, err := NewAPIToken()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token1).ToNot(Equal(token2))
+		Expect(strings.HasPrefix(token1, apiTokenPrefix)).To(BeTrue())
+	})
+
+	It("hashes tokens", func() {
+		token, err := NewAPIToken()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(HashAPIToken(token)).To(Equal(HashAPIToken(token)))
+		Expect(HashAPIToken(token)).ToNot(ContainSubstring(token))
+		Expect(HashAPIToken(token)).To(HaveLen(64))
+	})
+})
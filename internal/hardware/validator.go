@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"math"
 	"regexp"
-	"sort"
 	"strings"
 
 	"github.com/dustin/go-humanize"
@@ -81,10 +80,6 @@ func (v *validator) GetHostValidDisks(host *models.Host) ([]*models.Disk, error)
 	return v.ListEligibleDisks(&inventory), nil
 }
 
-func isNvme(name string) bool {
-	return strings.HasPrefix(name, "nvme")
-}
-
 // DiskIsEligible checks if a disk is eligible for installation by testing
 // it against a list of predicates. Returns all the reasons the disk
 // was found to be not eligible, or an empty slice if it was found to
@@ -131,28 +126,7 @@ func (v *validator) purgeServiceReasons(reasons []string) []string {
 }
 
 func (v *validator) ListEligibleDisks(inventory *models.Inventory) []*models.Disk {
-	eligibleDisks := funk.Filter(inventory.Disks, func(disk *models.Disk) bool {
-		return disk.InstallationEligibility.Eligible
-	}).([]*models.Disk)
-
-	// Sorting list by size increase
-	sort.Slice(eligibleDisks, func(i, j int) bool {
-		isNvme1 := isNvme(eligibleDisks[i].Name)
-		isNvme2 := isNvme(eligibleDisks[j].Name)
-		if isNvme1 != isNvme2 {
-			return isNvme2
-		}
-
-		// HDD is before SSD
-		switch v := strings.Compare(eligibleDisks[i].DriveType, eligibleDisks[j].DriveType); v {
-		case 0:
-			return eligibleDisks[i].SizeBytes < eligibleDisks[j].SizeBytes
-		default:
-			return v < 0
-		}
-	})
-
-	return eligibleDisks
+	return hostutil.ListEligibleDisks(inventory)
 }
 
 func (v *validator) GetClusterHostRequirements(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirements, error) {
@@ -166,6 +140,11 @@ func (v *validator) GetClusterHostRequirements(ctx context.Context, cluster *com
 		return nil, err
 	}
 	total := totalizeRequirements(ocpRequirements, operatorsRequirements)
+	policy, err := common.GetValidationPolicy(cluster)
+	if err != nil {
+		return nil, err
+	}
+	applyValidationPolicy(&total, policy)
 	return &models.ClusterHostRequirements{
 		HostID:    *host.ID,
 		Ocp:       &ocpRequirements,
@@ -234,6 +213,20 @@ func totalizeRequirements(ocpRequirements models.ClusterHostRequirementsDetails,
 	return total
 }
 
+// applyValidationPolicy overrides the thresholds of the requirements with the thresholds of the validation policy of
+// the cluster
+func applyValidationPolicy(total *models.ClusterHostRequirementsDetails, policy *models.ValidationPolicy) {
+	if policy.InstallationDiskSpeedThresholdMs != nil {
+		total.InstallationDiskSpeedThresholdMs = *policy.InstallationDiskSpeedThresholdMs
+	}
+	if policy.NetworkLatencyThresholdMs != nil {
+		total.NetworkLatencyThresholdMs = policy.NetworkLatencyThresholdMs
+	}
+	if policy.PacketLossPercentage != nil {
+		total.PacketLossPercentage = policy.PacketLossPercentage
+	}
+}
+
 func (v *validator) getOCPHostRoleRequirementsForVersion(cluster *common.Cluster, role models.HostRole) (models.ClusterHostRequirementsDetails, error) {
 	requirements, err := v.getOCPRequirementsForVersion(cluster)
 	if err != nil {
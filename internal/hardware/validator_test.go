@@ -542,6 +542,43 @@ var _ = Describe("Cluster host requirements", func() {
 		Expect(err).To(Equal(failure))
 	})
 
+	It("should override the thresholds with the validation policy of the cluster", func() {
+		role := models.HostRoleMaster
+		id1 := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &id1, ClusterID: *cluster.ID, Role: role}
+		policy, err := common.MarshalValidationPolicy(&models.ValidationPolicy{
+			InstallationDiskSpeedThresholdMs: swag.Int64(40),
+			NetworkLatencyThresholdMs:        pointer.Float64Ptr(400),
+			PacketLossPercentage:             pointer.Float64Ptr(20),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		cluster.ValidationPolicy = policy
+
+		operatorsMock.EXPECT().GetRequirementsBreakdownForHostInCluster(gomock.Any(), gomock.Eq(cluster), gomock.Eq(host)).Return(operatorRequirements, nil)
+
+		result, err := hwvalidator.GetClusterHostRequirements(context.TODO(), cluster, host)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Ocp.InstallationDiskSpeedThresholdMs).To(BeEquivalentTo(defaultMasterDiskSpeedThreshold))
+		Expect(result.Total.InstallationDiskSpeedThresholdMs).To(BeEquivalentTo(40))
+		Expect(result.Total.NetworkLatencyThresholdMs).To(Equal(pointer.Float64Ptr(400)))
+		Expect(result.Total.PacketLossPercentage).To(Equal(pointer.Float64Ptr(20)))
+		Expect(result.Total.RAMMib).To(BeEquivalentTo(defaultMasterRam + details1.RAMMib + details2.RAMMib))
+	})
+
+	It("should fail on a malformed validation policy", func() {
+		role := models.HostRoleMaster
+		id1 := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &id1, ClusterID: *cluster.ID, Role: role}
+		cluster.ValidationPolicy = "not json"
+
+		operatorsMock.EXPECT().GetRequirementsBreakdownForHostInCluster(gomock.Any(), gomock.Eq(cluster), gomock.Eq(host)).Return(operatorRequirements, nil)
+
+		_, err := hwvalidator.GetClusterHostRequirements(context.TODO(), cluster, host)
+
+		Expect(err).To(HaveOccurred())
+	})
+
 	table.DescribeTable("should contain correct requirements for host role and dedicated OCP version requirements",
 		func(role models.HostRole, expectedOcpRequirements models.ClusterHostRequirementsDetails) {
 
@@ -76,7 +76,7 @@ func indexOfStage(element models.HostStage, data []models.HostStage) int {
 }
 
 // update host role with an option to update only if the current role is srcRole to prevent races
-func updateRole(log logrus.FieldLogger, h *models.Host, role models.HostRole, db *gorm.DB, srcRole *string) error {
+func updateRole(log logrus.FieldLogger, h *models.Host, role models.HostRole, db *gorm.DB, srcRole *string, autoAssigned bool) error {
 	hostStatus := swag.StringValue(h.Status)
 	if !funk.ContainsString(hostStatusesBeforeInstallation[:], hostStatus) {
 		return common.NewApiError(http.StatusBadRequest,
@@ -90,8 +90,16 @@ func updateRole(log logrus.FieldLogger, h *models.Host, role models.HostRole, db
 		extras = append(extras, "machine_config_pool_name", role)
 	}
 
-	_, err := hostutil.UpdateHost(log, db, h.ClusterID, *h.ID, *h.Status, extras...)
-	return err
+	if _, err := hostutil.UpdateHost(log, db, h.ClusterID, *h.ID, *h.Status, extras...); err != nil {
+		return err
+	}
+	updates := map[string]interface{}{"role_auto_assigned": autoAssigned}
+	// The hardware of the host is validated against the inventory it had when its role was assigned
+	if role != models.HostRoleAutoAssign {
+		updates["hardware_baseline"] = h.Inventory
+	}
+	return db.Model(&common.Host{}).Where("id = ? and cluster_id = ?", h.ID.String(), h.ClusterID.String()).
+		Updates(updates).Error
 }
 
 func GetHostnameAndRoleByIP(ip string, hosts []*models.Host) (string, models.HostRole, error) {
@@ -22,6 +22,8 @@ const (
 	StageInWrongBootStages               = conditionId("stage-in-wrong-boot-stages")
 	ClusterInError                       = conditionId("cluster-in-error")
 	SuccessfulContainerImageAvailability = conditionId("successful-container-image-availability")
+	CustomValidationsSucceeded           = conditionId("custom-validations-succeeded")
+	OperatorsRequirementsSatisfied       = conditionId("operators-requirements-satisfied")
 )
 
 func (c conditionId) String() string {
@@ -0,0 +1,162 @@
+package host
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jmespath/go-jmespath"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	customValidationPrefix   = "custom-"
+	customValidationCategory = "custom"
+)
+
+// HostValidationRules are user-defined validations of the hosts, whose expressions are evaluated over the inventory
+// of the hosts
+type HostValidationRules []*models.HostValidationRule
+
+func (r *HostValidationRules) Decode(value string) error {
+	var rules HostValidationRules
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return errors.Wrapf(err, "failed to parse host validation rules '%s'", value)
+	}
+	if err := ValidateHostValidationRules(rules); err != nil {
+		return err
+	}
+	*r = rules
+	return nil
+}
+
+// ValidateHostValidationRules verifies that the rules are valid, that their identifiers are unique and that their
+// expressions compile
+func ValidateHostValidationRules(rules []*models.HostValidationRule) error {
+	ids := make(map[string]struct{})
+	for _, rule := range rules {
+		if rule == nil {
+			return errors.New("host validation rule must not be empty")
+		}
+		if err := rule.Validate(strfmt.Default); err != nil {
+			return err
+		}
+		id := *rule.ID
+		if _, ok := ids[id]; ok {
+			return errors.Errorf("host validation rule %s is defined more than once", id)
+		}
+		ids[id] = struct{}{}
+		if _, err := jmespath.Compile(*rule.Expression); err != nil {
+			return errors.Wrapf(err, "invalid expression of host validation rule %s", id)
+		}
+	}
+	return nil
+}
+
+// GetClusterHostValidationRules returns the user-defined host validation rules of the cluster
+func GetClusterHostValidationRules(c *common.Cluster) (HostValidationRules, error) {
+	rules := HostValidationRules{}
+	if c.HostValidationRules == "" {
+		return rules, nil
+	}
+	if err := json.Unmarshal([]byte(c.HostValidationRules), &rules); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the host validation rules of cluster %s", c.ID.String())
+	}
+	return rules, nil
+}
+
+func customValidationID(rule *models.HostValidationRule) validationID {
+	return validationID(customValidationPrefix + *rule.ID)
+}
+
+func isCustomValidationID(id validationID) bool {
+	return strings.HasPrefix(string(id), customValidationPrefix)
+}
+
+// IsCustomValidationID returns whether the ID is the validation ID of a host validation rule
+func IsCustomValidationID(id string) bool {
+	return isCustomValidationID(validationID(id)) && len(id) > len(customValidationPrefix)
+}
+
+// effectiveRules returns the service-wide rules with the rules of the cluster, where rules of the cluster replace
+// the service-wide rules with the same identifier
+func (r *refreshPreprocessor) effectiveRules(c *validationContext) (HostValidationRules, error) {
+	clusterRules, err := GetClusterHostValidationRules(c.cluster)
+	if err != nil {
+		return nil, err
+	}
+	overridden := make(map[string]struct{}, len(clusterRules))
+	for _, rule := range clusterRules {
+		overridden[*rule.ID] = struct{}{}
+	}
+	rules := make(HostValidationRules, 0, len(r.hostValidationRules)+len(clusterRules))
+	for _, rule := range r.hostValidationRules {
+		if _, ok := overridden[*rule.ID]; !ok {
+			rules = append(rules, rule)
+		}
+	}
+	return append(rules, clusterRules...), nil
+}
+
+// customValidations evaluates the user-defined host validation rules, and returns their results and whether all of
+// them succeeded
+func (r *refreshPreprocessor) customValidations(c *validationContext, policy *models.ValidationPolicy) (ValidationResults, bool, error) {
+	rules, err := r.effectiveRules(c)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rules) == 0 {
+		return nil, true, nil
+	}
+
+	var data interface{}
+	if c.inventory != nil {
+		// The expressions refer to the fields of the inventory by their JSON names
+		b, err := json.Marshal(c.inventory)
+		if err != nil {
+			return nil, false, err
+		}
+		if err = json.Unmarshal(b, &data); err != nil {
+			return nil, false, err
+		}
+	}
+
+	results := make(ValidationResults, 0, len(rules))
+	succeeded := true
+	for _, rule := range rules {
+		id := customValidationID(rule)
+		var status ValidationStatus
+		var message string
+		if disabledMessage, disabled := r.disabledMessage(id, policy); disabled {
+			status = ValidationDisabled
+			message = disabledMessage
+		} else {
+			status, message = evaluateRule(rule, data)
+			succeeded = succeeded && status == ValidationSuccess
+		}
+		results = append(results, ValidationResult{ID: id, Status: status, Message: message})
+	}
+	sortByValidationResultID(results)
+	return results, succeeded, nil
+}
+
+func evaluateRule(rule *models.HostValidationRule, inventory interface{}) (ValidationStatus, string) {
+	description := rule.Description
+	if description == "" {
+		description = fmt.Sprintf("Host satisfies %s", *rule.Expression)
+	}
+	if inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	result, err := jmespath.Search(*rule.Expression, inventory)
+	if err != nil {
+		return ValidationError, fmt.Sprintf("Failed to evaluate the rule %s: %s", *rule.ID, err.Error())
+	}
+	if b, ok := result.(bool); ok && b {
+		return ValidationSuccess, description
+	}
+	return ValidationFailure, fmt.Sprintf("Host does not meet the requirement of rule %s: %s", *rule.ID, description)
+}
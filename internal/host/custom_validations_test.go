@@ -0,0 +1,81 @@
+package host
+
+import (
+	"os"
+
+	"github.com/go-openapi/swag"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Host validation rules", func() {
+	const hostValidationRulesEnvironmentName = "HOST_VALIDATION_RULES"
+
+	rule := func(id, expression string) *models.HostValidationRule {
+		return &models.HostValidationRule{ID: swag.String(id), Expression: swag.String(expression)}
+	}
+
+	AfterEach(func() {
+		os.Unsetenv(hostValidationRulesEnvironmentName)
+	})
+
+	It("should have the rules when the environment is defined", func() {
+		Expect(os.Setenv(hostValidationRulesEnvironmentName,
+			`[{"id": "bios-vendor", "expression": "system_vendor.manufacturer == 'Dell Inc.'"}]`)).NotTo(HaveOccurred())
+		cfg := Config{}
+		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).ToNot(HaveOccurred())
+		Expect(cfg.HostValidationRules).To(Equal(HostValidationRules{rule("bios-vendor", "system_vendor.manufacturer == 'Dell Inc.'")}))
+	})
+
+	It("should have no rules by default", func() {
+		cfg := Config{}
+		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).ToNot(HaveOccurred())
+		Expect(cfg.HostValidationRules).To(BeEmpty())
+	})
+
+	It("should error when the environment value is malformed", func() {
+		Expect(os.Setenv(hostValidationRulesEnvironmentName, `[{"id": "bad", "expression": "length("}]`)).NotTo(HaveOccurred())
+		cfg := Config{}
+		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).To(HaveOccurred())
+	})
+
+	DescribeTable("ValidateHostValidationRules",
+		func(rules []*models.HostValidationRule, valid bool) {
+			err := ValidateHostValidationRules(rules)
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("no rules", []*models.HostValidationRule{}, true),
+		Entry("valid rules", []*models.HostValidationRule{
+			rule("min-nic-speed", "min(interfaces[].speed_mbps) >= `10000`"),
+			rule("nics-with-carrier", "length(interfaces[?has_carrier]) >= `2`"),
+		}, true),
+		Entry("duplicate identifiers", []*models.HostValidationRule{rule("a", "`true`"), rule("a", "`false`")}, false),
+		Entry("invalid identifier", []*models.HostValidationRule{rule("Not Valid", "`true`")}, false),
+		Entry("invalid expression", []*models.HostValidationRule{rule("a", "length(")}, false),
+		Entry("missing expression", []*models.HostValidationRule{{ID: swag.String("a")}}, false),
+	)
+
+	DescribeTable("evaluateRule",
+		func(expression string, inventory interface{}, expectedStatus ValidationStatus) {
+			status, _ := evaluateRule(rule("test", expression), inventory)
+			Expect(status).To(Equal(expectedStatus))
+		},
+		Entry("true expression", "system_vendor.manufacturer == 'Dell Inc.'",
+			map[string]interface{}{"system_vendor": map[string]interface{}{"manufacturer": "Dell Inc."}}, ValidationSuccess),
+		Entry("false expression", "system_vendor.manufacturer == 'Dell Inc.'",
+			map[string]interface{}{"system_vendor": map[string]interface{}{"manufacturer": "HP"}}, ValidationFailure),
+		Entry("non boolean result", "system_vendor.manufacturer",
+			map[string]interface{}{"system_vendor": map[string]interface{}{"manufacturer": "HP"}}, ValidationFailure),
+		Entry("evaluation error", "length(system_vendor.manufacturer) > `1`",
+			map[string]interface{}{"system_vendor": map[string]interface{}{"manufacturer": true}}, ValidationError),
+		Entry("missing inventory", "`true`", nil, ValidationPending),
+	)
+})
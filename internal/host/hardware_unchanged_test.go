@@ -0,0 +1,52 @@
+package host
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Hardware unchanged validation", func() {
+	baseline := &models.Inventory{
+		CPU:        &models.CPU{Count: 8},
+		Memory:     &models.Memory{PhysicalBytes: 16 * 1024 * 1024 * 1024},
+		Disks:      []*models.Disk{{ID: "/dev/disk/by-id/sda", Name: "sda", SizeBytes: 120 * 1024 * 1024 * 1024}},
+		Interfaces: []*models.Interface{{Name: "eth0", HasCarrier: true, IPV4Addresses: []string{"1.2.3.4/24"}}},
+	}
+
+	DescribeTable("isHardwareUnchanged",
+		func(hardwareBaseline bool, modify func(*models.Inventory), expectedStatus ValidationStatus, expectedMessage string) {
+			var host models.Host
+			if hardwareBaseline {
+				b, err := hostutil.MarshalInventory(baseline)
+				Expect(err).ToNot(HaveOccurred())
+				host.HardwareBaseline = b
+			}
+			var inventory *models.Inventory
+			if modify != nil {
+				b, err := hostutil.MarshalInventory(baseline)
+				Expect(err).ToNot(HaveOccurred())
+				inventory, err = hostutil.UnmarshalInventory(b)
+				Expect(err).ToNot(HaveOccurred())
+				modify(inventory)
+			}
+			v := &validator{}
+			c := &validationContext{host: &host, inventory: inventory}
+			status := v.isHardwareUnchanged(c)
+			Expect(status).To(Equal(expectedStatus))
+			Expect(v.printHardwareUnchanged(c, status)).To(ContainSubstring(expectedMessage))
+		},
+		Entry("missing inventory", true, nil, ValidationPending, "Missing inventory"),
+		Entry("no baseline", false, func(inventory *models.Inventory) { inventory.Disks = nil }, ValidationSuccess,
+			"Host hardware is unchanged"),
+		Entry("unchanged", true, func(*models.Inventory) {}, ValidationSuccess, "Host hardware is unchanged"),
+		Entry("link changes are ignored", true, func(inventory *models.Inventory) { inventory.Interfaces[0].HasCarrier = false },
+			ValidationSuccess, "Host hardware is unchanged"),
+		Entry("disk removed", true, func(inventory *models.Inventory) { inventory.Disks = nil }, ValidationFailure,
+			"disk /dev/disk/by-id/sda was removed"),
+		Entry("memory changed", true, func(inventory *models.Inventory) { inventory.Memory.PhysicalBytes /= 2 },
+			ValidationFailure, "memory changed from 16.00 GiB to 8.00 GiB"),
+	)
+})
@@ -98,6 +98,8 @@ type Config struct {
 	ResetTimeout            time.Duration           `envconfig:"RESET_CLUSTER_TIMEOUT" default:"3m"`
 	MonitorBatchSize        int                     `envconfig:"HOST_MONITOR_BATCH_SIZE" default:"100"`
 	DisabledHostvalidations DisabledHostValidations `envconfig:"DISABLED_HOST_VALIDATIONS" default:"sufficient-network-latency-requirement-for-role,sufficient-packet-loss-requirement-for-role"` // Which host validations to disable (should not run in preprocess)
+	// User-defined host validations of all the clusters, in JSON
+	HostValidationRules HostValidationRules `envconfig:"HOST_VALIDATION_RULES" default:"[]"`
 }
 
 //go:generate mockgen -package=host -aux_files=github.com/openshift/assisted-service/internal/host/hostcommands=instruction_manager.go -destination=mock_host_api.go . API
@@ -128,6 +130,8 @@ type API interface {
 	// auto assign host role
 	AutoAssignRole(ctx context.Context, h *models.Host, db *gorm.DB) error
 	IsValidMasterCandidate(h *models.Host, c *common.Cluster, db *gorm.DB, log logrus.FieldLogger) (bool, error)
+	// assign the roles of all the hosts of the cluster at once, or only propose them on dry run
+	AssignRoles(ctx context.Context, c *common.Cluster, dryRun bool, db *gorm.DB) (*models.RoleAssignment, error)
 	SetUploadLogsAt(ctx context.Context, h *models.Host, db *gorm.DB) error
 	UpdateLogsProgress(ctx context.Context, h *models.Host, progress string) error
 	PermanentHostsDeletion(olderThan strfmt.DateTime) error
@@ -135,7 +139,7 @@ type API interface {
 
 	UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error
 	UpdateHostname(ctx context.Context, h *models.Host, hostname string, db *gorm.DB) error
-	UpdateInventory(ctx context.Context, h *models.Host, inventory string) error
+	UpdateInventory(ctx context.Context, h *models.Host, inventory string, db *gorm.DB) error
 	RefreshInventory(ctx context.Context, cluster *common.Cluster, h *models.Host, db *gorm.DB) error
 	UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*models.NtpSource, db *gorm.DB) error
 	UpdateMachineConfigPoolName(ctx context.Context, db *gorm.DB, h *models.Host, machineConfigPoolName string) error
@@ -176,7 +180,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handle
 		hwValidator:    hwValidator,
 		eventsHandler:  eventsHandler,
 		sm:             NewHostStateMachine(th),
-		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations),
+		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, config.HostValidationRules),
 		metricApi:      metricApi,
 		Config:         *config,
 		leaderElector:  leaderElector,
@@ -287,14 +291,17 @@ func (m *Manager) RefreshInventory(ctx context.Context, cluster *common.Cluster,
 	return m.updateInventory(ctx, cluster, h, h.Inventory, db)
 }
 
-func (m *Manager) UpdateInventory(ctx context.Context, h *models.Host, inventoryStr string) error {
+func (m *Manager) UpdateInventory(ctx context.Context, h *models.Host, inventoryStr string, db *gorm.DB) error {
 	log := logutil.FromContext(ctx, m.log)
-	cluster, err := common.GetClusterFromDB(m.db, h.ClusterID, common.UseEagerLoading)
+	if db == nil {
+		db = m.db
+	}
+	cluster, err := common.GetClusterFromDB(db, h.ClusterID, common.UseEagerLoading)
 	if err != nil {
 		log.WithError(err).Errorf("not updating inventory - failed to find cluster %s", h.ClusterID)
 		return common.NewApiError(http.StatusNotFound, err)
 	}
-	return m.updateInventory(ctx, cluster, h, inventoryStr, m.db)
+	return m.updateInventory(ctx, cluster, h, inventoryStr, db)
 }
 
 // Check if the value of the
@@ -395,6 +402,9 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 		installationDiskPath != h.InstallationDiskPath ||
 		installationDiskID != h.InstallationDiskID ||
 		m.ntpSyncedChanged(cluster, h, marshalledInventory) {
+		if canonizeInventory(marshalledInventory) != canonizeInventory(h.Inventory) {
+			m.recordInventorySnapshot(ctx, h, inventory, marshalledInventory, db)
+		}
 		return db.Model(h).Update(map[string]interface{}{
 			"inventory":              marshalledInventory,
 			"installation_disk_path": installationDiskPath,
@@ -409,6 +419,28 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 	}
 }
 
+// recordInventorySnapshot retains the inventory reported by the host, and emits an event when the hardware of the host
+// changed from its previous inventory
+func (m *Manager) recordInventorySnapshot(ctx context.Context, h *models.Host, inventory *models.Inventory, inventoryStr string, db *gorm.DB) {
+	log := logutil.FromContext(ctx, m.log)
+	if err := common.AddInventorySnapshot(db, h.ClusterID, *h.ID, inventoryStr, time.Now()); err != nil {
+		log.WithError(err).Warnf("failed to record an inventory snapshot of host %s", h.ID)
+	}
+	if h.Inventory == "" {
+		return
+	}
+	previous, err := hostutil.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		log.WithError(err).Warnf("failed to compare the inventory of host %s to its previous inventory", h.ID)
+		return
+	}
+	if changes := hostutil.HardwareChanges(hostutil.DiffInventories(previous, inventory)); len(changes) > 0 {
+		m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning,
+			fmt.Sprintf("Host %s: hardware changed: %s", hostutil.GetHostnameForMsg(h), hostutil.FormatInventoryChanges(changes)),
+			time.Now())
+	}
+}
+
 func (m *Manager) refreshStatusInternal(ctx context.Context, h *models.Host, c *common.Cluster, db *gorm.DB) error {
 	if db == nil {
 		db = m.db
@@ -659,9 +691,9 @@ func (m *Manager) UpdateRole(ctx context.Context, h *models.Host, role models.Ho
 	}
 
 	if h.Role == "" {
-		return updateRole(m.log, h, role, cdb, nil)
+		return updateRole(m.log, h, role, cdb, nil, false)
 	} else {
-		return updateRole(m.log, h, role, cdb, swag.String(string(h.Role)))
+		return updateRole(m.log, h, role, cdb, swag.String(string(h.Role)), false)
 	}
 }
 
@@ -775,6 +807,7 @@ func (m *Manager) UpdateInstallationDisk(ctx context.Context, db *gorm.DB, h *mo
 	return cdb.Model(h).Update(map[string]interface{}{
 		"installation_disk_path": h.InstallationDiskPath,
 		"installation_disk_id":   h.InstallationDiskID,
+		"hardware_baseline":      h.Inventory,
 	}).Error
 }
 
@@ -1010,7 +1043,7 @@ func (m *Manager) autoRoleSelection(ctx context.Context, h *models.Host, db *gor
 		return err
 	}
 	// use sourced role to prevent races with user role setting
-	if err := updateRole(m.log, h, role, db, swag.String(string(models.HostRoleAutoAssign))); err != nil {
+	if err := updateRole(m.log, h, role, db, swag.String(string(models.HostRoleAutoAssign)), true); err != nil {
 		log.WithError(err).Errorf("failed to update role %s for host %s cluster %s",
 			role, h.ID.String(), h.ClusterID.String())
 	}
@@ -332,6 +332,11 @@ var _ = Describe("update_progress", func() {
 				hostFromDB = hostutil.GetHostFromDB(*hostFromDB.ID, host.ClusterID, db)
 				Expect(*hostFromDB.Status).Should(Equal(models.HostStatusInstallingInProgress))
 				Expect(hostFromDB.StageUpdatedAt.String()).Should(Equal(updatedAt))
+
+				var entries []*common.InstallationTimelineEntry
+				Expect(db.Where("host_id = ?", host.ID.String()).Find(&entries).Error).ShouldNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].Stage).To(Equal(string(progress.CurrentStage)))
 			})
 
 			It("writing to disk", func() {
@@ -949,6 +954,7 @@ var _ = Describe("UpdateInventory", func() {
 		db                *gorm.DB
 		ctrl              *gomock.Controller
 		mockValidator     *hardware.MockValidator
+		mockEvents        *events.MockHandler
 		hostId, clusterId strfmt.UUID
 		host              models.Host
 		dbName            string
@@ -959,7 +965,8 @@ var _ = Describe("UpdateInventory", func() {
 		dummy := &leader.DummyElector{}
 		ctrl = gomock.NewController(GinkgoT())
 		mockValidator = hardware.NewMockValidator(ctrl)
-		hapi = NewManager(common.GetTestLog(), db, nil, mockValidator,
+		mockEvents = events.NewMockHandler(ctrl)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockValidator,
 			nil, createValidatorCfg(), nil, defaultConfig, dummy, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
@@ -1006,9 +1013,11 @@ var _ = Describe("UpdateInventory", func() {
 				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
 				mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
 				mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(test.inventory.Disks)
+				mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, &hostId, models.EventSeverityWarning,
+					gomock.Any(), gomock.Any())
 				inventoryStr, err := hostutil.MarshalInventory(&test.inventory)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(hapi.(*Manager).UpdateInventory(ctx, &host, inventoryStr)).ToNot(HaveOccurred())
+				Expect(hapi.(*Manager).UpdateInventory(ctx, &host, inventoryStr, nil)).ToNot(HaveOccurred())
 				h := hostutil.GetHostFromDB(hostId, clusterId, db)
 				Expect(h.Inventory).To(Not(BeEmpty()))
 				inventory, err := hostutil.UnmarshalInventory(h.Inventory)
@@ -1107,7 +1116,7 @@ var _ = Describe("UpdateInventory", func() {
 				[]*models.Disk{{ID: diskId, Name: diskName}},
 			)
 
-			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory, nil)).ToNot(HaveOccurred())
 
 			h := hostutil.GetHostFromDB(hostId, clusterId, db)
 			Expect(h.InstallationDiskPath).To(Equal(diskPath))
@@ -1118,7 +1127,7 @@ var _ = Describe("UpdateInventory", func() {
 			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(
 				[]*models.Disk{},
 			)
-			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory, nil)).ToNot(HaveOccurred())
 
 			h = hostutil.GetHostFromDB(hostId, clusterId, db)
 			Expect(h.InstallationDiskPath).To(Equal(""))
@@ -1129,7 +1138,7 @@ var _ = Describe("UpdateInventory", func() {
 			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(
 				[]*models.Disk{{Name: diskName}},
 			)
-			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory, nil)).ToNot(HaveOccurred())
 			h := hostutil.GetHostFromDB(hostId, clusterId, db)
 			Expect(h.InstallationDiskPath).To(Equal(diskPath))
 			Expect(h.InstallationDiskID).To(Equal(diskPath))
@@ -1138,7 +1147,7 @@ var _ = Describe("UpdateInventory", func() {
 				[]*models.Disk{{ID: diskId, Name: diskName}},
 			)
 			mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
-			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory, nil)).ToNot(HaveOccurred())
 			h = hostutil.GetHostFromDB(hostId, clusterId, db)
 			Expect(h.InstallationDiskPath).To(Equal(diskPath))
 			Expect(h.InstallationDiskID).To(Equal(diskId))
@@ -1157,7 +1166,7 @@ var _ = Describe("UpdateInventory", func() {
 			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(
 				[]*models.Disk{},
 			).AnyTimes()
-			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory, nil)).ToNot(HaveOccurred())
 			h := hostutil.GetHostFromDB(hostId, clusterId, db)
 			updatedAt := h.UpdatedAt
 			var inventory models.Inventory
@@ -1167,7 +1176,7 @@ var _ = Describe("UpdateInventory", func() {
 			b, err := json.Marshal(&inventory)
 			Expect(err).ToNot(HaveOccurred())
 			time.Sleep(time.Second)
-			Expect(hapi.UpdateInventory(ctx, &host, string(b))).ToNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, string(b), nil)).ToNot(HaveOccurred())
 			h = hostutil.GetHostFromDB(hostId, clusterId, db)
 			Expect(updatedAt).To(Equal(h.UpdatedAt))
 			Expect(h.Inventory).To(Equal(string(b)))
@@ -1177,7 +1186,7 @@ var _ = Describe("UpdateInventory", func() {
 			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(
 				[]*models.Disk{},
 			).AnyTimes()
-			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory, nil)).ToNot(HaveOccurred())
 			h := hostutil.GetHostFromDB(hostId, clusterId, db)
 			updatedAt := h.UpdatedAt
 			var inventory models.Inventory
@@ -1190,11 +1199,35 @@ var _ = Describe("UpdateInventory", func() {
 			b, err := json.Marshal(&inventory)
 			Expect(err).ToNot(HaveOccurred())
 			time.Sleep(time.Second)
-			Expect(hapi.UpdateInventory(ctx, &host, string(b))).ToNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, &hostId, models.EventSeverityWarning,
+				fmt.Sprintf("Host %s: hardware changed: interface abcd was added", hostutil.GetHostnameForMsg(&host)),
+				gomock.Any())
+			Expect(hapi.UpdateInventory(ctx, &host, string(b), nil)).ToNot(HaveOccurred())
 			h = hostutil.GetHostFromDB(hostId, clusterId, db)
 			Expect(updatedAt).ToNot(Equal(h.UpdatedAt))
 			Expect(h.Inventory).To(Equal(string(b)))
 		})
+
+		It("Retains a bounded number of inventory snapshots", func() {
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(
+				[]*models.Disk{},
+			).AnyTimes()
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, &hostId, models.EventSeverityWarning,
+				gomock.Any(), gomock.Any()).AnyTimes()
+			var inventory models.Inventory
+			Expect(json.Unmarshal([]byte(host.Inventory), &inventory)).ToNot(HaveOccurred())
+			for i := 0; i < common.MaxInventorySnapshotsPerHost+2; i++ {
+				inventory.Memory.PhysicalBytes += 1024
+				b, err := json.Marshal(&inventory)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hapi.UpdateInventory(ctx, &host, string(b), nil)).ToNot(HaveOccurred())
+				host = hostutil.GetHostFromDB(hostId, clusterId, db).Host
+			}
+			var snapshots []*common.InventorySnapshot
+			Expect(db.Where("host_id = ?", hostId.String()).Order("id").Find(&snapshots).Error).ToNot(HaveOccurred())
+			Expect(snapshots).To(HaveLen(common.MaxInventorySnapshotsPerHost))
+			Expect(snapshots[len(snapshots)-1].Inventory).To(Equal(host.Inventory))
+		})
 	})
 
 	Context("enable host", func() {
@@ -1299,7 +1332,7 @@ var _ = Describe("UpdateInventory", func() {
 				host = hostutil.GenerateTestHost(hostId, clusterId, t.srcState)
 				host.Inventory = common.GenerateTestDefaultInventory()
 				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
-				t.validation(hapi.UpdateInventory(ctx, &host, string(newInventoryBytes)))
+				t.validation(hapi.UpdateInventory(ctx, &host, string(newInventoryBytes), nil))
 			})
 		}
 	})
@@ -2092,6 +2125,8 @@ var _ = Describe("AutoAssignRole", func() {
 			{Status: api.Success, ValidationId: string(models.HostValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.HostValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.HostValidationIDLvmRequirementsSatisfied)},
 		}, nil)
 		masterRequirements := models.ClusterHostRequirementsDetails{
 			CPUCores:   4,
@@ -2210,6 +2245,15 @@ var _ = Describe("AutoAssignRole", func() {
 		Expect(hapi.AutoAssignRole(ctx, &h, db)).ShouldNot(HaveOccurred())
 		Expect(hostutil.GetHostFromDB(*h.ID, clusterId, db).Role).Should(Equal(models.HostRoleWorker))
 	})
+
+	It("records the hardware baseline of the host", func() {
+		h := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), clusterId, models.HostStatusKnown)
+		h.Inventory = hostutil.GenerateMasterInventory()
+		h.Role = models.HostRoleAutoAssign
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		Expect(hapi.AutoAssignRole(ctx, &h, db)).ShouldNot(HaveOccurred())
+		Expect(hostutil.GetHostFromDB(*h.ID, clusterId, db).HardwareBaseline).Should(Equal(h.Inventory))
+	})
 })
 
 var _ = Describe("IsValidMasterCandidate", func() {
@@ -2248,6 +2292,8 @@ var _ = Describe("IsValidMasterCandidate", func() {
 			{Status: api.Success, ValidationId: string(models.HostValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.HostValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.HostValidationIDLvmRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -46,7 +46,7 @@ func NewImageAvailabilityCmd(log logrus.FieldLogger, db *gorm.DB, ocRelease oc.R
 func (cmd *imageAvailabilityCmd) getImages(cluster *common.Cluster) (Images, error) {
 
 	images := Images{}
-	releaseImage, err := cmd.versionsHandler.GetReleaseImage(cluster.OpenshiftVersion)
+	releaseImage, err := cmd.versionsHandler.GetReleaseImage(cluster.OpenshiftVersion, cluster.CPUArchitecture)
 	if err != nil {
 		return images, err
 	}
@@ -7,6 +7,7 @@ import (
 	"net"
 
 	"github.com/alessio/shellescape"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -32,7 +33,7 @@ func (f *freeAddressesCmd) prepareParam(host *models.Host) (string, error) {
 		return "", err
 	}
 	m := make(map[string]struct{})
-	for _, intf := range inventory.Interfaces {
+	for _, intf := range hostutil.GetL3Interfaces(&inventory) {
 		for _, ipv4 := range intf.IPV4Addresses {
 			var cidr *net.IPNet
 			_, cidr, err = net.ParseCIDR(ipv4)
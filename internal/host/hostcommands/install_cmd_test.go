@@ -74,7 +74,7 @@ var _ = Describe("installcmd", func() {
 	})
 
 	mockGetReleaseImage := func(times int) {
-		mockVersions.EXPECT().GetReleaseImage(gomock.Any()).Return(defaultReleaseImage, nil).Times(times)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(defaultReleaseImage, nil).Times(times)
 	}
 
 	mockImages := func(times int) {
@@ -270,7 +270,7 @@ var _ = Describe("installcmd arguments", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockRelease = oc.NewMockRelease(ctrl)
 		mockVersions = versions.NewMockHandler(ctrl)
-		mockVersions.EXPECT().GetReleaseImage(gomock.Any()).Return(defaultReleaseImage, nil).AnyTimes()
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(defaultReleaseImage, nil).AnyTimes()
 		mockImages()
 	})
 
@@ -224,7 +224,7 @@ func checkStepsByState(state string, host *models.Host, db *gorm.DB, mockEvents
 	ExpectWithOffset(1, updateReply).ShouldNot(BeNil())
 	h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
 	ExpectWithOffset(1, swag.StringValue(h.Status)).Should(Equal(state))
-	mockVersions.EXPECT().GetReleaseImage(gomock.Any()).Return(defaultReleaseImage, nil).AnyTimes()
+	mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(defaultReleaseImage, nil).AnyTimes()
 	mockValidator.EXPECT().GetHostInstallationPath(gomock.Any()).Return("/dev/disk/by-id/wwn-sda").AnyTimes()
 	mockRelease.EXPECT().GetMCOImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(defaultMCOImage, nil).AnyTimes()
 	mockRelease.EXPECT().GetMustGatherImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(defaultMustGatherImage, nil).AnyTimes()
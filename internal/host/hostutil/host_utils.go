@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
@@ -90,6 +92,36 @@ func DetermineInstallationDisk(disks []*models.Disk, installationPath string) *m
 	return installationDisk
 }
 
+// ListEligibleDisks returns the disks of the inventory that are eligible for installation, ordered by preference
+func ListEligibleDisks(inventory *models.Inventory) []*models.Disk {
+	eligibleDisks := funk.Filter(inventory.Disks, func(disk *models.Disk) bool {
+		return disk.InstallationEligibility.Eligible
+	}).([]*models.Disk)
+
+	// Sorting list by size increase
+	sort.Slice(eligibleDisks, func(i, j int) bool {
+		isNvme1 := isNvme(eligibleDisks[i].Name)
+		isNvme2 := isNvme(eligibleDisks[j].Name)
+		if isNvme1 != isNvme2 {
+			return isNvme2
+		}
+
+		// HDD is before SSD
+		switch v := strings.Compare(eligibleDisks[i].DriveType, eligibleDisks[j].DriveType); v {
+		case 0:
+			return eligibleDisks[i].SizeBytes < eligibleDisks[j].SizeBytes
+		default:
+			return v < 0
+		}
+	})
+
+	return eligibleDisks
+}
+
+func isNvme(name string) bool {
+	return strings.HasPrefix(name, "nvme")
+}
+
 func GetDeviceIdentifier(installationDisk *models.Disk) string {
 	// We changed the host.installationDiskPath to contain the disk id instead of the disk path.
 	// Here we updates the old installationDiskPath to the disk id.
@@ -123,6 +155,33 @@ func UnmarshalInventory(inventoryStr string) (*models.Inventory, error) {
 	return &inventory, nil
 }
 
+const (
+	InterfaceTypeBond   = "bond"
+	InterfaceTypeBridge = "bridge"
+	InterfaceTypeVlan   = "vlan"
+)
+
+// GetL3Interfaces returns the interfaces of the inventory that carry the addresses of the host. These are all the
+// interfaces, including bonds, bridges and VLAN interfaces, except for the ports of bonds and bridges, whose addresses
+// are not used.
+func GetL3Interfaces(inventory *models.Inventory) []*models.Interface {
+	ports := make(map[string]bool)
+	for _, intf := range inventory.Interfaces {
+		if intf.Type == InterfaceTypeBond || intf.Type == InterfaceTypeBridge {
+			for _, lower := range intf.LowerInterfaces {
+				ports[lower] = true
+			}
+		}
+	}
+	ret := make([]*models.Interface, 0, len(inventory.Interfaces))
+	for _, intf := range inventory.Interfaces {
+		if !ports[intf.Name] {
+			ret = append(ret, intf)
+		}
+	}
+	return ret
+}
+
 func GetHostInstallationPath(host *models.Host) string {
 	if host.InstallationDiskID != "" {
 		return host.InstallationDiskID
@@ -27,6 +27,30 @@ var _ = Describe("ValidateInstallerArgs", func() {
 	})
 })
 
+var _ = Describe("GetL3Interfaces", func() {
+	It("returns the bonds, bridges and VLAN interfaces, without the ports of bonds and bridges", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "eth0"},
+			{Name: "eth1"},
+			{Name: "eth2"},
+			{Name: "eth3"},
+			{Name: "bond0", Type: InterfaceTypeBond, LowerInterfaces: []string{"eth0", "eth1"}},
+			{Name: "bond0.100", Type: InterfaceTypeVlan, VlanID: 100, LowerInterfaces: []string{"bond0"}},
+			{Name: "br0", Type: InterfaceTypeBridge, LowerInterfaces: []string{"eth2"}},
+		}}
+		names := make([]string, 0)
+		for _, intf := range GetL3Interfaces(inventory) {
+			names = append(names, intf.Name)
+		}
+		Expect(names).To(Equal([]string{"eth3", "bond0", "bond0.100", "br0"}))
+	})
+
+	It("returns all the interfaces when their topology is not reported", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{{Name: "eth0"}, {Name: "eth1"}}}
+		Expect(GetL3Interfaces(inventory)).To(Equal(inventory.Interfaces))
+	})
+})
+
 var _ = Describe("Installation Disk selection", func() {
 	const (
 		diskName      = "FirstDisk"
@@ -0,0 +1,188 @@
+package hostutil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/thoas/go-funk"
+)
+
+// hardwareChangeKinds are the changes of the inventory that change the hardware of the host, as opposed to changes of
+// the state of its network interfaces
+var hardwareChangeKinds = []string{
+	models.InventoryChangeKindDiskAdded,
+	models.InventoryChangeKindDiskRemoved,
+	models.InventoryChangeKindDiskSizeChanged,
+	models.InventoryChangeKindInterfaceAdded,
+	models.InventoryChangeKindInterfaceRemoved,
+	models.InventoryChangeKindMemoryChanged,
+	models.InventoryChangeKindCPUChanged,
+}
+
+// DiffInventories returns the changes of the disks, network interfaces, memory and CPU of the host from the previous
+// inventory to the current one
+func DiffInventories(previous, current *models.Inventory) []*models.InventoryChange {
+	changes := make([]*models.InventoryChange, 0)
+	changes = append(changes, diffDisks(previous.Disks, current.Disks)...)
+	changes = append(changes, diffInterfaces(previous.Interfaces, current.Interfaces)...)
+
+	previousMemory, currentMemory := GetPhysicalMemory(previous), GetPhysicalMemory(current)
+	if previousMemory != currentMemory {
+		changes = append(changes, newInventoryChange(models.InventoryChangeKindMemoryChanged, "",
+			conversions.BytesToString(previousMemory), conversions.BytesToString(currentMemory),
+			"memory changed from %s to %s"))
+	}
+	previousCPUs, currentCPUs := GetCPUCount(previous), GetCPUCount(current)
+	if previousCPUs != currentCPUs {
+		changes = append(changes, newInventoryChange(models.InventoryChangeKindCPUChanged, "",
+			fmt.Sprint(previousCPUs), fmt.Sprint(currentCPUs), "CPU count changed from %s to %s"))
+	}
+	return changes
+}
+
+// HardwareChanges returns the changes that changed the hardware of the host
+func HardwareChanges(changes []*models.InventoryChange) []*models.InventoryChange {
+	return funk.Filter(changes, func(change *models.InventoryChange) bool {
+		return funk.ContainsString(hardwareChangeKinds, change.Kind)
+	}).([]*models.InventoryChange)
+}
+
+// FormatInventoryChanges returns the descriptions of the changes, separated by commas
+func FormatInventoryChanges(changes []*models.InventoryChange) string {
+	descriptions := make([]string, 0, len(changes))
+	for _, change := range changes {
+		descriptions = append(descriptions, change.Description)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func diffDisks(previous, current []*models.Disk) []*models.InventoryChange {
+	changes := make([]*models.InventoryChange, 0)
+	previousDisks := disksByIdentifier(previous)
+	currentDisks := disksByIdentifier(current)
+	for _, id := range sortedKeys(previousDisks, currentDisks) {
+		previousDisk, existed := previousDisks[id]
+		currentDisk, exists := currentDisks[id]
+		switch {
+		case !exists:
+			changes = append(changes, newInventoryChange(models.InventoryChangeKindDiskRemoved, id,
+				conversions.BytesToString(previousDisk.SizeBytes), "", "disk %[1]s was removed"))
+		case !existed:
+			changes = append(changes, newInventoryChange(models.InventoryChangeKindDiskAdded, id,
+				"", conversions.BytesToString(currentDisk.SizeBytes), "disk %[1]s was added"))
+		case previousDisk.SizeBytes != currentDisk.SizeBytes:
+			changes = append(changes, newInventoryChange(models.InventoryChangeKindDiskSizeChanged, id,
+				conversions.BytesToString(previousDisk.SizeBytes), conversions.BytesToString(currentDisk.SizeBytes),
+				"size of disk %[1]s changed from %[2]s to %[3]s"))
+		}
+	}
+	return changes
+}
+
+func diffInterfaces(previous, current []*models.Interface) []*models.InventoryChange {
+	changes := make([]*models.InventoryChange, 0)
+	previousInterfaces := interfacesByName(previous)
+	currentInterfaces := interfacesByName(current)
+	for _, name := range sortedKeys(previousInterfaces, currentInterfaces) {
+		previousInterface, existed := previousInterfaces[name]
+		currentInterface, exists := currentInterfaces[name]
+		switch {
+		case !exists:
+			changes = append(changes, newInventoryChange(models.InventoryChangeKindInterfaceRemoved, name,
+				previousInterface.MacAddress, "", "interface %[1]s was removed"))
+			continue
+		case !existed:
+			changes = append(changes, newInventoryChange(models.InventoryChangeKindInterfaceAdded, name,
+				"", currentInterface.MacAddress, "interface %[1]s was added"))
+			continue
+		}
+		if previousInterface.HasCarrier != currentInterface.HasCarrier {
+			changes = append(changes, newInventoryChange(models.InventoryChangeKindInterfaceLinkChanged, name,
+				linkState(previousInterface), linkState(currentInterface), "link of interface %[1]s went %[3]s"))
+		}
+		if previousInterface.SpeedMbps != currentInterface.SpeedMbps {
+			changes = append(changes, newInventoryChange(models.InventoryChangeKindInterfaceSpeedChanged, name,
+				fmt.Sprintf("%d Mbps", previousInterface.SpeedMbps), fmt.Sprintf("%d Mbps", currentInterface.SpeedMbps),
+				"speed of interface %[1]s changed from %[2]s to %[3]s"))
+		}
+		previousAddresses, currentAddresses := interfaceAddresses(previousInterface), interfaceAddresses(currentInterface)
+		if previousAddresses != currentAddresses {
+			changes = append(changes, newInventoryChange(models.InventoryChangeKindInterfaceAddressesChanged, name,
+				previousAddresses, currentAddresses, "addresses of interface %[1]s changed from [%[2]s] to [%[3]s]"))
+		}
+	}
+	return changes
+}
+
+// newInventoryChange returns a change whose description is formatted with the subject, the previous and the current
+// values
+func newInventoryChange(kind, subject, previous, current, format string) *models.InventoryChange {
+	var description string
+	if subject == "" {
+		description = fmt.Sprintf(format, previous, current)
+	} else {
+		description = fmt.Sprintf(format, subject, previous, current)
+	}
+	return &models.InventoryChange{
+		Kind:        kind,
+		Subject:     subject,
+		Previous:    previous,
+		Current:     current,
+		Description: description,
+	}
+}
+
+func disksByIdentifier(disks []*models.Disk) map[string]*models.Disk {
+	ret := make(map[string]*models.Disk, len(disks))
+	for _, disk := range disks {
+		ret[GetDeviceIdentifier(disk)] = disk
+	}
+	return ret
+}
+
+func interfacesByName(interfaces []*models.Interface) map[string]*models.Interface {
+	ret := make(map[string]*models.Interface, len(interfaces))
+	for _, intf := range interfaces {
+		ret[intf.Name] = intf
+	}
+	return ret
+}
+
+// sortedKeys returns the keys of both maps, sorted so the changes are reported in a stable order
+func sortedKeys(previous, current interface{}) []string {
+	keys := funk.UniqString(append(funk.Keys(previous).([]string), funk.Keys(current).([]string)...))
+	sort.Strings(keys)
+	return keys
+}
+
+func linkState(intf *models.Interface) string {
+	if intf.HasCarrier {
+		return "up"
+	}
+	return "down"
+}
+
+func interfaceAddresses(intf *models.Interface) string {
+	addresses := append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...)
+	sort.Strings(addresses)
+	return strings.Join(addresses, ", ")
+}
+
+// GetPhysicalMemory returns the physical memory of the host in bytes, or 0 if the inventory does not report it
+func GetPhysicalMemory(inventory *models.Inventory) int64 {
+	if inventory.Memory == nil {
+		return 0
+	}
+	return inventory.Memory.PhysicalBytes
+}
+
+// GetCPUCount returns the CPU cores of the host, or 0 if the inventory does not report them
+func GetCPUCount(inventory *models.Inventory) int64 {
+	if inventory.CPU == nil {
+		return 0
+	}
+	return inventory.CPU.Count
+}
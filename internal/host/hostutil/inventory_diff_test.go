@@ -0,0 +1,81 @@
+package hostutil
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("DiffInventories", func() {
+	var previous, current *models.Inventory
+
+	BeforeEach(func() {
+		previous = &models.Inventory{
+			CPU:    &models.CPU{Count: 4},
+			Memory: &models.Memory{PhysicalBytes: 8 * 1024 * 1024 * 1024},
+			Disks: []*models.Disk{
+				{ID: "/dev/disk/by-id/sda", Name: "sda", SizeBytes: 120 * 1024 * 1024 * 1024},
+				{Name: "sdb", SizeBytes: 240 * 1024 * 1024 * 1024},
+			},
+			Interfaces: []*models.Interface{
+				{Name: "eth0", MacAddress: "52:54:00:00:00:01", HasCarrier: true, SpeedMbps: 1000, IPV4Addresses: []string{"1.2.3.4/24"}},
+				{Name: "eth1", MacAddress: "52:54:00:00:00:02", HasCarrier: true},
+			},
+		}
+		current = &models.Inventory{
+			CPU:    &models.CPU{Count: 4},
+			Memory: &models.Memory{PhysicalBytes: 8 * 1024 * 1024 * 1024},
+			Disks: []*models.Disk{
+				{ID: "/dev/disk/by-id/sda", Name: "sda", SizeBytes: 120 * 1024 * 1024 * 1024},
+				{Name: "sdb", SizeBytes: 240 * 1024 * 1024 * 1024},
+			},
+			Interfaces: []*models.Interface{
+				{Name: "eth0", MacAddress: "52:54:00:00:00:01", HasCarrier: true, SpeedMbps: 1000, IPV4Addresses: []string{"1.2.3.4/24"}},
+				{Name: "eth1", MacAddress: "52:54:00:00:00:02", HasCarrier: true},
+			},
+		}
+	})
+
+	It("reports no changes for identical inventories", func() {
+		Expect(DiffInventories(previous, current)).To(BeEmpty())
+	})
+
+	It("reports disk changes", func() {
+		current.Disks[0].SizeBytes = 100 * 1024 * 1024 * 1024
+		current.Disks = append(current.Disks[:1], &models.Disk{ID: "/dev/disk/by-id/sdc", Name: "sdc", SizeBytes: 1024 * 1024 * 1024})
+		changes := DiffInventories(previous, current)
+		Expect(changes).To(HaveLen(3))
+		Expect(changes[0].Kind).To(Equal(models.InventoryChangeKindDiskSizeChanged))
+		Expect(changes[0].Description).To(Equal("size of disk /dev/disk/by-id/sda changed from 120.00 GiB to 100.00 GiB"))
+		Expect(changes[1].Kind).To(Equal(models.InventoryChangeKindDiskAdded))
+		Expect(changes[1].Subject).To(Equal("/dev/disk/by-id/sdc"))
+		Expect(changes[2].Kind).To(Equal(models.InventoryChangeKindDiskRemoved))
+		Expect(changes[2].Subject).To(Equal("/dev/sdb"))
+		Expect(HardwareChanges(changes)).To(HaveLen(3))
+	})
+
+	It("reports interface changes", func() {
+		current.Interfaces[0].HasCarrier = false
+		current.Interfaces[0].SpeedMbps = 100
+		current.Interfaces[0].IPV6Addresses = []string{"fe80::1/64"}
+		current.Interfaces = current.Interfaces[:1]
+		changes := DiffInventories(previous, current)
+		Expect(changes).To(HaveLen(4))
+		Expect(changes[0].Description).To(Equal("link of interface eth0 went down"))
+		Expect(changes[1].Description).To(Equal("speed of interface eth0 changed from 1000 Mbps to 100 Mbps"))
+		Expect(changes[2].Description).To(Equal("addresses of interface eth0 changed from [1.2.3.4/24] to [1.2.3.4/24, fe80::1/64]"))
+		Expect(changes[3].Kind).To(Equal(models.InventoryChangeKindInterfaceRemoved))
+		Expect(changes[3].Previous).To(Equal("52:54:00:00:00:02"))
+		Expect(HardwareChanges(changes)).To(Equal(changes[3:]))
+	})
+
+	It("reports memory and CPU changes", func() {
+		current.Memory.PhysicalBytes = 4 * 1024 * 1024 * 1024
+		current.CPU = nil
+		changes := DiffInventories(previous, current)
+		Expect(changes).To(HaveLen(2))
+		Expect(changes[0].Description).To(Equal("memory changed from 8.00 GiB to 4.00 GiB"))
+		Expect(changes[1].Description).To(Equal("CPU count changed from 4 to 0"))
+		Expect(FormatInventoryChanges(changes)).To(Equal("memory changed from 8.00 GiB to 4.00 GiB, CPU count changed from 4 to 0"))
+	})
+})
@@ -21,14 +21,25 @@ func UpdateHostProgress(ctx context.Context, log logrus.FieldLogger, db *gorm.DB
 	srcStatus string, newStatus string, statusInfo string,
 	srcStage models.HostStage, newStage models.HostStage, progressInfo string, extra ...interface{}) (*common.Host, error) {
 
+	now := time.Now()
 	extra = append(append(make([]interface{}, 0), "progress_current_stage", newStage, "progress_progress_info", progressInfo,
-		"progress_stage_updated_at", strfmt.DateTime(time.Now())), extra...)
+		"progress_stage_updated_at", strfmt.DateTime(now)), extra...)
 
 	if newStage != srcStage {
-		extra = append(extra, "progress_stage_started_at", strfmt.DateTime(time.Now()))
+		extra = append(extra, "progress_stage_started_at", strfmt.DateTime(now))
 	}
 
-	return UpdateHostStatus(ctx, log, db, eventsHandler, clusterId, hostId, srcStatus, newStatus, statusInfo, extra...)
+	host, err := UpdateHostStatus(ctx, log, db, eventsHandler, clusterId, hostId, srcStatus, newStatus, statusInfo, extra...)
+	if err != nil {
+		return nil, err
+	}
+
+	if newStage != srcStage && newStage != "" {
+		if err = common.AddInstallationTimelineEntry(db, clusterId, hostId, string(newStage), progressInfo, now); err != nil {
+			log.WithError(err).Warnf("failed to record stage %s of host %s in the installation timeline", newStage, hostId)
+		}
+	}
+	return host, nil
 }
 
 func UpdateLogsProgress(_ context.Context, log logrus.FieldLogger, db *gorm.DB, _ events.Handler, clusterId strfmt.UUID, hostId strfmt.UUID, srcStatus string, progress string, extra ...interface{}) (*common.Host, error) {
@@ -58,8 +69,9 @@ func UpdateHostStatus(ctx context.Context, log logrus.FieldLogger, db *gorm.DB,
 
 	extra = append(append(make([]interface{}, 0), "status", newStatus, "status_info", statusInfo), extra...)
 
+	now := time.Now()
 	if newStatus != srcStatus {
-		extra = append(extra, "status_updated_at", strfmt.DateTime(time.Now()))
+		extra = append(extra, "status_updated_at", strfmt.DateTime(now))
 	}
 
 	if host, err = UpdateHost(log, db, clusterId, hostId, srcStatus, extra...); err != nil ||
@@ -75,6 +87,10 @@ func UpdateHostStatus(ctx context.Context, log logrus.FieldLogger, db *gorm.DB,
 		}
 		eventsHandler.AddEvent(ctx, clusterId, &hostId, GetEventSeverityFromHostStatus(newStatus), msg, time.Now())
 		log.Infof("host %s from cluster %s has been updated with the following updates %+v", hostId, clusterId, extra)
+
+		if err = common.AddStatusChange(db, clusterId, hostId, newStatus, statusInfo, now); err != nil {
+			log.WithError(err).Warnf("failed to record status %s of host %s", newStatus, hostId)
+		}
 	}
 
 	return host, nil
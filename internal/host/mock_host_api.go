@@ -38,6 +38,21 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 	return m.recorder
 }
 
+// AssignRoles mocks base method
+func (m *MockAPI) AssignRoles(arg0 context.Context, arg1 *common.Cluster, arg2 bool, arg3 *gorm.DB) (*models.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRoles", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignRoles indicates an expected call of AssignRoles
+func (mr *MockAPIMockRecorder) AssignRoles(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRoles", reflect.TypeOf((*MockAPI)(nil).AssignRoles), arg0, arg1, arg2, arg3)
+}
+
 // AutoAssignRole mocks base method
 func (m *MockAPI) AutoAssignRole(arg0 context.Context, arg1 *models.Host, arg2 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -474,17 +489,17 @@ func (mr *MockAPIMockRecorder) UpdateInstallationDisk(arg0, arg1, arg2, arg3 int
 }
 
 // UpdateInventory mocks base method
-func (m *MockAPI) UpdateInventory(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+func (m *MockAPI) UpdateInventory(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateInventory", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateInventory", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateInventory indicates an expected call of UpdateInventory
-func (mr *MockAPIMockRecorder) UpdateInventory(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) UpdateInventory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInventory", reflect.TypeOf((*MockAPI)(nil).UpdateInventory), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInventory", reflect.TypeOf((*MockAPI)(nil).UpdateInventory), arg0, arg1, arg2, arg3)
 }
 
 // UpdateKubeKeyNS mocks base method
@@ -0,0 +1,73 @@
+package host
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("MTU consistent validation", func() {
+	var (
+		v       *validator
+		cluster *common.Cluster
+	)
+
+	createHost := func(mtu int64, address string) (*models.Host, *models.Inventory) {
+		id := strfmt.UUID(uuid.New().String())
+		inventory := &models.Inventory{Interfaces: []*models.Interface{{Name: "eth0", Mtu: mtu, IPV4Addresses: []string{address}}}}
+		b, err := hostutil.MarshalInventory(inventory)
+		Expect(err).ToNot(HaveOccurred())
+		return &models.Host{ID: &id, RequestedHostname: "host-" + address, Inventory: b}, inventory
+	}
+
+	BeforeEach(func() {
+		v = &validator{}
+		cluster = &common.Cluster{Cluster: models.Cluster{MachineNetworkCidr: "1.2.4.0/23"}}
+	})
+
+	It("is pending without an inventory", func() {
+		host, _ := createHost(1500, "1.2.4.10/23")
+		c := &validationContext{host: host, cluster: cluster}
+		status := v.isMtuConsistent(c)
+		Expect(status).To(Equal(ValidationPending))
+		Expect(v.printMtuConsistent(c, status)).To(Equal("Missing inventory"))
+	})
+
+	It("succeeds when the hosts have the same MTU on the machine network", func() {
+		host, inventory := createHost(1500, "1.2.4.10/23")
+		other, _ := createHost(1500, "1.2.4.11/23")
+		cluster.Hosts = []*models.Host{host, other}
+		c := &validationContext{host: host, cluster: cluster, inventory: inventory}
+		status := v.isMtuConsistent(c)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(v.printMtuConsistent(c, status)).To(Equal("Host MTU is consistent"))
+	})
+
+	It("fails when another host has a different MTU on the machine network", func() {
+		host, inventory := createHost(9000, "1.2.4.10/23")
+		other, _ := createHost(1500, "1.2.4.11/23")
+		cluster.Hosts = []*models.Host{host, other}
+		c := &validationContext{host: host, cluster: cluster, inventory: inventory}
+		status := v.isMtuConsistent(c)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(v.printMtuConsistent(c, status)).To(Equal("Host MTU is inconsistent: The MTU 9000 of interface eth0 on the " +
+			"machine network 1.2.4.0/23 differs from the MTU 1500 of hosts host-1.2.4.11/23"))
+	})
+
+	It("calculates the MTU problems once per validation context", func() {
+		host, inventory := createHost(9000, "1.2.4.10/23")
+		other, _ := createHost(1500, "1.2.4.11/23")
+		cluster.Hosts = []*models.Host{host, other}
+		c := &validationContext{host: host, cluster: cluster, inventory: inventory}
+		status := v.isMtuConsistent(c)
+		Expect(status).To(Equal(ValidationFailure))
+
+		By("formatting the problems found by the validation")
+		cluster.Hosts = []*models.Host{host}
+		Expect(v.printMtuConsistent(c, status)).To(ContainSubstring("differs from the MTU 1500 of hosts host-1.2.4.11/23"))
+	})
+})
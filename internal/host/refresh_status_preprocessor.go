@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
@@ -35,9 +36,10 @@ type refreshPreprocessor struct {
 	conditions              []condition
 	operatorsApi            operators.API
 	disabledHostValidations DisabledHostValidations
+	hostValidationRules     HostValidationRules
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator, operatorsApi operators.API, disabledHostValidations DisabledHostValidations) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator, operatorsApi operators.API, disabledHostValidations DisabledHostValidations, hostValidationRules HostValidationRules) *refreshPreprocessor {
 	v := &validator{
 		log:            log,
 		hwValidatorCfg: hwValidatorCfg,
@@ -50,21 +52,41 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.Val
 		conditions:              newConditions(v),
 		operatorsApi:            operatorsApi,
 		disabledHostValidations: disabledHostValidations,
+		hostValidationRules:     hostValidationRules,
 	}
 }
 
-const validationDisabledByConfiguration = "Validation disabled by configuration"
+const (
+	validationDisabledByConfiguration = "Validation disabled by configuration"
+	validationDisabledByPolicy        = "Validation disabled by the validation policy of the cluster"
+)
+
+// disabledMessage returns whether the validation is disabled, either by the service configuration or by the
+// validation policy of the cluster, and the message to report for it
+func (r *refreshPreprocessor) disabledMessage(id validationID, policy *models.ValidationPolicy) (string, bool) {
+	if r.disabledHostValidations.IsDisabled(id) {
+		return validationDisabledByConfiguration, true
+	}
+	if common.IsValidationDisabledByPolicy(policy.DisabledHostValidations, id.String()) {
+		return validationDisabledByPolicy, true
+	}
+	return "", false
+}
 
 func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool, ValidationsStatus, error) {
 	conditions := make(map[string]bool)
 	validationsOutput := make(ValidationsStatus)
+	policy, err := common.GetValidationPolicy(c.cluster)
+	if err != nil {
+		return nil, nil, err
+	}
 	for _, v := range r.validations {
 
 		var st ValidationStatus
 		var message string
-		if r.disabledHostValidations.IsDisabled(v.id) {
+		if disabledMessage, disabled := r.disabledMessage(v.id, policy); disabled {
 			st = ValidationDisabled
-			message = validationDisabledByConfiguration
+			message = disabledMessage
 			conditions[v.id.String()] = true
 		} else {
 			st = v.condition(c)
@@ -97,9 +119,11 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 	if err != nil {
 		return nil, nil, err
 	}
+	operatorsSucceeded := true
 	for _, result := range results {
 		id := validationID(result.ValidationId)
 		conditions[id.String()] = result.Status == api.Success
+		operatorsSucceeded = operatorsSucceeded && result.Status == api.Success
 		category, err := id.category()
 		if err != nil {
 			logrus.WithError(err).Warn("id.category()")
@@ -116,6 +140,18 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 		sortByValidationResultID(validationsOutput[category])
 	}
 
+	conditions[OperatorsRequirementsSatisfied.String()] = operatorsSucceeded
+
+	// Validate user-defined rules
+	customResults, succeeded, err := r.customValidations(c, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+	conditions[CustomValidationsSucceeded.String()] = succeeded
+	if len(customResults) > 0 {
+		validationsOutput[customValidationCategory] = customResults
+	}
+
 	return conditions, validationsOutput, nil
 }
 
@@ -199,6 +235,21 @@ func newValidations(v *validator) []validation {
 			condition: v.isValidPlatform,
 			formatter: v.printValidPlatform,
 		},
+		{
+			id:        IsCPUArchitectureCompatible,
+			condition: v.isCPUArchitectureCompatible,
+			formatter: v.printCPUArchitectureCompatible,
+		},
+		{
+			id:        IsHardwareUnchanged,
+			condition: v.isHardwareUnchanged,
+			formatter: v.printHardwareUnchanged,
+		},
+		{
+			id:        IsMtuConsistent,
+			condition: v.isMtuConsistent,
+			formatter: v.printMtuConsistent,
+		},
 		{
 			id:            IsNTPSynced,
 			condition:     v.isNTPSynced,
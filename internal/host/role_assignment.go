@@ -0,0 +1,308 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	// The score of a host is made of its CPU cores and memory, relative to the strongest host of the cluster, and of
+	// the speed of its installation disk
+	cpuScoreWeight      = 40
+	memoryScoreWeight   = 40
+	fastDiskScoreWeight = 20
+)
+
+// roleCandidate is a host whose role may be assigned by the role assignment
+type roleCandidate struct {
+	host             *common.Host
+	inventory        *models.Inventory
+	canBeMaster      bool
+	masterViolations []string
+	workerViolations []string
+	fastDisk         bool
+	score            int64
+	master           bool
+	result           *models.HostRoleAssignment
+}
+
+// AssignRoles assigns the roles of all the hosts of the cluster at once, selecting the masters among the hosts with
+// the most resources, spread across failure domains and with the fewest operator requirement violations.
+// Hosts whose role was set by the user keep it. The roles are only proposed when dryRun is set.
+func (m *Manager) AssignRoles(ctx context.Context, c *common.Cluster, dryRun bool, db *gorm.DB) (*models.RoleAssignment, error) {
+	log := logutil.FromContext(ctx, m.log)
+	var hosts []*common.Host
+	if err := db.Where("cluster_id = ? and status != ?", c.ID.String(), models.HostStatusDisabled).
+		Order("id").Find(&hosts).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get the hosts of cluster %s", c.ID.String())
+	}
+
+	assignment := &models.RoleAssignment{Hosts: []*models.HostRoleAssignment{}, Warnings: []string{}}
+	requiredMasters := common.MinMasterHostsNeededForInstallation
+	if common.IsSingleNodeCluster(c) {
+		requiredMasters = 1
+	}
+	mastersPerDomain := make(map[string]int)
+	failureDomains := make(map[string]bool)
+	candidates := make([]*roleCandidate, 0, len(hosts))
+	for _, h := range hosts {
+		result := &models.HostRoleAssignment{
+			HostID:        *h.ID,
+			Hostname:      hostutil.GetHostnameForMsg(&h.Host),
+			FailureDomain: h.FailureDomain,
+			CurrentRole:   h.Role,
+			Role:          h.Role,
+			Reasons:       []string{},
+		}
+		assignment.Hosts = append(assignment.Hosts, result)
+		if h.FailureDomain != "" {
+			failureDomains[h.FailureDomain] = true
+		}
+
+		if reason := fixedRoleReason(h); reason != "" {
+			result.Reasons = append(result.Reasons, reason)
+			if h.Role == models.HostRoleMaster {
+				mastersPerDomain[h.FailureDomain]++
+			}
+			continue
+		}
+		candidate, err := m.newRoleCandidate(h, c, db, result)
+		if err != nil {
+			log.WithError(err).Errorf("failed to evaluate the roles of host %s", h.ID.String())
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	scoreRoleCandidates(candidates)
+	masters := countMasters(mastersPerDomain)
+	for ; masters < requiredMasters; masters++ {
+		best := selectMaster(candidates, mastersPerDomain)
+		if best == nil {
+			break
+		}
+		best.master = true
+		mastersPerDomain[best.host.FailureDomain]++
+	}
+
+	for _, candidate := range candidates {
+		candidate.result.Role = models.HostRoleWorker
+		if candidate.master {
+			candidate.result.Role = models.HostRoleMaster
+		}
+		candidate.result.Reasons = append(candidate.result.Reasons, candidate.reasons(mastersPerDomain)...)
+	}
+	assignment.Warnings = append(assignment.Warnings, roleAssignmentWarnings(masters, requiredMasters, mastersPerDomain, failureDomains)...)
+
+	if dryRun {
+		return assignment, nil
+	}
+	changed := make([]string, 0)
+	for _, candidate := range candidates {
+		h := candidate.host
+		if candidate.result.Role == h.Role && h.RoleAutoAssigned {
+			continue
+		}
+		if err := updateRole(log, &h.Host, candidate.result.Role, db, swag.String(string(h.Role)), true); err != nil {
+			log.WithError(err).Errorf("failed to assign role %s to host %s", candidate.result.Role, h.ID.String())
+			return nil, err
+		}
+		if candidate.result.Role != h.Role {
+			changed = append(changed, fmt.Sprintf("%s: %s", candidate.result.Hostname, candidate.result.Role))
+		}
+	}
+	assignment.Applied = true
+	if len(changed) > 0 {
+		m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityInfo,
+			fmt.Sprintf("Assigned the roles of %d hosts (%s)", len(changed), strings.Join(changed, ", ")), time.Now())
+	}
+	return assignment, nil
+}
+
+// fixedRoleReason returns why the role of the host cannot be assigned, or an empty string if it can
+func fixedRoleReason(h *common.Host) string {
+	switch {
+	case hostutil.IsDay2Host(&h.Host):
+		return "Hosts added to an installed cluster are always workers"
+	case !funk.ContainsString(hostStatusesBeforeInstallation[:], swag.StringValue(h.Status)):
+		return fmt.Sprintf("The role cannot be changed while the host is %s", swag.StringValue(h.Status))
+	case h.Role != models.HostRoleAutoAssign && !h.RoleAutoAssigned:
+		return "The role was set by the user"
+	case h.Inventory == "":
+		return "The host did not report its hardware yet"
+	}
+	return ""
+}
+
+func (m *Manager) newRoleCandidate(h *common.Host, c *common.Cluster, db *gorm.DB, result *models.HostRoleAssignment) (*roleCandidate, error) {
+	inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return nil, err
+	}
+	candidate := &roleCandidate{host: h, inventory: inventory, result: result}
+	result.Assignable = true
+
+	if candidate.canBeMaster, candidate.masterViolations, err = m.evaluateRole(&h.Host, c, models.HostRoleMaster, db); err != nil {
+		return nil, err
+	}
+	if _, candidate.workerViolations, err = m.evaluateRole(&h.Host, c, models.HostRoleWorker, db); err != nil {
+		return nil, err
+	}
+	if disk, err := hostutil.GetHostInstallationDisk(&h.Host); err == nil && disk != nil {
+		candidate.fastDisk = disk.DriveType == "SSD"
+	}
+	return candidate, nil
+}
+
+// evaluateRole runs the validations of a copy of the host with the given role, and returns whether the host has the
+// hardware required by the role and which operator requirements it would not satisfy
+func (m *Manager) evaluateRole(h *models.Host, c *common.Cluster, role models.HostRole, db *gorm.DB) (bool, []string, error) {
+	hostCopy := *h
+	hostCopy.Role = role
+	vc, err := newValidationContext(&hostCopy, c, db, m.hwValidator)
+	if err != nil {
+		return false, nil, err
+	}
+	conditions, validationsOutput, err := m.rp.preprocess(vc)
+	if err != nil {
+		return false, nil, err
+	}
+	violations := make([]string, 0)
+	for _, v := range validationsOutput["operators"] {
+		if v.Status == ValidationFailure {
+			violations = append(violations, v.ID.String())
+		}
+	}
+	return m.canBeMaster(conditions), violations, nil
+}
+
+func scoreRoleCandidates(candidates []*roleCandidate) {
+	var maxCores, maxMemory int64
+	for _, candidate := range candidates {
+		if cores := hostutil.GetCPUCount(candidate.inventory); cores > maxCores {
+			maxCores = cores
+		}
+		if memory := hostutil.GetPhysicalMemory(candidate.inventory); memory > maxMemory {
+			maxMemory = memory
+		}
+	}
+	for _, candidate := range candidates {
+		var score float64
+		if maxCores > 0 {
+			score += cpuScoreWeight * float64(hostutil.GetCPUCount(candidate.inventory)) / float64(maxCores)
+		}
+		if maxMemory > 0 {
+			score += memoryScoreWeight * float64(hostutil.GetPhysicalMemory(candidate.inventory)) / float64(maxMemory)
+		}
+		if candidate.fastDisk {
+			score += fastDiskScoreWeight
+		}
+		candidate.score = int64(math.Round(score))
+		candidate.result.Score = candidate.score
+	}
+}
+
+// selectMaster returns the best candidate to be the next master: the one in the failure domain with the fewest masters,
+// then the one that violates the fewest operator requirements as a master, then the one with the highest score
+func selectMaster(candidates []*roleCandidate, mastersPerDomain map[string]int) *roleCandidate {
+	var best *roleCandidate
+	for _, candidate := range candidates {
+		if !candidate.canBeMaster || candidate.master {
+			continue
+		}
+		if best == nil || isBetterMaster(candidate, best, mastersPerDomain) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+func isBetterMaster(candidate, best *roleCandidate, mastersPerDomain map[string]int) bool {
+	candidateMasters, bestMasters := mastersPerDomain[candidate.host.FailureDomain], mastersPerDomain[best.host.FailureDomain]
+	if candidateMasters != bestMasters {
+		return candidateMasters < bestMasters
+	}
+	candidateViolations, bestViolations := candidate.violationsAsMaster(), best.violationsAsMaster()
+	if candidateViolations != bestViolations {
+		return candidateViolations < bestViolations
+	}
+	return candidate.score > best.score
+}
+
+// violationsAsMaster returns how many more operator requirements the host violates as a master than as a worker
+func (r *roleCandidate) violationsAsMaster() int {
+	return len(r.masterViolations) - len(r.workerViolations)
+}
+
+func (r *roleCandidate) reasons(mastersPerDomain map[string]int) []string {
+	reasons := make([]string, 0)
+	resources := fmt.Sprintf("%d CPU cores, %s of RAM", hostutil.GetCPUCount(r.inventory), conversions.BytesToString(hostutil.GetPhysicalMemory(r.inventory)))
+	if r.fastDisk {
+		resources += ", SSD installation disk"
+	}
+	switch {
+	case r.master && r.host.FailureDomain != "":
+		reasons = append(reasons, fmt.Sprintf("Selected as a master with score %d (%s) in failure domain %s",
+			r.score, resources, r.host.FailureDomain))
+	case r.master:
+		reasons = append(reasons, fmt.Sprintf("Selected as a master with score %d (%s)", r.score, resources))
+	case !r.canBeMaster:
+		reasons = append(reasons, fmt.Sprintf("The host does not have the CPU cores and memory required for a master (%s)", resources))
+	default:
+		reasons = append(reasons, fmt.Sprintf("The required masters were selected among other hosts (score %d, %s)", r.score, resources))
+	}
+
+	violations := r.workerViolations
+	if r.master {
+		violations = r.masterViolations
+	}
+	if len(violations) > 0 {
+		reasons = append(reasons, fmt.Sprintf("Operator requirements are not satisfied: %s", strings.Join(violations, ", ")))
+	}
+	return reasons
+}
+
+func roleAssignmentWarnings(masters, requiredMasters int, mastersPerDomain map[string]int, failureDomains map[string]bool) []string {
+	warnings := make([]string, 0)
+	if masters < requiredMasters {
+		warnings = append(warnings, fmt.Sprintf("Only %d of the %d required masters could be assigned", masters, requiredMasters))
+	}
+	if len(failureDomains) < 2 || masters == 0 {
+		return warnings
+	}
+	maxPerDomain := int(math.Ceil(float64(masters) / float64(len(failureDomains))))
+	domains := funk.Keys(mastersPerDomain).([]string)
+	sort.Strings(domains)
+	for _, domain := range domains {
+		count := mastersPerDomain[domain]
+		switch {
+		case domain == "" && count > 0:
+			warnings = append(warnings, fmt.Sprintf("%d of the %d masters have no failure domain", count, masters))
+		case domain != "" && count > maxPerDomain:
+			warnings = append(warnings, fmt.Sprintf("Failure domain %s has %d of the %d masters", domain, count, masters))
+		}
+	}
+	return warnings
+}
+
+func countMasters(mastersPerDomain map[string]int) int {
+	masters := 0
+	for _, count := range mastersPerDomain {
+		masters += count
+	}
+	return masters
+}
@@ -0,0 +1,225 @@
+package host
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/leader"
+)
+
+func roleAssignmentInventory(cores int64, memoryGib int64, driveType string) string {
+	inventory, err := hostutil.UnmarshalInventory(hostutil.GenerateMasterInventory())
+	Expect(err).ToNot(HaveOccurred())
+	inventory.CPU.Count = cores
+	inventory.Memory.PhysicalBytes = conversions.GibToBytes(memoryGib)
+	inventory.Memory.UsableBytes = conversions.GibToBytes(memoryGib)
+	inventory.Disks[0].DriveType = driveType
+	ret, err := hostutil.MarshalInventory(inventory)
+	Expect(err).ToNot(HaveOccurred())
+	return ret
+}
+
+var _ = Describe("Role assignment selection", func() {
+	newCandidate := func(failureDomain string, score int64, masterViolations int) *roleCandidate {
+		id := strfmt.UUID(uuid.New().String())
+		return &roleCandidate{
+			host:             &common.Host{Host: models.Host{ID: &id, FailureDomain: failureDomain}},
+			canBeMaster:      true,
+			score:            score,
+			masterViolations: make([]string, masterViolations),
+			workerViolations: []string{},
+		}
+	}
+
+	It("prefers the hosts with the highest score", func() {
+		weak, strong := newCandidate("", 50, 0), newCandidate("", 90, 0)
+		Expect(selectMaster([]*roleCandidate{weak, strong}, map[string]int{})).To(Equal(strong))
+	})
+
+	It("spreads the masters across failure domains", func() {
+		rackA, rackB := newCandidate("rack-a", 90, 0), newCandidate("rack-b", 50, 0)
+		Expect(selectMaster([]*roleCandidate{rackA, rackB}, map[string]int{"rack-a": 1})).To(Equal(rackB))
+	})
+
+	It("prefers the hosts that violate fewer operator requirements as masters", func() {
+		violating, satisfying := newCandidate("", 90, 1), newCandidate("", 50, 0)
+		Expect(selectMaster([]*roleCandidate{violating, satisfying}, map[string]int{})).To(Equal(satisfying))
+	})
+
+	It("skips hosts that cannot be masters or are already selected", func() {
+		small, selected := newCandidate("", 90, 0), newCandidate("", 80, 0)
+		small.canBeMaster = false
+		selected.master = true
+		Expect(selectMaster([]*roleCandidate{small, selected}, map[string]int{})).To(BeNil())
+	})
+
+	It("warns about missing masters and unbalanced failure domains", func() {
+		warnings := roleAssignmentWarnings(2, 3, map[string]int{"rack-a": 2},
+			map[string]bool{"rack-a": true, "rack-b": true, "rack-c": true})
+		Expect(warnings).To(ConsistOf(
+			"Only 2 of the 3 required masters could be assigned",
+			"Failure domain rack-a has 2 of the 2 masters",
+		))
+		Expect(roleAssignmentWarnings(3, 3, map[string]int{"rack-a": 1, "rack-b": 1, "rack-c": 1},
+			map[string]bool{"rack-a": true, "rack-b": true, "rack-c": true})).To(BeEmpty())
+	})
+})
+
+var _ = Describe("AssignRoles", func() {
+	var (
+		ctx             = context.Background()
+		clusterId       strfmt.UUID
+		hapi            API
+		db              *gorm.DB
+		ctrl            *gomock.Controller
+		mockHwValidator *hardware.MockValidator
+		mockEvents      *events.MockHandler
+		dbName          string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockHwValidator = hardware.NewMockValidator(ctrl)
+		mockHwValidator.EXPECT().ListEligibleDisks(gomock.Any()).AnyTimes()
+		mockHwValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(nil, nil).AnyTimes()
+		mockHwValidator.EXPECT().GetHostInstallationPath(gomock.Any()).Return("abc").AnyTimes()
+		mockEvents = events.NewMockHandler(ctrl)
+		mockOperators := operators.NewMockAPI(ctrl)
+		mockOperators.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
+			{Status: api.Success, ValidationId: string(models.HostValidationIDOcsRequirementsSatisfied)},
+		}, nil)
+		masterRequirements := models.ClusterHostRequirementsDetails{CPUCores: 4, DiskSizeGb: 120, RAMMib: 16384}
+		workerRequirements := models.ClusterHostRequirementsDetails{CPUCores: 2, DiskSizeGb: 120, RAMMib: 8192}
+		mockHwValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirements, error) {
+			details := workerRequirements
+			if host.Role == models.HostRoleMaster {
+				details = masterRequirements
+			}
+			return &models.ClusterHostRequirements{Total: &details}, nil
+		})
+		mockHwValidator.EXPECT().GetPreflightHardwareRequirements(gomock.Any(), gomock.Any()).AnyTimes().Return(
+			&models.PreflightHardwareRequirements{
+				Ocp: &models.HostTypeHardwareRequirementsWrapper{
+					Master: &models.HostTypeHardwareRequirements{Quantitative: &masterRequirements},
+					Worker: &models.HostTypeHardwareRequirements{Quantitative: &workerRequirements},
+				},
+			}, nil)
+
+		db, dbName = common.PrepareTestDB()
+		clusterId = strfmt.UUID(uuid.New().String())
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil,
+			defaultConfig, &leader.DummyElector{}, mockOperators)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterId}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		db.Close()
+		ctrl.Finish()
+	})
+
+	addHost := func(role models.HostRole, inventory, failureDomain string) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		h := hostutil.GenerateTestHost(id, clusterId, models.HostStatusKnown)
+		h.Role = role
+		h.Inventory = inventory
+		h.FailureDomain = failureDomain
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		return id
+	}
+
+	getCluster := func() *common.Cluster {
+		c, err := common.GetClusterFromDB(db, clusterId, common.UseEagerLoading)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	rolesOf := func(assignment *models.RoleAssignment) map[strfmt.UUID]models.HostRole {
+		ret := make(map[strfmt.UUID]models.HostRole)
+		for _, h := range assignment.Hosts {
+			ret[h.HostID] = h.Role
+		}
+		return ret
+	}
+
+	It("selects the strongest hosts as masters, spread across failure domains", func() {
+		strongA := addHost(models.HostRoleAutoAssign, roleAssignmentInventory(16, 64, "SSD"), "rack-a")
+		otherA := addHost(models.HostRoleAutoAssign, roleAssignmentInventory(16, 64, "SSD"), "rack-a")
+		weakB := addHost(models.HostRoleAutoAssign, roleAssignmentInventory(8, 32, "HDD"), "rack-b")
+		weakC := addHost(models.HostRoleAutoAssign, roleAssignmentInventory(8, 32, "HDD"), "rack-c")
+		small := addHost(models.HostRoleAutoAssign, workerInventory(), "rack-b")
+
+		assignment, err := hapi.AssignRoles(ctx, getCluster(), true, db)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(assignment.Applied).To(BeFalse())
+		Expect(assignment.Warnings).To(BeEmpty())
+		roles := rolesOf(assignment)
+		Expect(roles[weakB]).To(Equal(models.HostRoleMaster))
+		Expect(roles[weakC]).To(Equal(models.HostRoleMaster))
+		Expect(roles[small]).To(Equal(models.HostRoleWorker))
+		Expect([]models.HostRole{roles[strongA], roles[otherA]}).To(ConsistOf(models.HostRoleMaster, models.HostRoleWorker))
+
+		By("leaving the roles unchanged on dry run")
+		Expect(hostutil.GetHostFromDB(weakB, clusterId, db).Role).To(Equal(models.HostRoleAutoAssign))
+	})
+
+	It("keeps the roles set by the user and applies the assignment", func() {
+		userMaster := addHost(models.HostRoleMaster, roleAssignmentInventory(8, 32, "HDD"), "")
+		userWorker := addHost(models.HostRoleWorker, roleAssignmentInventory(16, 64, "SSD"), "")
+		candidates := []strfmt.UUID{
+			addHost(models.HostRoleAutoAssign, roleAssignmentInventory(8, 32, "HDD"), ""),
+			addHost(models.HostRoleAutoAssign, roleAssignmentInventory(8, 32, "HDD"), ""),
+			addHost(models.HostRoleAutoAssign, roleAssignmentInventory(4, 16, "HDD"), ""),
+		}
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+		assignment, err := hapi.AssignRoles(ctx, getCluster(), false, db)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(assignment.Applied).To(BeTrue())
+		for _, h := range assignment.Hosts {
+			if h.HostID == userMaster || h.HostID == userWorker {
+				Expect(h.Assignable).To(BeFalse())
+				Expect(h.Reasons).To(ConsistOf("The role was set by the user"))
+			}
+		}
+		Expect(hostutil.GetHostFromDB(userMaster, clusterId, db).Role).To(Equal(models.HostRoleMaster))
+		Expect(hostutil.GetHostFromDB(userWorker, clusterId, db).Role).To(Equal(models.HostRoleWorker))
+		Expect(hostutil.GetHostFromDB(candidates[0], clusterId, db).Role).To(Equal(models.HostRoleMaster))
+		Expect(hostutil.GetHostFromDB(candidates[1], clusterId, db).Role).To(Equal(models.HostRoleMaster))
+		Expect(hostutil.GetHostFromDB(candidates[2], clusterId, db).Role).To(Equal(models.HostRoleWorker))
+
+		By("recording the hardware baseline of the assigned hosts")
+		for _, id := range candidates {
+			h := hostutil.GetHostFromDB(id, clusterId, db)
+			Expect(h.HardwareBaseline).To(Equal(h.Inventory))
+		}
+
+		By("reassigning the roles it selected on a later pass")
+		Expect(hostutil.GetHostFromDB(candidates[2], clusterId, db).RoleAutoAssigned).To(BeTrue())
+		assignment, err = hapi.AssignRoles(ctx, getCluster(), true, db)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rolesOf(assignment)[candidates[2]]).To(Equal(models.HostRoleWorker))
+	})
+
+	It("warns when not enough hosts can be masters", func() {
+		addHost(models.HostRoleAutoAssign, roleAssignmentInventory(8, 32, "HDD"), "")
+		addHost(models.HostRoleAutoAssign, workerInventory(), "")
+
+		assignment, err := hapi.AssignRoles(ctx, getCluster(), true, db)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(assignment.Warnings).To(ConsistOf("Only 1 of the 3 required masters could be assigned"))
+	})
+})
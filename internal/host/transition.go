@@ -74,7 +74,19 @@ func (th *transitionHandler) PostRegisterHost(sw stateswitch.StateSwitch, args s
 	hostParam.StatusUpdatedAt = strfmt.DateTime(time.Now())
 	hostParam.StatusInfo = swag.String(statusInfoDiscovering)
 	log.Infof("Register new host %s cluster %s", hostParam.ID.String(), hostParam.ClusterID)
-	return params.db.Create(hostParam).Error
+	if err := params.db.Create(hostParam).Error; err != nil {
+		return err
+	}
+	addRegisteredStatusChange(log, params.db, hostParam)
+	return nil
+}
+
+// addRegisteredStatusChange records the status of a host that was registered
+func addRegisteredStatusChange(log logrus.FieldLogger, db *gorm.DB, host *models.Host) {
+	if err := common.AddStatusChange(db, host.ClusterID, *host.ID, swag.StringValue(host.Status), swag.StringValue(host.StatusInfo),
+		time.Time(host.StatusUpdatedAt)); err != nil {
+		log.WithError(err).Warnf("failed to record status of registered host %s", host.ID.String())
+	}
 }
 
 func (th *transitionHandler) PostRegisterDuringInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
@@ -167,7 +179,11 @@ func (th *transitionHandler) PostRegisterInstalledHost(sw stateswitch.StateSwitc
 	sHost.host.StatusUpdatedAt = strfmt.DateTime(time.Now())
 	sHost.host.StatusInfo = swag.String(statusInfoDiscovering)
 	log.Infof("Register installed host %s cluster %s", sHost.host.ID.String(), sHost.host.ClusterID)
-	return params.db.Create(sHost.host).Error
+	if err := params.db.Create(sHost.host).Error; err != nil {
+		return err
+	}
+	addRegisteredStatusChange(log, params.db, sHost.host)
+	return nil
 }
 
 ////////////////////////////////////////////////////////////////////////////
@@ -3951,6 +3951,173 @@ var _ = Describe("Refresh Host", func() {
 			})
 		}
 	})
+	Context("custom host validations", func() {
+		rule := func(id, expression string) *models.HostValidationRule {
+			return &models.HostValidationRule{ID: swag.String(id), Description: id + " description", Expression: swag.String(expression)}
+		}
+
+		BeforeEach(func() {
+			mockDefaultClusterHostRequirements(mockHwValidator)
+			defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
+			Expect(err).NotTo(HaveOccurred())
+			cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusDiscovering)
+			host.Inventory = hostutil.GenerateInventoryWithResourcesWithBytes(4, conversions.GibToBytes(16), "master")
+			host.Role = models.HostRoleMaster
+			host.NtpSources = string(defaultNTPSourcesInBytes)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID,
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				AnyTimes()
+			defaultConfig.DisabledHostvalidations = DisabledHostValidations{
+				string(models.HostValidationIDBelongsToMajorityGroup):   struct{}{},
+				string(models.HostValidationIDContainerImagesAvailable): struct{}{},
+			}
+		})
+
+		AfterEach(func() {
+			defaultConfig.HostValidationRules = nil
+			defaultConfig.DisabledHostvalidations = defaultDisabledHostValidations
+		})
+
+		tests := []struct {
+			name          string
+			defaultRules  HostValidationRules
+			clusterRules  HostValidationRules
+			dstState      string
+			expectedRules map[string]ValidationStatus
+		}{
+			{
+				name:          "Host is known when the service-wide rules succeed",
+				defaultRules:  HostValidationRules{rule("min-cpus", "cpu.count >= `4`")},
+				dstState:      models.HostStatusKnown,
+				expectedRules: map[string]ValidationStatus{"custom-min-cpus": ValidationSuccess},
+			},
+			{
+				name:          "Host is insufficient when a rule of the cluster fails",
+				defaultRules:  HostValidationRules{rule("min-cpus", "cpu.count >= `4`")},
+				clusterRules:  HostValidationRules{rule("more-cpus", "cpu.count >= `8`")},
+				dstState:      models.HostStatusInsufficient,
+				expectedRules: map[string]ValidationStatus{"custom-min-cpus": ValidationSuccess, "custom-more-cpus": ValidationFailure},
+			},
+			{
+				name:          "Rules of the cluster replace the service-wide rules with the same identifier",
+				defaultRules:  HostValidationRules{rule("min-cpus", "cpu.count >= `8`")},
+				clusterRules:  HostValidationRules{rule("min-cpus", "cpu.count >= `2`")},
+				dstState:      models.HostStatusKnown,
+				expectedRules: map[string]ValidationStatus{"custom-min-cpus": ValidationSuccess},
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				if t.clusterRules != nil {
+					data, err := json.Marshal(t.clusterRules)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterId.String()).
+						Update("host_validation_rules", string(data)).Error).ToNot(HaveOccurred())
+				}
+				defaultConfig.HostValidationRules = t.defaultRules
+				hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager)
+
+				Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+
+				var resultHost models.Host
+				Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId, clusterId.String()).Error).ToNot(HaveOccurred())
+				Expect(swag.StringValue(resultHost.Status)).To(Equal(t.dstState))
+				validationRes := ValidationsStatus{}
+				Expect(json.Unmarshal([]byte(resultHost.ValidationsInfo), &validationRes)).ToNot(HaveOccurred())
+				Expect(validationRes[customValidationCategory]).To(HaveLen(len(t.expectedRules)))
+				for _, val := range validationRes[customValidationCategory] {
+					Expect(val.Status).To(Equal(t.expectedRules[val.ID.String()]))
+					if val.Status == ValidationFailure {
+						Expect(swag.StringValue(resultHost.StatusInfo)).To(ContainSubstring(val.Message))
+					}
+				}
+			})
+		}
+	})
+	Context("validation policy", func() {
+		BeforeEach(func() {
+			mockDefaultClusterHostRequirements(mockHwValidator)
+			defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
+			Expect(err).NotTo(HaveOccurred())
+			cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusDiscovering)
+			host.Inventory = hostutil.GenerateInventoryWithResourcesWithBytes(4, conversions.GibToBytes(8), "master")
+			host.Role = models.HostRoleMaster
+			host.NtpSources = string(defaultNTPSourcesInBytes)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID,
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				AnyTimes()
+			defaultConfig.DisabledHostvalidations = DisabledHostValidations{
+				string(models.HostValidationIDBelongsToMajorityGroup):   struct{}{},
+				string(models.HostValidationIDContainerImagesAvailable): struct{}{},
+			}
+			defaultConfig.HostValidationRules = HostValidationRules{
+				{ID: swag.String("bios-vendor"), Expression: swag.String("system_vendor.manufacturer == 'Dell Inc.'")},
+			}
+		})
+
+		AfterEach(func() {
+			defaultConfig.HostValidationRules = nil
+			defaultConfig.DisabledHostvalidations = defaultDisabledHostValidations
+		})
+
+		refresh := func(policy *models.ValidationPolicy) (*models.Host, ValidationsStatus) {
+			if policy != nil {
+				policyStr, err := common.MarshalValidationPolicy(policy)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterId.String()).
+					Update("validation_policy", policyStr).Error).ToNot(HaveOccurred())
+			}
+			hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager)
+
+			Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+
+			var resultHost models.Host
+			Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId, clusterId.String()).Error).ToNot(HaveOccurred())
+			validationRes := ValidationsStatus{}
+			Expect(json.Unmarshal([]byte(resultHost.ValidationsInfo), &validationRes)).ToNot(HaveOccurred())
+			return &resultHost, validationRes
+		}
+
+		statusOf := func(validationRes ValidationsStatus, id string) ValidationStatus {
+			for _, results := range validationRes {
+				for _, result := range results {
+					if result.ID.String() == id {
+						return result.Status
+					}
+				}
+			}
+			return ""
+		}
+
+		It("Host is insufficient without a validation policy", func() {
+			resultHost, validationRes := refresh(nil)
+			Expect(swag.StringValue(resultHost.Status)).To(Equal(models.HostStatusInsufficient))
+			Expect(statusOf(validationRes, string(models.HostValidationIDHasMemoryForRole))).To(Equal(ValidationFailure))
+			Expect(statusOf(validationRes, "custom-bios-vendor")).To(Equal(ValidationFailure))
+		})
+
+		It("Host is known when the failing validations are disabled by the validation policy", func() {
+			resultHost, validationRes := refresh(&models.ValidationPolicy{
+				DisabledHostValidations: []string{
+					string(models.HostValidationIDHasMinMemory),
+					string(models.HostValidationIDHasMemoryForRole),
+					"custom-bios-vendor",
+				},
+			})
+			Expect(swag.StringValue(resultHost.Status)).To(Equal(models.HostStatusKnown))
+			Expect(statusOf(validationRes, string(models.HostValidationIDHasMemoryForRole))).To(Equal(ValidationDisabled))
+			Expect(statusOf(validationRes, "custom-bios-vendor")).To(Equal(ValidationDisabled))
+			Expect(statusOf(validationRes, string(models.HostValidationIDBelongsToMajorityGroup))).To(Equal(ValidationDisabled))
+		})
+	})
 	Context("L3 network latency and packet loss validation", func() {
 
 		defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
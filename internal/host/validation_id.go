@@ -2,8 +2,10 @@ package host
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 )
@@ -33,17 +35,30 @@ const (
 	SufficientOrUnknownInstallationDiskSpeed       = validationID(models.HostValidationIDSufficientInstallationDiskSpeed)
 	HasSufficientNetworkLatencyRequirementForRole  = validationID(models.HostValidationIDSufficientNetworkLatencyRequirementForRole)
 	HasSufficientPacketLossRequirementForRole      = validationID(models.HostValidationIDSufficientPacketLossRequirementForRole)
+	IsCPUArchitectureCompatible                    = validationID(models.HostValidationIDCompatibleCPUArchitecture)
+	AreSriovRequirementsSatisfied                  = validationID(models.HostValidationIDSriovRequirementsSatisfied)
+	AreLvmRequirementsSatisfied                    = validationID(models.HostValidationIDLvmRequirementsSatisfied)
+	IsHardwareUnchanged                            = validationID(models.HostValidationIDHardwareUnchanged)
+	IsMtuConsistent                                = validationID(models.HostValidationIDMtuConsistent)
 )
 
 func (v validationID) category() (string, error) {
+	if isCustomValidationID(v) {
+		return customValidationCategory, nil
+	}
 	switch v {
 	case IsConnected, IsMachineCidrDefined, BelongsToMachineCidr,
-		IsAPIVipConnected, BelongsToMajorityGroup, IsNTPSynced, SucessfullOrUnknownContainerImagesAvailability, HasSufficientNetworkLatencyRequirementForRole, HasSufficientPacketLossRequirementForRole:
+		IsAPIVipConnected, BelongsToMajorityGroup, IsNTPSynced, SucessfullOrUnknownContainerImagesAvailability, HasSufficientNetworkLatencyRequirementForRole, HasSufficientPacketLossRequirementForRole,
+		IsMtuConsistent:
 		return "network", nil
 	case HasInventory, HasMinCPUCores, HasMinValidDisks, HasMinMemory, SufficientOrUnknownInstallationDiskSpeed,
-		HasCPUCoresForRole, HasMemoryForRole, IsHostnameUnique, IsHostnameValid, IsPlatformValid:
+		HasCPUCoresForRole, HasMemoryForRole, IsHostnameUnique, IsHostnameValid, IsPlatformValid, IsCPUArchitectureCompatible,
+		IsHardwareUnchanged:
 		return "hardware", nil
-	case AreLsoRequirementsSatisfied, AreOcsRequirementsSatisfied, AreCnvRequirementsSatisfied:
+	case AreLsoRequirementsSatisfied, AreOcsRequirementsSatisfied, AreCnvRequirementsSatisfied, AreSriovRequirementsSatisfied, AreLvmRequirementsSatisfied:
+		return "operators", nil
+	}
+	if strings.HasSuffix(string(v), api.ValidationIDSuffix) {
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
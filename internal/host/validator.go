@@ -45,6 +45,11 @@ var (
 	forbiddenHostnames = []string{
 		"localhost",
 	}
+
+	// The agent reports the CPU architecture as named by the kernel
+	reportedCPUArchitectures = map[string]string{
+		"aarch64": models.ClusterCreateParamsCPUArchitectureArm64,
+	}
 )
 
 func (v ValidationStatus) String() string {
@@ -59,6 +64,7 @@ type validationContext struct {
 	clusterHostRequirements *models.ClusterHostRequirements
 	minCPUCoresRequirement  int64
 	minRAMMibRequirement    int64
+	mtuProblems             []string
 }
 
 type validationCondition func(context *validationContext) ValidationStatus
@@ -79,6 +85,15 @@ func (c *validationContext) loadCluster() error {
 	return err
 }
 
+// getMtuProblems returns the MTU problems of the host, which are calculated once per validation context since they
+// require the inventories of all the hosts of the cluster
+func (c *validationContext) getMtuProblems() []string {
+	if c.mtuProblems == nil {
+		c.mtuProblems = network.GetMtuProblems(c.cluster, c.host, c.inventory)
+	}
+	return c.mtuProblems
+}
+
 func (c *validationContext) loadInventory() error {
 	if c.host.Inventory != "" {
 		var inventory models.Inventory
@@ -351,6 +366,99 @@ func (v *validator) printValidPlatform(c *validationContext, status ValidationSt
 	}
 }
 
+func getHostCPUArchitecture(inventory *models.Inventory) string {
+	if inventory.CPU.Architecture == "" {
+		// Agents that don't report the architecture run only on the default one
+		return common.DefaultCPUArchitecture
+	}
+	if cpuArchitecture, ok := reportedCPUArchitectures[inventory.CPU.Architecture]; ok {
+		return cpuArchitecture
+	}
+	return inventory.CPU.Architecture
+}
+
+func getClusterCPUArchitecture(cluster *common.Cluster) string {
+	if cluster.CPUArchitecture == "" {
+		return common.DefaultCPUArchitecture
+	}
+	return cluster.CPUArchitecture
+}
+
+func (v *validator) isCPUArchitectureCompatible(c *validationContext) ValidationStatus {
+	if c.inventory == nil {
+		return ValidationPending
+	}
+	return boolValue(getHostCPUArchitecture(c.inventory) == getClusterCPUArchitecture(c.cluster))
+}
+
+func (v *validator) printCPUArchitectureCompatible(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return fmt.Sprintf("Host CPU architecture %s matches the cluster CPU architecture", getHostCPUArchitecture(c.inventory))
+	case ValidationFailure:
+		return fmt.Sprintf("Host CPU architecture %s does not match the cluster CPU architecture %s",
+			getHostCPUArchitecture(c.inventory), getClusterCPUArchitecture(c.cluster))
+	case ValidationPending:
+		return "Missing inventory"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+// hardwareChanges returns the changes of the hardware of the host since its role was assigned or its installation
+// disk was selected
+func hardwareChanges(c *validationContext) []*models.InventoryChange {
+	if c.host.HardwareBaseline == "" || c.inventory == nil {
+		return nil
+	}
+	baseline, err := hostutil.UnmarshalInventory(c.host.HardwareBaseline)
+	if err != nil {
+		return nil
+	}
+	return hostutil.HardwareChanges(hostutil.DiffInventories(baseline, c.inventory))
+}
+
+func (v *validator) isHardwareUnchanged(c *validationContext) ValidationStatus {
+	if c.inventory == nil {
+		return ValidationPending
+	}
+	return boolValue(len(hardwareChanges(c)) == 0)
+}
+
+func (v *validator) printHardwareUnchanged(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "Host hardware is unchanged"
+	case ValidationFailure:
+		return fmt.Sprintf("Host hardware changed after its role was assigned or its installation disk was selected: %s. Reassign the role or reselect the installation disk of the host",
+			hostutil.FormatInventoryChanges(hardwareChanges(c)))
+	case ValidationPending:
+		return "Missing inventory"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) isMtuConsistent(c *validationContext) ValidationStatus {
+	if c.inventory == nil {
+		return ValidationPending
+	}
+	return boolValue(len(c.getMtuProblems()) == 0)
+}
+
+func (v *validator) printMtuConsistent(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "Host MTU is consistent"
+	case ValidationFailure:
+		return fmt.Sprintf("Host MTU is inconsistent: %s", strings.Join(c.getMtuProblems(), "; "))
+	case ValidationPending:
+		return "Missing inventory"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
 func (v *validator) printHasMemoryForRole(c *validationContext, status ValidationStatus) string {
 	switch status {
 	case ValidationSuccess:
@@ -366,24 +474,25 @@ func (v *validator) printHasMemoryForRole(c *validationContext, status Validatio
 }
 
 func (v *validator) belongsToMachineCidr(c *validationContext) ValidationStatus {
-	if swag.StringValue(c.cluster.Kind) == models.ClusterKindAddHostsCluster || (swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(c.cluster)) {
+	if swag.StringValue(c.cluster.Kind) == models.ClusterKindAddHostsCluster || (swag.BoolValue(c.cluster.UserManagedNetworking) &&
+		!common.IsSingleNodeCluster(c.cluster) && !common.IsMultiSubnetCluster(c.cluster)) {
 		return ValidationSuccess
 	}
 	if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
 		return ValidationPending
 	}
-	return boolValue(network.IsHostInMachineNetCidr(v.log, c.cluster, c.host))
+	return boolValue(network.IsHostInMachineNetCidrs(v.log, c.cluster, c.host))
 }
 
 func (v *validator) printBelongsToMachineCidr(c *validationContext, status ValidationStatus) string {
 	switch status {
 	case ValidationSuccess:
-		if swag.BoolValue(c.cluster.UserManagedNetworking) {
+		if swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsMultiSubnetCluster(c.cluster) {
 			return "No machine network CIDR validation needed: User Managed Networking"
 		}
-		return fmt.Sprintf("Host belongs to machine network CIDR %s", c.cluster.MachineNetworkCidr)
+		return fmt.Sprintf("Host belongs to machine network CIDR %s", strings.Join(common.GetMachineNetworkCidrs(c.cluster), ", "))
 	case ValidationFailure:
-		return fmt.Sprintf("Host does not belong to machine network CIDR %s", c.cluster.MachineNetworkCidr)
+		return fmt.Sprintf("Host does not belong to machine network CIDR %s", strings.Join(common.GetMachineNetworkCidrs(c.cluster), ", "))
 	case ValidationPending:
 		return "Missing inventory or machine network CIDR"
 	default:
@@ -493,7 +602,7 @@ func getNumEnabledHosts(hosts []*models.Host) int {
 }
 
 func (v *validator) belongsToMajorityGroup(c *validationContext) ValidationStatus {
-	if hostutil.IsDay2Host(c.host) || swag.BoolValue(c.cluster.UserManagedNetworking) {
+	if hostutil.IsDay2Host(c.host) || (swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsMultiSubnetCluster(c.cluster)) {
 		return ValidationSuccess
 	}
 	if c.cluster.MachineNetworkCidr == "" || c.cluster.ConnectivityMajorityGroups == "" {
@@ -505,7 +614,12 @@ func (v *validator) belongsToMajorityGroup(c *validationContext) ValidationStatu
 		v.log.WithError(err).Warn("Parse majority group")
 		return ValidationError
 	}
-	if funk.Contains(majorityGroups[c.cluster.MachineNetworkCidr], *c.host.ID) {
+	// The hosts of multi-subnet clusters are connected over L3 across all the machine networks
+	majorityGroupKey := c.cluster.MachineNetworkCidr
+	if common.IsMultiSubnetCluster(c.cluster) {
+		majorityGroupKey = network.L3MajorityGroupKey(common.GetMachineNetworkCidrs(c.cluster))
+	}
+	if funk.Contains(majorityGroups[majorityGroupKey], *c.host.ID) {
 		return ValidationSuccess
 	} else if getNumEnabledHosts(c.cluster.Hosts) < 3 {
 		// The minimum non disabled hosts for connectivity check is 3
@@ -520,7 +634,7 @@ func (v *validator) printBelongsToMajorityGroup(c *validationContext, status Val
 		if hostutil.IsDay2Host(c.host) {
 			return "Day2 host is not required to be connected to other hosts in the cluster"
 		}
-		if swag.BoolValue(c.cluster.UserManagedNetworking) {
+		if swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsMultiSubnetCluster(c.cluster) {
 			return "L2 connectivy validation skipped: User Managed Networking"
 		}
 		return "Host has connectivity to the majority of hosts in the cluster"
@@ -14,13 +14,32 @@ func IsAdmin(ctx context.Context) bool {
 	return funk.Contains(allowedRoles, authPayload.Role)
 }
 
-func AddUserFilter(ctx context.Context, query string) string {
+// IsFullAdmin returns whether the user is an admin that may modify the resources of other users, unlike a read-only
+// admin
+func IsFullAdmin(ctx context.Context) bool {
+	return ocm.PayloadFromContext(ctx).Role == ocm.AdminRole
+}
+
+// AddUserFilter limits a clusters query to the clusters that the user owns or that are shared with the user.
+// It returns the query and its arguments, with the arguments of the user filter appended to args.
+func AddUserFilter(ctx context.Context, query string, args ...interface{}) (string, []interface{}) {
+	return addUserFilter(ctx, query, "id", args)
+}
+
+// AddHostUserFilter limits a hosts query to the hosts of the clusters that the user owns or that are shared with the user.
+// It returns the query and its arguments, with the arguments of the user filter appended to args.
+func AddHostUserFilter(ctx context.Context, query string, args ...interface{}) (string, []interface{}) {
+	return addUserFilter(ctx, query, "cluster_id", args)
+}
+
+func addUserFilter(ctx context.Context, query string, clusterIDColumn string, args []interface{}) (string, []interface{}) {
 	if !IsAdmin(ctx) {
 		if query != "" {
 			query += " and "
 		}
 		username := ocm.UserNameFromContext(ctx)
-		query += fmt.Sprintf("user_name = '%s'", username)
+		query += fmt.Sprintf("(user_name = ? or %s in (select cluster_id from cluster_accesses where user_name = ?))", clusterIDColumn)
+		args = append(args, username, username)
 	}
-	return query
+	return query, args
 }
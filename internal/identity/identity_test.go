@@ -41,38 +41,89 @@ var _ = Describe("Identity", func() {
 		})
 	})
 
+	Context("IsFullAdmin", func() {
+		It("admin user", func() {
+			payload := &ocm.AuthPayload{}
+			payload.Role = ocm.AdminRole
+			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
+
+			Expect(IsFullAdmin(ctx)).Should(Equal(true))
+		})
+		It("read-only admin user", func() {
+			payload := &ocm.AuthPayload{}
+			payload.Role = ocm.ReadOnlyAdminRole
+			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
+
+			Expect(IsFullAdmin(ctx)).Should(Equal(false))
+		})
+	})
+
 	Context("AddUserFilter", func() {
 		It("admin user - empty query", func() {
 			payload := &ocm.AuthPayload{}
 			payload.Role = ocm.AdminRole
 			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
-			query := AddUserFilter(ctx, "")
+			query, args := AddUserFilter(ctx, "")
 
 			Expect(query).Should(Equal(""))
+			Expect(args).Should(BeEmpty())
 		})
 		It("admin user - non-empty query", func() {
 			payload := &ocm.AuthPayload{}
 			payload.Role = ocm.AdminRole
 			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
-			query := AddUserFilter(ctx, "id = ?")
+			query, args := AddUserFilter(ctx, "id = ?", "cluster-id")
 
 			Expect(query).Should(Equal("id = ?"))
+			Expect(args).Should(Equal([]interface{}{"cluster-id"}))
 		})
 		It("non-admin user - empty query", func() {
 			payload := &ocm.AuthPayload{}
 			payload.Username = "test_user"
 			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
-			query := AddUserFilter(ctx, "")
+			query, args := AddUserFilter(ctx, "")
 
-			Expect(query).Should(Equal("user_name = 'test_user'"))
+			Expect(query).Should(Equal("(user_name = ? or id in (select cluster_id from cluster_accesses where user_name = ?))"))
+			Expect(args).Should(Equal([]interface{}{"test_user", "test_user"}))
 		})
 		It("non-admin user - non-empty query", func() {
 			payload := &ocm.AuthPayload{}
 			payload.Username = "test_user"
 			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
-			query := AddUserFilter(ctx, "id = ?")
+			query, args := AddUserFilter(ctx, "id = ?", "cluster-id")
+
+			Expect(query).Should(Equal("id = ? and (user_name = ? or id in (select cluster_id from cluster_accesses where user_name = ?))"))
+			Expect(args).Should(Equal([]interface{}{"cluster-id", "test_user", "test_user"}))
+		})
+		It("non-admin user - user name is not part of the query", func() {
+			payload := &ocm.AuthPayload{}
+			payload.Username = "test_user' or '1' = '1"
+			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
+			query, args := AddUserFilter(ctx, "")
+
+			Expect(query).ShouldNot(ContainSubstring("test_user"))
+			Expect(args).Should(Equal([]interface{}{payload.Username, payload.Username}))
+		})
+	})
+
+	Context("AddHostUserFilter", func() {
+		It("admin user", func() {
+			payload := &ocm.AuthPayload{}
+			payload.Role = ocm.AdminRole
+			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
+			query, args := AddHostUserFilter(ctx, "id = ? and cluster_id = ?", "host-id", "cluster-id")
+
+			Expect(query).Should(Equal("id = ? and cluster_id = ?"))
+			Expect(args).Should(Equal([]interface{}{"host-id", "cluster-id"}))
+		})
+		It("non-admin user", func() {
+			payload := &ocm.AuthPayload{}
+			payload.Username = "test_user"
+			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
+			query, args := AddHostUserFilter(ctx, "id = ? and cluster_id = ?", "host-id", "cluster-id")
 
-			Expect(query).Should(Equal("id = ? and user_name = 'test_user'"))
+			Expect(query).Should(Equal("id = ? and cluster_id = ? and (user_name = ? or cluster_id in (select cluster_id from cluster_accesses where user_name = ?))"))
+			Expect(args).Should(Equal([]interface{}{"host-id", "cluster-id", "test_user", "test_user"}))
 		})
 	})
 })
@@ -29,10 +29,12 @@ type host struct {
 }
 
 type baremetal struct {
-	ProvisioningNetwork string `yaml:"provisioningNetwork"`
-	APIVIP              string `yaml:"apiVIP"`
-	IngressVIP          string `yaml:"ingressVIP"`
-	Hosts               []host `yaml:"hosts"`
+	ProvisioningNetwork string   `yaml:"provisioningNetwork"`
+	APIVIP              string   `yaml:"apiVIP"`
+	APIVIPs             []string `yaml:"apiVIPs,omitempty"`
+	IngressVIP          string   `yaml:"ingressVIP"`
+	IngressVIPs         []string `yaml:"ingressVIPs,omitempty"`
+	Hosts               []host   `yaml:"hosts"`
 }
 
 type platform struct {
@@ -80,11 +82,13 @@ type InstallerConfigBaremetal struct {
 		Hyperthreading string `yaml:"hyperthreading,omitempty"`
 		Name           string `yaml:"name"`
 		Replicas       int    `yaml:"replicas"`
+		Architecture   string `yaml:"architecture,omitempty"`
 	} `yaml:"compute"`
 	ControlPlane struct {
 		Hyperthreading string `yaml:"hyperthreading,omitempty"`
 		Name           string `yaml:"name"`
 		Replicas       int    `yaml:"replicas"`
+		Architecture   string `yaml:"architecture,omitempty"`
 	} `yaml:"controlPlane"`
 	Platform              platform             `yaml:"platform"`
 	BootstrapInPlace      bootstrapInPlace     `yaml:"bootstrapInPlace,omitempty"`
@@ -157,7 +161,8 @@ func (i *installConfigBuilder) getBMHName(host *models.Host, masterIdx, workerId
 
 func (i *installConfigBuilder) getNetworkType(cluster *common.Cluster) string {
 	networkType := "OpenShiftSDN"
-	if network.IsIPv6CIDR(cluster.ClusterNetworkCidr) || network.IsIPv6CIDR(cluster.MachineNetworkCidr) || network.IsIPv6CIDR(cluster.ServiceNetworkCidr) {
+	if network.IsIPv6CIDR(cluster.ClusterNetworkCidr) || network.IsIPv6CIDR(cluster.MachineNetworkCidr) || network.IsIPv6CIDR(cluster.ServiceNetworkCidr) ||
+		common.IsDualStackCluster(cluster) {
 		networkType = "OVNKubernetes"
 	}
 	return networkType
@@ -170,12 +175,17 @@ func (i *installConfigBuilder) generateNoProxy(cluster *common.Cluster) string {
 	}
 
 	splitNoProxy := funk.FilterString(strings.Split(noProxy, ","), func(s string) bool { return s != "" })
-	if cluster.MachineNetworkCidr != "" {
-		splitNoProxy = append(splitNoProxy, cluster.MachineNetworkCidr)
-	}
+	splitNoProxy = append(splitNoProxy, common.GetMachineNetworkCidrs(cluster)...)
 	// Add internal OCP DNS domain
-	internalDnsDomain := "." + cluster.Name + "." + cluster.BaseDNSDomain
-	return strings.Join(append(splitNoProxy, internalDnsDomain, cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr), ",")
+	splitNoProxy = append(splitNoProxy, "."+cluster.Name+"."+cluster.BaseDNSDomain, cluster.ClusterNetworkCidr)
+	if cluster.SecondaryClusterNetworkCidr != "" {
+		splitNoProxy = append(splitNoProxy, cluster.SecondaryClusterNetworkCidr)
+	}
+	splitNoProxy = append(splitNoProxy, cluster.ServiceNetworkCidr)
+	if cluster.SecondaryServiceNetworkCidr != "" {
+		splitNoProxy = append(splitNoProxy, cluster.SecondaryServiceNetworkCidr)
+	}
+	return strings.Join(splitNoProxy, ",")
 }
 
 func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*InstallerConfigBaremetal, error) {
@@ -218,26 +228,46 @@ func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*
 			Hyperthreading string `yaml:"hyperthreading,omitempty"`
 			Name           string `yaml:"name"`
 			Replicas       int    `yaml:"replicas"`
+			Architecture   string `yaml:"architecture,omitempty"`
 		}{
 			{
 				Hyperthreading: i.getHypethreadingConfiguration(cluster, "worker"),
 				Name:           string(models.HostRoleWorker),
 				Replicas:       i.countHostsByRole(cluster, models.HostRoleWorker),
+				Architecture:   i.getArchitecture(cluster),
 			},
 		},
 		ControlPlane: struct {
 			Hyperthreading string `yaml:"hyperthreading,omitempty"`
 			Name           string `yaml:"name"`
 			Replicas       int    `yaml:"replicas"`
+			Architecture   string `yaml:"architecture,omitempty"`
 		}{
 			Hyperthreading: i.getHypethreadingConfiguration(cluster, "master"),
 			Name:           string(models.HostRoleMaster),
 			Replicas:       i.countHostsByRole(cluster, models.HostRoleMaster),
+			Architecture:   i.getArchitecture(cluster),
 		},
 		PullSecret: cluster.PullSecret,
 		SSHKey:     cluster.SSHPublicKey,
 	}
 
+	// Dual-stack clusters have a network of each IP address family, the IPv4 one first
+	if common.IsDualStackCluster(cluster) {
+		cfg.Networking.ClusterNetwork = cfg.Networking.ClusterNetwork[:0]
+		for _, clusterNetwork := range common.GetClusterNetworks(cluster) {
+			cfg.Networking.ClusterNetwork = append(cfg.Networking.ClusterNetwork, struct {
+				Cidr       string `yaml:"cidr"`
+				HostPrefix int    `yaml:"hostPrefix"`
+			}{Cidr: clusterNetwork.Cidr, HostPrefix: int(clusterNetwork.HostPrefix)})
+		}
+		cfg.Networking.ServiceNetwork = common.GetServiceNetworkCidrs(cluster)
+	}
+	// Multi-subnet clusters have all the subnets of their hosts as machine networks
+	if common.IsDualStackCluster(cluster) || common.IsMultiSubnetCluster(cluster) {
+		setMachineNetworks(cfg, common.GetMachineNetworkCidrs(cluster))
+	}
+
 	if cluster.HTTPProxy != "" || cluster.HTTPSProxy != "" {
 		cfg.Proxy = &proxy{
 			HTTPProxy:  cluster.HTTPProxy,
@@ -329,9 +359,22 @@ func (i *installConfigBuilder) setBMPlatformInstallconfig(cluster *common.Cluste
 		},
 		None: nil,
 	}
+	if cluster.SecondaryAPIVip != "" || cluster.SecondaryIngressVip != "" {
+		cfg.Platform.Baremetal.APIVIPs = common.GetAPIVips(cluster)
+		cfg.Platform.Baremetal.IngressVIPs = common.GetIngressVips(cluster)
+	}
 	return nil
 }
 
+func setMachineNetworks(cfg *InstallerConfigBaremetal, cidrs []string) {
+	cfg.Networking.MachineNetwork = cfg.Networking.MachineNetwork[:0]
+	for _, cidr := range cidrs {
+		cfg.Networking.MachineNetwork = append(cfg.Networking.MachineNetwork, struct {
+			Cidr string `yaml:"cidr"`
+		}{Cidr: cidr})
+	}
+}
+
 func (i *installConfigBuilder) applyConfigOverrides(overrides string, cfg *InstallerConfigBaremetal) error {
 	if overrides == "" {
 		return nil
@@ -356,7 +399,10 @@ func (i *installConfigBuilder) getInstallConfig(cluster *common.Cluster, addRhCa
 		}
 
 		bootstrapCidr := network.GetMachineCidrForUserManagedNetwork(cluster, i.log)
-		if bootstrapCidr != "" {
+		if common.IsMultiSubnetCluster(cluster) {
+			i.log.Infof("None-Platform: Selected machine networks %s for multi-subnet cluster %s",
+				strings.Join(common.GetMachineNetworkCidrs(cluster), ", "), cluster.ID.String())
+		} else if bootstrapCidr != "" {
 			i.log.Infof("None-Platform: Selected bootstrap machine network CIDR %s for cluster %s", bootstrapCidr, cluster.ID.String())
 			cfg.Networking.MachineNetwork = []struct {
 				Cidr string `yaml:"cidr"`
@@ -435,6 +481,15 @@ func (i *installConfigBuilder) getHypethreadingConfiguration(cluster *common.Clu
 	return "Disabled"
 }
 
+// getArchitecture returns the machine pool architecture. The installer names the default
+// architecture amd64, so it is left empty and the installer default is used instead.
+func (i *installConfigBuilder) getArchitecture(cluster *common.Cluster) string {
+	if cluster.CPUArchitecture == common.DefaultCPUArchitecture {
+		return ""
+	}
+	return cluster.CPUArchitecture
+}
+
 func (i *installConfigBuilder) getCAContents(cluster *common.Cluster, rhRootCA string, installRHRootCAFlag bool) string {
 	// CA for mirror registries and RH CA are mutually exclusive
 	if i.mirrorRegistriesBuilder.IsMirrorRegistriesConfigured() {
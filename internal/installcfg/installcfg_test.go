@@ -202,6 +202,48 @@ var _ = Describe("installcfg", func() {
 		Expect(result.Networking.NetworkType).Should(Equal(OvnKubernetes))
 	})
 
+	It("dual-stack networks", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = ""
+		cluster.ClusterNetworkCidr = "10.128.0.0/14"
+		cluster.ClusterNetworkHostPrefix = 23
+		cluster.ServiceNetworkCidr = "172.30.0.0/16"
+		cluster.SecondaryMachineNetworkCidr = "1001:db8::/120"
+		cluster.SecondaryClusterNetworkCidr = "fd01::/48"
+		cluster.SecondaryClusterNetworkHostPrefix = 64
+		cluster.SecondaryServiceNetworkCidr = "fd02::/112"
+		cluster.SecondaryAPIVip = "1001:db8::64"
+		cluster.SecondaryIngressVip = "1001:db8::65"
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		data, err := installConfig.GetInstallConfig(&cluster, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Networking.NetworkType).Should(Equal(OvnKubernetes))
+		Expect(result.Networking.MachineNetwork).Should(HaveLen(2))
+		Expect(result.Networking.MachineNetwork[0].Cidr).Should(Equal("1.2.3.0/24"))
+		Expect(result.Networking.MachineNetwork[1].Cidr).Should(Equal("1001:db8::/120"))
+		Expect(result.Networking.ClusterNetwork).Should(HaveLen(2))
+		Expect(result.Networking.ClusterNetwork[1].Cidr).Should(Equal("fd01::/48"))
+		Expect(result.Networking.ClusterNetwork[1].HostPrefix).Should(Equal(64))
+		Expect(result.Networking.ServiceNetwork).Should(Equal([]string{"172.30.0.0/16", "fd02::/112"}))
+		Expect(result.Platform.Baremetal.APIVIP).Should(Equal("102.345.34.34"))
+		Expect(result.Platform.Baremetal.APIVIPs).Should(Equal([]string{"102.345.34.34", "1001:db8::64"}))
+		Expect(result.Platform.Baremetal.IngressVIPs).Should(Equal([]string{"376.5.56.6", "1001:db8::65"}))
+	})
+
+	It("single-stack VIPs", func() {
+		var result InstallerConfigBaremetal
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		data, err := installConfig.GetInstallConfig(&cluster, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Networking.MachineNetwork).Should(HaveLen(1))
+		Expect(result.Platform.Baremetal.APIVIPs).Should(BeEmpty())
+		Expect(string(data)).ShouldNot(ContainSubstring("apiVIPs"))
+	})
+
 	It("doesn't fail with empty overrides, IPv6 service CIDR", func() {
 		var result InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
@@ -312,6 +354,24 @@ var _ = Describe("installcfg", func() {
 		Expect(result.Networking.NetworkType).Should(Equal(OvnKubernetes))
 	})
 
+	It("UserManagedNetworking None Platform multi-subnet machine networks", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = ""
+		cluster.UserManagedNetworking = swag.Bool(true)
+		cluster.AdditionalMachineNetworkCidrs = "1.2.4.0/24,1.2.5.0/24"
+		host1.Bootstrap = true
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		data, err := installConfig.GetInstallConfig(&cluster, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Platform.None).ShouldNot(BeNil())
+		Expect(result.Networking.MachineNetwork).Should(HaveLen(3))
+		Expect(result.Networking.MachineNetwork[0].Cidr).Should(Equal("1.2.3.0/24"))
+		Expect(result.Networking.MachineNetwork[1].Cidr).Should(Equal("1.2.4.0/24"))
+		Expect(result.Networking.MachineNetwork[2].Cidr).Should(Equal("1.2.5.0/24"))
+	})
+
 	It("UserManagedNetworking BareMetal", func() {
 		var result InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
@@ -392,6 +452,21 @@ var _ = Describe("installcfg", func() {
 		Expect(data.Compute[0].Hyperthreading).Should(Equal("Disabled"))
 	})
 
+	It("CPU architecture config", func() {
+		cluster.CPUArchitecture = common.DefaultCPUArchitecture
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+		data, err := installConfig.getBasicInstallConfig(&cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(data.ControlPlane.Architecture).Should(BeEmpty())
+		Expect(data.Compute[0].Architecture).Should(BeEmpty())
+		cluster.CPUArchitecture = models.ClusterCreateParamsCPUArchitectureArm64
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+		data, err = installConfig.getBasicInstallConfig(&cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(data.ControlPlane.Architecture).Should(Equal("arm64"))
+		Expect(data.Compute[0].Architecture).Should(Equal("arm64"))
+	})
+
 	AfterEach(func() {
 		// cleanup
 		ctrl.Finish()
@@ -499,6 +574,14 @@ var _ = Describe("Generate NoProxy", func() {
 		noProxy := installConfig.generateNoProxy(cluster)
 		Expect(noProxy).Should(Equal("domain.org,127.0.0.2,10.35.20.0/24,.proxycluster.myproxy.com,192.168.1.0/24,fe80::1/64"))
 	})
+	It("Dual-stack NoProxy", func() {
+		cluster.ServiceNetworkCidr = "172.30.0.0/16"
+		cluster.SecondaryMachineNetworkCidr = "1001:db8::/120"
+		cluster.SecondaryClusterNetworkCidr = "fd01::/48"
+		cluster.SecondaryServiceNetworkCidr = "fd02::/112"
+		noProxy := installConfig.generateNoProxy(cluster)
+		Expect(noProxy).Should(Equal("10.35.20.0/24,1001:db8::/120,.proxycluster.myproxy.com,192.168.1.0/24,fd01::/48,172.30.0.0/16,fd02::/112"))
+	})
 	It("All-excluded NoProxy", func() {
 		cluster.NoProxy = "*"
 		noProxy := installConfig.generateNoProxy(cluster)
@@ -0,0 +1,174 @@
+package installscheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+type Config struct {
+	Interval time.Duration `envconfig:"INSTALL_SCHEDULER_INTERVAL" default:"30s"`
+}
+
+//go:generate mockgen -source=installscheduler.go -package=installscheduler -destination=mock_installscheduler.go
+type ClusterInstaller interface {
+	InstallClusterInternal(ctx context.Context, params installer.InstallClusterParams) (*common.Cluster, error)
+}
+
+// preInstallationStatuses are the statuses in which a scheduled installation is still waiting to start
+var preInstallationStatuses = []string{
+	models.ClusterStatusInsufficient,
+	models.ClusterStatusReady,
+	models.ClusterStatusPendingForInput,
+}
+
+// Scheduler starts the installation of the clusters whose installation schedule is met, and cancels the
+// schedules whose installation window ended before the clusters were ready
+type Scheduler struct {
+	Config
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	installer     ClusterInstaller
+	eventsHandler events.Handler
+	leaderElector leader.Leader
+}
+
+func NewScheduler(cfg Config, db *gorm.DB, log logrus.FieldLogger, installer ClusterInstaller,
+	eventsHandler events.Handler, leaderElector leader.Leader) *Scheduler {
+	return &Scheduler{
+		Config:        cfg,
+		db:            db,
+		log:           log,
+		installer:     installer,
+		eventsHandler: eventsHandler,
+		leaderElector: leaderElector,
+	}
+}
+
+// ScheduleTask evaluates the pending installation schedules
+func (s *Scheduler) ScheduleTask() {
+	if !s.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	log := logutil.FromContext(ctx, s.log)
+
+	var clusters []*common.Cluster
+	if err := s.db.Preload("Hosts").Find(&clusters, "install_schedule_status = ?",
+		models.InstallScheduleStatusPending).Error; err != nil {
+		log.WithError(err).Error("failed to get clusters with a pending installation schedule")
+		return
+	}
+	for _, cluster := range clusters {
+		s.evaluate(ctx, cluster, time.Now())
+	}
+}
+
+func (s *Scheduler) evaluate(ctx context.Context, cluster *common.Cluster, now time.Time) {
+	log := logutil.FromContext(ctx, s.log)
+	schedule := cluster.InstallSchedule
+	status := swag.StringValue(cluster.Status)
+
+	if !funk.ContainsString(preInstallationStatuses, status) {
+		s.cancel(ctx, cluster, fmt.Sprintf("Scheduled installation was canceled since the cluster is in status %s", status))
+		return
+	}
+	if schedule.NotBefore != nil && now.Before(time.Time(*schedule.NotBefore)) {
+		return
+	}
+
+	reason := s.waitReason(cluster, now)
+	if schedule.NotAfter != nil && now.After(time.Time(*schedule.NotAfter)) {
+		// the installation does not start outside of the window, even if the cluster became ready in the meantime
+		if reason != "" {
+			s.cancel(ctx, cluster, fmt.Sprintf("Scheduled installation was canceled since the installation window ended while waiting for %s", reason))
+		} else {
+			s.cancel(ctx, cluster, "Scheduled installation was canceled since the installation window ended")
+		}
+		return
+	}
+	if reason != "" {
+		s.updateStatusInfo(ctx, cluster, fmt.Sprintf("Waiting for %s", reason))
+		return
+	}
+
+	log.Infof("Starting the scheduled installation of cluster %s", cluster.ID.String())
+	if _, err := s.installer.InstallClusterInternal(ctx, installer.InstallClusterParams{ClusterID: *cluster.ID}); err != nil {
+		log.WithError(err).Warnf("failed to start the scheduled installation of cluster %s", cluster.ID.String())
+		s.updateStatusInfo(ctx, cluster, fmt.Sprintf("Failed to start the installation: %s", err.Error()))
+		return
+	}
+	s.markTriggered(ctx, cluster)
+	s.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityInfo, "Scheduled installation was started", now)
+}
+
+// waitReason returns the reason that the installation policy is not met yet, or an empty string if it is met
+func (s *Scheduler) waitReason(cluster *common.Cluster, now time.Time) string {
+	if swag.StringValue(cluster.Status) != models.ClusterStatusReady {
+		return fmt.Sprintf("the cluster to be ready (%s)", swag.StringValue(cluster.StatusInfo))
+	}
+	knownFor := time.Duration(cluster.InstallSchedule.HostsKnownForMinutes) * time.Minute
+	for _, h := range cluster.Hosts {
+		hostStatus := swag.StringValue(h.Status)
+		if hostStatus == models.HostStatusDisabled {
+			continue
+		}
+		if hostStatus != models.HostStatusKnown || now.Sub(time.Time(h.StatusUpdatedAt)) < knownFor {
+			return fmt.Sprintf("all hosts to be known for %d minutes", cluster.InstallSchedule.HostsKnownForMinutes)
+		}
+	}
+	return ""
+}
+
+func (s *Scheduler) cancel(ctx context.Context, cluster *common.Cluster, reason string) {
+	logutil.FromContext(ctx, s.log).Infof("%s, cluster %s", reason, cluster.ID.String())
+	s.updateStatus(ctx, cluster, models.InstallScheduleStatusCanceled, reason)
+	s.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityWarning, reason, time.Now())
+}
+
+// markTriggered attributes the triggered schedule to the scheduler. Starting the installation already marks the
+// pending schedule as triggered.
+func (s *Scheduler) markTriggered(ctx context.Context, cluster *common.Cluster) {
+	if err := s.db.Model(&common.Cluster{}).
+		Where("id = ? AND install_schedule_status IN (?)", cluster.ID.String(),
+			[]string{models.InstallScheduleStatusPending, models.InstallScheduleStatusTriggered}).
+		Updates(map[string]interface{}{
+			"install_schedule_status":      models.InstallScheduleStatusTriggered,
+			"install_schedule_status_info": "Installation was started by the schedule",
+		}).Error; err != nil {
+		logutil.FromContext(ctx, s.log).WithError(err).Errorf(
+			"failed to update the installation schedule of cluster %s", cluster.ID.String())
+	}
+}
+
+func (s *Scheduler) updateStatusInfo(ctx context.Context, cluster *common.Cluster, statusInfo string) {
+	if cluster.InstallSchedule.StatusInfo == statusInfo {
+		return
+	}
+	s.updateStatus(ctx, cluster, models.InstallScheduleStatusPending, statusInfo)
+}
+
+func (s *Scheduler) updateStatus(ctx context.Context, cluster *common.Cluster, status, statusInfo string) {
+	// only a pending schedule is updated, in case it was removed or replaced in the meantime
+	if err := s.db.Model(&common.Cluster{}).
+		Where("id = ? AND install_schedule_status = ?", cluster.ID.String(), models.InstallScheduleStatusPending).
+		Updates(map[string]interface{}{
+			"install_schedule_status":      status,
+			"install_schedule_status_info": statusInfo,
+		}).Error; err != nil {
+		logutil.FromContext(ctx, s.log).WithError(err).Errorf(
+			"failed to update the installation schedule of cluster %s", cluster.ID.String())
+	}
+}
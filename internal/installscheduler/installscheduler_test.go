@@ -0,0 +1,157 @@
+package installscheduler
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("ScheduleTask", func() {
+	var (
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockInstaller *MockClusterInstaller
+		mockEvents    *events.MockHandler
+		scheduler     *Scheduler
+		clusterID     strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstaller = NewMockClusterInstaller(ctrl)
+		mockEvents = events.NewMockHandler(ctrl)
+		scheduler = NewScheduler(Config{}, db, logrus.New(), mockInstaller, mockEvents, &leader.DummyElector{})
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createCluster := func(status string, schedule *models.InstallSchedule, hostUpdatedAt time.Time) {
+		schedule.Status = models.InstallScheduleStatusPending
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:              &clusterID,
+			Status:          swag.String(status),
+			StatusInfo:      swag.String("status info"),
+			InstallSchedule: schedule,
+		}}).Error).ShouldNot(HaveOccurred())
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Host{
+			ID:              &hostID,
+			ClusterID:       clusterID,
+			Status:          swag.String(models.HostStatusKnown),
+			StatusUpdatedAt: strfmt.DateTime(hostUpdatedAt),
+		}).Error).ShouldNot(HaveOccurred())
+	}
+
+	getSchedule := func() *models.InstallSchedule {
+		c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		return c.InstallSchedule
+	}
+
+	dateTime := func(d time.Duration) *strfmt.DateTime {
+		t := strfmt.DateTime(time.Now().Add(d))
+		return &t
+	}
+
+	It("starts the installation once the schedule is met", func() {
+		createCluster(models.ClusterStatusReady, &models.InstallSchedule{NotBefore: dateTime(-time.Minute)}, time.Now())
+		mockInstaller.EXPECT().InstallClusterInternal(gomock.Any(), installer.InstallClusterParams{ClusterID: clusterID}).
+			Return(&common.Cluster{}, nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, "Scheduled installation was started", gomock.Any()).Times(1)
+		scheduler.ScheduleTask()
+		Expect(getSchedule().Status).Should(Equal(models.InstallScheduleStatusTriggered))
+	})
+
+	It("waits for the installation window", func() {
+		createCluster(models.ClusterStatusReady, &models.InstallSchedule{NotBefore: dateTime(time.Hour)}, time.Now())
+		scheduler.ScheduleTask()
+		Expect(getSchedule().Status).Should(Equal(models.InstallScheduleStatusPending))
+	})
+
+	It("waits for the hosts to be known long enough", func() {
+		createCluster(models.ClusterStatusReady, &models.InstallSchedule{HostsKnownForMinutes: 10}, time.Now().Add(-5*time.Minute))
+		scheduler.ScheduleTask()
+		schedule := getSchedule()
+		Expect(schedule.Status).Should(Equal(models.InstallScheduleStatusPending))
+		Expect(schedule.StatusInfo).Should(Equal("Waiting for all hosts to be known for 10 minutes"))
+	})
+
+	It("starts once the hosts are known long enough", func() {
+		createCluster(models.ClusterStatusReady, &models.InstallSchedule{HostsKnownForMinutes: 10}, time.Now().Add(-15*time.Minute))
+		mockInstaller.EXPECT().InstallClusterInternal(gomock.Any(), gomock.Any()).Return(&common.Cluster{}, nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+		scheduler.ScheduleTask()
+		Expect(getSchedule().Status).Should(Equal(models.InstallScheduleStatusTriggered))
+	})
+
+	It("waits for the cluster to be ready", func() {
+		createCluster(models.ClusterStatusInsufficient, &models.InstallSchedule{NotAfter: dateTime(time.Hour)}, time.Now())
+		scheduler.ScheduleTask()
+		schedule := getSchedule()
+		Expect(schedule.Status).Should(Equal(models.InstallScheduleStatusPending))
+		Expect(schedule.StatusInfo).Should(Equal("Waiting for the cluster to be ready (status info)"))
+	})
+
+	It("cancels the schedule when the window ends while the cluster is not ready", func() {
+		createCluster(models.ClusterStatusInsufficient, &models.InstallSchedule{NotAfter: dateTime(-time.Minute)}, time.Now())
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityWarning, gomock.Any(), gomock.Any()).Times(1)
+		scheduler.ScheduleTask()
+		schedule := getSchedule()
+		Expect(schedule.Status).Should(Equal(models.InstallScheduleStatusCanceled))
+		Expect(schedule.StatusInfo).Should(ContainSubstring("installation window ended"))
+	})
+
+	It("cancels the schedule when the window ended before the cluster was ready", func() {
+		createCluster(models.ClusterStatusReady, &models.InstallSchedule{NotAfter: dateTime(-time.Minute)}, time.Now())
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityWarning,
+			"Scheduled installation was canceled since the installation window ended", gomock.Any()).Times(1)
+		scheduler.ScheduleTask()
+		schedule := getSchedule()
+		Expect(schedule.Status).Should(Equal(models.InstallScheduleStatusCanceled))
+		Expect(schedule.StatusInfo).Should(Equal("Scheduled installation was canceled since the installation window ended"))
+	})
+
+	It("cancels the schedule when the cluster is already installing", func() {
+		createCluster(models.ClusterStatusInstalling, &models.InstallSchedule{}, time.Now())
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityWarning, gomock.Any(), gomock.Any()).Times(1)
+		scheduler.ScheduleTask()
+		Expect(getSchedule().Status).Should(Equal(models.InstallScheduleStatusCanceled))
+	})
+
+	It("keeps the schedule pending when the installation fails to start", func() {
+		createCluster(models.ClusterStatusReady, &models.InstallSchedule{}, time.Now())
+		mockInstaller.EXPECT().InstallClusterInternal(gomock.Any(), gomock.Any()).Return(nil, errors.New("not ready")).Times(1)
+		scheduler.ScheduleTask()
+		schedule := getSchedule()
+		Expect(schedule.Status).Should(Equal(models.InstallScheduleStatusPending))
+		Expect(schedule.StatusInfo).Should(Equal("Failed to start the installation: not ready"))
+	})
+
+	It("does nothing when not the leader", func() {
+		createCluster(models.ClusterStatusReady, &models.InstallSchedule{}, time.Now())
+		mockLeader := leader.NewMockElectorInterface(ctrl)
+		mockLeader.EXPECT().IsLeader().Return(false).Times(1)
+		scheduler = NewScheduler(Config{}, db, logrus.New(), mockInstaller, mockEvents, mockLeader)
+		scheduler.ScheduleTask()
+		Expect(getSchedule().Status).Should(Equal(models.InstallScheduleStatusPending))
+	})
+})
@@ -41,6 +41,8 @@ const (
 	counterFilesystemUsagePercentage              = "assisted_installer_filesystem_usage_percentage"
 	counterMonitoredHosts                         = "assisted_installer_monitored_hosts"
 	counterMonitoredClusters                      = "assisted_installer_monitored_clusters"
+	counterOperatorStatusChanged                  = "assisted_installer_operator_status_changes"
+	counterOperatorTimedOut                       = "assisted_installer_operator_timeouts"
 )
 
 const (
@@ -65,6 +67,8 @@ const (
 	counterDescriptionFilesystemUsagePercentage              = "The percentage of the filesystem usage by the service"
 	counterDescriptionMonitoredHosts                         = "Number of hosts monitored by host monitor"
 	counterDescriptionMonitoredClusters                      = "Number of clusters monitored by cluster monitor"
+	counterDescriptionOperatorStatusChanged                  = "Number of status changes of the monitored operators, by OCP version, operator and status"
+	counterDescriptionOperatorTimedOut                       = "Number of monitored operators that did not become available in time, by OCP version and operator"
 )
 
 const (
@@ -88,6 +92,8 @@ const (
 	hostValidationTypeLabel    = "hostValidationType"
 	clusterValidationTypeLabel = "clusterValidationType"
 	imageLabel                 = "imageName"
+	operatorLabel              = "operator"
+	operatorStatusLabel        = "operatorStatus"
 	hosts                      = "hosts"
 	clusters                   = "clusters"
 )
@@ -108,6 +114,8 @@ type API interface {
 	FileSystemUsage(usageInPercentage float64)
 	MonitoredHostsCount(monitoredHosts int64)
 	MonitoredClusterCount(monitoredClusters int64)
+	MonitoredOperatorStatusChanged(clusterVersion string, operatorName string, status models.OperatorStatus)
+	MonitoredOperatorTimedOut(clusterVersion string, operatorName string)
 }
 
 type MetricsManager struct {
@@ -135,6 +143,8 @@ type MetricsManager struct {
 	serviceLogicFilesystemUsagePercentage              *prometheus.GaugeVec
 	serviceLogicMonitoredHosts                         *prometheus.GaugeVec
 	serviceLogicMonitoredClusters                      *prometheus.GaugeVec
+	serviceLogicOperatorStatusChanged                  *prometheus.CounterVec
+	serviceLogicOperatorTimedOut                       *prometheus.CounterVec
 }
 
 var _ API = &MetricsManager{}
@@ -310,6 +320,22 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler events.Hand
 			Name:      counterMonitoredClusters,
 			Help:      counterDescriptionMonitoredClusters,
 		}, []string{hosts}),
+
+		serviceLogicOperatorStatusChanged: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterOperatorStatusChanged,
+				Help:      counterDescriptionOperatorStatusChanged,
+			}, []string{openshiftVersionLabel, operatorLabel, operatorStatusLabel}),
+
+		serviceLogicOperatorTimedOut: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterOperatorTimedOut,
+				Help:      counterDescriptionOperatorTimedOut,
+			}, []string{openshiftVersionLabel, operatorLabel}),
 	}
 
 	registry.MustRegister(
@@ -332,6 +358,8 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler events.Hand
 		m.serviceLogicFilesystemUsagePercentage,
 		m.serviceLogicMonitoredHosts,
 		m.serviceLogicMonitoredClusters,
+		m.serviceLogicOperatorStatusChanged,
+		m.serviceLogicOperatorTimedOut,
 	)
 	return m
 }
@@ -513,6 +541,14 @@ func (m *MetricsManager) MonitoredClusterCount(monitoredClusters int64) {
 	m.serviceLogicMonitoredClusters.WithLabelValues(clusters).Set(float64(monitoredClusters))
 }
 
+func (m *MetricsManager) MonitoredOperatorStatusChanged(clusterVersion string, operatorName string, status models.OperatorStatus) {
+	m.serviceLogicOperatorStatusChanged.WithLabelValues(clusterVersion, operatorName, string(status)).Inc()
+}
+
+func (m *MetricsManager) MonitoredOperatorTimedOut(clusterVersion string, operatorName string) {
+	m.serviceLogicOperatorTimedOut.WithLabelValues(clusterVersion, operatorName).Inc()
+}
+
 func bytesToGib(bytes int64) int64 {
 	return bytes / int64(units.GiB)
 }
@@ -215,3 +215,27 @@ func (mr *MockAPIMockRecorder) MonitoredClusterCount(monitoredClusters interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitoredClusterCount", reflect.TypeOf((*MockAPI)(nil).MonitoredClusterCount), monitoredClusters)
 }
+
+// MonitoredOperatorStatusChanged mocks base method
+func (m *MockAPI) MonitoredOperatorStatusChanged(clusterVersion, operatorName string, status models.OperatorStatus) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MonitoredOperatorStatusChanged", clusterVersion, operatorName, status)
+}
+
+// MonitoredOperatorStatusChanged indicates an expected call of MonitoredOperatorStatusChanged
+func (mr *MockAPIMockRecorder) MonitoredOperatorStatusChanged(clusterVersion, operatorName, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitoredOperatorStatusChanged", reflect.TypeOf((*MockAPI)(nil).MonitoredOperatorStatusChanged), clusterVersion, operatorName, status)
+}
+
+// MonitoredOperatorTimedOut mocks base method
+func (m *MockAPI) MonitoredOperatorTimedOut(clusterVersion, operatorName string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MonitoredOperatorTimedOut", clusterVersion, operatorName)
+}
+
+// MonitoredOperatorTimedOut indicates an expected call of MonitoredOperatorTimedOut
+func (mr *MockAPIMockRecorder) MonitoredOperatorTimedOut(clusterVersion, operatorName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitoredOperatorTimedOut", reflect.TypeOf((*MockAPI)(nil).MonitoredOperatorTimedOut), clusterVersion, operatorName)
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+// backfillRoleAutoAssigned marks the hosts whose role is still to be selected automatically, so that the role
+// assignment may select their roles
+func backfillRoleAutoAssigned() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		return tx.Model(&common.Host{}).Where("role = ?", models.HostRoleAutoAssign).
+			UpdateColumn("role_auto_assigned", true).Error
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		return tx.Model(&common.Host{}).Where("role_auto_assigned = ?", true).
+			UpdateColumn("role_auto_assigned", false).Error
+	}
+
+	return &gormigrate.Migration{
+		ID:       "20261018120000",
+		Migrate:  migrate,
+		Rollback: rollback,
+	}
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gopkg.in/gormigrate.v1"
+)
+
+var _ = Describe("BackfillRoleAutoAssigned", func() {
+	var (
+		db                         *gorm.DB
+		dbName                     string
+		gm                         *gormigrate.Gormigrate
+		autoAssignHost, masterHost strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID := strfmt.UUID(uuid.New().String())
+		autoAssignHost = strfmt.UUID(uuid.New().String())
+		masterHost = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Host{Host: models.Host{ID: &autoAssignHost, ClusterID: clusterID, Role: models.HostRoleAutoAssign}}).Error).NotTo(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{ID: &masterHost, ClusterID: clusterID, Role: models.HostRoleMaster}}).Error).NotTo(HaveOccurred())
+
+		gm = gormigrate.New(db, gormigrate.DefaultOptions, all())
+		Expect(gm.MigrateTo("20261018120000")).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("Migrates down and up", func() {
+		expectRoleAutoAssigned(db, autoAssignHost.String(), true)
+		expectRoleAutoAssigned(db, masterHost.String(), false)
+
+		Expect(gm.RollbackMigration(backfillRoleAutoAssigned())).ToNot(HaveOccurred())
+		expectRoleAutoAssigned(db, autoAssignHost.String(), false)
+		expectRoleAutoAssigned(db, masterHost.String(), false)
+
+		Expect(gm.MigrateTo("20261018120000")).ToNot(HaveOccurred())
+		expectRoleAutoAssigned(db, autoAssignHost.String(), true)
+		expectRoleAutoAssigned(db, masterHost.String(), false)
+	})
+})
+
+func expectRoleAutoAssigned(db *gorm.DB, hostID string, autoAssigned bool) {
+	var h common.Host
+	Expect(db.First(&h, "id = ?", hostID).Error).ShouldNot(HaveOccurred())
+	Expect(h.RoleAutoAssigned).To(Equal(autoAssigned))
+}
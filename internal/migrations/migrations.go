@@ -17,6 +17,7 @@ func all() []*gormigrate.Migration {
 		changeImageSSHKeyToText(),
 		changeClusterValidationsInfoToText(),
 		changeHostValidationsInfoToText(),
+		backfillRoleAutoAssigned(),
 	}
 
 	sort.SliceStable(allMigrations, func(i, j int) bool { return allMigrations[i].ID < allMigrations[j].ID })
@@ -2,6 +2,7 @@ package network
 
 import (
 	"net"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -125,3 +126,121 @@ func VerifyNetworkHostPrefix(prefix int64) error {
 	}
 	return nil
 }
+
+// VerifyAddressFamilies verifies that the addresses or CIDRs are at most one of each IP address family, the IPv4 one
+// first, as required by dual-stack clusters
+func VerifyAddressFamilies(name string, addresses []string) error {
+	switch {
+	case len(addresses) > 2:
+		return errors.Errorf("%s can have at most one address of each IP address family, got %d", name, len(addresses))
+	case len(addresses) == 2 && (!isIPv4Address(addresses[0]) || isIPv4Address(addresses[1])):
+		return errors.Errorf("%s of a dual-stack cluster must be an IPv4 address followed by an IPv6 address", name)
+	}
+	return nil
+}
+
+// VerifyDualStackFamilies verifies that the cluster and service networks are of the same IP address families
+func VerifyDualStackFamilies(clusterNetworkCidrs, serviceNetworkCidrs []string) error {
+	if len(clusterNetworkCidrs) == 0 || len(serviceNetworkCidrs) == 0 {
+		return nil
+	}
+	if len(clusterNetworkCidrs) != len(serviceNetworkCidrs) ||
+		isIPv4Address(clusterNetworkCidrs[0]) != isIPv4Address(serviceNetworkCidrs[0]) {
+		return errors.Errorf("Cluster networks %s and service networks %s must be of the same IP address families",
+			strings.Join(clusterNetworkCidrs, ", "), strings.Join(serviceNetworkCidrs, ", "))
+	}
+	return nil
+}
+
+// VerifyDualStackCIDRsNotOverlap verifies that the machine, cluster and service networks of each IP address family do
+// not overlap
+func VerifyDualStackCIDRsNotOverlap(machineNetworkCidrs, clusterNetworkCidrs, serviceNetworkCidrs []string, userManagedNetworking bool) error {
+	for _, ipv4 := range []bool{true, false} {
+		if err := VerifyClusterCIDRsNotOverlap(addressOfFamily(machineNetworkCidrs, ipv4), addressOfFamily(clusterNetworkCidrs, ipv4),
+			addressOfFamily(serviceNetworkCidrs, ipv4), userManagedNetworking); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyMachineNetworks verifies the machine networks of a cluster. Multi-subnet clusters may have several machine
+// networks of the same IP address family, which must not overlap. The first network is an IPv4 one when the cluster has
+// networks of both families.
+func VerifyMachineNetworks(machineNetworkCidrs []string) error {
+	for i, cidr := range machineNetworkCidrs {
+		if err := VerifyMachineCIDR(cidr); err != nil {
+			return errors.Wrapf(err, "Machine network %s", cidr)
+		}
+		for _, other := range machineNetworkCidrs[:i] {
+			if err := VerifyCIDRsNotOverlap(other, cidr); err != nil {
+				return errors.Wrap(err, "Machine networks")
+			}
+		}
+	}
+	if len(machineNetworkCidrs) > 1 && !isIPv4Address(machineNetworkCidrs[0]) && addressOfFamily(machineNetworkCidrs, true) != "" {
+		return errors.Errorf("The first machine network of a dual-stack cluster must be an IPv4 network")
+	}
+	return nil
+}
+
+// VerifyMachineNetworksNotOverlap verifies that none of the machine networks of a multi-subnet cluster overlaps with the
+// cluster and service networks
+func VerifyMachineNetworksNotOverlap(machineNetworkCidrs, clusterNetworkCidrs, serviceNetworkCidrs []string) error {
+	for _, machineNetworkCidr := range machineNetworkCidrs {
+		for _, clusterNetworkCidr := range clusterNetworkCidrs {
+			if err := VerifyCIDRsNotOverlap(machineNetworkCidr, clusterNetworkCidr); err != nil {
+				return errors.Wrap(err, "MachineNetworkCIDR and ClusterNetworkCidr")
+			}
+		}
+		for _, serviceNetworkCidr := range serviceNetworkCidrs {
+			if err := VerifyCIDRsNotOverlap(machineNetworkCidr, serviceNetworkCidr); err != nil {
+				return errors.Wrap(err, "MachineNetworkCIDR and ServiceNetworkCIDR")
+			}
+		}
+	}
+	return nil
+}
+
+// IsMultiSubnet returns whether there are several networks of the same IP address family
+func IsMultiSubnet(cidrs []string) bool {
+	ipv4Networks := 0
+	for _, cidr := range cidrs {
+		if isIPv4Address(cidr) {
+			ipv4Networks++
+		}
+	}
+	return ipv4Networks > 1 || len(cidrs)-ipv4Networks > 1
+}
+
+// SplitMachineNetworks returns the first machine network, the first machine network of the other IP address family,
+// and the additional machine networks of multi-subnet clusters
+func SplitMachineNetworks(machineNetworkCidrs []string) (string, string, []string) {
+	var primary, secondary string
+	additional := make([]string, 0)
+	for _, cidr := range machineNetworkCidrs {
+		switch {
+		case primary == "":
+			primary = cidr
+		case secondary == "" && isIPv4Address(cidr) != isIPv4Address(primary):
+			secondary = cidr
+		default:
+			additional = append(additional, cidr)
+		}
+	}
+	return primary, secondary, additional
+}
+
+func isIPv4Address(addressOrCidr string) bool {
+	return IsIPv4Addr(strings.Split(addressOrCidr, "/")[0])
+}
+
+// addressOfFamily returns the address or CIDR of the IP address family, or an empty string if there is none
+func addressOfFamily(addresses []string, ipv4 bool) string {
+	for _, address := range addresses {
+		if isIPv4Address(address) == ipv4 {
+			return address
+		}
+	}
+	return ""
+}
@@ -53,4 +53,61 @@ var _ = Describe("CIDR validations", func() {
 			Expect(VerifyClusterOrServiceCIDR("1.2.3.0/25")).ToNot(HaveOccurred())
 		})
 	})
+	Context("Dual-stack", func() {
+		It("address families", func() {
+			Expect(VerifyAddressFamilies("API VIPs", []string{"1.2.3.4"})).ToNot(HaveOccurred())
+			Expect(VerifyAddressFamilies("API VIPs", []string{"1001:db8::1"})).ToNot(HaveOccurred())
+			Expect(VerifyAddressFamilies("Machine networks", []string{"1.2.3.0/24", "1001:db8::/120"})).ToNot(HaveOccurred())
+			Expect(VerifyAddressFamilies("Machine networks", []string{"1001:db8::/120", "1.2.3.0/24"})).To(HaveOccurred())
+			Expect(VerifyAddressFamilies("Machine networks", []string{"1.2.3.0/24", "1.2.4.0/24"})).To(HaveOccurred())
+			Expect(VerifyAddressFamilies("API VIPs", []string{"1.2.3.4", "1001:db8::1", "1001:db8::2"})).To(HaveOccurred())
+		})
+		It("cluster and service network families", func() {
+			Expect(VerifyDualStackFamilies([]string{"10.128.0.0/14", "fd01::/48"}, []string{"172.30.0.0/16", "fd02::/112"})).ToNot(HaveOccurred())
+			Expect(VerifyDualStackFamilies([]string{"10.128.0.0/14", "fd01::/48"}, []string{"172.30.0.0/16"})).To(HaveOccurred())
+			Expect(VerifyDualStackFamilies([]string{"fd01::/48"}, []string{"172.30.0.0/16"})).To(HaveOccurred())
+			Expect(VerifyDualStackFamilies([]string{"10.128.0.0/14"}, nil)).ToNot(HaveOccurred())
+		})
+		It("overlapping networks of the same family", func() {
+			Expect(VerifyDualStackCIDRsNotOverlap([]string{"1.2.3.0/24", "1001:db8::/120"}, []string{"10.128.0.0/14", "fd01::/48"},
+				[]string{"172.30.0.0/16", "fd02::/112"}, false)).ToNot(HaveOccurred())
+			Expect(VerifyDualStackCIDRsNotOverlap([]string{"1.2.3.0/24", "fd01::/120"}, []string{"10.128.0.0/14", "fd01::/48"},
+				[]string{"172.30.0.0/16", "fd02::/112"}, false)).To(HaveOccurred())
+			Expect(VerifyDualStackCIDRsNotOverlap([]string{"1.2.3.0/24"}, []string{"10.128.0.0/14", "fd01::/48"},
+				[]string{"172.30.0.0/16", "fd01:0:0:1::/112"}, true)).To(HaveOccurred())
+		})
+	})
+	Context("Multi-subnet", func() {
+		It("machine networks", func() {
+			Expect(VerifyMachineNetworks([]string{"1.2.3.0/24", "1.2.4.0/24", "1001:db8::/120"})).ToNot(HaveOccurred())
+			Expect(VerifyMachineNetworks([]string{"1.2.3.0/24", "1.2.3.128/25"})).To(HaveOccurred())
+			Expect(VerifyMachineNetworks([]string{"1001:db8::/120", "1.2.3.0/24"})).To(HaveOccurred())
+			Expect(VerifyMachineNetworks([]string{"1001:db8::/120", "1001:db9::/120"})).ToNot(HaveOccurred())
+			Expect(VerifyMachineNetworks([]string{"1.2.3.0/24", "1.2.4.0/31"})).To(HaveOccurred())
+		})
+		It("machine networks overlapping the cluster and service networks", func() {
+			Expect(VerifyMachineNetworksNotOverlap([]string{"1.2.3.0/24", "1.2.4.0/24"}, []string{"10.128.0.0/14"},
+				[]string{"172.30.0.0/16"})).ToNot(HaveOccurred())
+			Expect(VerifyMachineNetworksNotOverlap([]string{"1.2.3.0/24", "10.129.0.0/24"}, []string{"10.128.0.0/14"},
+				[]string{"172.30.0.0/16"})).To(HaveOccurred())
+			Expect(VerifyMachineNetworksNotOverlap([]string{"1.2.3.0/24", "172.30.1.0/24"}, []string{"10.128.0.0/14"},
+				[]string{"172.30.0.0/16"})).To(HaveOccurred())
+		})
+		It("is multi-subnet", func() {
+			Expect(IsMultiSubnet([]string{"1.2.3.0/24", "1001:db8::/120"})).To(BeFalse())
+			Expect(IsMultiSubnet([]string{"1.2.3.0/24"})).To(BeFalse())
+			Expect(IsMultiSubnet([]string{"1.2.3.0/24", "1.2.4.0/24"})).To(BeTrue())
+			Expect(IsMultiSubnet([]string{"1.2.3.0/24", "1001:db8::/120", "1001:db9::/120"})).To(BeTrue())
+		})
+		It("split machine networks", func() {
+			primary, secondary, additional := SplitMachineNetworks([]string{"1.2.3.0/24", "1.2.4.0/24", "1001:db8::/120", "1001:db9::/120"})
+			Expect(primary).To(Equal("1.2.3.0/24"))
+			Expect(secondary).To(Equal("1001:db8::/120"))
+			Expect(additional).To(Equal([]string{"1.2.4.0/24", "1001:db9::/120"}))
+			primary, secondary, additional = SplitMachineNetworks([]string{"1.2.3.0/24"})
+			Expect(primary).To(Equal("1.2.3.0/24"))
+			Expect(secondary).To(BeEmpty())
+			Expect(additional).To(BeEmpty())
+		})
+	})
 })
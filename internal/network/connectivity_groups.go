@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net"
 	"sort"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/golang-collections/go-datastructures/bitarray"
@@ -269,6 +270,74 @@ func createMachineCidrConnectivityMap(cidr string, hosts []*models.Host, idToInd
 	return ret, nil
 }
 
+/*
+ * Create connectivity map from host list for multi-subnet clusters.  It is the information if a host has L3 connectivity
+ * to an address of other host in any of the machine networks, which may be in different routed subnets.
+ */
+func createL3ConnectivityMap(cidrs []string, hosts []*models.Host, idToIndex map[strfmt.UUID]int) (connectivityMap, error) {
+	ret := make(connectivityMap)
+	parsedCidrs := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, parsedCidr, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		parsedCidrs = append(parsedCidrs, parsedCidr)
+	}
+	inMachineNetworks := func(ip net.IP) bool {
+		for _, parsedCidr := range parsedCidrs {
+			if parsedCidr.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+	for fromIndex, h := range hosts {
+		if h.Connectivity == "" {
+			continue
+		}
+		var connectivityReport models.ConnectivityReport
+		if err := json.Unmarshal([]byte(h.Connectivity), &connectivityReport); err != nil {
+			return nil, err
+		}
+		for _, r := range connectivityReport.RemoteHosts {
+			for _, l3 := range r.L3Connectivity {
+				ip := net.ParseIP(l3.RemoteIPAddress)
+				if ip != nil && inMachineNetworks(ip) && l3.Successful {
+					toIndex, ok := idToIndex[r.HostID]
+					if ok {
+						ret.add(fromIndex, toIndex, true)
+					}
+					break
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+// L3MajorityGroupKey returns the key of the majority group of the machine networks of a multi-subnet cluster in the
+// connectivity majority groups of the cluster
+func L3MajorityGroupKey(machineNetworkCidrs []string) string {
+	return strings.Join(machineNetworkCidrs, ",")
+}
+
+/*
+ * Create majority group for the machine networks of a multi-subnet cluster.  Since the hosts are in different subnets,
+ * the connectivity between them is the L3 connectivity.
+ */
+func CreateL3MajorityGroup(machineNetworkCidrs []string, hosts []*models.Host) ([]strfmt.UUID, error) {
+	idToIndex := make(map[strfmt.UUID]int)
+	for i, h := range hosts {
+		idToIndex[*h.ID] = i
+	}
+	cMap, err := createL3ConnectivityMap(machineNetworkCidrs, hosts, idToIndex)
+	if err != nil {
+		return nil, err
+	}
+	return majorityGroup(cMap, hosts), nil
+}
+
 /*
  * Crate majority for a cidr.  A majority group is a the largest group of hosts in a cluster that all of them have full mesh
  * to the other group members.
@@ -284,6 +353,10 @@ func CreateMajorityGroup(cidr string, hosts []*models.Host) ([]strfmt.UUID, erro
 	if err != nil {
 		return nil, err
 	}
+	return majorityGroup(cMap, hosts), nil
+}
+
+func majorityGroup(cMap connectivityMap, hosts []*models.Host) []strfmt.UUID {
 	candidates := make([]groupCandidate, 0)
 	for hostIndex := range hosts {
 		candidate := createHostGroupCandidate(hostIndex, len(hosts), cMap)
@@ -293,7 +366,7 @@ func CreateMajorityGroup(cidr string, hosts []*models.Host) ([]strfmt.UUID, erro
 	}
 	groups := createConnectivityGroups(candidates)
 	if len(groups) > 0 {
-		return groups[0].toList(hosts), nil
+		return groups[0].toList(hosts)
 	}
-	return make([]strfmt.UUID, 0), nil
+	return make([]strfmt.UUID, 0)
 }
@@ -457,3 +457,69 @@ func GenerateConnectivityGroupTests(ipV4 bool, net1CIDR, net2CIDR string) {
 		})
 	})
 }
+
+var _ = Describe("L3 connectivity groups", func() {
+	machineNetworks := []string{"1.2.3.0/24", "1.2.4.0/24"}
+	ids := make([]strfmt.UUID, 4)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+	}
+	addresses := []string{"1.2.3.10", "1.2.4.10", "1.2.3.11", "5.6.7.8"}
+
+	createL3Remote := func(index int) *models.ConnectivityRemoteHost {
+		return &models.ConnectivityRemoteHost{
+			HostID: ids[index],
+			L3Connectivity: []*models.L3Connectivity{
+				{RemoteIPAddress: addresses[index], Successful: true},
+			},
+		}
+	}
+
+	createHost := func(index int, remotes ...int) *models.Host {
+		report := models.ConnectivityReport{RemoteHosts: make([]*models.ConnectivityRemoteHost, 0)}
+		for _, remote := range remotes {
+			report.RemoteHosts = append(report.RemoteHosts, createL3Remote(remote))
+		}
+		b, err := json.Marshal(&report)
+		Expect(err).ToNot(HaveOccurred())
+		return &models.Host{ID: &ids[index], Connectivity: string(b)}
+	}
+
+	It("hosts in different subnets", func() {
+		hosts := []*models.Host{
+			createHost(0, 1, 2),
+			createHost(1, 0, 2),
+			createHost(2, 0, 1),
+		}
+		ret, err := CreateL3MajorityGroup(machineNetworks, hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(ConsistOf(ids[0], ids[1], ids[2]))
+	})
+
+	It("ignores addresses out of the machine networks", func() {
+		hosts := []*models.Host{
+			createHost(0, 1, 2, 3),
+			createHost(1, 0, 2, 3),
+			createHost(2, 0, 1, 3),
+			createHost(3, 0, 1, 2),
+		}
+		ret, err := CreateL3MajorityGroup(machineNetworks, hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(ConsistOf(ids[0], ids[1], ids[2]))
+	})
+
+	It("no connectivity", func() {
+		hosts := []*models.Host{
+			createHost(0),
+			createHost(1),
+			createHost(2),
+		}
+		ret, err := CreateL3MajorityGroup(machineNetworks, hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(BeEmpty())
+	})
+
+	It("key", func() {
+		Expect(L3MajorityGroupKey(machineNetworks)).To(Equal("1.2.3.0/24,1.2.4.0/24"))
+	})
+})
@@ -0,0 +1,140 @@
+package network
+
+import (
+	"bytes"
+	"net"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxIPPoolCandidates is the number of addresses of the subnet of an IP pool that are examined when allocating an
+// address, so that allocating from large IPv6 subnets is bounded
+const MaxIPPoolCandidates = 1 << 16
+
+func parseIPPoolAddress(ipNet *net.IPNet, address, name string) (net.IP, error) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, errors.Errorf("%s %s is not a valid IP address", name, address)
+	}
+	if !ipNet.Contains(ip) {
+		return nil, errors.Errorf("%s %s is not in the subnet %s", name, address, ipNet.String())
+	}
+	return ip, nil
+}
+
+// VerifyIPPool verifies that the gateway, the DNS servers and the excluded ranges of the IP pool are in its subnet
+func VerifyIPPool(pool *models.IPPool) error {
+	ip, ipNet, err := net.ParseCIDR(swag.StringValue(pool.Cidr))
+	if err != nil {
+		return err
+	}
+	if !ip.Equal(ipNet.IP) {
+		return errors.Errorf("%s is not a valid network CIDR", swag.StringValue(pool.Cidr))
+	}
+	if _, err = parseIPPoolAddress(ipNet, swag.StringValue(pool.Gateway), "Gateway"); err != nil {
+		return err
+	}
+	for _, server := range pool.DNSServers {
+		if net.ParseIP(server) == nil {
+			return errors.Errorf("DNS server %s is not a valid IP address", server)
+		}
+	}
+	for _, excluded := range pool.ExcludedRanges {
+		start, err := parseIPPoolAddress(ipNet, swag.StringValue(excluded.Start), "Excluded range start")
+		if err != nil {
+			return err
+		}
+		end, err := parseIPPoolAddress(ipNet, swag.StringValue(excluded.End), "Excluded range end")
+		if err != nil {
+			return err
+		}
+		if bytes.Compare(start.To16(), end.To16()) > 0 {
+			return errors.Errorf("Excluded range start %s is after its end %s", start.String(), end.String())
+		}
+	}
+	return nil
+}
+
+// IPPoolContains returns whether the address is in the subnet of the IP pool
+func IPPoolContains(pool *models.IPPool, address string) bool {
+	_, ipNet, err := net.ParseCIDR(swag.StringValue(pool.Cidr))
+	ip := net.ParseIP(address)
+	return err == nil && ip != nil && ipNet.Contains(ip)
+}
+
+func nextIP(ip net.IP) net.IP {
+	ret := append(net.IP{}, ip...)
+	for i := len(ret) - 1; i >= 0; i-- {
+		ret[i]++
+		if ret[i] != 0 {
+			break
+		}
+	}
+	return ret
+}
+
+func isBroadcast(ip net.IP, ipNet *net.IPNet) bool {
+	if ip.To4() == nil {
+		return false
+	}
+	ip4 := ip.To4()
+	for i := range ip4 {
+		if ip4[i]|ipNet.Mask[i] != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// AllocateIPPoolAddress returns the first address of the subnet of the IP pool that is not the network or broadcast
+// address, the gateway, a DNS server, in an excluded range or in the used addresses, and that is not reported busy by
+// the free addresses of the hosts.
+func AllocateIPPoolAddress(pool *models.IPPool, used []string, hosts []*models.Host, log logrus.FieldLogger) (string, error) {
+	_, ipNet, err := net.ParseCIDR(swag.StringValue(pool.Cidr))
+	if err != nil {
+		return "", err
+	}
+	if ipNet.IP.To4() != nil {
+		ipNet.IP = ipNet.IP.To4()
+	}
+	reserved := make(map[string]bool)
+	for _, address := range append(append([]string{swag.StringValue(pool.Gateway)}, pool.DNSServers...), used...) {
+		if ip := net.ParseIP(address); ip != nil {
+			reserved[ip.String()] = true
+		}
+	}
+	freeSet := MakeFreeAddressesSet(hosts, ipNet.String(), nil, log)
+
+	ip := nextIP(ipNet.IP)
+	for i := 0; i < MaxIPPoolCandidates && ipNet.Contains(ip); i, ip = i+1, nextIP(ip) {
+		if isBroadcast(ip, ipNet) || reserved[ip.String()] || inExcludedRange(pool, ip) {
+			continue
+		}
+		if len(freeSet) > 0 {
+			if _, free := freeSet[strfmt.IPv4(ip.String())]; !free {
+				log.Debugf("Address %s of the IP pool %s is not free", ip.String(), ipNet.String())
+				continue
+			}
+		}
+		return ip.String(), nil
+	}
+	return "", errors.Errorf("No free address is left in the IP pool %s", ipNet.String())
+}
+
+func inExcludedRange(pool *models.IPPool, ip net.IP) bool {
+	for _, excluded := range pool.ExcludedRanges {
+		start := net.ParseIP(swag.StringValue(excluded.Start))
+		end := net.ParseIP(swag.StringValue(excluded.End))
+		if start == nil || end == nil {
+			continue
+		}
+		if bytes.Compare(ip.To16(), start.To16()) >= 0 && bytes.Compare(ip.To16(), end.To16()) <= 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package network
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("IP pool", func() {
+	var (
+		log  logrus.FieldLogger
+		pool *models.IPPool
+	)
+
+	BeforeEach(func() {
+		log = logrus.New()
+		pool = &models.IPPool{
+			Cidr:       swag.String("192.168.126.0/29"),
+			Gateway:    swag.String("192.168.126.1"),
+			DNSServers: []string{"192.168.126.2", "8.8.8.8"},
+		}
+	})
+
+	Context("VerifyIPPool", func() {
+		It("accepts a valid pool", func() {
+			pool.ExcludedRanges = []*models.IPRange{{Start: swag.String("192.168.126.3"), End: swag.String("192.168.126.4")}}
+			Expect(VerifyIPPool(pool)).ToNot(HaveOccurred())
+		})
+		It("rejects a CIDR that is not a network", func() {
+			pool.Cidr = swag.String("192.168.126.1/29")
+			Expect(VerifyIPPool(pool)).To(HaveOccurred())
+		})
+		It("rejects a gateway outside of the subnet", func() {
+			pool.Gateway = swag.String("192.168.127.1")
+			Expect(VerifyIPPool(pool)).To(HaveOccurred())
+		})
+		It("rejects an IPv6 gateway of an IPv4 subnet", func() {
+			pool.Gateway = swag.String("1001:db8::1")
+			Expect(VerifyIPPool(pool)).To(HaveOccurred())
+		})
+		It("rejects an excluded range outside of the subnet", func() {
+			pool.ExcludedRanges = []*models.IPRange{{Start: swag.String("192.168.126.3"), End: swag.String("192.168.126.9")}}
+			Expect(VerifyIPPool(pool)).To(HaveOccurred())
+		})
+		It("rejects an excluded range whose start is after its end", func() {
+			pool.ExcludedRanges = []*models.IPRange{{Start: swag.String("192.168.126.4"), End: swag.String("192.168.126.3")}}
+			Expect(VerifyIPPool(pool)).To(HaveOccurred())
+		})
+	})
+
+	Context("AllocateIPPoolAddress", func() {
+		It("skips the gateway and the DNS servers", func() {
+			Expect(AllocateIPPoolAddress(pool, nil, nil, log)).To(Equal("192.168.126.3"))
+		})
+		It("skips the used addresses and the excluded ranges", func() {
+			pool.ExcludedRanges = []*models.IPRange{{Start: swag.String("192.168.126.4"), End: swag.String("192.168.126.5")}}
+			Expect(AllocateIPPoolAddress(pool, []string{"192.168.126.3"}, nil, log)).To(Equal("192.168.126.6"))
+		})
+		It("skips the addresses that are not free", func() {
+			hosts := []*models.Host{
+				{FreeAddresses: "[{\"network\":\"192.168.126.0/29\",\"free_addresses\":[\"192.168.126.5\",\"192.168.126.6\"]}]"},
+			}
+			Expect(AllocateIPPoolAddress(pool, nil, hosts, log)).To(Equal("192.168.126.5"))
+		})
+		It("fails when the pool is exhausted, without allocating the broadcast address", func() {
+			_, err := AllocateIPPoolAddress(pool, []string{"192.168.126.3", "192.168.126.4", "192.168.126.5", "192.168.126.6"}, nil, log)
+			Expect(err).To(HaveOccurred())
+		})
+		It("allocates IPv6 addresses", func() {
+			pool = &models.IPPool{Cidr: swag.String("1001:db8::/120"), Gateway: swag.String("1001:db8::1")}
+			Expect(AllocateIPPoolAddress(pool, []string{"1001:db8:0::2"}, nil, log)).To(Equal("1001:db8::3"))
+		})
+	})
+
+	It("IPPoolContains", func() {
+		Expect(IPPoolContains(pool, "192.168.126.6")).To(BeTrue())
+		Expect(IPPoolContains(pool, "192.168.126.8")).To(BeFalse())
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -55,7 +56,7 @@ func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*mode
 		if err != nil {
 			continue
 		}
-		for _, intf := range inventory.Interfaces {
+		for _, intf := range hostutil.GetL3Interfaces(&inventory) {
 			var ipnet *net.IPNet
 			if isIPv4 {
 				ipnet = getVIPInterfaceNetwork(parsedVipAddr, intf.IPV4Addresses)
@@ -73,6 +74,24 @@ func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*mode
 	return "", errors.Errorf("No suitable matching CIDR found for VIP %s", ip)
 }
 
+// CalculateMachineNetworkCIDRs calculates the machine network of each IP address family of the VIPs, the IPv4 one
+// first. A network is empty when none of the hosts has an address in it and a match is not required.
+func CalculateMachineNetworkCIDRs(apiVips []string, ingressVips []string, hosts []*models.Host, isMatchRequired bool) ([]string, error) {
+	ret := make([]string, 0)
+	for _, ipv4 := range []bool{true, false} {
+		apiVip, ingressVip := addressOfFamily(apiVips, ipv4), addressOfFamily(ingressVips, ipv4)
+		if apiVip == "" && ingressVip == "" {
+			continue
+		}
+		cidr, err := CalculateMachineNetworkCIDR(apiVip, ingressVip, hosts, isMatchRequired)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, cidr)
+	}
+	return ret, nil
+}
+
 func ipInCidr(ipStr, cidrStr string) bool {
 	ip := net.ParseIP(ipStr)
 	if ip == nil {
@@ -116,6 +135,31 @@ func VerifyVips(hosts []*models.Host, machineNetworkCidr string, apiVip string,
 	return err
 }
 
+// VerifyDualStackVip verifies each of the VIPs against the machine network of its IP address family
+func VerifyDualStackVip(hosts []*models.Host, machineNetworkCidrs []string, vips []string, vipName string, mustExist bool, log logrus.FieldLogger) error {
+	for _, vip := range vips {
+		if err := VerifyVip(hosts, addressOfFamily(machineNetworkCidrs, isIPv4Address(vip)), vip, vipName, mustExist, log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyDualStackVips verifies the API and Ingress VIPs of each IP address family against the machine network of the
+// same family
+func VerifyDualStackVips(hosts []*models.Host, machineNetworkCidrs []string, apiVips []string, ingressVips []string, mustExist bool, log logrus.FieldLogger) error {
+	err := VerifyDualStackVip(hosts, machineNetworkCidrs, apiVips, "api-vip", mustExist, log)
+	if err == nil {
+		err = VerifyDualStackVip(hosts, machineNetworkCidrs, ingressVips, "ingress-vip", mustExist, log)
+	}
+	for _, ipv4 := range []bool{true, false} {
+		if err == nil {
+			err = VerifyDifferentVipAddresses(addressOfFamily(apiVips, ipv4), addressOfFamily(ingressVips, ipv4))
+		}
+	}
+	return err
+}
+
 func findMatchingIPForFamily(ipnet *net.IPNet, addresses []string) (bool, string) {
 	for _, addr := range addresses {
 		ip, _, err := net.ParseCIDR(addr)
@@ -148,7 +192,7 @@ func getMachineCIDRObj(host *models.Host, machineNetworkCidr string, obj string)
 	if err != nil {
 		return "", err
 	}
-	for _, intf := range inventory.Interfaces {
+	for _, intf := range hostutil.GetL3Interfaces(&inventory) {
 		found, addr := findMatchingIP(ipNet, intf, isIPv4)
 		if found {
 			switch obj {
@@ -191,27 +235,29 @@ func belongsToNetwork(log logrus.FieldLogger, h *models.Host, machineIpnet *net.
 		log.WithError(err).Warnf("Error unmarshalling host %s inventory %s", h.ID, h.Inventory)
 		return false
 	}
-	isIPv4 := IsIPV4CIDR(machineIpnet.String())
-	for _, intf := range inventory.Interfaces {
-		if found, _ := findMatchingIP(machineIpnet, intf, isIPv4); found {
-			return true
-		}
-	}
-	return false
+	return machineNetworkInterface(&inventory, machineIpnet) != nil
 }
 
+// GetMachineCIDRHosts returns the hosts that belong to any of the machine networks of the cluster
 func GetMachineCIDRHosts(log logrus.FieldLogger, cluster *common.Cluster) ([]*models.Host, error) {
 	if cluster.MachineNetworkCidr == "" {
 		return nil, errors.New("Machine network CIDR was not set in cluster")
 	}
-	_, machineIpnet, err := net.ParseCIDR(cluster.MachineNetworkCidr)
-	if err != nil {
-		return nil, err
+	machineIpnets := make([]*net.IPNet, 0)
+	for _, cidr := range common.GetMachineNetworkCidrs(cluster) {
+		_, machineIpnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		machineIpnets = append(machineIpnets, machineIpnet)
 	}
 	ret := make([]*models.Host, 0)
 	for _, h := range cluster.Hosts {
-		if belongsToNetwork(log, h, machineIpnet) {
-			ret = append(ret, h)
+		for _, machineIpnet := range machineIpnets {
+			if belongsToNetwork(log, h, machineIpnet) {
+				ret = append(ret, h)
+				break
+			}
 		}
 	}
 	return ret, nil
@@ -271,7 +317,7 @@ func GetClusterNetworks(hosts []*models.Host, log logrus.FieldLogger) []string {
 				log.WithError(err).Warnf("Unmarshal inventory %s", h.Inventory)
 				continue
 			}
-			for _, inf := range inventory.Interfaces {
+			for _, inf := range hostutil.GetL3Interfaces(&inventory) {
 
 				for _, ipv4 := range inf.IPV4Addresses {
 					_, cidr, err := net.ParseCIDR(ipv4)
@@ -308,6 +354,23 @@ func IsHostInMachineNetCidr(log logrus.FieldLogger, cluster *common.Cluster, hos
 	return belongsToNetwork(log, host, machineIpnet)
 }
 
+// IsHostInMachineNetCidrs returns whether the host belongs to a machine network of each IP address family of the
+// cluster. The hosts of multi-subnet clusters may belong to any of the machine networks of a family.
+func IsHostInMachineNetCidrs(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) bool {
+	belongs := make(map[bool]bool)
+	for _, cidr := range common.GetMachineNetworkCidrs(cluster) {
+		ipv4 := isIPv4Address(cidr)
+		_, machineIpnet, err := net.ParseCIDR(cidr)
+		belongs[ipv4] = belongs[ipv4] || (err == nil && belongsToNetwork(log, host, machineIpnet))
+	}
+	for _, ok := range belongs {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
 type IPSet map[strfmt.IPv4]struct{}
 
 func (s IPSet) Add(str strfmt.IPv4) {
@@ -249,6 +249,109 @@ var _ = Describe("inventory", func() {
 		})
 	})
 
+	Context("CalculateMachineNetworkCIDRs", func() {
+		dualStackInventory := func(ipv4Address, ipv6Address string) string {
+			return createInventory(addIPv6Addresses(createInterface(ipv4Address), ipv6Address))
+		}
+
+		It("dual-stack", func() {
+			hosts := createHosts(dualStackInventory("1.2.5.7/23", "1001:db8::1/120"), dualStackInventory("1.2.5.8/23", "1001:db8::2/120"))
+			cidrs, err := CalculateMachineNetworkCIDRs([]string{"1.2.5.6", "1001:db8::64"}, []string{"1.2.5.9", "1001:db8::65"}, hosts, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cidrs).To(Equal([]string{"1.2.4.0/23", "1001:db8::/120"}))
+		})
+		It("single-stack", func() {
+			hosts := createHosts(dualStackInventory("1.2.5.7/23", "1001:db8::1/120"))
+			cidrs, err := CalculateMachineNetworkCIDRs([]string{"1.2.5.6"}, nil, hosts, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cidrs).To(Equal([]string{"1.2.4.0/23"}))
+		})
+		It("no VIPs", func() {
+			cidrs, err := CalculateMachineNetworkCIDRs(nil, nil, createHosts(dualStackInventory("1.2.5.7/23", "1001:db8::1/120")), true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cidrs).To(BeEmpty())
+		})
+		It("no match for the IPv6 VIPs", func() {
+			hosts := createHosts(createInventory(createInterface("1.2.5.7/23")))
+			_, err := CalculateMachineNetworkCIDRs([]string{"1.2.5.6", "1001:db8::64"}, nil, hosts, true)
+			Expect(err).To(HaveOccurred())
+			cidrs, err := CalculateMachineNetworkCIDRs([]string{"1.2.5.6", "1001:db8::64"}, nil, hosts, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cidrs).To(Equal([]string{"1.2.4.0/23", ""}))
+		})
+	})
+	Context("VerifyDualStackVips", func() {
+		var (
+			log   logrus.FieldLogger
+			hosts []*models.Host
+		)
+
+		BeforeEach(func() {
+			log = logrus.New()
+			hosts = []*models.Host{
+				{
+					FreeAddresses: "[{\"network\":\"1.2.4.0/23\",\"free_addresses\":[\"1.2.5.6\",\"1.2.5.8\"]}]",
+				},
+			}
+		})
+		It("VIPs of both families", func() {
+			err := VerifyDualStackVips(hosts, []string{"1.2.4.0/23", "1001:db8::/120"}, []string{"1.2.5.6", "1001:db8::64"},
+				[]string{"1.2.5.8", "1001:db8::65"}, true, log)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("IPv6 VIP outside the IPv6 machine network", func() {
+			err := VerifyDualStackVips(hosts, []string{"1.2.4.0/23", "1001:db8::/120"}, []string{"1.2.5.6", "1001:db9::64"},
+				[]string{"1.2.5.8"}, true, log)
+			Expect(err).To(HaveOccurred())
+		})
+		It("IPv6 VIP without an IPv6 machine network", func() {
+			err := VerifyDualStackVips(hosts, []string{"1.2.4.0/23"}, []string{"1.2.5.6", "1001:db8::64"}, []string{"1.2.5.8"}, true, log)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Same IPv6 VIPs", func() {
+			err := VerifyDualStackVips(hosts, []string{"1.2.4.0/23", "1001:db8::/120"}, []string{"1.2.5.6", "1001:db8::64"},
+				[]string{"1.2.5.8", "1001:db8::64"}, true, log)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+	Context("IsHostInMachineNetCidrs", func() {
+		It("belongs to both machine networks", func() {
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(addIPv6Addresses(createInterface("1.2.5.7/23"), "1001:db8::1/120")))
+			cluster.SecondaryMachineNetworkCidr = "1001:db8::/120"
+			Expect(IsHostInMachineNetCidrs(logrus.New(), cluster, cluster.Hosts[0])).To(BeTrue())
+		})
+		It("does not belong to the IPv6 machine network", func() {
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(addIPv6Addresses(createInterface("1.2.5.7/23"), "1001:db9::1/120")))
+			cluster.SecondaryMachineNetworkCidr = "1001:db8::/120"
+			Expect(IsHostInMachineNetCidrs(logrus.New(), cluster, cluster.Hosts[0])).To(BeFalse())
+		})
+		It("belongs to an additional machine network of a multi-subnet cluster", func() {
+			cluster := createCluster("", "1.2.4.0/23",
+				createInventory(createInterface("1.2.8.7/23")))
+			cluster.AdditionalMachineNetworkCidrs = "1.2.6.0/23,1.2.8.0/23"
+			Expect(IsHostInMachineNetCidrs(logrus.New(), cluster, cluster.Hosts[0])).To(BeTrue())
+			cluster.AdditionalMachineNetworkCidrs = "1.2.6.0/23"
+			Expect(IsHostInMachineNetCidrs(logrus.New(), cluster, cluster.Hosts[0])).To(BeFalse())
+		})
+		It("belongs through a VLAN interface on a bond, rather than the ports of the bond", func() {
+			port := createInterface("1.2.6.7/23")
+			port.Name = "eth0"
+			bond := createInterface()
+			bond.Name, bond.Type, bond.LowerInterfaces = "bond0", "bond", []string{"eth0", "eth1"}
+			vlan := createInterface("1.2.4.7/23")
+			vlan.Name, vlan.Type, vlan.VlanID, vlan.LowerInterfaces = "bond0.100", "vlan", 100, []string{"bond0"}
+			cluster := createCluster("", "1.2.4.0/23", createInventory(port, bond, vlan))
+			Expect(IsHostInMachineNetCidrs(logrus.New(), cluster, cluster.Hosts[0])).To(BeTrue())
+			Expect(GetMachineCIDRInterface(cluster.Hosts[0], cluster)).To(Equal("bond0.100"))
+
+			By("ignoring the addresses of the ports of the bond")
+			cluster.MachineNetworkCidr = "1.2.6.0/23"
+			Expect(IsHostInMachineNetCidrs(logrus.New(), cluster, cluster.Hosts[0])).To(BeFalse())
+		})
+	})
+
 	Context("GetClusterNetworks", func() {
 
 		var log logrus.FieldLogger
@@ -0,0 +1,118 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+// machineNetworkInterface returns the interface of the inventory that has an address in the machine network, or nil
+func machineNetworkInterface(inventory *models.Inventory, machineIpnet *net.IPNet) *models.Interface {
+	isIPv4 := IsIPV4CIDR(machineIpnet.String())
+	for _, intf := range hostutil.GetL3Interfaces(inventory) {
+		if found, _ := findMatchingIP(machineIpnet, intf, isIPv4); found {
+			return intf
+		}
+	}
+	return nil
+}
+
+// GetMtuProblems returns the MTU problems of the host: the interfaces whose MTU is larger than the MTU of an interface
+// they are on, such as a VLAN interface and its parent, and the machine networks of the cluster on which the MTU of the
+// host differs from the MTU of the majority of the hosts. When no MTU is shared by more hosts than any other MTU, every
+// host whose MTU differs from another host is reported. Interfaces whose MTU is not reported are ignored.
+func GetMtuProblems(cluster *common.Cluster, host *models.Host, inventory *models.Inventory) []string {
+	ret := make([]string, 0)
+	interfaces := make(map[string]*models.Interface, len(inventory.Interfaces))
+	for _, intf := range inventory.Interfaces {
+		interfaces[intf.Name] = intf
+	}
+	for _, intf := range inventory.Interfaces {
+		for _, lowerName := range intf.LowerInterfaces {
+			lower, ok := interfaces[lowerName]
+			if ok && lower.Mtu > 0 && intf.Mtu > lower.Mtu {
+				ret = append(ret, fmt.Sprintf("The MTU %d of interface %s is larger than the MTU %d of interface %s that it is on",
+					intf.Mtu, intf.Name, lower.Mtu, lower.Name))
+			}
+		}
+	}
+
+	// The inventories of the other hosts are unmarshalled once for all the machine networks
+	var others []*models.Host
+	var otherInventories []*models.Inventory
+	for _, cidr := range common.GetMachineNetworkCidrs(cluster) {
+		_, machineIpnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		intf := machineNetworkInterface(inventory, machineIpnet)
+		if intf == nil || intf.Mtu <= 0 {
+			continue
+		}
+		if others == nil {
+			others, otherInventories = otherHostInventories(cluster, host)
+		}
+		sameMtu := 1
+		otherMtus := make(map[int64][]string)
+		for i, h := range others {
+			otherIntf := machineNetworkInterface(otherInventories[i], machineIpnet)
+			if otherIntf == nil || otherIntf.Mtu <= 0 {
+				continue
+			}
+			if otherIntf.Mtu == intf.Mtu {
+				sameMtu++
+			} else {
+				otherMtus[otherIntf.Mtu] = append(otherMtus[otherIntf.Mtu], hostutil.GetHostnameForMsg(h))
+			}
+		}
+		if isMajorityMtu(sameMtu, otherMtus) {
+			continue
+		}
+		mtus := make([]int64, 0, len(otherMtus))
+		for mtu := range otherMtus {
+			mtus = append(mtus, mtu)
+		}
+		sort.Slice(mtus, func(i, j int) bool { return mtus[i] < mtus[j] })
+		for _, mtu := range mtus {
+			sort.Strings(otherMtus[mtu])
+			ret = append(ret, fmt.Sprintf("The MTU %d of interface %s on the machine network %s differs from the MTU %d of hosts %s",
+				intf.Mtu, intf.Name, cidr, mtu, strings.Join(otherMtus[mtu], ", ")))
+		}
+	}
+	return ret
+}
+
+// isMajorityMtu returns whether the MTU shared by sameMtu hosts is shared by more hosts than any of the other MTUs
+func isMajorityMtu(sameMtu int, otherMtus map[int64][]string) bool {
+	for _, hosts := range otherMtus {
+		if len(hosts) >= sameMtu {
+			return false
+		}
+	}
+	return true
+}
+
+// otherHostInventories returns the enabled hosts of the cluster other than the host that have an inventory, and their
+// inventories
+func otherHostInventories(cluster *common.Cluster, host *models.Host) ([]*models.Host, []*models.Inventory) {
+	hosts := make([]*models.Host, 0, len(cluster.Hosts))
+	inventories := make([]*models.Inventory, 0, len(cluster.Hosts))
+	for _, h := range cluster.Hosts {
+		if h.ID.String() == host.ID.String() || h.Inventory == "" || swag.StringValue(h.Status) == models.HostStatusDisabled {
+			continue
+		}
+		inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			continue
+		}
+		hosts = append(hosts, h)
+		inventories = append(inventories, inventory)
+	}
+	return hosts, inventories
+}
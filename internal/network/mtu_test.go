@@ -0,0 +1,100 @@
+package network
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("GetMtuProblems", func() {
+	createMtuInterface := func(name string, mtu int64, ipv4Addresses ...string) *models.Interface {
+		ret := createInterface(ipv4Addresses...)
+		ret.Name = name
+		ret.Mtu = mtu
+		return ret
+	}
+
+	createMtuHost := func(hostname string, interfaces ...*models.Interface) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		inventory := &models.Inventory{Hostname: hostname, Interfaces: interfaces}
+		b, err := hostutil.MarshalInventory(inventory)
+		Expect(err).ToNot(HaveOccurred())
+		return &models.Host{ID: &id, Inventory: b, Status: swag.String(models.HostStatusKnown)}
+	}
+
+	getProblems := func(cluster *common.Cluster, host *models.Host) []string {
+		inventory, err := hostutil.UnmarshalInventory(host.Inventory)
+		Expect(err).ToNot(HaveOccurred())
+		return GetMtuProblems(cluster, host, inventory)
+	}
+
+	It("accepts hosts with the same MTU on the machine network", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{MachineNetworkCidr: "1.2.4.0/23", Hosts: []*models.Host{
+			createMtuHost("master-0", createMtuInterface("eth0", 9000, "1.2.4.10/23"), createMtuInterface("eth1", 1500, "10.0.0.10/24")),
+			createMtuHost("master-1", createMtuInterface("eth0", 9000, "1.2.4.11/23"), createMtuInterface("eth1", 1400, "10.0.0.11/24")),
+			createMtuHost("master-2", createMtuInterface("eth0", 0, "1.2.4.12/23")),
+		}}}
+		for _, host := range cluster.Hosts {
+			Expect(getProblems(cluster, host)).To(BeEmpty())
+		}
+	})
+
+	It("reports only the host whose MTU differs from the majority on the machine network", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{MachineNetworkCidr: "1.2.4.0/23", Hosts: []*models.Host{
+			createMtuHost("master-0", createMtuInterface("eth0", 9000, "1.2.4.10/23")),
+			createMtuHost("master-1", createMtuInterface("eth0", 1500, "1.2.4.11/23")),
+			createMtuHost("master-2", createMtuInterface("eth0", 1500, "1.2.4.12/23")),
+		}}}
+		Expect(getProblems(cluster, cluster.Hosts[0])).To(ConsistOf(
+			"The MTU 9000 of interface eth0 on the machine network 1.2.4.0/23 differs from the MTU 1500 of hosts master-1, master-2"))
+		Expect(getProblems(cluster, cluster.Hosts[1])).To(BeEmpty())
+		Expect(getProblems(cluster, cluster.Hosts[2])).To(BeEmpty())
+	})
+
+	It("reports every host with a different MTU when there is no majority on the machine network", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{MachineNetworkCidr: "1.2.4.0/23", Hosts: []*models.Host{
+			createMtuHost("master-0", createMtuInterface("eth0", 9000, "1.2.4.10/23")),
+			createMtuHost("master-1", createMtuInterface("eth0", 1500, "1.2.4.11/23")),
+			createMtuHost("master-2", createMtuInterface("eth0", 1500, "1.2.4.12/23")),
+			createMtuHost("master-3", createMtuInterface("eth0", 9000, "1.2.4.13/23")),
+		}}}
+		Expect(getProblems(cluster, cluster.Hosts[0])).To(ConsistOf(
+			"The MTU 9000 of interface eth0 on the machine network 1.2.4.0/23 differs from the MTU 1500 of hosts master-1, master-2"))
+		Expect(getProblems(cluster, cluster.Hosts[1])).To(ConsistOf(
+			"The MTU 1500 of interface eth0 on the machine network 1.2.4.0/23 differs from the MTU 9000 of hosts master-0, master-3"))
+
+		By("ignoring disabled hosts")
+		cluster.Hosts[3].Status = swag.String(models.HostStatusDisabled)
+		Expect(getProblems(cluster, cluster.Hosts[1])).To(BeEmpty())
+	})
+
+	It("compares the MTU of the VLAN interface that is on the machine network", func() {
+		vlan := func(mtu int64, address string) []*models.Interface {
+			return []*models.Interface{
+				createMtuInterface("eth0", 9000),
+				{Name: "eth0.100", Type: hostutil.InterfaceTypeVlan, VlanID: 100, LowerInterfaces: []string{"eth0"}, Mtu: mtu,
+					IPV4Addresses: []string{address}},
+			}
+		}
+		cluster := &common.Cluster{Cluster: models.Cluster{MachineNetworkCidr: "1.2.4.0/23", Hosts: []*models.Host{
+			createMtuHost("master-0", vlan(1500, "1.2.4.10/23")...),
+			createMtuHost("master-1", vlan(1400, "1.2.4.11/23")...),
+		}}}
+		Expect(getProblems(cluster, cluster.Hosts[0])).To(ConsistOf(
+			"The MTU 1500 of interface eth0.100 on the machine network 1.2.4.0/23 differs from the MTU 1400 of hosts master-1"))
+	})
+
+	It("reports interfaces whose MTU is larger than the MTU of an interface they are on", func() {
+		host := createMtuHost("master-0",
+			createMtuInterface("eth0", 1500),
+			&models.Interface{Name: "eth0.100", Type: hostutil.InterfaceTypeVlan, VlanID: 100, LowerInterfaces: []string{"eth0"}, Mtu: 9000})
+		cluster := &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{host}}}
+		Expect(getProblems(cluster, host)).To(ConsistOf(
+			"The MTU 9000 of interface eth0.100 is larger than the MTU 1500 of interface eth0 that it is on"))
+	})
+})
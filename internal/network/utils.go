@@ -13,7 +13,7 @@ func GetHostAddressFamilies(host *models.Host) (bool, bool, error) {
 	}
 	v4 := false
 	v6 := false
-	for _, i := range inventory.Interfaces {
+	for _, i := range hostutil.GetL3Interfaces(inventory) {
 		v4 = v4 || len(i.IPV4Addresses) > 0
 		v6 = v6 || len(i.IPV6Addresses) > 0
 		if v4 && v6 {
@@ -2,9 +2,13 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/hashicorp/go-version"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
 )
 
 type ValidationStatus string
@@ -15,6 +19,9 @@ const (
 	Pending ValidationStatus = "pending"
 )
 
+// ValidationIDSuffix is the suffix of the cluster and host validation IDs of the operators
+const ValidationIDSuffix = "-requirements-satisfied"
+
 // ValidationResult hold result of operator validation
 type ValidationResult struct {
 	// ValidationId is an id of the validation
@@ -25,6 +32,58 @@ type ValidationResult struct {
 	Reasons []string
 }
 
+// Channels is a compatibility table of the subscription channels of an operator: it maps "major.minor" OpenShift
+// versions to the channels that can be used with them
+type Channels map[string][]string
+
+// For returns the channels compatible with the OpenShift version
+func (c Channels) For(openshiftVersion string) []string {
+	v, err := version.NewVersion(openshiftVersion)
+	if err != nil || len(v.Segments()) < 2 {
+		return []string{}
+	}
+	return c[fmt.Sprintf("%d.%d", v.Segments()[0], v.Segments()[1])]
+}
+
+// ClusterServiceVersions is a compatibility table of the ClusterServiceVersions of an operator: it maps "major.minor"
+// OpenShift versions to the "major.minor" versions of the operator that can be used with them
+type ClusterServiceVersions map[string][]string
+
+// For returns the versions of the operator compatible with the OpenShift version
+func (c ClusterServiceVersions) For(openshiftVersion string) []string {
+	return Channels(c).For(openshiftVersion)
+}
+
+// ClusterServiceVersionRelease returns the "major.minor" version of the operator of a ClusterServiceVersion, that is
+// named "<package>.v<version>" or "<package>.<version>"
+func ClusterServiceVersionRelease(csv string) (string, error) {
+	i := strings.Index(csv, ".")
+	if i < 0 {
+		return "", errors.Errorf("ClusterServiceVersion %s is not named after the version of the operator", csv)
+	}
+	v, err := version.NewVersion(strings.TrimPrefix(csv[i+1:], "v"))
+	if err != nil || len(v.Segments()) < 2 {
+		return "", errors.Errorf("ClusterServiceVersion %s is not named after the version of the operator", csv)
+	}
+	return fmt.Sprintf("%d.%d", v.Segments()[0], v.Segments()[1]), nil
+}
+
+// Subscription returns the channel and the ClusterServiceVersion selected for the operator of the cluster; the
+// channel falls back to defaultChannel when none is selected
+func Subscription(cluster *common.Cluster, operatorName string, defaultChannel string) (channel string, csv string) {
+	channel = defaultChannel
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.Name != operatorName {
+			continue
+		}
+		if operator.Channel != "" {
+			channel = operator.Channel
+		}
+		return channel, operator.Csv
+	}
+	return channel, ""
+}
+
 // Operator provides generic API of an OLM operator installation plugin
 //go:generate mockgen -package=api -self_package=github.com/openshift/assisted-service/internal/operators/api -destination=mock_operator_api.go . Operator
 type Operator interface {
@@ -50,4 +109,8 @@ type Operator interface {
 	GetMonitoredOperator() *models.MonitoredOperator
 	// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
 	GetPreflightRequirements(ctx context.Context, cluster *common.Cluster) (*models.OperatorHardwareRequirements, error)
+	// GetSupportedChannels returns the subscription channels of the operator that are compatible with the OpenShift version
+	GetSupportedChannels(openshiftVersion string) []string
+	// GetSupportedClusterServiceVersions returns the "major.minor" versions of the operator whose ClusterServiceVersions are compatible with the OpenShift version
+	GetSupportedClusterServiceVersions(openshiftVersion string) []string
 }
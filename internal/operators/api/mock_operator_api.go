@@ -165,6 +165,34 @@ func (mr *MockOperatorMockRecorder) GetProperties() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProperties", reflect.TypeOf((*MockOperator)(nil).GetProperties))
 }
 
+// GetSupportedChannels mocks base method
+func (m *MockOperator) GetSupportedChannels(arg0 string) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedChannels", arg0)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetSupportedChannels indicates an expected call of GetSupportedChannels
+func (mr *MockOperatorMockRecorder) GetSupportedChannels(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedChannels", reflect.TypeOf((*MockOperator)(nil).GetSupportedChannels), arg0)
+}
+
+// GetSupportedClusterServiceVersions mocks base method
+func (m *MockOperator) GetSupportedClusterServiceVersions(arg0 string) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedClusterServiceVersions", arg0)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetSupportedClusterServiceVersions indicates an expected call of GetSupportedClusterServiceVersions
+func (mr *MockOperatorMockRecorder) GetSupportedClusterServiceVersions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedClusterServiceVersions", reflect.TypeOf((*MockOperator)(nil).GetSupportedClusterServiceVersions), arg0)
+}
+
 // ValidateCluster mocks base method
 func (m *MockOperator) ValidateCluster(arg0 context.Context, arg1 *common.Cluster) (ValidationResult, error) {
 	m.ctrl.T.Helper()
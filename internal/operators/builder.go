@@ -3,12 +3,16 @@ package operators
 import (
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
+	"github.com/openshift/assisted-service/internal/operators/generic"
 	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/operators/ocs"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
 )
 
 var OperatorCVO = models.MonitoredOperator{
@@ -26,11 +30,54 @@ var OperatorConsole = models.MonitoredOperator{
 type Options struct {
 	CheckClusterVersion bool
 	CNVConfig           cnv.Config
+	SRIOVConfig         sriov.Config
+	// Catalog holds the definitions of the OLM operators that are installed without an operator specific plugin
+	Catalog generic.Catalog `envconfig:"OLM_OPERATORS_CATALOG" default:"[]"`
+	// CatalogFile is a file of operator definitions, e.g. mounted from a ConfigMap, that are added to the Catalog
+	CatalogFile string `envconfig:"OLM_OPERATORS_CATALOG_FILE" default:""`
 }
 
 // NewManager creates new instance of an Operator Manager
 func NewManager(log logrus.FieldLogger, manifestAPI restapi.ManifestsAPI, options Options, objectHandler s3wrapper.API) *Manager {
-	return NewManagerWithOperators(log, manifestAPI, options, objectHandler, lso.NewLSOperator(), ocs.NewOcsOperator(log), cnv.NewCNVOperator(log, options.CNVConfig))
+	olmOperators := []api.Operator{lso.NewLSOperator(), ocs.NewOcsOperator(log), cnv.NewCNVOperator(log, options.CNVConfig), sriov.NewSriovOperator(log, options.SRIOVConfig), lvm.NewLvmOperator(log)}
+	return NewManagerWithOperators(log, manifestAPI, options, objectHandler, append(olmOperators, catalogOperators(log, olmOperators, options.Catalog)...)...)
+}
+
+// catalogOperators creates the operators of the catalog, skipping the ones that conflict with other operators
+// or depend, directly or through other operators of the catalog, on unknown operators
+func catalogOperators(log logrus.FieldLogger, builtinOperators []api.Operator, catalog generic.Catalog) []api.Operator {
+	known := make(map[string]bool)
+	for _, operator := range builtinOperators {
+		known[operator.GetName()] = true
+	}
+	definitions := make(generic.Catalog, 0, len(catalog))
+	for _, definition := range catalog {
+		if known[definition.Name] {
+			log.Errorf("Operator %s of the catalog conflicts with another operator and is ignored", definition.Name)
+			continue
+		}
+		known[definition.Name] = true
+		definitions = append(definitions, definition)
+	}
+
+	// Ignoring an operator makes the operators that depend on it unknown too, so the operators are
+	// filtered until no more operators are ignored
+	ret := generic.NewGenericOperators(log, definitions)
+	for ignored := true; ignored; {
+		ignored = false
+		accepted := make([]api.Operator, 0, len(ret))
+		for _, operator := range ret {
+			if missing := funk.FilterString(operator.GetDependencies(), func(dep string) bool { return !known[dep] }); len(missing) > 0 {
+				log.Errorf("Operator %s of the catalog depends on unknown operators %v and is ignored", operator.GetName(), missing)
+				delete(known, operator.GetName())
+				ignored = true
+				continue
+			}
+			accepted = append(accepted, operator)
+		}
+		ret = accepted
+	}
+	return ret
 }
 
 // NewManagerWithOperators creates new instance of an Operator Manager and configures it with given operators
@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/generic"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 )
@@ -74,4 +75,42 @@ var _ = Describe("Operators manager builder", func() {
 		Expect(monitoredOperatorsList).To(HaveKeyWithValue(OperatorConsole.Name, &OperatorConsole))
 		Expect(monitoredOperatorsList).To(HaveKeyWithValue(OperatorCVO.Name, &OperatorCVO))
 	})
+	It("should add the operators of the catalog to the OLM operators", func() {
+		catalog, err := generic.ParseCatalog([]byte(`
+- name: nmstate
+  namespace: openshift-nmstate
+  subscription_name: kubernetes-nmstate-operator
+  dependencies: [lso]
+- name: lso
+  namespace: openshift-local-storage
+  subscription_name: local-storage-operator
+- name: broken
+  namespace: broken
+  subscription_name: broken
+  dependencies: [unknown]
+- name: depends-on-broken
+  namespace: depends-on-broken
+  subscription_name: depends-on-broken
+  dependencies: [lso, broken]
+- name: depends-on-depends-on-broken
+  namespace: depends-on-depends-on-broken
+  subscription_name: depends-on-depends-on-broken
+  dependencies: [depends-on-broken]
+`))
+		Expect(err).ToNot(HaveOccurred())
+
+		manager := NewManager(log, nil, Options{Catalog: catalog}, nil)
+
+		Expect(manager.GetSupportedOperators()).To(ConsistOf("lso", "ocs", "cnv", "sriov", "lvm", "nmstate"))
+		operator, err := manager.GetOperatorByName("nmstate")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operator.OperatorType).To(Equal(models.OperatorTypeOlm))
+		Expect(operator.Namespace).To(Equal("openshift-nmstate"))
+		Expect(operator.SubscriptionName).To(Equal("kubernetes-nmstate-operator"))
+
+		operators, err := manager.ResolveDependencies([]*models.MonitoredOperator{operator})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(HaveLen(2))
+		Expect(operators[1].Name).To(Equal("lso"))
+	})
 })
@@ -30,6 +30,24 @@ type operator struct {
 	config Config
 }
 
+// Channels are the subscription channels of OpenShift Virtualization by OpenShift version
+var Channels = api.Channels{
+	"4.6":  {defaultChannel},
+	"4.7":  {defaultChannel},
+	"4.8":  {defaultChannel, "candidate"},
+	"4.9":  {defaultChannel, "candidate"},
+	"4.10": {defaultChannel, "candidate"},
+}
+
+// ClusterServiceVersions are the versions of OpenShift Virtualization by OpenShift version
+var ClusterServiceVersions = api.ClusterServiceVersions{
+	"4.6":  {"2.5"},
+	"4.7":  {"2.6"},
+	"4.8":  {"4.8"},
+	"4.9":  {"4.9"},
+	"4.10": {"4.10"},
+}
+
 var Operator = models.MonitoredOperator{
 	Name:             "cnv",
 	Namespace:        DownstreamNamespace,
@@ -120,7 +138,7 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 
 // GenerateManifests generates manifests for the operator
 func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, map[string][]byte, error) {
-	return Manifests(o.config)
+	return Manifests(o.config, c)
 }
 
 // GetProperties provides description of operator properties: none required
@@ -128,6 +146,20 @@ func (o *operator) GetProperties() models.OperatorProperties {
 	return models.OperatorProperties{}
 }
 
+// GetSupportedChannels returns the subscription channels of the operator that are compatible with the OpenShift version
+func (o *operator) GetSupportedChannels(openshiftVersion string) []string {
+	return Channels.For(openshiftVersion)
+}
+
+// GetSupportedClusterServiceVersions returns the versions of the operator that are compatible with the OpenShift
+// version: the versions of the community operator don't follow OpenShift, so none of them is listed
+func (o *operator) GetSupportedClusterServiceVersions(openshiftVersion string) []string {
+	if !o.config.Mode {
+		return []string{}
+	}
+	return ClusterServiceVersions.For(openshiftVersion)
+}
+
 // GetMonitoredOperator returns MonitoredOperator corresponding to the CNV Operator
 func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
 	opt := Operator
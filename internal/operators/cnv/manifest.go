@@ -3,6 +3,9 @@ package cnv
 import (
 	"bytes"
 	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
 )
 
 const (
@@ -14,6 +17,8 @@ const (
 
 	upstreamSourceName   string = "community-kubevirt-hyperconverged"
 	downstreamSourceName string = "kubevirt-hyperconverged"
+
+	defaultChannel string = "stable"
 )
 
 type manifestConfig struct {
@@ -35,9 +40,9 @@ func configSource(config Config) manifestConfig {
 }
 
 // Manifests returns manifests needed to deploy CNV
-func Manifests(config Config) (map[string][]byte, map[string][]byte, error) {
+func Manifests(config Config, cluster *common.Cluster) (map[string][]byte, map[string][]byte, error) {
 	configSource := configSource(config)
-	cnvSubsManifest, err := subscription(configSource)
+	cnvSubsManifest, err := subscription(configSource, cluster)
 
 	if err != nil {
 		return nil, nil, err
@@ -65,12 +70,15 @@ func Manifests(config Config) (map[string][]byte, map[string][]byte, error) {
 	return openshiftManifests, manifests, nil
 }
 
-func subscription(config manifestConfig) ([]byte, error) {
+func subscription(config manifestConfig, cluster *common.Cluster) ([]byte, error) {
+	channel, csv := api.Subscription(cluster, Operator.Name, defaultChannel)
 	data := map[string]string{
 		"OPERATOR_NAMESPACE":         config.Namespace,
 		"OPERATOR_SUBSCRIPTION_NAME": Operator.SubscriptionName,
 		"OPERATOR_SOURCE":            config.Source,
 		"OPERATOR_SOURCE_NAME":       config.SourceName,
+		"OPERATOR_CHANNEL":           channel,
+		"OPERATOR_CSV":               csv,
 	}
 	return executeTemplate(data, "cnvSubscription", cnvSubscription)
 }
@@ -118,7 +126,10 @@ spec:
   source: "{{.OPERATOR_SOURCE}}"
   sourceNamespace: openshift-marketplace
   name: "{{.OPERATOR_SOURCE_NAME}}"
-  channel: stable
+  channel: "{{.OPERATOR_CHANNEL}}"
+{{- if .OPERATOR_CSV }}
+  startingCSV: "{{.OPERATOR_CSV}}"
+{{- end }}
   installPlanApproval: "Automatic"`
 
 const cnvNamespace = `apiVersion: v1
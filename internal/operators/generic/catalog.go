@@ -0,0 +1,182 @@
+package generic
+
+import (
+	"io/ioutil"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultSource          = "redhat-operators"
+	defaultSourceNamespace = "openshift-marketplace"
+	defaultTimeoutSeconds  = 60 * 60
+)
+
+// Definition describes an OLM operator that is installed with the cluster by its subscription, without an operator
+// specific installation plugin
+type Definition struct {
+	// Name of the operator, as requested in the olm_operators of the cluster
+	Name string `json:"name"`
+	// Namespace the operator is installed in
+	Namespace string `json:"namespace"`
+	// SubscriptionName is the name of the subscription of the operator
+	SubscriptionName string `json:"subscription_name"`
+	// Package is the name of the operator package in the catalog source, defaults to the subscription name
+	Package string `json:"package,omitempty"`
+	// Channel is the subscription channel, the default channel of the package is used if empty
+	Channel string `json:"channel,omitempty"`
+	// Channels are the channels, by "major.minor" OpenShift version, that users can select instead of the Channel
+	Channels api.Channels `json:"channels,omitempty"`
+	// ClusterServiceVersions are the "major.minor" versions of the operator, by "major.minor" OpenShift version, whose
+	// ClusterServiceVersions users can select
+	ClusterServiceVersions api.ClusterServiceVersions `json:"cluster_service_versions,omitempty"`
+	// Source is the catalog source of the operator, defaults to redhat-operators
+	Source string `json:"source,omitempty"`
+	// SourceNamespace is the namespace of the catalog source, defaults to openshift-marketplace
+	SourceNamespace string `json:"source_namespace,omitempty"`
+	// AllNamespaces makes the operator group of the operator target all the namespaces instead of its own
+	AllNamespaces bool `json:"all_namespaces,omitempty"`
+	// Dependencies are the names of the operators the operator requires
+	Dependencies []string `json:"dependencies,omitempty"`
+	// TimeoutSeconds is the time the operator is given to become available after the installation
+	TimeoutSeconds int64 `json:"timeout_seconds,omitempty"`
+	// MinOpenshiftVersion is the minimal OpenShift version the operator can be installed with
+	MinOpenshiftVersion string `json:"min_openshift_version,omitempty"`
+	// Requirements are the additional hardware requirements of the operator towards the hosts by their role
+	Requirements Requirements `json:"requirements,omitempty"`
+	// Manifests are additional manifests installed with the operator
+	Manifests []Manifest `json:"manifests,omitempty"`
+}
+
+// Requirements are the hardware requirements of an operator towards the masters and the workers
+type Requirements struct {
+	Master *models.ClusterHostRequirementsDetails `json:"master,omitempty"`
+	Worker *models.ClusterHostRequirementsDetails `json:"worker,omitempty"`
+}
+
+// Manifest is a template of a manifest of an operator
+type Manifest struct {
+	// FileName is the name of the manifest file
+	FileName string `json:"file_name"`
+	// Content is a text/template of the manifest
+	Content string `json:"content"`
+	// PostInstall manifests are applied after OLM is deployed, so they may contain resources provided by the operator
+	PostInstall bool `json:"post_install,omitempty"`
+}
+
+// Catalog is a list of operator definitions, formatted as YAML or JSON
+type Catalog []*Definition
+
+func (c *Catalog) Decode(value string) error {
+	catalog, err := ParseCatalog([]byte(value))
+	if err != nil {
+		return err
+	}
+	*c = catalog
+	return nil
+}
+
+// LoadCatalog reads the operator definitions of a catalog file, e.g. mounted from a ConfigMap
+func LoadCatalog(fileName string) (Catalog, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read operators catalog %s", fileName)
+	}
+	catalog, err := ParseCatalog(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid operators catalog %s", fileName)
+	}
+	return catalog, nil
+}
+
+// ParseCatalog parses and validates the operator definitions of a catalog
+func ParseCatalog(content []byte) (Catalog, error) {
+	var catalog Catalog
+	if err := yaml.Unmarshal(content, &catalog); err != nil {
+		return nil, err
+	}
+	if err := catalog.validate(); err != nil {
+		return nil, err
+	}
+	for _, definition := range catalog {
+		definition.setDefaults()
+	}
+	return catalog, nil
+}
+
+func (c Catalog) validate() error {
+	names := make(map[string]bool)
+	for _, definition := range c {
+		if definition == nil {
+			return errors.New("operator definition must not be empty")
+		}
+		if err := definition.validate(); err != nil {
+			return errors.Wrapf(err, "invalid definition of operator %s", definition.Name)
+		}
+		if names[definition.Name] {
+			return errors.Errorf("operator %s is defined more than once", definition.Name)
+		}
+		names[definition.Name] = true
+	}
+	return nil
+}
+
+func (d *Definition) validate() error {
+	if errs := validation.IsDNS1123Label(d.Name); len(errs) > 0 {
+		return errors.Errorf("name %q is invalid: %v", d.Name, errs)
+	}
+	if errs := validation.IsDNS1123Label(d.Namespace); len(errs) > 0 {
+		return errors.Errorf("namespace %q is invalid: %v", d.Namespace, errs)
+	}
+	if d.SubscriptionName == "" {
+		return errors.New("subscription_name is required")
+	}
+	if d.TimeoutSeconds < 0 {
+		return errors.New("timeout_seconds must not be negative")
+	}
+	for _, dependency := range d.Dependencies {
+		if dependency == d.Name {
+			return errors.New("operator must not depend on itself")
+		}
+	}
+	fileNames := make(map[string]bool)
+	for _, manifest := range d.Manifests {
+		if manifest.FileName == "" {
+			return errors.New("file_name of a manifest is required")
+		}
+		if fileNames[manifest.FileName] {
+			return errors.Errorf("manifest %s is defined more than once", manifest.FileName)
+		}
+		fileNames[manifest.FileName] = true
+		if _, err := template.New(manifest.FileName).Option("missingkey=error").Parse(manifest.Content); err != nil {
+			return errors.Wrapf(err, "invalid template of manifest %s", manifest.FileName)
+		}
+	}
+	return nil
+}
+
+func (d *Definition) setDefaults() {
+	if d.Package == "" {
+		d.Package = d.SubscriptionName
+	}
+	if d.Source == "" {
+		d.Source = defaultSource
+	}
+	if d.SourceNamespace == "" {
+		d.SourceNamespace = defaultSourceNamespace
+	}
+	if d.TimeoutSeconds == 0 {
+		d.TimeoutSeconds = defaultTimeoutSeconds
+	}
+	if d.Requirements.Master == nil {
+		d.Requirements.Master = &models.ClusterHostRequirementsDetails{}
+	}
+	if d.Requirements.Worker == nil {
+		d.Requirements.Worker = &models.ClusterHostRequirementsDetails{}
+	}
+}
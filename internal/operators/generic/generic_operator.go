@@ -0,0 +1,149 @@
+package generic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// operator is an OLM operator plugin driven by a catalog definition; it implements api.Operator
+type operator struct {
+	log        logrus.FieldLogger
+	definition *Definition
+}
+
+// NewGenericOperator creates new instance of an installation plugin of the operator of the catalog definition
+func NewGenericOperator(log logrus.FieldLogger, definition *Definition) *operator {
+	return &operator{
+		log:        log,
+		definition: definition,
+	}
+}
+
+// NewGenericOperators creates installation plugins of all the operators of the catalog
+func NewGenericOperators(log logrus.FieldLogger, catalog Catalog) []api.Operator {
+	operators := make([]api.Operator, 0, len(catalog))
+	for _, definition := range catalog {
+		operators = append(operators, NewGenericOperator(log, definition))
+	}
+	return operators
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return o.definition.Name
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies() []string {
+	return append(make([]string, 0, len(o.definition.Dependencies)), o.definition.Dependencies...)
+}
+
+// GetClusterValidationID returns cluster validation ID for the Operator
+func (o *operator) GetClusterValidationID() string {
+	return o.definition.Name + api.ValidationIDSuffix
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return o.definition.Name + api.ValidationIDSuffix
+}
+
+// ValidateCluster verifies that the OpenShift version of the cluster is supported by the operator
+func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (api.ValidationResult, error) {
+	result := api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID(), Reasons: []string{}}
+	if o.definition.MinOpenshiftVersion == "" {
+		return result, nil
+	}
+	supported, err := common.VersionGreaterOrEqual(cluster.OpenshiftVersion, o.definition.MinOpenshiftVersion)
+	if err != nil {
+		o.log.WithError(err).Errorf("Failed to compare OpenShift version %s of cluster %s", cluster.OpenshiftVersion, cluster.ID)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID()}, err
+	}
+	if !supported {
+		result.Status = api.Failure
+		result.Reasons = []string{fmt.Sprintf("%s requires OpenShift version %s or newer", o.GetName(), o.definition.MinOpenshiftVersion)}
+	}
+	return result, nil
+}
+
+// ValidateHost always return "valid" result, the hardware requirements of the operator are verified by the hardware validations
+func (o *operator) ValidateHost(_ context.Context, _ *common.Cluster, _ *models.Host) (api.ValidationResult, error) {
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID(), Reasons: []string{}}, nil
+}
+
+// GenerateManifests generates manifests for the operator
+func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, map[string][]byte, error) {
+	return Manifests(o.definition, c)
+}
+
+// GetProperties provides description of operator properties: none required
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetSupportedChannels returns the channel of the definition and the channels it lists for the OpenShift version
+func (o *operator) GetSupportedChannels(openshiftVersion string) []string {
+	channels := make([]string, 0)
+	if o.definition.Channel != "" {
+		channels = append(channels, o.definition.Channel)
+	}
+	for _, channel := range o.definition.Channels.For(openshiftVersion) {
+		if channel != o.definition.Channel {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// GetSupportedClusterServiceVersions returns the versions of the operator the definition lists for the OpenShift version
+func (o *operator) GetSupportedClusterServiceVersions(openshiftVersion string) []string {
+	return o.definition.ClusterServiceVersions.For(openshiftVersion)
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the operator of the definition
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &models.MonitoredOperator{
+		Name:             o.definition.Name,
+		OperatorType:     models.OperatorTypeOlm,
+		Namespace:        o.definition.Namespace,
+		SubscriptionName: o.definition.SubscriptionName,
+		TimeoutSeconds:   o.definition.TimeoutSeconds,
+	}
+}
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(_ context.Context, _ *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	switch host.Role {
+	case models.HostRoleMaster:
+		return copyRequirements(o.definition.Requirements.Master), nil
+	case models.HostRoleWorker, models.HostRoleAutoAssign:
+		return copyRequirements(o.definition.Requirements.Worker), nil
+	}
+	return nil, fmt.Errorf("unsupported role: %s", host.Role)
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(context.Context, *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: o.GetDependencies(),
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: &models.HostTypeHardwareRequirements{
+				Quantitative: copyRequirements(o.definition.Requirements.Master),
+			},
+			Worker: &models.HostTypeHardwareRequirements{
+				Quantitative: copyRequirements(o.definition.Requirements.Worker),
+			},
+		},
+	}, nil
+}
+
+func copyRequirements(requirements *models.ClusterHostRequirementsDetails) *models.ClusterHostRequirementsDetails {
+	ret := *requirements
+	return &ret
+}
@@ -0,0 +1,164 @@
+package generic
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+const catalogYAML = `
+- name: nmstate
+  namespace: openshift-nmstate
+  subscription_name: kubernetes-nmstate-operator
+  channel: "4.8"
+  channels:
+    "4.9": ["stable", "4.8"]
+  cluster_service_versions:
+    "4.9": ["4.9", "4.8"]
+  min_openshift_version: "4.8"
+  requirements:
+    master:
+      cpu_cores: 1
+      ram_mib: 100
+    worker:
+      cpu_cores: 2
+      ram_mib: 200
+  manifests:
+    - file_name: 99_nmstate_cr.yaml
+      post_install: true
+      content: |
+        apiVersion: nmstate.io/v1beta1
+        kind: NMState
+        metadata:
+          name: nmstate
+          namespace: "{{.OPERATOR_NAMESPACE}}"
+`
+
+var _ = Describe("Operators catalog", func() {
+	It("parses the operator definitions with their defaults", func() {
+		catalog, err := ParseCatalog([]byte(catalogYAML))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(catalog).To(HaveLen(1))
+		Expect(catalog[0].Package).To(Equal("kubernetes-nmstate-operator"))
+		Expect(catalog[0].Source).To(Equal(defaultSource))
+		Expect(catalog[0].SourceNamespace).To(Equal(defaultSourceNamespace))
+		Expect(catalog[0].TimeoutSeconds).To(BeEquivalentTo(defaultTimeoutSeconds))
+		Expect(catalog[0].Requirements.Worker.RAMMib).To(BeEquivalentTo(200))
+	})
+
+	table.DescribeTable("rejects invalid definitions", func(content string) {
+		_, err := ParseCatalog([]byte(content))
+		Expect(err).To(HaveOccurred())
+	},
+		table.Entry("invalid name", `[{name: Op_1, namespace: ns, subscription_name: sub}]`),
+		table.Entry("missing namespace", `[{name: op, subscription_name: sub}]`),
+		table.Entry("missing subscription", `[{name: op, namespace: ns}]`),
+		table.Entry("duplicate name", `[{name: op, namespace: ns, subscription_name: sub}, {name: op, namespace: ns, subscription_name: sub}]`),
+		table.Entry("self dependency", `[{name: op, namespace: ns, subscription_name: sub, dependencies: [op]}]`),
+		table.Entry("invalid template", `[{name: op, namespace: ns, subscription_name: sub, manifests: [{file_name: m.yaml, content: "{{.X"}]}]`),
+	)
+})
+
+var _ = Describe("Generic operator", func() {
+	var (
+		operator api.Operator
+		cluster  *common.Cluster
+	)
+
+	BeforeEach(func() {
+		catalog, err := ParseCatalog([]byte(catalogYAML))
+		Expect(err).ToNot(HaveOccurred())
+		operator = NewGenericOperator(logrus.New(), catalog[0])
+		cluster = &common.Cluster{Cluster: models.Cluster{Name: "test", OpenshiftVersion: "4.8"}}
+	})
+
+	It("reports its validation IDs and monitored operator", func() {
+		Expect(operator.GetClusterValidationID()).To(Equal("nmstate-requirements-satisfied"))
+		Expect(operator.GetHostValidationID()).To(Equal("nmstate-requirements-satisfied"))
+		Expect(operator.GetMonitoredOperator()).To(Equal(&models.MonitoredOperator{
+			Name:             "nmstate",
+			OperatorType:     models.OperatorTypeOlm,
+			Namespace:        "openshift-nmstate",
+			SubscriptionName: "kubernetes-nmstate-operator",
+			TimeoutSeconds:   defaultTimeoutSeconds,
+		}))
+	})
+
+	table.DescribeTable("validates the OpenShift version of the cluster", func(version string, expected api.ValidationStatus) {
+		cluster.OpenshiftVersion = version
+		result, err := operator.ValidateCluster(context.TODO(), cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status).To(Equal(expected))
+	},
+		table.Entry("older version", "4.7", api.Failure),
+		table.Entry("minimal version", "4.8", api.Success),
+		table.Entry("newer version", "4.9", api.Success),
+	)
+
+	table.DescribeTable("returns the host requirements by role", func(role models.HostRole, cpu, ram int64) {
+		requirements, err := operator.GetHostRequirements(context.TODO(), cluster, &models.Host{Role: role})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements.CPUCores).To(Equal(cpu))
+		Expect(requirements.RAMMib).To(Equal(ram))
+	},
+		table.Entry("master", models.HostRoleMaster, int64(1), int64(100)),
+		table.Entry("worker", models.HostRoleWorker, int64(2), int64(200)),
+		table.Entry("auto-assign", models.HostRoleAutoAssign, int64(2), int64(200)),
+	)
+
+	It("generates the subscription and the manifests of the definition", func() {
+		openshiftManifests, manifests, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(openshiftManifests).To(HaveLen(3))
+		Expect(manifests).To(HaveLen(1))
+
+		subscription := make(map[string]interface{})
+		Expect(yaml.Unmarshal(openshiftManifests["99_nmstate_subscription.yaml"], &subscription)).To(Succeed())
+		Expect(subscription["spec"]).To(Equal(map[string]interface{}{
+			"channel":             "4.8",
+			"installPlanApproval": "Automatic",
+			"name":                "kubernetes-nmstate-operator",
+			"source":              defaultSource,
+			"sourceNamespace":     defaultSourceNamespace,
+		}))
+		Expect(openshiftManifests["99_nmstate_operator_group.yaml"]).To(ContainSubstring("targetNamespaces"))
+		Expect(string(openshiftManifests["99_nmstate_subscription.yaml"])).NotTo(ContainSubstring("startingCSV"))
+		Expect(string(manifests["99_nmstate_cr.yaml"])).To(ContainSubstring(`namespace: "openshift-nmstate"`))
+		for _, manifest := range openshiftManifests {
+			_, err := yaml.YAMLToJSON(manifest)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+	})
+
+	It("generates the subscription with the selected channel and ClusterServiceVersion", func() {
+		cluster.MonitoredOperators = []*models.MonitoredOperator{{Name: "nmstate", Channel: "stable", Csv: "kubernetes-nmstate-operator.4.8.0"}}
+		openshiftManifests, _, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		subscription := make(map[string]interface{})
+		Expect(yaml.Unmarshal(openshiftManifests["99_nmstate_subscription.yaml"], &subscription)).To(Succeed())
+		Expect(subscription["spec"]).To(HaveKeyWithValue("channel", "stable"))
+		Expect(subscription["spec"]).To(HaveKeyWithValue("startingCSV", "kubernetes-nmstate-operator.4.8.0"))
+	})
+
+	table.DescribeTable("supports the channel of the definition and the channels listed for the OpenShift version", func(openshiftVersion string, expected []string) {
+		Expect(operator.GetSupportedChannels(openshiftVersion)).To(Equal(expected))
+	},
+		table.Entry("version without listed channels", "4.8", []string{"4.8"}),
+		table.Entry("version with listed channels", "4.9.1", []string{"4.8", "stable"}),
+	)
+
+	table.DescribeTable("supports the versions listed for the OpenShift version", func(openshiftVersion string, expected []string) {
+		Expect(operator.GetSupportedClusterServiceVersions(openshiftVersion)).To(Equal(expected))
+	},
+		table.Entry("version without listed versions", "4.8", []string(nil)),
+		table.Entry("version with listed versions", "4.9.1", []string{"4.9", "4.8"}),
+	)
+})
@@ -0,0 +1,107 @@
+package generic
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+)
+
+// Manifests generates the namespace, operator group and subscription of the operator of the definition, and renders
+// its additional manifests. The post-install manifests are returned separately, to be applied after OLM is deployed.
+func Manifests(definition *Definition, cluster *common.Cluster) (map[string][]byte, map[string][]byte, error) {
+	data := templateData(definition, cluster)
+	openshiftManifests := make(map[string][]byte)
+	manifests := make(map[string][]byte)
+
+	for name, content := range map[string]string{
+		fmt.Sprintf("99_%s_ns.yaml", definition.Name):             namespace,
+		fmt.Sprintf("99_%s_operator_group.yaml", definition.Name): operatorGroup,
+		fmt.Sprintf("99_%s_subscription.yaml", definition.Name):   subscription,
+	} {
+		manifest, err := executeTemplate(name, content, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		openshiftManifests[name] = manifest
+	}
+
+	for _, m := range definition.Manifests {
+		manifest, err := executeTemplate(m.FileName, m.Content, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if m.PostInstall {
+			manifests[m.FileName] = manifest
+		} else {
+			openshiftManifests[m.FileName] = manifest
+		}
+	}
+	return openshiftManifests, manifests, nil
+}
+
+func templateData(definition *Definition, cluster *common.Cluster) map[string]interface{} {
+	channel, csv := api.Subscription(cluster, definition.Name, definition.Channel)
+	return map[string]interface{}{
+		"OPERATOR_NAME":                  definition.Name,
+		"OPERATOR_NAMESPACE":             definition.Namespace,
+		"OPERATOR_SUBSCRIPTION_NAME":     definition.SubscriptionName,
+		"OPERATOR_PACKAGE":               definition.Package,
+		"OPERATOR_CHANNEL":               channel,
+		"OPERATOR_CSV":                   csv,
+		"OPERATOR_SOURCE":                definition.Source,
+		"OPERATOR_SOURCE_NAMESPACE":      definition.SourceNamespace,
+		"OPERATOR_TARGET_ALL_NAMESPACES": definition.AllNamespaces,
+		"CLUSTER_NAME":                   cluster.Name,
+		"BASE_DOMAIN":                    cluster.BaseDNSDomain,
+		"OPENSHIFT_VERSION":              cluster.OpenshiftVersion,
+	}
+}
+
+func executeTemplate(name string, content string, data map[string]interface{}) ([]byte, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err = tmpl.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+const namespace = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: "{{.OPERATOR_NAMESPACE}}"`
+
+const operatorGroup = `apiVersion: operators.coreos.com/v1
+kind: OperatorGroup
+metadata:
+  name: "{{.OPERATOR_NAME}}"
+  namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+{{- if .OPERATOR_TARGET_ALL_NAMESPACES }} {}
+{{- else }}
+  targetNamespaces:
+  - "{{.OPERATOR_NAMESPACE}}"
+{{- end }}`
+
+const subscription = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: "{{.OPERATOR_SUBSCRIPTION_NAME}}"
+  namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+{{- if .OPERATOR_CHANNEL }}
+  channel: "{{.OPERATOR_CHANNEL}}"
+{{- end }}
+{{- if .OPERATOR_CSV }}
+  startingCSV: "{{.OPERATOR_CSV}}"
+{{- end }}
+  installPlanApproval: Automatic
+  name: "{{.OPERATOR_PACKAGE}}"
+  source: "{{.OPERATOR_SOURCE}}"
+  sourceNamespace: "{{.OPERATOR_SOURCE_NAMESPACE}}"`
@@ -11,6 +11,7 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -26,11 +27,12 @@ type Handler struct {
 	db            *gorm.DB
 	log           logrus.FieldLogger
 	eventsHandler events.Handler
+	metricAPI     metrics.API
 }
 
 // NewHandler creates new handler
-func NewHandler(operatorsAPI operators.API, log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler) *Handler {
-	return &Handler{operatorsAPI: operatorsAPI, log: log, db: db, eventsHandler: eventsHandler}
+func NewHandler(operatorsAPI operators.API, log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler, metricAPI metrics.API) *Handler {
+	return &Handler{operatorsAPI: operatorsAPI, log: log, db: db, eventsHandler: eventsHandler, metricAPI: metricAPI}
 }
 
 // ListOperatorProperties Lists properties for an operator name.
@@ -130,9 +132,7 @@ func (h *Handler) UpdateMonitoredOperatorStatus(ctx context.Context, clusterID s
 		return err
 	}
 
-	operator.Status = status
-	operator.StatusInfo = statusInfo
-	operator.StatusUpdatedAt = strfmt.DateTime(time.Now())
+	changed := operators.SetStatus(operator, status, statusInfo, time.Now())
 
 	if err = tx.Save(operator).Error; err != nil {
 		err = errors.Wrapf(err, "failed to update operator %s of cluster %s", operator.Name, clusterID)
@@ -145,11 +145,31 @@ func (h *Handler) UpdateMonitoredOperatorStatus(ctx context.Context, clusterID s
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
+	txSuccess = true
+
 	if operator.Name == operators.OperatorCVO.Name {
 		eventInfo := fmt.Sprintf("Cluster version status: %s message: %s", status, statusInfo)
 		h.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityInfo, eventInfo, time.Now())
+	} else if changed {
+		severity := models.EventSeverityInfo
+		if status == models.OperatorStatusFailed {
+			severity = models.EventSeverityWarning
+		}
+		eventInfo := fmt.Sprintf("Operator %s status: %s message: %s", operator.Name, status, statusInfo)
+		h.eventsHandler.AddEvent(ctx, clusterID, nil, severity, eventInfo, time.Now())
 	}
 
-	txSuccess = true
+	if changed {
+		h.reportStatusChange(ctx, clusterID, operator)
+	}
 	return nil
 }
+
+func (h *Handler) reportStatusChange(ctx context.Context, clusterID strfmt.UUID, operator *models.MonitoredOperator) {
+	cluster, err := common.GetClusterFromDB(h.db, clusterID, common.SkipEagerLoading)
+	if err != nil {
+		logutil.FromContext(ctx, h.log).WithError(err).Warnf("failed to report the status change of operator %s", operator.Name)
+		return
+	}
+	h.metricAPI.MonitoredOperatorStatusChanged(cluster.OpenshiftVersion, operator.Name, operator.Status)
+}
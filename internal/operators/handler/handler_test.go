@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/operators"
 	operatorsHandler "github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/operators/lso"
@@ -29,6 +30,7 @@ var _ = Describe("Operators manager", func() {
 		ctrl              *gomock.Controller
 		mockApi           *operators.MockAPI
 		mockEvents        *events.MockHandler
+		mockMetric        *metrics.MockAPI
 		handler           *operatorsHandler.Handler
 		lastUpdatedTime   strfmt.DateTime
 	)
@@ -38,7 +40,8 @@ var _ = Describe("Operators manager", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockApi = operators.NewMockAPI(ctrl)
 		mockEvents = events.NewMockHandler(ctrl)
-		handler = operatorsHandler.NewHandler(mockApi, log, db, mockEvents)
+		mockMetric = metrics.NewMockAPI(ctrl)
+		handler = operatorsHandler.NewHandler(mockApi, log, db, mockEvents, mockMetric)
 
 		// create simple cluster #1
 		clusterID := strfmt.UUID(uuid.New().String())
@@ -140,6 +143,9 @@ var _ = Describe("Operators manager", func() {
 			operatorName := common.TestDefaultConfig.MonitoredOperator.Name
 			newStatus := models.OperatorStatusFailed
 
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityWarning, gomock.Any(), gomock.Any()).Times(1)
+			mockMetric.EXPECT().MonitoredOperatorStatusChanged(cluster.OpenshiftVersion, operatorName, newStatus).Times(1)
+
 			err := handler.UpdateMonitoredOperatorStatus(context.TODO(), *cluster.ID, operatorName, newStatus, statusInfo)
 
 			Expect(err).ToNot(HaveOccurred())
@@ -192,10 +198,31 @@ var _ = Describe("Operators manager", func() {
 			statusInfo := common.TestDefaultConfig.StatusInfo
 
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+			mockMetric.EXPECT().MonitoredOperatorStatusChanged(cluster.OpenshiftVersion, operators.OperatorCVO.Name, newStatus).Times(1)
 
 			err := handler.UpdateMonitoredOperatorStatus(context.TODO(), *cluster.ID, operators.OperatorCVO.Name, newStatus, statusInfo)
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should record the status changes in the status history", func() {
+			operatorName := lso.Operator.Name
+
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(2)
+			mockMetric.EXPECT().MonitoredOperatorStatusChanged(cluster.OpenshiftVersion, operatorName, models.OperatorStatusProgressing).Times(1)
+			mockMetric.EXPECT().MonitoredOperatorStatusChanged(cluster.OpenshiftVersion, operatorName, models.OperatorStatusAvailable).Times(1)
+
+			Expect(handler.UpdateMonitoredOperatorStatus(context.TODO(), *cluster.ID, operatorName, models.OperatorStatusProgressing, "installing")).To(Succeed())
+			Expect(handler.UpdateMonitoredOperatorStatus(context.TODO(), *cluster.ID, operatorName, models.OperatorStatusProgressing, "still installing")).To(Succeed())
+			Expect(handler.UpdateMonitoredOperatorStatus(context.TODO(), *cluster.ID, operatorName, models.OperatorStatusAvailable, "installed")).To(Succeed())
+
+			operator, err := handler.FindMonitoredOperator(context.TODO(), *cluster.ID, operatorName, db)
+			Expect(err).ToNot(HaveOccurred())
+			history := operator.StatusHistory
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].Status).To(Equal(models.OperatorStatusProgressing))
+			Expect(history[0].StatusInfo).To(Equal("installing"))
+			Expect(history[1].Status).To(Equal(models.OperatorStatusAvailable))
+		})
 	})
 })
 
@@ -12,6 +12,24 @@ import (
 type lsOperator struct {
 }
 
+// Channels are the subscription channels of the Local Storage Operator by OpenShift version
+var Channels = api.Channels{
+	"4.6":  {"4.6"},
+	"4.7":  {"4.7"},
+	"4.8":  {"4.8", "stable"},
+	"4.9":  {"stable"},
+	"4.10": {"stable"},
+}
+
+// ClusterServiceVersions are the versions of the Local Storage Operator by OpenShift version
+var ClusterServiceVersions = api.ClusterServiceVersions{
+	"4.6":  {"4.6"},
+	"4.7":  {"4.7"},
+	"4.8":  {"4.8"},
+	"4.9":  {"4.9"},
+	"4.10": {"4.10"},
+}
+
 var Operator = models.MonitoredOperator{
 	Name:             "lso",
 	OperatorType:     models.OperatorTypeOlm,
@@ -58,7 +76,7 @@ func (l *lsOperator) ValidateHost(_ context.Context, _ *common.Cluster, _ *model
 // GenerateManifests generates manifests for the operator
 
 func (l *lsOperator) GenerateManifests(c *common.Cluster) (map[string][]byte, map[string][]byte, error) {
-	return Manifests()
+	return Manifests(c)
 }
 
 // GetProperties provides description of operator properties: none required
@@ -66,6 +84,16 @@ func (l *lsOperator) GetProperties() models.OperatorProperties {
 	return models.OperatorProperties{}
 }
 
+// GetSupportedChannels returns the subscription channels of the operator that are compatible with the OpenShift version
+func (l *lsOperator) GetSupportedChannels(openshiftVersion string) []string {
+	return Channels.For(openshiftVersion)
+}
+
+// GetSupportedClusterServiceVersions returns the versions of the operator that are compatible with the OpenShift version
+func (l *lsOperator) GetSupportedClusterServiceVersions(openshiftVersion string) []string {
+	return ClusterServiceVersions.For(openshiftVersion)
+}
+
 // GetMonitoredOperator returns MonitoredOperator corresponding to the LSO
 func (l *lsOperator) GetMonitoredOperator() *models.MonitoredOperator {
 	return &Operator
@@ -3,12 +3,18 @@ package lso
 import (
 	"bytes"
 	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
 )
 
-func lsoSubscription() ([]byte, error) {
+func lsoSubscription(cluster *common.Cluster) ([]byte, error) {
+	channel, csv := api.Subscription(cluster, Operator.Name, "")
 	data := map[string]string{
 		"OPERATOR_NAMESPACE":         Operator.Namespace,
 		"OPERATOR_SUBSCRIPTION_NAME": Operator.SubscriptionName,
+		"OPERATOR_CHANNEL":           channel,
+		"OPERATOR_CSV":               csv,
 	}
 
 	const lsoSubscription = `apiVersion: operators.coreos.com/v1alpha1
@@ -17,6 +23,12 @@ metadata:
   name: "{{.OPERATOR_SUBSCRIPTION_NAME}}"
   namespace: "{{.OPERATOR_NAMESPACE}}"
 spec:
+{{- if .OPERATOR_CHANNEL }}
+  channel: "{{.OPERATOR_CHANNEL}}"
+{{- end }}
+{{- if .OPERATOR_CSV }}
+  startingCSV: "{{.OPERATOR_CSV}}"
+{{- end }}
   installPlanApproval: Automatic
   name: local-storage-operator
   source: redhat-operators
@@ -34,8 +46,8 @@ spec:
 	return buf.Bytes(), nil
 }
 
-func Manifests() (map[string][]byte, map[string][]byte, error) {
-	lsoSubs, err := lsoSubscription()
+func Manifests(cluster *common.Cluster) (map[string][]byte, map[string][]byte, error) {
+	lsoSubs, err := lsoSubscription(cluster)
 	if err != nil {
 		return nil, nil, err
 	}
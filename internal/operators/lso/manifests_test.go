@@ -36,4 +36,19 @@ var _ = Describe("LSO manifest generation", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 		}
 	})
+
+	Context("Create LSO Manifest with the selected channel", func() {
+		selected := common.Cluster{Cluster: models.Cluster{
+			OpenshiftVersion:   "4.8",
+			MonitoredOperators: []*models.MonitoredOperator{{Name: Operator.Name, Channel: "stable", Csv: "local-storage-operator.4.8.0"}},
+		}}
+
+		openshiftManifests, _, err := operator.GenerateManifests(&selected)
+		Expect(err).ShouldNot(HaveOccurred())
+		subscription := string(openshiftManifests["99_openshift-lso_subscription.yaml"])
+		Expect(subscription).To(ContainSubstring(`channel: "stable"`))
+		Expect(subscription).To(ContainSubstring(`startingCSV: "local-storage-operator.4.8.0"`))
+		_, err = yaml.YAMLToJSON([]byte(subscription))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
 })
@@ -0,0 +1,202 @@
+package lvm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/ocs"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	// Memory value provided in MiB
+	MasterMemory int64 = 400
+	MasterCPU    int64 = 1
+	// LvmMinOpenshiftVersion is the first OpenShift version the LVM Operator is shipped with
+	LvmMinOpenshiftVersion = "4.10"
+)
+
+// operator is a Logical Volume Manager storage OLM plugin; it implements api.Operator
+type operator struct {
+	log logrus.FieldLogger
+}
+
+var Operator = models.MonitoredOperator{
+	Name:             "lvm",
+	OperatorType:     models.OperatorTypeOlm,
+	Namespace:        "openshift-storage",
+	SubscriptionName: "odf-lvm-operator",
+	TimeoutSeconds:   30 * 60,
+}
+
+// NewLvmOperator creates new instance of a Logical Volume Manager installation plugin
+func NewLvmOperator(log logrus.FieldLogger) *operator {
+	return &operator{
+		log: log,
+	}
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return Operator.Name
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies() []string {
+	return make([]string, 0)
+}
+
+// GetClusterValidationID returns cluster validation ID for the Operator
+func (o *operator) GetClusterValidationID() string {
+	return string(models.ClusterValidationIDLvmRequirementsSatisfied)
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return string(models.HostValidationIDLvmRequirementsSatisfied)
+}
+
+// ValidateCluster verifies that the cluster is a single-node cluster of a supported version and that OCS is not enabled
+func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (api.ValidationResult, error) {
+	result := api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID(), Reasons: []string{}}
+
+	if !common.IsSingleNodeCluster(cluster) {
+		result.Reasons = append(result.Reasons, "Logical Volume Manager is only supported for single-node clusters")
+	}
+	if isOcsEnabled(cluster) {
+		result.Reasons = append(result.Reasons, "Logical Volume Manager cannot be installed together with OpenShift Container Storage")
+	}
+	supported, err := common.VersionGreaterOrEqual(cluster.OpenshiftVersion, LvmMinOpenshiftVersion)
+	if err != nil {
+		o.log.WithError(err).Errorf("Failed to compare OpenShift version %s of cluster %s", cluster.OpenshiftVersion, cluster.ID)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID()}, err
+	}
+	if !supported {
+		result.Reasons = append(result.Reasons, fmt.Sprintf("Logical Volume Manager is only supported for OpenShift versions %s and above", LvmMinOpenshiftVersion))
+	}
+
+	if len(result.Reasons) > 0 {
+		result.Status = api.Failure
+	}
+	return result, nil
+}
+
+// ValidateHost verifies that the host has at least one eligible disk besides the installation disk
+func (o *operator) ValidateHost(_ context.Context, _ *common.Cluster, host *models.Host) (api.ValidationResult, error) {
+	if host.Inventory == "" {
+		o.log.Info("Empty Inventory of host with hostID ", host.ID)
+		return api.ValidationResult{Status: api.Pending, ValidationId: o.GetHostValidationID(), Reasons: []string{"Missing Inventory in some of the hosts"}}, nil
+	}
+	inventory, err := hostutil.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		o.log.Errorf("Failed to get inventory from host with id %s", host.ID)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
+	}
+
+	if len(storageDisks(host, inventory)) == 0 {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(),
+			Reasons: []string{"Logical Volume Manager requires at least one eligible disk besides the installation disk"}}, nil
+	}
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
+}
+
+// GenerateManifests generates manifests for the operator
+func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, map[string][]byte, error) {
+	return Manifests(c)
+}
+
+// GetProperties provides description of operator properties: none required
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetSupportedChannels returns the subscription channels of the operator that are compatible with the OpenShift
+// version: the channels are named after the OpenShift versions the operator is shipped with
+func (o *operator) GetSupportedChannels(openshiftVersion string) []string {
+	if supported, err := common.VersionGreaterOrEqual(openshiftVersion, LvmMinOpenshiftVersion); err != nil || !supported {
+		return []string{}
+	}
+	return []string{channel(openshiftVersion)}
+}
+
+// GetSupportedClusterServiceVersions returns the versions of the operator that are compatible with the OpenShift
+// version: the operator is versioned after the OpenShift versions it is shipped with
+func (o *operator) GetSupportedClusterServiceVersions(openshiftVersion string) []string {
+	versions := make([]string, 0)
+	for _, channel := range o.GetSupportedChannels(openshiftVersion) {
+		versions = append(versions, strings.TrimPrefix(channel, "stable-"))
+	}
+	return versions
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the LVM Operator
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &Operator
+}
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	preflightRequirements, err := o.GetPreflightRequirements(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	switch host.Role {
+	case models.HostRoleMaster, models.HostRoleAutoAssign:
+		return preflightRequirements.Requirements.Master.Quantitative, nil
+	case models.HostRoleWorker:
+		return preflightRequirements.Requirements.Worker.Quantitative, nil
+	}
+	return nil, fmt.Errorf("unsupported role: %s", host.Role)
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(context.Context, *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: o.GetDependencies(),
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: &models.HostTypeHardwareRequirements{
+				Qualitative: []string{
+					"Single-node cluster",
+					"At least 1 eligible disk besides the installation disk",
+				},
+				Quantitative: &models.ClusterHostRequirementsDetails{
+					CPUCores: MasterCPU,
+					RAMMib:   MasterMemory,
+				},
+			},
+			Worker: &models.HostTypeHardwareRequirements{
+				Quantitative: &models.ClusterHostRequirementsDetails{},
+			},
+		},
+	}, nil
+}
+
+// storageDisks returns the eligible disks of the host that are not its installation disk. When no installation disk
+// is selected yet, the first eligible disk is the one that will be picked for the installation.
+func storageDisks(host *models.Host, inventory *models.Inventory) []*models.Disk {
+	eligibleDisks := hostutil.ListEligibleDisks(inventory)
+	installationDisk := hostutil.GetDiskByInstallationPath(inventory.Disks, hostutil.GetHostInstallationPath(host))
+	if installationDisk == nil {
+		if len(eligibleDisks) == 0 {
+			return eligibleDisks
+		}
+		return eligibleDisks[1:]
+	}
+	return funk.Filter(eligibleDisks, func(disk *models.Disk) bool {
+		return disk != installationDisk
+	}).([]*models.Disk)
+}
+
+func isOcsEnabled(cluster *common.Cluster) bool {
+	return funk.Contains(cluster.MonitoredOperators, func(operator *models.MonitoredOperator) bool {
+		return operator.Name == ocs.Operator.Name
+	})
+}
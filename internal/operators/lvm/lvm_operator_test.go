@@ -0,0 +1,110 @@
+package lvm
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/ocs"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("LVM operator", func() {
+	var (
+		log      = logrus.New()
+		operator api.Operator
+		cluster  *common.Cluster
+	)
+
+	BeforeEach(func() {
+		operator = NewLvmOperator(log)
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			OpenshiftVersion:     "4.10",
+			HighAvailabilityMode: swagString(models.ClusterHighAvailabilityModeNone),
+		}}
+	})
+
+	eligible := models.DiskInstallationEligibility{Eligible: true}
+	sda := &models.Disk{ID: "/dev/disk/by-id/sda", Name: "sda", ByPath: "/dev/disk/by-path/pci-0000:00:06.0", SizeBytes: 120 * 1024 * 1024 * 1024, InstallationEligibility: eligible}
+	sdb := &models.Disk{ID: "/dev/disk/by-id/sdb", Name: "sdb", SizeBytes: 240 * 1024 * 1024 * 1024, InstallationEligibility: eligible}
+	sr0 := &models.Disk{ID: "/dev/disk/by-id/sr0", Name: "sr0", SizeBytes: 1024, InstallationEligibility: models.DiskInstallationEligibility{Eligible: false}}
+
+	hostWithDisks := func(installationDiskID string, disks ...*models.Disk) *models.Host {
+		inventory, err := hostutil.MarshalInventory(&models.Inventory{Disks: disks})
+		Expect(err).ToNot(HaveOccurred())
+		return &models.Host{Role: models.HostRoleMaster, Inventory: inventory, InstallationDiskID: installationDiskID}
+	}
+
+	table.DescribeTable("cluster validation", func(highAvailabilityMode, openshiftVersion string, monitoredOperators []*models.MonitoredOperator, expected api.ValidationStatus) {
+		cluster.HighAvailabilityMode = swagString(highAvailabilityMode)
+		cluster.OpenshiftVersion = openshiftVersion
+		cluster.MonitoredOperators = monitoredOperators
+
+		result, err := operator.ValidateCluster(context.TODO(), cluster)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ValidationId).To(Equal(string(models.ClusterValidationIDLvmRequirementsSatisfied)))
+		Expect(result.Status).To(Equal(expected))
+	},
+		table.Entry("single-node cluster", models.ClusterHighAvailabilityModeNone, "4.10", []*models.MonitoredOperator{&Operator}, api.Success),
+		table.Entry("multi-node cluster", models.ClusterHighAvailabilityModeFull, "4.10", []*models.MonitoredOperator{&Operator}, api.Failure),
+		table.Entry("unsupported OpenShift version", models.ClusterHighAvailabilityModeNone, "4.9", []*models.MonitoredOperator{&Operator}, api.Failure),
+		table.Entry("OCS is enabled", models.ClusterHighAvailabilityModeNone, "4.10", []*models.MonitoredOperator{&Operator, &ocs.Operator}, api.Failure),
+	)
+
+	table.DescribeTable("host validation", func(host *models.Host, expected api.ValidationStatus) {
+		result, err := operator.ValidateHost(context.TODO(), cluster, host)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ValidationId).To(Equal(string(models.HostValidationIDLvmRequirementsSatisfied)))
+		Expect(result.Status).To(Equal(expected))
+	},
+		table.Entry("additional eligible disk", hostWithDisks(sda.ID, sda, sdb), api.Success),
+		table.Entry("additional eligible disk, installation disk not selected", hostWithDisks("", sda, sdb), api.Success),
+		table.Entry("installation disk only", hostWithDisks(sda.ID, sda), api.Failure),
+		table.Entry("installation disk only, not selected", hostWithDisks("", sda), api.Failure),
+		table.Entry("additional ineligible disk", hostWithDisks(sda.ID, sda, sr0), api.Failure),
+		table.Entry("no inventory", &models.Host{Role: models.HostRoleMaster}, api.Pending),
+	)
+
+	It("reports the preflight requirements", func() {
+		requirements, err := operator.GetPreflightRequirements(context.TODO(), cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements.OperatorName).To(Equal(Operator.Name))
+		Expect(requirements.Requirements.Master.Qualitative).NotTo(BeEmpty())
+		Expect(requirements.Requirements.Master.Quantitative).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: MasterCPU, RAMMib: MasterMemory}))
+	})
+
+	It("generates the operator manifests and an LVMCluster selecting the additional disks", func() {
+		cluster.Hosts = []*models.Host{hostWithDisks(sdb.ID, sda, sdb, sr0)}
+
+		openshiftManifests, manifests, err := operator.GenerateManifests(cluster)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(openshiftManifests).To(HaveLen(3))
+		Expect(openshiftManifests["99_openshift-lvm_ns.yaml"]).NotTo(HaveLen(0))
+		Expect(openshiftManifests["99_openshift-lvm_operator_group.yaml"]).NotTo(HaveLen(0))
+		Expect(string(openshiftManifests["99_openshift-lvm_subscription.yaml"])).To(ContainSubstring(`channel: "stable-4.10"`))
+		Expect(manifests).To(HaveLen(1))
+		lvmCluster := string(manifests["50_openshift-lvm_lvmcluster.yaml"])
+		Expect(lvmCluster).To(ContainSubstring(sda.ByPath))
+		Expect(lvmCluster).NotTo(ContainSubstring("/dev/sdb"))
+		Expect(lvmCluster).NotTo(ContainSubstring("sr0"))
+		for _, manifest := range []map[string][]byte{openshiftManifests, manifests} {
+			for _, content := range manifest {
+				_, err := yaml.YAMLToJSON(content)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		}
+	})
+})
+
+func swagString(s string) *string {
+	return &s
+}
@@ -0,0 +1,13 @@
+package lvm
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLvm(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "LVM suite")
+}
@@ -0,0 +1,148 @@
+package lvm
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+)
+
+// channel returns the subscription channel of the operator, that is named after the OpenShift version
+func channel(openshiftVersion string) string {
+	v, err := version.NewVersion(openshiftVersion)
+	if err != nil || len(v.Segments()) < 2 {
+		return ""
+	}
+	return fmt.Sprintf("stable-%d.%d", v.Segments()[0], v.Segments()[1])
+}
+
+// devicePaths returns the paths of the disks of the cluster hosts that are selected for the volume group
+func devicePaths(cluster *common.Cluster) ([]string, error) {
+	paths := make([]string, 0)
+	for _, host := range cluster.Hosts {
+		if host.Inventory == "" {
+			continue
+		}
+		inventory, err := hostutil.UnmarshalInventory(host.Inventory)
+		if err != nil {
+			return nil, err
+		}
+		for _, disk := range storageDisks(host, inventory) {
+			paths = append(paths, devicePath(disk))
+		}
+	}
+	return paths, nil
+}
+
+// devicePath prefers the by-path link of the disk, that does not change across reboots, over its kernel name
+func devicePath(disk *models.Disk) string {
+	if disk.ByPath != "" {
+		return disk.ByPath
+	}
+	return hostutil.GetDeviceFullName(disk)
+}
+
+func executeTemplate(name string, content string, data interface{}) ([]byte, error) {
+	tmpl, err := template.New(name).Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err = tmpl.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func Manifests(cluster *common.Cluster) (map[string][]byte, map[string][]byte, error) {
+	selectedChannel, csv := api.Subscription(cluster, Operator.Name, channel(cluster.OpenshiftVersion))
+	lvmSubscription, err := executeTemplate("lvmSubscription", lvmSubscription, map[string]string{
+		"OPERATOR_NAMESPACE":         Operator.Namespace,
+		"OPERATOR_SUBSCRIPTION_NAME": Operator.SubscriptionName,
+		"OPERATOR_CHANNEL":           selectedChannel,
+		"OPERATOR_CSV":               csv,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	paths, err := devicePaths(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	lvmCluster, err := executeTemplate("lvmCluster", lvmCluster, map[string]interface{}{
+		"OPERATOR_NAMESPACE": Operator.Namespace,
+		"DEVICE_PATHS":       paths,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	openshiftManifests := make(map[string][]byte)
+	manifests := make(map[string][]byte)
+	openshiftManifests["99_openshift-lvm_ns.yaml"] = []byte(lvmNamespace)
+	openshiftManifests["99_openshift-lvm_operator_group.yaml"] = []byte(lvmOperatorGroup)
+	openshiftManifests["99_openshift-lvm_subscription.yaml"] = lvmSubscription
+	manifests["50_openshift-lvm_lvmcluster.yaml"] = lvmCluster
+	return openshiftManifests, manifests, nil
+}
+
+const lvmNamespace = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: openshift-storage
+  annotations:
+    workload.openshift.io/allowed: management
+  labels:
+    openshift.io/cluster-monitoring: "true"`
+
+const lvmOperatorGroup = `apiVersion: operators.coreos.com/v1
+kind: OperatorGroup
+metadata:
+  name: openshift-storage-operatorgroup
+  namespace: openshift-storage
+spec:
+  targetNamespaces:
+  - openshift-storage`
+
+const lvmSubscription = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: "{{.OPERATOR_SUBSCRIPTION_NAME}}"
+  namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+{{- if .OPERATOR_CHANNEL }}
+  channel: "{{.OPERATOR_CHANNEL}}"
+{{- end }}
+{{- if .OPERATOR_CSV }}
+  startingCSV: "{{.OPERATOR_CSV}}"
+{{- end }}
+  installPlanApproval: Automatic
+  name: odf-lvm-operator
+  source: redhat-operators
+  sourceNamespace: openshift-marketplace`
+
+const lvmCluster = `apiVersion: lvm.topolvm.io/v1alpha1
+kind: LVMCluster
+metadata:
+  name: lvmcluster
+  namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+  storage:
+    deviceClasses:
+    - name: vg1
+      thinPoolConfig:
+        name: thin-pool-1
+        sizePercent: 90
+        overprovisionRatio: 10
+{{- if .DEVICE_PATHS }}
+      deviceSelector:
+        paths:
+{{- range .DEVICE_PATHS }}
+        - "{{ . }}"
+{{- end }}
+{{- end }}`
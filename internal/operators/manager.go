@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const customManifestFile = "custom_manifests.yaml"
@@ -59,6 +60,8 @@ type API interface {
 	GetRequirementsBreakdownForHostInCluster(ctx context.Context, cluster *common.Cluster, host *models.Host) ([]*models.OperatorHostRequirements, error)
 	// GetPreflightRequirementsBreakdownForCluster provides host requirements breakdown for each supported OLM operator
 	GetPreflightRequirementsBreakdownForCluster(ctx context.Context, cluster *common.Cluster) ([]*models.OperatorHardwareRequirements, error)
+	// ValidateSubscriptions verifies that the channels and the ClusterServiceVersions selected for the operators are compatible with the OpenShift version
+	ValidateSubscriptions(openshiftVersion string, operators []*models.MonitoredOperator) error
 }
 
 // GetPreflightRequirementsBreakdownForCluster provides host requirements breakdown for each supported OLM operator
@@ -277,6 +280,41 @@ func (mgr *Manager) GetOperatorProperties(operatorName string) (models.OperatorP
 	return nil, errors.Errorf("Operator %s not found", operatorName)
 }
 
+// ValidateSubscriptions verifies that the channels and the ClusterServiceVersions selected for the operators are compatible with the OpenShift version
+func (mgr *Manager) ValidateSubscriptions(openshiftVersion string, operators []*models.MonitoredOperator) error {
+	for _, operator := range operators {
+		if operator.Channel == "" && operator.Csv == "" {
+			continue
+		}
+		olmOperator, ok := mgr.olmOperators[operator.Name]
+		if !ok {
+			return errors.Errorf("Operator %s does not support channel selection", operator.Name)
+		}
+		if operator.Channel != "" {
+			channels := olmOperator.GetSupportedChannels(openshiftVersion)
+			if !funk.ContainsString(channels, operator.Channel) {
+				return errors.Errorf("Channel %s of operator %s is not compatible with OpenShift version %s, supported channels: %v",
+					operator.Channel, operator.Name, openshiftVersion, channels)
+			}
+		}
+		if operator.Csv != "" {
+			if errs := validation.IsDNS1123Subdomain(operator.Csv); len(errs) > 0 {
+				return errors.Errorf("ClusterServiceVersion %s of operator %s is invalid: %v", operator.Csv, operator.Name, errs)
+			}
+			release, err := api.ClusterServiceVersionRelease(operator.Csv)
+			if err != nil {
+				return errors.Wrapf(err, "ClusterServiceVersion of operator %s is invalid", operator.Name)
+			}
+			versions := olmOperator.GetSupportedClusterServiceVersions(openshiftVersion)
+			if !funk.ContainsString(versions, release) {
+				return errors.Errorf("ClusterServiceVersion %s of operator %s is not compatible with OpenShift version %s, supported versions: %v",
+					operator.Csv, operator.Name, openshiftVersion, versions)
+			}
+		}
+	}
+	return nil
+}
+
 func (mgr *Manager) ResolveDependencies(operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
 	allDependentOperators := mgr.getDependencies(operators)
 
@@ -18,7 +18,9 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
 	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/operators/ocs"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/mocks"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
@@ -119,6 +121,25 @@ var _ = Describe("Operators manager", func() {
 			manifestsAPI.EXPECT().CreateClusterManifest(gomock.Any(), gomock.Any()).Return(operations.NewCreateClusterManifestCreated()).Times(6)
 			Expect(manager.GenerateManifests(ctx, cluster)).ShouldNot(HaveOccurred())
 		})
+
+		It("should create 3 manifests (SR-IOV) using the manifest API", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				&sriov.Operator,
+			}
+
+			manifestsAPI.EXPECT().CreateClusterManifest(gomock.Any(), gomock.Any()).Return(operations.NewCreateClusterManifestCreated()).Times(3)
+			Expect(manager.GenerateManifests(ctx, cluster)).ShouldNot(HaveOccurred())
+		})
+
+		It("should create 4 manifests (LVM) using the manifest API", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				&lvm.Operator,
+			}
+
+			mockS3Api.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			manifestsAPI.EXPECT().CreateClusterManifest(gomock.Any(), gomock.Any()).Return(operations.NewCreateClusterManifestCreated()).Times(3)
+			Expect(manager.GenerateManifests(ctx, cluster)).ShouldNot(HaveOccurred())
+		})
 	})
 
 	Context("AnyOLMOperatorEnabled", func() {
@@ -153,11 +174,13 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(3))
+			Expect(results).To(HaveLen(5))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDOcsRequirementsSatisfied), Reasons: []string{"ocs is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied), Reasons: []string{"cnv is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied), Reasons: []string{"sriov is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLvmRequirementsSatisfied), Reasons: []string{"lvm is disabled"}},
 			))
 		})
 
@@ -170,12 +193,14 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(3))
+			Expect(results).To(HaveLen(5))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied), Reasons: []string{}},
 				api.ValidationResult{Status: api.Failure, ValidationId: string(models.ClusterValidationIDOcsRequirementsSatisfied),
 					Reasons: []string{"Insufficient hosts to deploy OCS. A minimum of 3 hosts is required to deploy OCS."}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied), Reasons: []string{"cnv is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied), Reasons: []string{"sriov is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLvmRequirementsSatisfied), Reasons: []string{"lvm is disabled"}},
 			))
 		})
 	})
@@ -187,11 +212,13 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(3))
+			Expect(results).To(HaveLen(5))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDOcsRequirementsSatisfied), Reasons: []string{"ocs is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDCnvRequirementsSatisfied), Reasons: []string{"cnv is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDSriovRequirementsSatisfied), Reasons: []string{"sriov is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLvmRequirementsSatisfied), Reasons: []string{"lvm is disabled"}},
 			))
 		})
 
@@ -203,16 +230,55 @@ var _ = Describe("Operators manager", func() {
 
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(3))
+			Expect(results).To(HaveLen(5))
 
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDOcsRequirementsSatisfied), Reasons: []string{}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDCnvRequirementsSatisfied), Reasons: []string{"cnv is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDSriovRequirementsSatisfied), Reasons: []string{"sriov is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLvmRequirementsSatisfied), Reasons: []string{"lvm is disabled"}},
 			))
 		})
 	})
 
+	Context("ValidateSubscriptions", func() {
+		BeforeEach(func() {
+			manager = operators.NewManager(log, manifestsAPI, operators.Options{CNVConfig: cnv.Config{Mode: true}}, mockS3Api)
+		})
+
+		It("should not accept a ClusterServiceVersion of the community OpenShift Virtualization", func() {
+			manager = operators.NewManager(log, manifestsAPI, operators.Options{}, mockS3Api)
+			err := manager.ValidateSubscriptions("4.8", []*models.MonitoredOperator{
+				{Name: cnv.Operator.Name, Csv: "kubevirt-hyperconverged-operator.v1.4.0"},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		table.DescribeTable("should validate the selected channels and ClusterServiceVersions", func(openshiftVersion string, operator models.MonitoredOperator, valid bool) {
+			err := manager.ValidateSubscriptions(openshiftVersion, []*models.MonitoredOperator{&operator})
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+			table.Entry("no selection", "4.8", models.MonitoredOperator{Name: lso.Operator.Name}, true),
+			table.Entry("compatible channel", "4.8", models.MonitoredOperator{Name: lso.Operator.Name, Channel: "stable"}, true),
+			table.Entry("channel of another OpenShift version", "4.7", models.MonitoredOperator{Name: lso.Operator.Name, Channel: "4.8"}, false),
+			table.Entry("OpenShift version without channels", "4.5", models.MonitoredOperator{Name: ocs.Operator.Name, Channel: "stable-4.6"}, false),
+			table.Entry("channel named after the OpenShift version", "4.8.2", models.MonitoredOperator{Name: sriov.Operator.Name, Channel: "4.8"}, true),
+			table.Entry("compatible channel and ClusterServiceVersion", "4.8", models.MonitoredOperator{Name: cnv.Operator.Name, Channel: "stable", Csv: "kubevirt-hyperconverged-operator.v4.8.0"}, true),
+			table.Entry("invalid ClusterServiceVersion", "4.8", models.MonitoredOperator{Name: cnv.Operator.Name, Csv: "Kubevirt Operator"}, false),
+			table.Entry("ClusterServiceVersion without version", "4.8", models.MonitoredOperator{Name: cnv.Operator.Name, Csv: "kubevirt-hyperconverged-operator"}, false),
+			table.Entry("ClusterServiceVersion of another OpenShift version", "4.7", models.MonitoredOperator{Name: lso.Operator.Name, Csv: "local-storage-operator.4.8.0-202106291913"}, false),
+			table.Entry("ClusterServiceVersion of a version with a different numbering", "4.7", models.MonitoredOperator{Name: cnv.Operator.Name, Csv: "kubevirt-hyperconverged-operator.v2.6.5"}, true),
+			table.Entry("ClusterServiceVersion of a previous version", "4.8", models.MonitoredOperator{Name: ocs.Operator.Name, Channel: "stable-4.7", Csv: "ocs-operator.v4.7.2"}, true),
+			table.Entry("ClusterServiceVersion of an operator versioned after OpenShift", "4.10", models.MonitoredOperator{Name: lvm.Operator.Name, Csv: "odf-lvm-operator.v4.10.0"}, true),
+			table.Entry("unknown operator", "4.8", models.MonitoredOperator{Name: operators.OperatorConsole.Name, Channel: "stable"}, false),
+		)
+	})
+
 	Context("ResolveDependencies", func() {
 		table.DescribeTable("should resolve dependencies", func(input []*models.MonitoredOperator, expected []*models.MonitoredOperator) {
 			cluster.MonitoredOperators = input
@@ -238,6 +304,14 @@ var _ = Describe("Operators manager", func() {
 				[]*models.MonitoredOperator{&cnv.Operator},
 				[]*models.MonitoredOperator{&cnv.Operator, &lso.Operator},
 			),
+			table.Entry("when only SR-IOV is specified",
+				[]*models.MonitoredOperator{&sriov.Operator},
+				[]*models.MonitoredOperator{&sriov.Operator},
+			),
+			table.Entry("when only LVM is specified",
+				[]*models.MonitoredOperator{&lvm.Operator},
+				[]*models.MonitoredOperator{&lvm.Operator},
+			),
 			table.Entry("when CNV, OCS and LSO are specified",
 				[]*models.MonitoredOperator{&cnv.Operator, &ocs.Operator, &lso.Operator},
 				[]*models.MonitoredOperator{&cnv.Operator, &ocs.Operator, &lso.Operator},
@@ -249,7 +323,7 @@ var _ = Describe("Operators manager", func() {
 		It("should provide list of supported operators", func() {
 			supportedOperators := manager.GetSupportedOperators()
 
-			Expect(supportedOperators).To(ConsistOf("ocs", "lso", "cnv"))
+			Expect(supportedOperators).To(ConsistOf("ocs", "lso", "cnv", "sriov", "lvm"))
 		})
 
 		It("should provide properties of an operator", func() {
@@ -210,3 +210,17 @@ func (mr *MockAPIMockRecorder) ValidateHost(arg0, arg1, arg2 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateHost", reflect.TypeOf((*MockAPI)(nil).ValidateHost), arg0, arg1, arg2)
 }
+
+// ValidateSubscriptions mocks base method
+func (m *MockAPI) ValidateSubscriptions(arg0 string, arg1 []*models.MonitoredOperator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSubscriptions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSubscriptions indicates an expected call of ValidateSubscriptions
+func (mr *MockAPIMockRecorder) ValidateSubscriptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSubscriptions", reflect.TypeOf((*MockAPI)(nil).ValidateSubscriptions), arg0, arg1)
+}
@@ -3,6 +3,9 @@ package ocs
 import (
 	"bytes"
 	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
 )
 
 type storageInfo struct {
@@ -37,7 +40,7 @@ func generateStorageClusterManifest(StorageClusterManifest string, ocsDiskCounts
 
 }
 
-func Manifests(ocsConfig *Config) (map[string][]byte, map[string][]byte, error) {
+func Manifests(ocsConfig *Config, cluster *common.Cluster) (map[string][]byte, map[string][]byte, error) {
 	openshiftManifests := make(map[string][]byte)
 	manifests := make(map[string][]byte)
 	var ocsSC []byte
@@ -56,7 +59,7 @@ func Manifests(ocsConfig *Config) (map[string][]byte, map[string][]byte, error)
 	}
 	manifests["99_openshift-ocssc.yaml"] = ocsSC
 	openshiftManifests["99_openshift-ocs_ns.yaml"] = []byte(ocsNamespace)
-	ocsSubscription, err := ocsSubscription()
+	ocsSubscription, err := ocsSubscription(cluster)
 	if err != nil {
 		return map[string][]byte{}, map[string][]byte{}, err
 	}
@@ -65,10 +68,13 @@ func Manifests(ocsConfig *Config) (map[string][]byte, map[string][]byte, error)
 	return openshiftManifests, manifests, nil
 }
 
-func ocsSubscription() (string, error) {
+func ocsSubscription(cluster *common.Cluster) (string, error) {
+	channel, csv := api.Subscription(cluster, Operator.Name, "")
 	data := map[string]string{
 		"OPERATOR_NAMESPACE":         Operator.Namespace,
 		"OPERATOR_SUBSCRIPTION_NAME": Operator.SubscriptionName,
+		"OPERATOR_CHANNEL":           channel,
+		"OPERATOR_CSV":               csv,
 	}
 
 	const ocsSubscription = `apiVersion: operators.coreos.com/v1alpha1
@@ -77,6 +83,12 @@ metadata:
   name: "{{.OPERATOR_SUBSCRIPTION_NAME}}"
   namespace: "{{.OPERATOR_NAMESPACE}}"
 spec:
+{{- if .OPERATOR_CHANNEL }}
+  channel: "{{.OPERATOR_CHANNEL}}"
+{{- end }}
+{{- if .OPERATOR_CSV }}
+  startingCSV: "{{.OPERATOR_CSV}}"
+{{- end }}
   installPlanApproval: Automatic
   name: ocs-operator
   source: redhat-operators
@@ -20,6 +20,20 @@ type operator struct {
 	config *Config
 }
 
+// Channels are the subscription channels of the OpenShift Container Storage operator by OpenShift version
+var Channels = api.Channels{
+	"4.6": {"stable-4.6"},
+	"4.7": {"stable-4.7", "stable-4.6"},
+	"4.8": {"stable-4.8", "stable-4.7"},
+}
+
+// ClusterServiceVersions are the versions of the OCS Operator by OpenShift version
+var ClusterServiceVersions = api.ClusterServiceVersions{
+	"4.6": {"4.6"},
+	"4.7": {"4.7", "4.6"},
+	"4.8": {"4.8", "4.7"},
+}
+
 var Operator = models.MonitoredOperator{
 	Name:             "ocs",
 	OperatorType:     models.OperatorTypeOlm,
@@ -114,7 +128,7 @@ func (o *operator) ValidateHost(_ context.Context, cluster *common.Cluster, host
 // GenerateManifests generates manifests for the operator
 func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte, map[string][]byte, error) {
 	o.log.Info("No. of OCS eligible disks are ", o.config.OCSDisksAvailable)
-	return Manifests(o.config)
+	return Manifests(o.config, cluster)
 }
 
 // GetProperties provides description of operator properties: none required
@@ -122,6 +136,16 @@ func (o *operator) GetProperties() models.OperatorProperties {
 	return models.OperatorProperties{}
 }
 
+// GetSupportedChannels returns the subscription channels of the operator that are compatible with the OpenShift version
+func (o *operator) GetSupportedChannels(openshiftVersion string) []string {
+	return Channels.For(openshiftVersion)
+}
+
+// GetSupportedClusterServiceVersions returns the versions of the operator that are compatible with the OpenShift version
+func (o *operator) GetSupportedClusterServiceVersions(openshiftVersion string) []string {
+	return ClusterServiceVersions.For(openshiftVersion)
+}
+
 // GetMonitoredOperator returns MonitoredOperator corresponding to the OCS Operator
 func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
 	return &Operator
@@ -0,0 +1,10 @@
+package sriov
+
+import (
+	"github.com/openshift/assisted-service/internal/operators/cnv"
+)
+
+type Config struct {
+	// List of supported SR-IOV NICs: https://docs.openshift.com/container-platform/4.8/networking/hardware_networks/about-sriov.html#supported-devices_about-sriov
+	SupportedNICs cnv.DeviceIDDecoder `envconfig:"SRIOV_SUPPORTED_NICS" default:"8086:1572,8086:158b,8086:1592,8086:1593,15b3:1013,15b3:1015,15b3:1017,15b3:1019,15b3:101b,15b3:101d,15b3:a2d6"`
+}
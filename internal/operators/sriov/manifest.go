@@ -0,0 +1,87 @@
+package sriov
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+)
+
+// channel returns the subscription channel of the operator, that is named after the OpenShift version
+func channel(openshiftVersion string) string {
+	v, err := version.NewVersion(openshiftVersion)
+	if err != nil || len(v.Segments()) < 2 {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d", v.Segments()[0], v.Segments()[1])
+}
+
+func sriovSubscription(cluster *common.Cluster) ([]byte, error) {
+	selectedChannel, csv := api.Subscription(cluster, Operator.Name, channel(cluster.OpenshiftVersion))
+	data := map[string]string{
+		"OPERATOR_NAMESPACE":         Operator.Namespace,
+		"OPERATOR_SUBSCRIPTION_NAME": Operator.SubscriptionName,
+		"OPERATOR_CHANNEL":           selectedChannel,
+		"OPERATOR_CSV":               csv,
+	}
+
+	const sriovSubscription = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: "{{.OPERATOR_SUBSCRIPTION_NAME}}"
+  namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+{{- if .OPERATOR_CHANNEL }}
+  channel: "{{.OPERATOR_CHANNEL}}"
+{{- end }}
+{{- if .OPERATOR_CSV }}
+  startingCSV: "{{.OPERATOR_CSV}}"
+{{- end }}
+  installPlanApproval: Automatic
+  name: sriov-network-operator
+  source: redhat-operators
+  sourceNamespace: openshift-marketplace`
+
+	tmpl, err := template.New("sriovSubscription").Parse(sriovSubscription)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func Manifests(cluster *common.Cluster) (map[string][]byte, map[string][]byte, error) {
+	sriovSubs, err := sriovSubscription(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	openshiftManifests := make(map[string][]byte)
+	manifests := make(map[string][]byte)
+	openshiftManifests["99_openshift-sriov_ns.yaml"] = []byte(sriovNamespace)
+	openshiftManifests["99_openshift-sriov_operator_group.yaml"] = []byte(sriovOperatorGroup)
+	openshiftManifests["99_openshift-sriov_subscription.yaml"] = sriovSubs
+	return openshiftManifests, manifests, nil
+}
+
+const sriovNamespace = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: openshift-sriov-network-operator
+  annotations:
+    workload.openshift.io/allowed: management`
+
+const sriovOperatorGroup = `apiVersion: operators.coreos.com/v1
+kind: OperatorGroup
+metadata:
+  name: sriov-network-operators
+  namespace: openshift-sriov-network-operator
+spec:
+  targetNamespaces:
+  - openshift-sriov-network-operator`
@@ -0,0 +1,185 @@
+package sriov
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Memory value provided in MiB
+	MasterMemory int64 = 250
+	MasterCPU    int64 = 1
+	// Memory value provided in MiB
+	WorkerMemory int64 = 250
+	WorkerCPU    int64 = 1
+)
+
+// operator is an SR-IOV Network Operator OLM plugin; it implements api.Operator
+type operator struct {
+	log    logrus.FieldLogger
+	config Config
+}
+
+var Operator = models.MonitoredOperator{
+	Name:             "sriov",
+	OperatorType:     models.OperatorTypeOlm,
+	Namespace:        "openshift-sriov-network-operator",
+	SubscriptionName: "sriov-network-operator-subscription",
+	TimeoutSeconds:   60 * 60,
+}
+
+// NewSriovOperator creates new instance of an SR-IOV Network Operator installation plugin
+func NewSriovOperator(log logrus.FieldLogger, cfg Config) *operator {
+	log.WithField("config", cfg).Infof("Configuring SR-IOV Operator plugin")
+	return &operator{
+		log:    log,
+		config: cfg,
+	}
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return Operator.Name
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies() []string {
+	return make([]string, 0)
+}
+
+// GetClusterValidationID returns cluster validation ID for the Operator
+func (o *operator) GetClusterValidationID() string {
+	return string(models.ClusterValidationIDSriovRequirementsSatisfied)
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return string(models.HostValidationIDSriovRequirementsSatisfied)
+}
+
+// ValidateCluster always return "valid" result
+func (o *operator) ValidateCluster(_ context.Context, _ *common.Cluster) (api.ValidationResult, error) {
+	// No need to validate cluster because it will be validate on per host basis
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID()}, nil
+}
+
+// ValidateHost verifies that the hosts that run workloads have an SR-IOV capable NIC
+func (o *operator) ValidateHost(_ context.Context, cluster *common.Cluster, host *models.Host) (api.ValidationResult, error) {
+	if host.Role == models.HostRoleMaster && !common.IsSingleNodeCluster(cluster) {
+		return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
+	}
+	if host.Inventory == "" {
+		o.log.Info("Empty Inventory of host with hostID ", host.ID)
+		return api.ValidationResult{Status: api.Pending, ValidationId: o.GetHostValidationID(), Reasons: []string{"Missing Inventory in some of the hosts"}}, nil
+	}
+	inventory, err := hostutil.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		o.log.Errorf("Failed to get inventory from host with id %s", host.ID)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
+	}
+
+	if o.getSriovNicCount(inventory) == 0 {
+		status := "The host does not have an SR-IOV capable network interface"
+		// If the Role is set to Auto-assign for a host, it is not possible to determine whether the node will end up as a master or worker node.
+		if host.Role == models.HostRoleAutoAssign {
+			status = "The host does not have an SR-IOV capable network interface and may be assigned the worker role"
+		}
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{status}}, nil
+	}
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
+}
+
+// GenerateManifests generates manifests for the operator
+func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, map[string][]byte, error) {
+	return Manifests(c)
+}
+
+// GetProperties provides description of operator properties: none required
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetSupportedChannels returns the subscription channels of the operator that are compatible with the OpenShift
+// version: the channels are named after the OpenShift versions
+func (o *operator) GetSupportedChannels(openshiftVersion string) []string {
+	if channel := channel(openshiftVersion); channel != "" {
+		return []string{channel}
+	}
+	return []string{}
+}
+
+// GetSupportedClusterServiceVersions returns the versions of the operator that are compatible with the OpenShift
+// version: the operator is versioned after the OpenShift versions
+func (o *operator) GetSupportedClusterServiceVersions(openshiftVersion string) []string {
+	return o.GetSupportedChannels(openshiftVersion)
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the SR-IOV Operator
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &Operator
+}
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	preflightRequirements, err := o.GetPreflightRequirements(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	switch host.Role {
+	case models.HostRoleMaster:
+		return preflightRequirements.Requirements.Master.Quantitative, nil
+	case models.HostRoleWorker, models.HostRoleAutoAssign:
+		return preflightRequirements.Requirements.Worker.Quantitative, nil
+	}
+	return nil, fmt.Errorf("unsupported role: %s", host.Role)
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(context.Context, *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: o.GetDependencies(),
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: &models.HostTypeHardwareRequirements{
+				Qualitative: []string{"At least one SR-IOV capable network interface on a single-node cluster"},
+				Quantitative: &models.ClusterHostRequirementsDetails{
+					CPUCores: MasterCPU,
+					RAMMib:   MasterMemory,
+				},
+			},
+			Worker: &models.HostTypeHardwareRequirements{
+				Qualitative: []string{"At least one SR-IOV capable network interface"},
+				Quantitative: &models.ClusterHostRequirementsDetails{
+					CPUCores: WorkerCPU,
+					RAMMib:   WorkerMemory,
+				},
+			},
+		},
+	}, nil
+}
+
+func (o *operator) getSriovNicCount(inventory *models.Inventory) int64 {
+	var count int64
+	for _, nic := range inventory.Interfaces {
+		if o.config.SupportedNICs[getDeviceKeyForInterface(nic)] {
+			count++
+		}
+	}
+	return count
+}
+
+func getDeviceKeyForInterface(nic *models.Interface) string {
+	return sanitizeID(nic.Vendor) + ":" + sanitizeID(nic.Product)
+}
+
+func sanitizeID(id string) string {
+	return strings.TrimPrefix(strings.ToLower(id), "0x")
+}
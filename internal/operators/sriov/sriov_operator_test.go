@@ -0,0 +1,95 @@
+package sriov
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("SR-IOV operator", func() {
+	var (
+		log      = logrus.New()
+		operator api.Operator
+		cluster  *common.Cluster
+	)
+
+	BeforeEach(func() {
+		operator = NewSriovOperator(log, Config{SupportedNICs: map[string]bool{"8086:158b": true, "15b3:1017": true}})
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			OpenshiftVersion:     common.TestDefaultConfig.OpenShiftVersion,
+			HighAvailabilityMode: swagString(models.ClusterHighAvailabilityModeFull),
+		}}
+	})
+
+	inventoryWithInterfaces := func(interfaces ...*models.Interface) string {
+		inventory, err := hostutil.MarshalInventory(&models.Inventory{Interfaces: interfaces})
+		Expect(err).ToNot(HaveOccurred())
+		return inventory
+	}
+
+	sriovNic := &models.Interface{Name: "ens1f0", Vendor: "0x8086", Product: "0x158b"}
+	otherNic := &models.Interface{Name: "eth0", Vendor: "0x8086", Product: "0x10d3"}
+
+	table.DescribeTable("host validation", func(role models.HostRole, singleNode bool, interfaces []*models.Interface, expected api.ValidationStatus) {
+		if singleNode {
+			cluster.HighAvailabilityMode = swagString(models.ClusterHighAvailabilityModeNone)
+		}
+		host := &models.Host{Role: role, Inventory: inventoryWithInterfaces(interfaces...)}
+
+		result, err := operator.ValidateHost(context.TODO(), cluster, host)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ValidationId).To(Equal(string(models.HostValidationIDSriovRequirementsSatisfied)))
+		Expect(result.Status).To(Equal(expected))
+	},
+		table.Entry("worker with an SR-IOV NIC", models.HostRoleWorker, false, []*models.Interface{otherNic, sriovNic}, api.Success),
+		table.Entry("worker without an SR-IOV NIC", models.HostRoleWorker, false, []*models.Interface{otherNic}, api.Failure),
+		table.Entry("auto-assign without an SR-IOV NIC", models.HostRoleAutoAssign, false, []*models.Interface{otherNic}, api.Failure),
+		table.Entry("auto-assign with an SR-IOV NIC", models.HostRoleAutoAssign, false, []*models.Interface{sriovNic}, api.Success),
+		table.Entry("master without an SR-IOV NIC", models.HostRoleMaster, false, []*models.Interface{otherNic}, api.Success),
+		table.Entry("single-node master without an SR-IOV NIC", models.HostRoleMaster, true, []*models.Interface{otherNic}, api.Failure),
+		table.Entry("single-node master with an SR-IOV NIC", models.HostRoleMaster, true, []*models.Interface{sriovNic}, api.Success),
+	)
+
+	It("host validation is pending without inventory", func() {
+		result, err := operator.ValidateHost(context.TODO(), cluster, &models.Host{Role: models.HostRoleWorker})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status).To(Equal(api.Pending))
+	})
+
+	table.DescribeTable("host requirements", func(role models.HostRole, cpu, ram int64) {
+		requirements, err := operator.GetHostRequirements(context.TODO(), cluster, &models.Host{Role: role})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: cpu, RAMMib: ram}))
+	},
+		table.Entry("master", models.HostRoleMaster, MasterCPU, MasterMemory),
+		table.Entry("worker", models.HostRoleWorker, WorkerCPU, WorkerMemory),
+	)
+
+	It("generates the namespace, operator group and subscription", func() {
+		cluster.OpenshiftVersion = "4.8.2"
+		openshiftManifests, manifests, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(BeEmpty())
+		Expect(openshiftManifests).To(HaveLen(3))
+		Expect(openshiftManifests["99_openshift-sriov_ns.yaml"]).NotTo(HaveLen(0))
+		Expect(openshiftManifests["99_openshift-sriov_operator_group.yaml"]).NotTo(HaveLen(0))
+		Expect(string(openshiftManifests["99_openshift-sriov_subscription.yaml"])).To(ContainSubstring(`channel: "4.8"`))
+		for _, manifest := range openshiftManifests {
+			_, err := yaml.YAMLToJSON(manifest)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+	})
+})
+
+func swagString(s string) *string {
+	return &s
+}
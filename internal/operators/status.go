@@ -0,0 +1,46 @@
+package operators
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+)
+
+// maxStatusHistoryLength is the number of the latest status changes kept in the status history of an operator
+const maxStatusHistoryLength = 50
+
+// SetStatus updates the status of the operator, and records it in the status history of the operator when it changes.
+// It reports whether the status has changed.
+func SetStatus(operator *models.MonitoredOperator, status models.OperatorStatus, statusInfo string, updatedAt time.Time) bool {
+	changed := operator.Status != status
+	operator.Status = status
+	operator.StatusInfo = statusInfo
+	operator.StatusUpdatedAt = strfmt.DateTime(updatedAt)
+	if !changed {
+		return false
+	}
+
+	history := append(operator.StatusHistory, &models.OperatorStatusChange{
+		Status:     status,
+		StatusInfo: statusInfo,
+		UpdatedAt:  strfmt.DateTime(updatedAt),
+	})
+	if len(history) > maxStatusHistoryLength {
+		history = history[len(history)-maxStatusHistoryLength:]
+	}
+	operator.StatusHistory = history
+	return true
+}
+
+// IsTimedOut reports whether the OLM operator is neither available nor failed, after its timeout has passed since the
+// installation of the operators has started
+func IsTimedOut(operator *models.MonitoredOperator, startedAt time.Time, now time.Time) bool {
+	if operator.OperatorType != models.OperatorTypeOlm || operator.TimeoutSeconds <= 0 {
+		return false
+	}
+	if operator.Status == models.OperatorStatusAvailable || operator.Status == models.OperatorStatusFailed {
+		return false
+	}
+	return now.Sub(startedAt) > time.Duration(operator.TimeoutSeconds)*time.Second
+}
@@ -0,0 +1,72 @@
+package operators_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Operator status", func() {
+	var operator *models.MonitoredOperator
+
+	BeforeEach(func() {
+		operator = &models.MonitoredOperator{Name: "lso", OperatorType: models.OperatorTypeOlm, TimeoutSeconds: 60}
+	})
+
+	It("records the status changes in the status history", func() {
+		now := time.Now()
+		Expect(operators.SetStatus(operator, models.OperatorStatusProgressing, "installing", now)).To(BeTrue())
+		Expect(operators.SetStatus(operator, models.OperatorStatusProgressing, "still installing", now.Add(time.Minute))).To(BeFalse())
+		Expect(operator.StatusInfo).To(Equal("still installing"))
+		Expect(operators.SetStatus(operator, models.OperatorStatusAvailable, "installed", now.Add(2*time.Minute))).To(BeTrue())
+
+		history := operator.StatusHistory
+		Expect(history).To(HaveLen(2))
+		Expect(history[0].Status).To(Equal(models.OperatorStatusProgressing))
+		Expect(history[0].StatusInfo).To(Equal("installing"))
+		Expect(history[1].Status).To(Equal(models.OperatorStatusAvailable))
+	})
+
+	It("keeps only the latest status changes", func() {
+		now := time.Now()
+		statuses := []models.OperatorStatus{models.OperatorStatusProgressing, models.OperatorStatusFailed}
+		for i := 0; i < 60; i++ {
+			operators.SetStatus(operator, statuses[i%2], "", now.Add(time.Duration(i)*time.Second))
+		}
+		history := operator.StatusHistory
+		Expect(history).To(HaveLen(50))
+		Expect(history[49].Status).To(Equal(models.OperatorStatusFailed))
+	})
+
+	It("stores the status history as JSON", func() {
+		operators.SetStatus(operator, models.OperatorStatusProgressing, "installing", time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC))
+		value, err := operator.StatusHistory.Value()
+		Expect(err).ToNot(HaveOccurred())
+
+		var history models.OperatorStatusHistory
+		Expect(history.Scan(value)).To(Succeed())
+		Expect(history).To(Equal(operator.StatusHistory))
+		Expect(history.Scan("")).To(Succeed())
+		Expect(history).To(BeEmpty())
+	})
+
+	table.DescribeTable("detects timed out operators", func(operatorType models.OperatorType, status models.OperatorStatus, timeoutSeconds int64, elapsed time.Duration, expected bool) {
+		operator.OperatorType = operatorType
+		operator.Status = status
+		operator.TimeoutSeconds = timeoutSeconds
+		now := time.Now()
+		Expect(operators.IsTimedOut(operator, now.Add(-elapsed), now)).To(Equal(expected))
+	},
+		table.Entry("progressing within the timeout", models.OperatorTypeOlm, models.OperatorStatusProgressing, int64(60), 30*time.Second, false),
+		table.Entry("progressing after the timeout", models.OperatorTypeOlm, models.OperatorStatusProgressing, int64(60), 2*time.Minute, true),
+		table.Entry("not reported after the timeout", models.OperatorTypeOlm, models.OperatorStatus(""), int64(60), 2*time.Minute, true),
+		table.Entry("available after the timeout", models.OperatorTypeOlm, models.OperatorStatusAvailable, int64(60), 2*time.Minute, false),
+		table.Entry("failed after the timeout", models.OperatorTypeOlm, models.OperatorStatusFailed, int64(60), 2*time.Minute, false),
+		table.Entry("without timeout", models.OperatorTypeOlm, models.OperatorStatusProgressing, int64(0), 2*time.Minute, false),
+		table.Entry("builtin operator", models.OperatorTypeBuiltin, models.OperatorStatusProgressing, int64(60), 2*time.Minute, false),
+	)
+})
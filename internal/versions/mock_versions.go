@@ -51,6 +51,21 @@ func (mr *MockHandlerMockRecorder) AddOpenshiftVersion(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOpenshiftVersion", reflect.TypeOf((*MockHandler)(nil).AddOpenshiftVersion), arg0, arg1)
 }
 
+// GetCPUArchitectures mocks base method
+func (m *MockHandler) GetCPUArchitectures(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCPUArchitectures", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCPUArchitectures indicates an expected call of GetCPUArchitectures
+func (mr *MockHandlerMockRecorder) GetCPUArchitectures(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCPUArchitectures", reflect.TypeOf((*MockHandler)(nil).GetCPUArchitectures), arg0)
+}
+
 // GetKey mocks base method
 func (m *MockHandler) GetKey(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -67,63 +82,63 @@ func (mr *MockHandlerMockRecorder) GetKey(arg0 interface{}) *gomock.Call {
 }
 
 // GetRHCOSImage mocks base method
-func (m *MockHandler) GetRHCOSImage(arg0 string) (string, error) {
+func (m *MockHandler) GetRHCOSImage(arg0, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRHCOSImage", arg0)
+	ret := m.ctrl.Call(m, "GetRHCOSImage", arg0, arg1)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRHCOSImage indicates an expected call of GetRHCOSImage
-func (mr *MockHandlerMockRecorder) GetRHCOSImage(arg0 interface{}) *gomock.Call {
+func (mr *MockHandlerMockRecorder) GetRHCOSImage(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRHCOSImage", reflect.TypeOf((*MockHandler)(nil).GetRHCOSImage), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRHCOSImage", reflect.TypeOf((*MockHandler)(nil).GetRHCOSImage), arg0, arg1)
 }
 
 // GetRHCOSRootFS mocks base method
-func (m *MockHandler) GetRHCOSRootFS(arg0 string) (string, error) {
+func (m *MockHandler) GetRHCOSRootFS(arg0, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRHCOSRootFS", arg0)
+	ret := m.ctrl.Call(m, "GetRHCOSRootFS", arg0, arg1)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRHCOSRootFS indicates an expected call of GetRHCOSRootFS
-func (mr *MockHandlerMockRecorder) GetRHCOSRootFS(arg0 interface{}) *gomock.Call {
+func (mr *MockHandlerMockRecorder) GetRHCOSRootFS(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRHCOSRootFS", reflect.TypeOf((*MockHandler)(nil).GetRHCOSRootFS), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRHCOSRootFS", reflect.TypeOf((*MockHandler)(nil).GetRHCOSRootFS), arg0, arg1)
 }
 
 // GetRHCOSVersion mocks base method
-func (m *MockHandler) GetRHCOSVersion(arg0 string) (string, error) {
+func (m *MockHandler) GetRHCOSVersion(arg0, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRHCOSVersion", arg0)
+	ret := m.ctrl.Call(m, "GetRHCOSVersion", arg0, arg1)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRHCOSVersion indicates an expected call of GetRHCOSVersion
-func (mr *MockHandlerMockRecorder) GetRHCOSVersion(arg0 interface{}) *gomock.Call {
+func (mr *MockHandlerMockRecorder) GetRHCOSVersion(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRHCOSVersion", reflect.TypeOf((*MockHandler)(nil).GetRHCOSVersion), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRHCOSVersion", reflect.TypeOf((*MockHandler)(nil).GetRHCOSVersion), arg0, arg1)
 }
 
 // GetReleaseImage mocks base method
-func (m *MockHandler) GetReleaseImage(arg0 string) (string, error) {
+func (m *MockHandler) GetReleaseImage(arg0, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReleaseImage", arg0)
+	ret := m.ctrl.Call(m, "GetReleaseImage", arg0, arg1)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReleaseImage indicates an expected call of GetReleaseImage
-func (mr *MockHandlerMockRecorder) GetReleaseImage(arg0 interface{}) *gomock.Call {
+func (mr *MockHandlerMockRecorder) GetReleaseImage(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseImage", reflect.TypeOf((*MockHandler)(nil).GetReleaseImage), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseImage", reflect.TypeOf((*MockHandler)(nil).GetReleaseImage), arg0, arg1)
 }
 
 // GetReleaseVersion mocks base method
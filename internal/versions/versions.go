@@ -3,9 +3,11 @@ package versions
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/restapi"
@@ -14,6 +16,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// ErrCPUArchitectureNotSupported is returned when an openshift version has no images for the requested CPU architecture
+var ErrCPUArchitectureNotSupported = errors.New("CPU architecture is not supported")
+
 type Versions struct {
 	SelfVersion     string `envconfig:"SELF_VERSION" default:"quay.io/ocpmetal/assisted-service:latest"`
 	AgentDockerImg  string `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/ocpmetal/agent:latest"`
@@ -25,13 +30,14 @@ type Versions struct {
 //go:generate mockgen -package versions -destination mock_versions.go -self_package github.com/openshift/assisted-service/internal/versions . Handler
 type Handler interface {
 	restapi.VersionsAPI
-	GetReleaseImage(openshiftVersion string) (string, error)
-	GetRHCOSImage(openshiftVersion string) (string, error)
-	GetRHCOSRootFS(openshiftVersion string) (string, error)
-	GetRHCOSVersion(openshiftVersion string) (string, error)
+	GetReleaseImage(openshiftVersion, cpuArchitecture string) (string, error)
+	GetRHCOSImage(openshiftVersion, cpuArchitecture string) (string, error)
+	GetRHCOSRootFS(openshiftVersion, cpuArchitecture string) (string, error)
+	GetRHCOSVersion(openshiftVersion, cpuArchitecture string) (string, error)
 	GetReleaseVersion(openshiftVersion string) (string, error)
 	GetKey(openshiftVersion string) (string, error)
 	GetVersion(openshiftVersion string) (*models.OpenshiftVersion, error)
+	GetCPUArchitectures(openshiftVersion string) ([]string, error)
 	IsOpenshiftVersionSupported(versionKey string) bool
 	AddOpenshiftVersion(ocpReleaseImage, pullSecret string) (*models.OpenshiftVersion, error)
 }
@@ -75,68 +81,108 @@ func (h *handler) ListSupportedOpenshiftVersions(ctx context.Context, params ope
 	return operations.NewListSupportedOpenshiftVersionsOK().WithPayload(h.openshiftVersions)
 }
 
-func (h *handler) GetReleaseImage(openshiftVersion string) (pullSpec string, err error) {
-	versionKey, err := h.GetKey(openshiftVersion)
+func (h *handler) GetReleaseImage(openshiftVersion, cpuArchitecture string) (pullSpec string, err error) {
+	images, err := h.getCPUArchitectureImages(openshiftVersion, cpuArchitecture)
 	if err != nil {
 		return "", err
 	}
-	if !h.IsOpenshiftVersionSupported(versionKey) {
-		return "", errors.Errorf("No release image for unsupported openshift version %s", versionKey)
+
+	if images.ReleaseImage == nil {
+		return "", errors.Errorf("Release image was missing for openshift version %s", openshiftVersion)
+	}
+
+	return *images.ReleaseImage, nil
+}
+
+func (h *handler) GetRHCOSImage(openshiftVersion, cpuArchitecture string) (string, error) {
+	images, err := h.getCPUArchitectureImages(openshiftVersion, cpuArchitecture)
+	if err != nil {
+		return "", err
 	}
 
-	if h.openshiftVersions[versionKey].ReleaseImage == nil {
-		return "", errors.Errorf("Release image was missing for openshift version %s", versionKey)
+	if images.RhcosImage == nil {
+		return "", errors.Errorf("RHCOS image was missing for openshift version %s", openshiftVersion)
 	}
 
-	return *h.openshiftVersions[versionKey].ReleaseImage, nil
+	return *images.RhcosImage, nil
 }
 
-func (h *handler) GetRHCOSImage(openshiftVersion string) (string, error) {
-	versionKey, err := h.GetKey(openshiftVersion)
+func (h *handler) GetRHCOSRootFS(openshiftVersion, cpuArchitecture string) (string, error) {
+	images, err := h.getCPUArchitectureImages(openshiftVersion, cpuArchitecture)
 	if err != nil {
 		return "", err
 	}
-	if !h.IsOpenshiftVersionSupported(versionKey) {
-		return "", errors.Errorf("No rhcos image for unsupported openshift version %s", versionKey)
+
+	if images.RhcosRootfs == nil {
+		return "", errors.Errorf("RHCOS rootfs was missing for openshift version %s", openshiftVersion)
+	}
+
+	return *images.RhcosRootfs, nil
+}
+
+func (h *handler) GetRHCOSVersion(openshiftVersion, cpuArchitecture string) (string, error) {
+	images, err := h.getCPUArchitectureImages(openshiftVersion, cpuArchitecture)
+	if err != nil {
+		return "", err
 	}
 
-	if h.openshiftVersions[versionKey].RhcosImage == nil {
-		return "", errors.Errorf("RHCOS image was missing for openshift version %s", versionKey)
+	if images.RhcosVersion == nil {
+		return "", errors.Errorf("RHCOS version was missing for openshift version %s", openshiftVersion)
 	}
 
-	return *h.openshiftVersions[versionKey].RhcosImage, nil
+	return *images.RhcosVersion, nil
 }
 
-func (h *handler) GetRHCOSRootFS(openshiftVersion string) (string, error) {
+// Returns the CPU architectures that have images for the given openshift version, the default architecture first
+func (h *handler) GetCPUArchitectures(openshiftVersion string) ([]string, error) {
 	versionKey, err := h.GetKey(openshiftVersion)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if !h.IsOpenshiftVersionSupported(versionKey) {
-		return "", errors.Errorf("No rhcos rootfs for unsupported openshift version %s", versionKey)
+		return nil, errors.Errorf("No CPU architectures for unsupported openshift version %s", versionKey)
 	}
 
-	if h.openshiftVersions[versionKey].RhcosRootfs == nil {
-		return "", errors.Errorf("RHCOS rootfs was missing for openshift version %s", versionKey)
+	cpuArchitectures := []string{common.DefaultCPUArchitecture}
+	additional := make([]string, 0, len(h.openshiftVersions[versionKey].CPUArchitectures))
+	for cpuArchitecture := range h.openshiftVersions[versionKey].CPUArchitectures {
+		if cpuArchitecture != common.DefaultCPUArchitecture {
+			additional = append(additional, cpuArchitecture)
+		}
 	}
+	sort.Strings(additional)
 
-	return *h.openshiftVersions[versionKey].RhcosRootfs, nil
+	return append(cpuArchitectures, additional...), nil
 }
 
-func (h *handler) GetRHCOSVersion(openshiftVersion string) (string, error) {
+// Returns the images of the openshift version that match the given CPU architecture.
+// The top level images of the version are used for the default architecture.
+func (h *handler) getCPUArchitectureImages(openshiftVersion, cpuArchitecture string) (*models.CPUArchitectureImages, error) {
 	versionKey, err := h.GetKey(openshiftVersion)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if !h.IsOpenshiftVersionSupported(versionKey) {
-		return "", errors.Errorf("No rhcos version for unsupported openshift version %s", versionKey)
+		return nil, errors.Errorf("No images for unsupported openshift version %s", versionKey)
 	}
 
-	if h.openshiftVersions[versionKey].RhcosVersion == nil {
-		return "", errors.Errorf("RHCOS version was missing for openshift version %s", versionKey)
+	version := h.openshiftVersions[versionKey]
+	if cpuArchitecture == "" || cpuArchitecture == common.DefaultCPUArchitecture {
+		return &models.CPUArchitectureImages{
+			ReleaseImage: version.ReleaseImage,
+			RhcosImage:   version.RhcosImage,
+			RhcosRootfs:  version.RhcosRootfs,
+			RhcosVersion: version.RhcosVersion,
+		}, nil
+	}
+
+	images, ok := version.CPUArchitectures[cpuArchitecture]
+	if !ok {
+		return nil, errors.Wrapf(ErrCPUArchitectureNotSupported, "No images for CPU architecture %s of openshift version %s",
+			cpuArchitecture, versionKey)
 	}
 
-	return *h.openshiftVersions[versionKey].RhcosVersion, nil
+	return &images, nil
 }
 
 func (h *handler) IsOpenshiftVersionSupported(versionKey string) bool {
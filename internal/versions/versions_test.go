@@ -13,6 +13,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/models"
 	operations "github.com/openshift/assisted-service/restapi/operations/versions"
@@ -37,6 +38,12 @@ var defaultOpenShiftVersions = models.OpenshiftVersions{
 		ReleaseImage: swag.String("release_4.6"), ReleaseVersion: swag.String("4.6-candidate"),
 		RhcosImage: swag.String("rhcos_4.6"), RhcosVersion: swag.String("version-46.123-0"),
 		SupportLevel: swag.String("newbie"),
+		CPUArchitectures: map[string]models.CPUArchitectureImages{
+			"arm64": {
+				ReleaseImage: swag.String("release_4.6_arm64"), RhcosImage: swag.String("rhcos_4.6_arm64"),
+				RhcosRootfs: swag.String("rhcos_rootfs_4.6_arm64"), RhcosVersion: swag.String("version-46.123-0"),
+			},
+		},
 	},
 }
 
@@ -147,17 +154,35 @@ var _ = Describe("list versions", func() {
 
 		It("default", func() {
 			for key := range *openshiftVersions {
-				releaseImage, err = h.GetReleaseImage(key)
+				releaseImage, err = h.GetReleaseImage(key, common.DefaultCPUArchitecture)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(releaseImage).Should(Equal(*(*openshiftVersions)[key].ReleaseImage))
 			}
 		})
 
 		It("unsupported_key", func() {
-			releaseImage, err = h.GetReleaseImage("unsupported")
+			releaseImage, err = h.GetReleaseImage("unsupported", common.DefaultCPUArchitecture)
 			Expect(err).Should(HaveOccurred())
 			Expect(releaseImage).Should(BeEmpty())
 		})
+
+		It("cpu_architecture", func() {
+			releaseImage, err = h.GetReleaseImage("4.6", "arm64")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(releaseImage).Should(Equal("release_4.6_arm64"))
+		})
+
+		It("unsupported_cpu_architecture", func() {
+			releaseImage, err = h.GetReleaseImage("4.5", "arm64")
+			Expect(errors.Is(err, ErrCPUArchitectureNotSupported)).Should(BeTrue())
+			Expect(releaseImage).Should(BeEmpty())
+		})
+
+		It("unsupported_key_is_not_an_unsupported_cpu_architecture", func() {
+			_, err = h.GetReleaseImage("unsupported", "arm64")
+			Expect(err).Should(HaveOccurred())
+			Expect(errors.Is(err, ErrCPUArchitectureNotSupported)).Should(BeFalse())
+		})
 	})
 
 	Context("GetRHCOSImage", func() {
@@ -173,17 +198,23 @@ var _ = Describe("list versions", func() {
 
 		It("default", func() {
 			for key := range *openshiftVersions {
-				rhcosImage, err = h.GetRHCOSImage(key)
+				rhcosImage, err = h.GetRHCOSImage(key, common.DefaultCPUArchitecture)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(rhcosImage).Should(Equal(*(*openshiftVersions)[key].RhcosImage))
 			}
 		})
 
 		It("unsupported_key", func() {
-			rhcosImage, err = h.GetRHCOSImage("unsupported")
+			rhcosImage, err = h.GetRHCOSImage("unsupported", common.DefaultCPUArchitecture)
 			Expect(err).Should(HaveOccurred())
 			Expect(rhcosImage).Should(BeEmpty())
 		})
+
+		It("cpu_architecture", func() {
+			rhcosImage, err = h.GetRHCOSImage("4.6", "arm64")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rhcosImage).Should(Equal("rhcos_4.6_arm64"))
+		})
 	})
 
 	Context("GetRHCOSVersion", func() {
@@ -199,19 +230,43 @@ var _ = Describe("list versions", func() {
 
 		It("default", func() {
 			for key := range *openshiftVersions {
-				rhcosVersion, err = h.GetRHCOSVersion(key)
+				rhcosVersion, err = h.GetRHCOSVersion(key, common.DefaultCPUArchitecture)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(rhcosVersion).Should(Equal(*(*openshiftVersions)[key].RhcosVersion))
 			}
 		})
 
 		It("unsupported_key", func() {
-			rhcosVersion, err = h.GetRHCOSVersion("unsupported")
+			rhcosVersion, err = h.GetRHCOSVersion("unsupported", common.DefaultCPUArchitecture)
 			Expect(err).Should(HaveOccurred())
 			Expect(rhcosVersion).Should(BeEmpty())
 		})
 	})
 
+	Context("GetCPUArchitectures", func() {
+		BeforeEach(func() {
+			openshiftVersions = &defaultOpenShiftVersions
+			h = NewHandler(logger, mockRelease, versions, *openshiftVersions, "")
+		})
+
+		It("default only", func() {
+			cpuArchitectures, err := h.GetCPUArchitectures("4.5")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cpuArchitectures).Should(Equal([]string{common.DefaultCPUArchitecture}))
+		})
+
+		It("multiple architectures", func() {
+			cpuArchitectures, err := h.GetCPUArchitectures("4.6")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cpuArchitectures).Should(Equal([]string{common.DefaultCPUArchitecture, "arm64"}))
+		})
+
+		It("unsupported_key", func() {
+			_, err := h.GetCPUArchitectures("unsupported")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
 	Context("GetReleaseVersion", func() {
 		var (
 			releaseVersion string
@@ -315,9 +370,9 @@ var _ = Describe("list versions", func() {
 			versionFromCache := h.openshiftVersions[versionKey]
 			Expect(*version.DisplayName).Should(Equal(ocpVersion))
 			Expect(h.GetReleaseVersion(keyVersion)).Should(Equal(ocpVersion))
-			Expect(h.GetReleaseImage(keyVersion)).Should(Equal(releaseImage))
-			Expect(h.GetRHCOSImage(keyVersion)).Should(Equal(*versionFromCache.RhcosImage))
-			Expect(h.GetRHCOSVersion(keyVersion)).Should(Equal(*versionFromCache.RhcosVersion))
+			Expect(h.GetReleaseImage(keyVersion, common.DefaultCPUArchitecture)).Should(Equal(releaseImage))
+			Expect(h.GetRHCOSImage(keyVersion, common.DefaultCPUArchitecture)).Should(Equal(*versionFromCache.RhcosImage))
+			Expect(h.GetRHCOSVersion(keyVersion, common.DefaultCPUArchitecture)).Should(Equal(*versionFromCache.RhcosVersion))
 			Expect(*version.SupportLevel).Should(Equal(models.OpenshiftVersionSupportLevelCustom))
 		})
 
@@ -328,13 +383,13 @@ var _ = Describe("list versions", func() {
 
 			_, err := h.AddOpenshiftVersion(releaseImage, pullSecret)
 			Expect(err).ShouldNot(HaveOccurred())
-			Expect(h.GetReleaseImage(keyVersion)).Should(Equal(releaseImage))
+			Expect(h.GetReleaseImage(keyVersion, common.DefaultCPUArchitecture)).Should(Equal(releaseImage))
 
 			// Override version with a new release image
 			releaseImage = "newReleaseImage"
 			_, err = h.AddOpenshiftVersion(releaseImage, pullSecret)
 			Expect(err).ShouldNot(HaveOccurred())
-			Expect(h.GetReleaseImage(keyVersion)).Should(Equal(releaseImage))
+			Expect(h.GetReleaseImage(keyVersion, common.DefaultCPUArchitecture)).Should(Equal(releaseImage))
 		})
 
 		It("keep support level from cache", func() {
@@ -0,0 +1,404 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/watch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	MessageClusterStatus = "cluster-status"
+	MessageHostStatus    = "host-status"
+	MessageEvent         = "event"
+)
+
+type Config struct {
+	PollInterval      time.Duration `envconfig:"WATCH_POLL_INTERVAL" default:"2s"`
+	KeepAliveInterval time.Duration `envconfig:"WATCH_KEEP_ALIVE_INTERVAL" default:"30s"`
+
+	// SettleWindow is the time in which rows may still be committed with lower IDs than rows that were already
+	// read, so the rows recorded within the window are read again on every poll
+	SettleWindow time.Duration `envconfig:"WATCH_SETTLE_WINDOW" default:"1m"`
+}
+
+var _ restapi.WatchAPI = &Api{}
+
+type Api struct {
+	db  *gorm.DB
+	cfg Config
+	log logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, cfg Config, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:  db,
+		cfg: cfg,
+		log: log,
+	}
+}
+
+// watchCursor marks the position of a watch client in the two streams it follows. The IDs are serial, but a
+// transaction may commit a row after a row with a higher ID was already read, so the position in each stream is
+// the ID up to which every row was handled, and the IDs of the rows above it that were already sent.
+type watchCursor struct {
+	eventID             uint
+	statusChangeID      uint
+	sentEventIDs        []uint
+	sentStatusChangeIDs []uint
+}
+
+func (c watchCursor) String() string {
+	cursor := fmt.Sprintf("%d-%d", c.eventID, c.statusChangeID)
+	if len(c.sentEventIDs) == 0 && len(c.sentStatusChangeIDs) == 0 {
+		return cursor
+	}
+	return fmt.Sprintf("%s:%s:%s", cursor, formatIDs(c.sentEventIDs), formatIDs(c.sentStatusChangeIDs))
+}
+
+func parseWatchCursor(cursor string) (*watchCursor, error) {
+	invalid := func(err error) error {
+		return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid watch cursor %s", cursor))
+	}
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 1 && len(parts) != 3 {
+		return nil, invalid(errors.New("unexpected number of parts"))
+	}
+	var c watchCursor
+	if _, err := fmt.Sscanf(parts[0], "%d-%d", &c.eventID, &c.statusChangeID); err != nil {
+		return nil, invalid(err)
+	}
+	if len(parts) == 3 {
+		var err error
+		if c.sentEventIDs, err = parseIDs(parts[1]); err != nil {
+			return nil, invalid(err)
+		}
+		if c.sentStatusChangeIDs, err = parseIDs(parts[2]); err != nil {
+			return nil, invalid(err)
+		}
+	}
+	return &c, nil
+}
+
+func formatIDs(ids []uint) string {
+	formatted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		formatted = append(formatted, strconv.FormatUint(uint64(id), 10))
+	}
+	return strings.Join(formatted, ",")
+}
+
+func parseIDs(ids string) ([]uint, error) {
+	if ids == "" {
+		return nil, nil
+	}
+	var parsed []uint
+	for _, id := range strings.Split(ids, ",") {
+		n, err := strconv.ParseUint(id, 10, 0)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, uint(n))
+	}
+	return parsed, nil
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// settle returns the IDs that remain above the given settled ID
+func settle(ids []uint, settledID uint) []uint {
+	var remaining []uint
+	for _, id := range ids {
+		if id > settledID {
+			remaining = append(remaining, id)
+		}
+	}
+	return remaining
+}
+
+func (a *Api) WatchCluster(ctx context.Context, params operations.WatchClusterParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	var cluster common.Cluster
+	query, args := identity.AddUserFilter(ctx, "id = ?", params.ClusterID.String())
+	if err := a.db.Select("id").Where(query, args...).First(&cluster).Error; err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return jsonResponder{common.NewApiError(http.StatusNotFound, err)}
+		}
+		return jsonResponder{common.NewApiError(http.StatusInternalServerError, err)}
+	}
+
+	var cursor *watchCursor
+	var err error
+	sendStatuses := false
+	if lastEventID := swag.StringValue(params.LastEventID); lastEventID != "" {
+		cursor, err = parseWatchCursor(lastEventID)
+	} else if params.Cursor != nil {
+		cursor, err = parseWatchCursor(*params.Cursor)
+	} else {
+		// new watches start with the current statuses and with the events and status changes from now on
+		cursor, err = a.initialWatchCursor(params.ClusterID)
+		sendStatuses = true
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to watch cluster %s", params.ClusterID)
+		return jsonResponder{common.GenerateErrorResponder(err)}
+	}
+
+	return &watchResponder{
+		ctx:          ctx,
+		log:          log,
+		db:           a.db,
+		cfg:          a.cfg,
+		clusterID:    params.ClusterID,
+		cursor:       *cursor,
+		sendStatuses: sendStatuses,
+	}
+}
+
+// initialWatchCursor skips the rows that exist when the watch starts. The rows that were recorded within the settle
+// window are skipped by their IDs, since rows with lower IDs may still be committed.
+func (a *Api) initialWatchCursor(clusterID strfmt.UUID) (*watchCursor, error) {
+	settledBefore := time.Now().Add(-a.cfg.SettleWindow)
+	var cursor watchCursor
+
+	var settled common.Event
+	err := a.db.Select("id").Where("cluster_id = ? AND created_at < ?", clusterID.String(), settledBefore).
+		Order("id desc").First(&settled).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	cursor.eventID = settled.ID
+	if err = a.db.Model(&common.Event{}).Where("cluster_id = ? AND id > ?", clusterID.String(), cursor.eventID).
+		Order("id").Pluck("id", &cursor.sentEventIDs).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var settledStatusChange common.StatusChange
+	err = a.db.Select("id").Where("cluster_id = ? AND created_at < ?", clusterID.String(), settledBefore).
+		Order("id desc").First(&settledStatusChange).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	cursor.statusChangeID = settledStatusChange.ID
+	if err = a.db.Model(&common.StatusChange{}).Where("cluster_id = ? AND id > ?", clusterID.String(), cursor.statusChangeID).
+		Order("id").Pluck("id", &cursor.sentStatusChangeIDs).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &cursor, nil
+}
+
+// jsonResponder writes error responses of the watch API, whose negotiated producer can't serialize them
+type jsonResponder struct {
+	next middleware.Responder
+}
+
+func (j jsonResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+	j.next.WriteResponse(rw, runtime.JSONProducer())
+}
+
+// watchResponder streams the cluster updates to the client until the client disconnects
+type watchResponder struct {
+	ctx       context.Context
+	log       logrus.FieldLogger
+	db        *gorm.DB
+	cfg       Config
+	clusterID strfmt.UUID
+	cursor    watchCursor
+
+	// sendStatuses is set until the current statuses of a new watch are sent
+	sendStatuses bool
+}
+
+func (w *watchResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	rw.Header().Set(runtime.HeaderContentType, "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		w.log.Errorf("watch of cluster %s is not supported by the response writer", w.clusterID)
+		return
+	}
+
+	poll := time.NewTicker(w.cfg.PollInterval)
+	defer poll.Stop()
+	lastWrite := time.Now()
+	for {
+		sent, err := w.poll(rw)
+		if err != nil {
+			w.log.WithError(err).Errorf("stopping watch of cluster %s", w.clusterID)
+			return
+		}
+		if sent == 0 && time.Since(lastWrite) >= w.cfg.KeepAliveInterval {
+			if _, err = fmt.Fprint(rw, ": keep-alive\n\n"); err != nil {
+				w.log.WithError(err).Infof("stopping watch of cluster %s", w.clusterID)
+				return
+			}
+			sent++
+		}
+		if sent > 0 {
+			flusher.Flush()
+			lastWrite = time.Now()
+		}
+
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-poll.C:
+		}
+	}
+}
+
+// poll writes the status changes and the events that were not sent yet and advances the cursor. The rows above the
+// settled IDs of the cursor are read again, so rows that are committed late are sent, and the rows that were
+// already sent are skipped.
+func (w *watchResponder) poll(rw http.ResponseWriter) (int, error) {
+	sent := 0
+	if w.sendStatuses {
+		n, err := w.sendCurrentStatuses(rw)
+		sent += n
+		if err != nil {
+			return sent, err
+		}
+		w.sendStatuses = false
+	}
+
+	settledBefore := time.Now().Add(-w.cfg.SettleWindow)
+
+	// every status change is sent in the order of its ID, so that clients see all the transitions
+	var changes []*common.StatusChange
+	if err := w.db.Where("cluster_id = ? AND id > ?", w.clusterID.String(), w.cursor.statusChangeID).
+		Order("id").Find(&changes).Error; err != nil {
+		return sent, errors.Wrapf(err, "failed to get status changes of cluster %s", w.clusterID)
+	}
+	settledID := w.cursor.statusChangeID
+	for _, change := range changes {
+		if change.CreatedAt.Before(settledBefore) {
+			settledID = change.ID
+		}
+		if containsID(w.cursor.sentStatusChangeIDs, change.ID) {
+			continue
+		}
+		w.cursor.sentStatusChangeIDs = append(w.cursor.sentStatusChangeIDs, change.ID)
+		clusterID := change.ClusterID
+		update := &models.StatusUpdate{
+			ClusterID:       &clusterID,
+			HostID:          change.HostID,
+			Status:          swag.String(change.Status),
+			StatusInfo:      change.StatusInfo,
+			StatusUpdatedAt: strfmt.DateTime(change.StatusUpdatedAt),
+		}
+		messageType := MessageClusterStatus
+		if change.HostID != "" {
+			messageType = MessageHostStatus
+		}
+		if err := w.send(rw, messageType, update); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	w.cursor.statusChangeID = settledID
+	w.cursor.sentStatusChangeIDs = settle(w.cursor.sentStatusChangeIDs, settledID)
+
+	var evs []*common.Event
+	if err := w.db.Where("cluster_id = ? AND id > ? AND category IN (?)", w.clusterID.String(), w.cursor.eventID, events.DefaultEventCategories).
+		Order("id").Find(&evs).Error; err != nil {
+		return sent, errors.Wrapf(err, "failed to get events of cluster %s", w.clusterID)
+	}
+	settledID = w.cursor.eventID
+	for _, ev := range evs {
+		if ev.CreatedAt.Before(settledBefore) {
+			settledID = ev.ID
+		}
+		if containsID(w.cursor.sentEventIDs, ev.ID) {
+			continue
+		}
+		w.cursor.sentEventIDs = append(w.cursor.sentEventIDs, ev.ID)
+		if err := w.send(rw, MessageEvent, &ev.Event); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	w.cursor.eventID = settledID
+	w.cursor.sentEventIDs = settle(w.cursor.sentEventIDs, settledID)
+	return sent, nil
+}
+
+// sendCurrentStatuses writes the current statuses of the cluster and of its hosts
+func (w *watchResponder) sendCurrentStatuses(rw http.ResponseWriter) (int, error) {
+	sent := 0
+	var cluster common.Cluster
+	if err := w.db.Select("id, status, status_info, status_updated_at").
+		First(&cluster, "id = ?", w.clusterID.String()).Error; err != nil {
+		return sent, errors.Wrapf(err, "failed to get status of cluster %s", w.clusterID)
+	}
+	var hosts []*common.Host
+	if err := w.db.Select("id, cluster_id, status, status_info, status_updated_at").
+		Where("cluster_id = ?", w.clusterID.String()).Order("status_updated_at, id").
+		Find(&hosts).Error; err != nil {
+		return sent, errors.Wrapf(err, "failed to get status of hosts of cluster %s", w.clusterID)
+	}
+
+	if err := w.send(rw, MessageClusterStatus, &models.StatusUpdate{
+		ClusterID:       cluster.ID,
+		Status:          cluster.Status,
+		StatusInfo:      swag.StringValue(cluster.StatusInfo),
+		StatusUpdatedAt: cluster.StatusUpdatedAt,
+	}); err != nil {
+		return sent, err
+	}
+	sent++
+	for _, h := range hosts {
+		if err := w.send(rw, MessageHostStatus, &models.StatusUpdate{
+			ClusterID:       &h.ClusterID,
+			HostID:          *h.ID,
+			Status:          h.Status,
+			StatusInfo:      swag.StringValue(h.StatusInfo),
+			StatusUpdatedAt: h.StatusUpdatedAt,
+		}); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func (w *watchResponder) send(rw http.ResponseWriter, messageType string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s message", messageType)
+	}
+	if _, err = fmt.Fprintf(rw, "id: %s\nevent: %s\ndata: %s\n\n", w.cursor, messageType, b); err != nil {
+		return errors.Wrapf(err, "failed to write %s message", messageType)
+	}
+	return nil
+}
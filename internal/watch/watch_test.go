@@ -0,0 +1,218 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/watch"
+	"github.com/sirupsen/logrus"
+)
+
+type message struct {
+	id          string
+	messageType string
+	data        string
+}
+
+func parseMessages(body string) []message {
+	var messages []message
+	for _, block := range strings.Split(body, "\n\n") {
+		var m message
+		for _, line := range strings.Split(block, "\n") {
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				m.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				m.messageType = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				m.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+		if m.messageType != "" {
+			messages = append(messages, m)
+		}
+	}
+	return messages
+}
+
+var _ = Describe("Watch cluster", func() {
+	var (
+		db            *gorm.DB
+		dbName        string
+		api           *Api
+		eventsHandler *events.Events
+		clusterID     strfmt.UUID
+		hostID        strfmt.UUID
+		start         time.Time
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		api = NewApi(db, Config{PollInterval: 10 * time.Millisecond, KeepAliveInterval: time.Minute}, logrus.New())
+		eventsHandler = events.New(db, logrus.New())
+		start = time.Now().Add(-time.Minute)
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:              &clusterID,
+			Status:          swag.String(models.ClusterStatusInsufficient),
+			StatusInfo:      swag.String("insufficient"),
+			StatusUpdatedAt: strfmt.DateTime(start),
+		}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{
+			ID:              &hostID,
+			ClusterID:       clusterID,
+			Status:          swag.String(models.HostStatusKnown),
+			StatusUpdatedAt: strfmt.DateTime(start.Add(time.Second)),
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	// watch streams the messages of the watch until the given function returns
+	watch := func(params operations.WatchClusterParams, during func()) (*httptest.ResponseRecorder, []message) {
+		ctx, cancel := context.WithCancel(context.Background())
+		params.ClusterID = clusterID
+		responder := api.WatchCluster(ctx, params)
+		rw := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			responder.WriteResponse(rw, runtime.JSONProducer())
+		}()
+		during()
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+		<-done
+		return rw, parseMessages(rw.Body.String())
+	}
+
+	It("streams the current statuses and new events", func() {
+		eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "old event", time.Now())
+
+		rw, messages := watch(operations.WatchClusterParams{}, func() {
+			time.Sleep(50 * time.Millisecond)
+			eventsHandler.AddEvent(context.TODO(), clusterID, &hostID, models.EventSeverityInfo, "new event", time.Now())
+		})
+		Expect(rw.Code).Should(Equal(http.StatusOK))
+		Expect(rw.Header().Get(runtime.HeaderContentType)).Should(Equal("text/event-stream"))
+		Expect(messages).Should(HaveLen(3))
+
+		Expect(messages[0].messageType).Should(Equal(MessageClusterStatus))
+		var update models.StatusUpdate
+		Expect(json.Unmarshal([]byte(messages[0].data), &update)).ShouldNot(HaveOccurred())
+		Expect(*update.Status).Should(Equal(models.ClusterStatusInsufficient))
+		Expect(update.StatusInfo).Should(Equal("insufficient"))
+
+		Expect(messages[1].messageType).Should(Equal(MessageHostStatus))
+		Expect(json.Unmarshal([]byte(messages[1].data), &update)).ShouldNot(HaveOccurred())
+		Expect(update.HostID).Should(Equal(hostID))
+		Expect(*update.Status).Should(Equal(models.HostStatusKnown))
+
+		Expect(messages[2].messageType).Should(Equal(MessageEvent))
+		var event models.Event
+		Expect(json.Unmarshal([]byte(messages[2].data), &event)).ShouldNot(HaveOccurred())
+		Expect(*event.Message).Should(Equal("new event"))
+	})
+
+	It("streams every status transition in order", func() {
+		_, messages := watch(operations.WatchClusterParams{}, func() {
+			time.Sleep(50 * time.Millisecond)
+			// transitions that are recorded at the same time, or faster than the poll interval, are all sent
+			now := time.Now()
+			Expect(common.AddStatusChange(db, clusterID, hostID, models.HostStatusInsufficient, "insufficient", now)).ShouldNot(HaveOccurred())
+			Expect(common.AddStatusChange(db, clusterID, "", models.ClusterStatusReady, "ready", now)).ShouldNot(HaveOccurred())
+			Expect(common.AddStatusChange(db, clusterID, hostID, models.HostStatusKnown, "known", now.Add(-time.Second))).ShouldNot(HaveOccurred())
+		})
+		Expect(messages).Should(HaveLen(5))
+		Expect(messages[2].messageType).Should(Equal(MessageHostStatus))
+		Expect(messages[2].data).Should(ContainSubstring(models.HostStatusInsufficient))
+		Expect(messages[3].messageType).Should(Equal(MessageClusterStatus))
+		Expect(messages[3].data).Should(ContainSubstring(models.ClusterStatusReady))
+		Expect(messages[4].messageType).Should(Equal(MessageHostStatus))
+		Expect(messages[4].data).Should(ContainSubstring(models.HostStatusKnown))
+	})
+
+	It("doesn't stream the status changes of other clusters", func() {
+		otherID := strfmt.UUID(uuid.New().String())
+		_, messages := watch(operations.WatchClusterParams{}, func() {
+			Expect(common.AddStatusChange(db, otherID, "", models.ClusterStatusReady, "ready", time.Now())).ShouldNot(HaveOccurred())
+		})
+		Expect(messages).Should(HaveLen(2))
+	})
+
+	It("resumes from a cursor", func() {
+		var messages []message
+		_, messages = watch(operations.WatchClusterParams{}, func() {})
+		Expect(messages).Should(HaveLen(2))
+		cursor := messages[1].id
+
+		eventsHandler.AddEvent(context.TODO(), clusterID, nil, models.EventSeverityInfo, "missed event", time.Now())
+		Expect(common.AddStatusChange(db, clusterID, hostID, models.HostStatusInsufficient, "insufficient", time.Now())).ShouldNot(HaveOccurred())
+		_, messages = watch(operations.WatchClusterParams{LastEventID: swag.String(cursor)}, func() {})
+		Expect(messages).Should(HaveLen(2))
+		Expect(messages[0].messageType).Should(Equal(MessageHostStatus))
+		Expect(messages[0].data).Should(ContainSubstring(models.HostStatusInsufficient))
+		Expect(messages[1].messageType).Should(Equal(MessageEvent))
+		Expect(messages[1].data).Should(ContainSubstring("missed event"))
+
+		_, messages = watch(operations.WatchClusterParams{Cursor: swag.String(cursor)}, func() {})
+		Expect(messages).Should(HaveLen(2))
+	})
+
+	It("streams the status changes that are committed after changes with higher IDs", func() {
+		api = NewApi(db, Config{PollInterval: 10 * time.Millisecond, KeepAliveInterval: time.Minute, SettleWindow: time.Minute}, logrus.New())
+		otherID := strfmt.UUID(uuid.New().String())
+		var messages []message
+		_, messages = watch(operations.WatchClusterParams{}, func() {
+			// the ID of the status change of the other cluster stands in for an ID that is committed late
+			late := common.StatusChange{ClusterID: otherID, Status: models.ClusterStatusReady}
+			Expect(db.Create(&late).Error).ShouldNot(HaveOccurred())
+			Expect(common.AddStatusChange(db, clusterID, hostID, models.HostStatusInsufficient, "insufficient", time.Now())).ShouldNot(HaveOccurred())
+			time.Sleep(50 * time.Millisecond)
+
+			Expect(db.Delete(&late).Error).ShouldNot(HaveOccurred())
+			Expect(db.Create(&common.StatusChange{ID: late.ID, ClusterID: clusterID, Status: models.ClusterStatusReady}).Error).ShouldNot(HaveOccurred())
+		})
+		Expect(messages).Should(HaveLen(4))
+		Expect(messages[2].data).Should(ContainSubstring(models.HostStatusInsufficient))
+		Expect(messages[3].messageType).Should(Equal(MessageClusterStatus))
+		Expect(messages[3].data).Should(ContainSubstring(models.ClusterStatusReady))
+
+		// resuming from the cursor skips the status changes that were already sent
+		_, messages = watch(operations.WatchClusterParams{LastEventID: swag.String(messages[3].id)}, func() {})
+		Expect(messages).Should(BeEmpty())
+	})
+
+	It("fails for an invalid cursor", func() {
+		responder := api.WatchCluster(context.Background(), operations.WatchClusterParams{ClusterID: clusterID, Cursor: swag.String("invalid")})
+		rw := httptest.NewRecorder()
+		responder.WriteResponse(rw, nil)
+		Expect(rw.Code).Should(Equal(http.StatusBadRequest))
+		Expect(rw.Header().Get(runtime.HeaderContentType)).Should(Equal(runtime.JSONMime))
+	})
+
+	It("fails for a missing cluster", func() {
+		responder := api.WatchCluster(context.Background(), operations.WatchClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		rw := httptest.NewRecorder()
+		responder.WriteResponse(rw, nil)
+		Expect(rw.Code).Should(Equal(http.StatusNotFound))
+	})
+})
@@ -287,6 +287,20 @@ func (mr *MockInstallerAPIMockRecorder) GetClusterInstallConfig(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterInstallConfig), arg0, arg1)
 }
 
+// GetClusterInstallationReport mocks base method
+func (m *MockInstallerAPI) GetClusterInstallationReport(arg0 context.Context, arg1 installer.GetClusterInstallationReportParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterInstallationReport", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterInstallationReport indicates an expected call of GetClusterInstallationReport
+func (mr *MockInstallerAPIMockRecorder) GetClusterInstallationReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInstallationReport", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterInstallationReport), arg0, arg1)
+}
+
 // GetCredentials mocks base method
 func (m *MockInstallerAPI) GetCredentials(arg0 context.Context, arg1 installer.GetCredentialsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -357,6 +371,20 @@ func (mr *MockInstallerAPIMockRecorder) GetHostIgnition(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostIgnition", reflect.TypeOf((*MockInstallerAPI)(nil).GetHostIgnition), arg0, arg1)
 }
 
+// GetHostInventoryHistory mocks base method
+func (m *MockInstallerAPI) GetHostInventoryHistory(arg0 context.Context, arg1 installer.GetHostInventoryHistoryParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostInventoryHistory", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetHostInventoryHistory indicates an expected call of GetHostInventoryHistory
+func (mr *MockInstallerAPIMockRecorder) GetHostInventoryHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostInventoryHistory", reflect.TypeOf((*MockInstallerAPI)(nil).GetHostInventoryHistory), arg0, arg1)
+}
+
 // GetHostRequirements mocks base method
 func (m *MockInstallerAPI) GetHostRequirements(arg0 context.Context, arg1 installer.GetHostRequirementsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -469,6 +497,20 @@ func (mr *MockInstallerAPIMockRecorder) ListClusters(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockInstallerAPI)(nil).ListClusters), arg0, arg1)
 }
 
+// ListHostValidationRules mocks base method
+func (m *MockInstallerAPI) ListHostValidationRules(arg0 context.Context, arg1 installer.ListHostValidationRulesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostValidationRules", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListHostValidationRules indicates an expected call of ListHostValidationRules
+func (mr *MockInstallerAPIMockRecorder) ListHostValidationRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostValidationRules", reflect.TypeOf((*MockInstallerAPI)(nil).ListHostValidationRules), arg0, arg1)
+}
+
 // ListHosts mocks base method
 func (m *MockInstallerAPI) ListHosts(arg0 context.Context, arg1 installer.ListHostsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -581,6 +623,188 @@ func (mr *MockInstallerAPIMockRecorder) ResetHostValidation(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetHostValidation", reflect.TypeOf((*MockInstallerAPI)(nil).ResetHostValidation), arg0, arg1)
 }
 
+// ScheduleClusterInstallation mocks base method
+func (m *MockInstallerAPI) ScheduleClusterInstallation(arg0 context.Context, arg1 installer.ScheduleClusterInstallationParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleClusterInstallation", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ScheduleClusterInstallation indicates an expected call of ScheduleClusterInstallation
+func (mr *MockInstallerAPIMockRecorder) ScheduleClusterInstallation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleClusterInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).ScheduleClusterInstallation), arg0, arg1)
+}
+
+// AssignClusterRoles mocks base method
+func (m *MockInstallerAPI) AssignClusterRoles(arg0 context.Context, arg1 installer.AssignClusterRolesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignClusterRoles", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// AssignClusterRoles indicates an expected call of AssignClusterRoles
+func (mr *MockInstallerAPIMockRecorder) AssignClusterRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignClusterRoles", reflect.TypeOf((*MockInstallerAPI)(nil).AssignClusterRoles), arg0, arg1)
+}
+
+// ListClusterStaticNetworkConfigs mocks base method
+func (m *MockInstallerAPI) ListClusterStaticNetworkConfigs(arg0 context.Context, arg1 installer.ListClusterStaticNetworkConfigsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterStaticNetworkConfigs", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListClusterStaticNetworkConfigs indicates an expected call of ListClusterStaticNetworkConfigs
+func (mr *MockInstallerAPIMockRecorder) ListClusterStaticNetworkConfigs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterStaticNetworkConfigs", reflect.TypeOf((*MockInstallerAPI)(nil).ListClusterStaticNetworkConfigs), arg0, arg1)
+}
+
+// CreateClusterStaticNetworkConfig mocks base method
+func (m *MockInstallerAPI) CreateClusterStaticNetworkConfig(arg0 context.Context, arg1 installer.CreateClusterStaticNetworkConfigParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterStaticNetworkConfig", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// CreateClusterStaticNetworkConfig indicates an expected call of CreateClusterStaticNetworkConfig
+func (mr *MockInstallerAPIMockRecorder) CreateClusterStaticNetworkConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterStaticNetworkConfig", reflect.TypeOf((*MockInstallerAPI)(nil).CreateClusterStaticNetworkConfig), arg0, arg1)
+}
+
+// UpdateClusterStaticNetworkConfig mocks base method
+func (m *MockInstallerAPI) UpdateClusterStaticNetworkConfig(arg0 context.Context, arg1 installer.UpdateClusterStaticNetworkConfigParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterStaticNetworkConfig", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UpdateClusterStaticNetworkConfig indicates an expected call of UpdateClusterStaticNetworkConfig
+func (mr *MockInstallerAPIMockRecorder) UpdateClusterStaticNetworkConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterStaticNetworkConfig", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateClusterStaticNetworkConfig), arg0, arg1)
+}
+
+// DeleteClusterStaticNetworkConfig mocks base method
+func (m *MockInstallerAPI) DeleteClusterStaticNetworkConfig(arg0 context.Context, arg1 installer.DeleteClusterStaticNetworkConfigParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterStaticNetworkConfig", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DeleteClusterStaticNetworkConfig indicates an expected call of DeleteClusterStaticNetworkConfig
+func (mr *MockInstallerAPIMockRecorder) DeleteClusterStaticNetworkConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterStaticNetworkConfig", reflect.TypeOf((*MockInstallerAPI)(nil).DeleteClusterStaticNetworkConfig), arg0, arg1)
+}
+
+// PreviewClusterStaticNetworkConfigs mocks base method
+func (m *MockInstallerAPI) PreviewClusterStaticNetworkConfigs(arg0 context.Context, arg1 installer.PreviewClusterStaticNetworkConfigsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewClusterStaticNetworkConfigs", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// PreviewClusterStaticNetworkConfigs indicates an expected call of PreviewClusterStaticNetworkConfigs
+func (mr *MockInstallerAPIMockRecorder) PreviewClusterStaticNetworkConfigs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewClusterStaticNetworkConfigs", reflect.TypeOf((*MockInstallerAPI)(nil).PreviewClusterStaticNetworkConfigs), arg0, arg1)
+}
+
+// GetClusterIPPool mocks base method
+func (m *MockInstallerAPI) GetClusterIPPool(arg0 context.Context, arg1 installer.GetClusterIPPoolParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterIPPool", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterIPPool indicates an expected call of GetClusterIPPool
+func (mr *MockInstallerAPIMockRecorder) GetClusterIPPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterIPPool", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterIPPool), arg0, arg1)
+}
+
+// UpdateClusterIPPool mocks base method
+func (m *MockInstallerAPI) UpdateClusterIPPool(arg0 context.Context, arg1 installer.UpdateClusterIPPoolParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterIPPool", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UpdateClusterIPPool indicates an expected call of UpdateClusterIPPool
+func (mr *MockInstallerAPIMockRecorder) UpdateClusterIPPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterIPPool", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateClusterIPPool), arg0, arg1)
+}
+
+// ListClusterIPLeases mocks base method
+func (m *MockInstallerAPI) ListClusterIPLeases(arg0 context.Context, arg1 installer.ListClusterIPLeasesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterIPLeases", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListClusterIPLeases indicates an expected call of ListClusterIPLeases
+func (mr *MockInstallerAPIMockRecorder) ListClusterIPLeases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterIPLeases", reflect.TypeOf((*MockInstallerAPI)(nil).ListClusterIPLeases), arg0, arg1)
+}
+
+// AllocateClusterIPLease mocks base method
+func (m *MockInstallerAPI) AllocateClusterIPLease(arg0 context.Context, arg1 installer.AllocateClusterIPLeaseParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateClusterIPLease", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// AllocateClusterIPLease indicates an expected call of AllocateClusterIPLease
+func (mr *MockInstallerAPIMockRecorder) AllocateClusterIPLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateClusterIPLease", reflect.TypeOf((*MockInstallerAPI)(nil).AllocateClusterIPLease), arg0, arg1)
+}
+
+// ReleaseClusterIPLease mocks base method
+func (m *MockInstallerAPI) ReleaseClusterIPLease(arg0 context.Context, arg1 installer.ReleaseClusterIPLeaseParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseClusterIPLease", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ReleaseClusterIPLease indicates an expected call of ReleaseClusterIPLease
+func (mr *MockInstallerAPIMockRecorder) ReleaseClusterIPLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseClusterIPLease", reflect.TypeOf((*MockInstallerAPI)(nil).ReleaseClusterIPLease), arg0, arg1)
+}
+
+// UnscheduleClusterInstallation mocks base method
+func (m *MockInstallerAPI) UnscheduleClusterInstallation(arg0 context.Context, arg1 installer.UnscheduleClusterInstallationParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnscheduleClusterInstallation", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UnscheduleClusterInstallation indicates an expected call of UnscheduleClusterInstallation
+func (mr *MockInstallerAPIMockRecorder) UnscheduleClusterInstallation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnscheduleClusterInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).UnscheduleClusterInstallation), arg0, arg1)
+}
+
 // UpdateCluster mocks base method
 func (m *MockInstallerAPI) UpdateCluster(arg0 context.Context, arg1 installer.UpdateClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -693,6 +917,20 @@ func (mr *MockInstallerAPIMockRecorder) UpdateHostLogsProgress(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostLogsProgress", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateHostLogsProgress), arg0, arg1)
 }
 
+// UpdateHostValidationRules mocks base method
+func (m *MockInstallerAPI) UpdateHostValidationRules(arg0 context.Context, arg1 installer.UpdateHostValidationRulesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHostValidationRules", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UpdateHostValidationRules indicates an expected call of UpdateHostValidationRules
+func (mr *MockInstallerAPIMockRecorder) UpdateHostValidationRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostValidationRules", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateHostValidationRules), arg0, arg1)
+}
+
 // UploadClusterIngressCert mocks base method
 func (m *MockInstallerAPI) UploadClusterIngressCert(arg0 context.Context, arg1 installer.UploadClusterIngressCertParams) middleware.Responder {
 	m.ctrl.T.Helper()
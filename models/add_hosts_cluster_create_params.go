@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -21,6 +23,10 @@ type AddHostsClusterCreateParams struct {
 	// Required: true
 	APIVipDnsname *string `json:"api_vip_dnsname"`
 
+	// The CPU architecture of the hosts of the OpenShift cluster (x86_64/arm64/etc).
+	// Enum: [x86_64 arm64 ppc64le s390x]
+	CPUArchitecture *string `json:"cpu_architecture,omitempty"`
+
 	// Unique identifier of the object.
 	// Required: true
 	// Format: uuid
@@ -43,6 +49,10 @@ func (m *AddHostsClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCPUArchitecture(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -70,6 +80,55 @@ func (m *AddHostsClusterCreateParams) validateAPIVipDnsname(formats strfmt.Regis
 	return nil
 }
 
+var addHostsClusterCreateParamsTypeCPUArchitecturePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["x86_64","arm64","ppc64le","s390x"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addHostsClusterCreateParamsTypeCPUArchitecturePropEnum = append(addHostsClusterCreateParamsTypeCPUArchitecturePropEnum, v)
+	}
+}
+
+const (
+
+	// AddHostsClusterCreateParamsCPUArchitectureX8664 captures enum value "x86_64"
+	AddHostsClusterCreateParamsCPUArchitectureX8664 string = "x86_64"
+
+	// AddHostsClusterCreateParamsCPUArchitectureArm64 captures enum value "arm64"
+	AddHostsClusterCreateParamsCPUArchitectureArm64 string = "arm64"
+
+	// AddHostsClusterCreateParamsCPUArchitecturePpc64le captures enum value "ppc64le"
+	AddHostsClusterCreateParamsCPUArchitecturePpc64le string = "ppc64le"
+
+	// AddHostsClusterCreateParamsCPUArchitectureS390x captures enum value "s390x"
+	AddHostsClusterCreateParamsCPUArchitectureS390x string = "s390x"
+)
+
+// prop value enum
+func (m *AddHostsClusterCreateParams) validateCPUArchitectureEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addHostsClusterCreateParamsTypeCPUArchitecturePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AddHostsClusterCreateParams) validateCPUArchitecture(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CPUArchitecture) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCPUArchitectureEnum("cpu_architecture", "body", *m.CPUArchitecture); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *AddHostsClusterCreateParams) validateID(formats strfmt.Registry) error {
 
 	if err := validate.Required("id", "body", m.ID); err != nil {
@@ -34,6 +34,9 @@ type Cluster struct {
 	// The domain name used to reach the OpenShift cluster API.
 	APIVipDNSName *string `json:"api_vip_dns_name,omitempty"`
 
+	// The API virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the api_vip.
+	APIVips []string `json:"api_vips" gorm:"-"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
@@ -46,6 +49,9 @@ type Cluster struct {
 	// Minimum: 1
 	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.
+	ClusterNetworks []*ClusterNetwork `json:"cluster_networks" gorm:"-"`
+
 	// Json formatted string containing the majority groups for connectivity checks.
 	ConnectivityMajorityGroups string `json:"connectivity_majority_groups,omitempty" gorm:"type:text"`
 
@@ -57,9 +63,12 @@ type Cluster struct {
 	// Format: date-time
 	ControllerLogsStartedAt strfmt.DateTime `json:"controller_logs_started_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// The CPU architecture of the image (x86_64/arm64/etc).
+	CPUArchitecture string `json:"cpu_architecture,omitempty" gorm:"default:'x86_64'"`
+
 	// The time that this cluster was created.
 	// Format: date-time
-	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone;index"`
 
 	// The time that the cluster was deleted.
 	// Format: date-time
@@ -83,6 +92,9 @@ type Cluster struct {
 	// List of host networks to be filled during query.
 	HostNetworks []*HostNetwork `json:"host_networks" gorm:"-"`
 
+	// JSON-formatted list of the user-defined host validation rules of the cluster.
+	HostValidationRules string `json:"host_validation_rules,omitempty" gorm:"type:text"`
+
 	// Hosts that are associated with this cluster.
 	Hosts []*Host `json:"hosts" gorm:"foreignkey:ClusterID;association_foreignkey:ID"`
 
@@ -120,6 +132,9 @@ type Cluster struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$
 	IngressVip string `json:"ingress_vip,omitempty"`
 
+	// The Ingress virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the ingress_vip.
+	IngressVips []string `json:"ingress_vips" gorm:"-"`
+
 	// The time that this cluster completed installation.
 	// Format: date-time
 	InstallCompletedAt strfmt.DateTime `json:"install_completed_at,omitempty" gorm:"type:timestamp with time zone;default:'2000-01-01 00:00:00z'"`
@@ -127,10 +142,16 @@ type Cluster struct {
 	// JSON-formatted string containing the user overrides for the install-config.yaml file.
 	InstallConfigOverrides string `json:"install_config_overrides,omitempty" gorm:"type:text"`
 
+	// install schedule
+	InstallSchedule *InstallSchedule `json:"install_schedule,omitempty" gorm:"embedded;embedded_prefix:install_schedule_"`
+
 	// The time that this cluster started installation.
 	// Format: date-time
 	InstallStartedAt strfmt.DateTime `json:"install_started_at,omitempty" gorm:"type:timestamp with time zone;default:'2000-01-01 00:00:00z'"`
 
+	// JSON-formatted IP pool of the cluster, from which the addresses of its static-IP hosts are allocated.
+	IPPool string `json:"ip_pool,omitempty" gorm:"type:text"`
+
 	// Indicates the type of this object. Will be 'Cluster' if this is a complete object,
 	// 'AddHostsCluster' for cluster that add hosts to existing OCP cluster,
 	//
@@ -145,6 +166,9 @@ type Cluster struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	MachineNetworkCidr string `json:"machine_network_cidr,omitempty"`
 
+	// The machine networks of the cluster, with the IPv4 network first in dual-stack clusters. Multi-subnet clusters, whose hosts are in several routed subnets, have several networks of the same IP address family and require user-managed networking. The first network is also the machine_network_cidr.
+	MachineNetworks []string `json:"machine_networks" gorm:"-"`
+
 	// Operators that are associated with this cluster.
 	MonitoredOperators []*MonitoredOperator `json:"monitored_operators" gorm:"foreignkey:ClusterID;association_foreignkey:ID"`
 
@@ -177,6 +201,9 @@ type Cluster struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
 
+	// The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.
+	ServiceNetworks []string `json:"service_networks" gorm:"-"`
+
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
@@ -206,6 +233,9 @@ type Cluster struct {
 	// user name
 	UserName string `json:"user_name,omitempty"`
 
+	// JSON-formatted validation policy of the cluster, that overrides the service-wide disabled validations and thresholds.
+	ValidationPolicy string `json:"validation_policy,omitempty" gorm:"type:text"`
+
 	// JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)
 	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:text"`
 
@@ -225,6 +255,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAPIVips(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -233,6 +267,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateClusterNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateControllerLogsCollectedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -281,10 +319,18 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateIngressVips(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInstallCompletedAt(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateInstallSchedule(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInstallStartedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -301,6 +347,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMachineNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMonitoredOperators(formats); err != nil {
 		res = append(res, err)
 	}
@@ -313,6 +363,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateServiceNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -361,6 +415,23 @@ func (m *Cluster) validateAPIVip(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateAPIVips(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.APIVips) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.APIVips); i++ {
+
+		if err := validate.Pattern("api_vips"+"."+strconv.Itoa(i), "body", string(m.APIVips[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateClusterNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
@@ -391,6 +462,31 @@ func (m *Cluster) validateClusterNetworkHostPrefix(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *Cluster) validateClusterNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+		if swag.IsZero(m.ClusterNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateControllerLogsCollectedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ControllerLogsCollectedAt) { // not required
@@ -638,6 +734,23 @@ func (m *Cluster) validateIngressVip(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateIngressVips(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.IngressVips) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.IngressVips); i++ {
+
+		if err := validate.Pattern("ingress_vips"+"."+strconv.Itoa(i), "body", string(m.IngressVips[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateInstallCompletedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.InstallCompletedAt) { // not required
@@ -651,6 +764,24 @@ func (m *Cluster) validateInstallCompletedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateInstallSchedule(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.InstallSchedule) { // not required
+		return nil
+	}
+
+	if m.InstallSchedule != nil {
+		if err := m.InstallSchedule.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("install_schedule")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateInstallStartedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.InstallStartedAt) { // not required
@@ -736,6 +867,23 @@ func (m *Cluster) validateMachineNetworkCidr(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateMachineNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MachineNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+
+		if err := validate.Pattern("machine_networks"+"."+strconv.Itoa(i), "body", string(m.MachineNetworks[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateMonitoredOperators(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.MonitoredOperators) { // not required
@@ -787,6 +935,23 @@ func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateServiceNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ServiceNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+
+		if err := validate.Pattern("service_networks"+"."+strconv.Itoa(i), "body", string(m.ServiceNetworks[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 var clusterTypeStatusPropEnum []interface{}
 
 func init() {
@@ -35,6 +35,13 @@ type ClusterCreateParams struct {
 	// Minimum: 1
 	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.
+	ClusterNetworks []*ClusterNetwork `json:"cluster_networks"`
+
+	// The CPU architecture of the image (x86_64/arm64/etc).
+	// Enum: [x86_64 arm64 ppc64le s390x]
+	CPUArchitecture *string `json:"cpu_architecture,omitempty"`
+
 	// Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster
 	// over multiple master nodes whereas 'None' installs a full cluster over one node.
 	//
@@ -86,6 +93,9 @@ type ClusterCreateParams struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
 
+	// The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.
+	ServiceNetworks []string `json:"service_networks"`
+
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
@@ -108,6 +118,14 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateClusterNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCPUArchitecture(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHighAvailabilityMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -140,6 +158,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateServiceNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -176,6 +198,80 @@ func (m *ClusterCreateParams) validateClusterNetworkHostPrefix(formats strfmt.Re
 	return nil
 }
 
+func (m *ClusterCreateParams) validateClusterNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+		if swag.IsZero(m.ClusterNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var clusterCreateParamsTypeCPUArchitecturePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["x86_64","arm64","ppc64le","s390x"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterCreateParamsTypeCPUArchitecturePropEnum = append(clusterCreateParamsTypeCPUArchitecturePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterCreateParamsCPUArchitectureX8664 captures enum value "x86_64"
+	ClusterCreateParamsCPUArchitectureX8664 string = "x86_64"
+
+	// ClusterCreateParamsCPUArchitectureArm64 captures enum value "arm64"
+	ClusterCreateParamsCPUArchitectureArm64 string = "arm64"
+
+	// ClusterCreateParamsCPUArchitecturePpc64le captures enum value "ppc64le"
+	ClusterCreateParamsCPUArchitecturePpc64le string = "ppc64le"
+
+	// ClusterCreateParamsCPUArchitectureS390x captures enum value "s390x"
+	ClusterCreateParamsCPUArchitectureS390x string = "s390x"
+)
+
+// prop value enum
+func (m *ClusterCreateParams) validateCPUArchitectureEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterCreateParamsTypeCPUArchitecturePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterCreateParams) validateCPUArchitecture(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CPUArchitecture) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCPUArchitectureEnum("cpu_architecture", "body", *m.CPUArchitecture); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var clusterCreateParamsTypeHighAvailabilityModePropEnum []interface{}
 
 func init() {
@@ -354,6 +450,23 @@ func (m *ClusterCreateParams) validateServiceNetworkCidr(formats strfmt.Registry
 	return nil
 }
 
+func (m *ClusterCreateParams) validateServiceNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ServiceNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+
+		if err := validate.Pattern("service_networks"+"."+strconv.Itoa(i), "body", string(m.ServiceNetworks[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ClusterCreateParams) MarshalBinary() ([]byte, error) {
 	if m == nil {
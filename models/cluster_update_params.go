@@ -30,6 +30,9 @@ type ClusterUpdateParams struct {
 	// The domain name used to reach the OpenShift cluster API.
 	APIVipDNSName *string `json:"api_vip_dns_name,omitempty"`
 
+	// The API virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the api_vip.
+	APIVips []string `json:"api_vips"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain *string `json:"base_dns_domain,omitempty"`
 
@@ -42,9 +45,15 @@ type ClusterUpdateParams struct {
 	// Minimum: 1
 	ClusterNetworkHostPrefix *int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.
+	ClusterNetworks []*ClusterNetwork `json:"cluster_networks"`
+
 	// disks selected config
 	DisksSelectedConfig []*ClusterUpdateParamsDisksSelectedConfigItems0 `json:"disks_selected_config"`
 
+	// The failure domains of hosts associated with the cluster.
+	HostsFailureDomains []*ClusterUpdateParamsHostsFailureDomainsItems0 `json:"hosts_failure_domains"`
+
 	// The desired machine config pool for hosts associated with the cluster.
 	HostsMachineConfigPoolNames []*ClusterUpdateParamsHostsMachineConfigPoolNamesItems0 `json:"hosts_machine_config_pool_names"`
 
@@ -72,10 +81,16 @@ type ClusterUpdateParams struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$
 	IngressVip *string `json:"ingress_vip,omitempty"`
 
+	// The Ingress virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the ingress_vip.
+	IngressVips []string `json:"ingress_vips"`
+
 	// A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	MachineNetworkCidr *string `json:"machine_network_cidr,omitempty"`
 
+	// The machine networks of the cluster, with the IPv4 network first in dual-stack clusters. Multi-subnet clusters, whose hosts are in several routed subnets, have several networks of the same IP address family and require user-managed networking. The first network is also the machine_network_cidr.
+	MachineNetworks []string `json:"machine_networks"`
+
 	// OpenShift cluster name.
 	// Max Length: 54
 	// Min Length: 1
@@ -94,12 +109,18 @@ type ClusterUpdateParams struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
 
+	// The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.
+	ServiceNetworks []string `json:"service_networks"`
+
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey *string `json:"ssh_public_key,omitempty"`
 
 	// Indicate if the networking is managed by the user.
 	UserManagedNetworking *bool `json:"user_managed_networking,omitempty"`
 
+	// Overrides the service-wide disabled validations and thresholds for the cluster.
+	ValidationPolicy *ValidationPolicy `json:"validation_policy,omitempty"`
+
 	// Indicate if virtual IP DHCP allocation mode is enabled.
 	VipDhcpAllocation *bool `json:"vip_dhcp_allocation,omitempty"`
 }
@@ -112,6 +133,10 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAPIVips(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -120,10 +145,18 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateClusterNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDisksSelectedConfig(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateHostsFailureDomains(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostsMachineConfigPoolNames(formats); err != nil {
 		res = append(res, err)
 	}
@@ -144,10 +177,18 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateIngressVips(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMachineNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateMachineNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -160,6 +201,14 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateServiceNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValidationPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -179,6 +228,23 @@ func (m *ClusterUpdateParams) validateAPIVip(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateAPIVips(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.APIVips) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.APIVips); i++ {
+
+		if err := validate.Pattern("api_vips"+"."+strconv.Itoa(i), "body", string(m.APIVips[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateClusterNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
@@ -209,6 +275,31 @@ func (m *ClusterUpdateParams) validateClusterNetworkHostPrefix(formats strfmt.Re
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateClusterNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+		if swag.IsZero(m.ClusterNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateDisksSelectedConfig(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.DisksSelectedConfig) { // not required
@@ -234,6 +325,31 @@ func (m *ClusterUpdateParams) validateDisksSelectedConfig(formats strfmt.Registr
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateHostsFailureDomains(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostsFailureDomains) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostsFailureDomains); i++ {
+		if swag.IsZero(m.HostsFailureDomains[i]) { // not required
+			continue
+		}
+
+		if m.HostsFailureDomains[i] != nil {
+			if err := m.HostsFailureDomains[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts_failure_domains" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateHostsMachineConfigPoolNames(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.HostsMachineConfigPoolNames) { // not required
@@ -371,6 +487,23 @@ func (m *ClusterUpdateParams) validateIngressVip(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateIngressVips(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.IngressVips) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.IngressVips); i++ {
+
+		if err := validate.Pattern("ingress_vips"+"."+strconv.Itoa(i), "body", string(m.IngressVips[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateMachineNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.MachineNetworkCidr) { // not required
@@ -384,6 +517,23 @@ func (m *ClusterUpdateParams) validateMachineNetworkCidr(formats strfmt.Registry
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateMachineNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MachineNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+
+		if err := validate.Pattern("machine_networks"+"."+strconv.Itoa(i), "body", string(m.MachineNetworks[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateName(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Name) { // not required
@@ -439,6 +589,41 @@ func (m *ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateServiceNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ServiceNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+
+		if err := validate.Pattern("service_networks"+"."+strconv.Itoa(i), "body", string(m.ServiceNetworks[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateValidationPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ValidationPolicy) { // not required
+		return nil
+	}
+
+	if m.ValidationPolicy != nil {
+		if err := m.ValidationPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("validation_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ClusterUpdateParams) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -544,6 +729,64 @@ func (m *ClusterUpdateParamsDisksSelectedConfigItems0) UnmarshalBinary(b []byte)
 	return nil
 }
 
+// ClusterUpdateParamsHostsFailureDomainsItems0 cluster update params hosts failure domains items0
+//
+// swagger:model ClusterUpdateParamsHostsFailureDomainsItems0
+type ClusterUpdateParamsHostsFailureDomainsItems0 struct {
+
+	// failure domain
+	FailureDomain string `json:"failure_domain,omitempty"`
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+}
+
+// Validate validates this cluster update params hosts failure domains items0
+func (m *ClusterUpdateParamsHostsFailureDomainsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterUpdateParamsHostsFailureDomainsItems0) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsFailureDomainsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsFailureDomainsItems0) UnmarshalBinary(b []byte) error {
+	var res ClusterUpdateParamsHostsFailureDomainsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
 // ClusterUpdateParamsHostsMachineConfigPoolNamesItems0 cluster update params hosts machine config pool names items0
 //
 // swagger:model ClusterUpdateParamsHostsMachineConfigPoolNamesItems0
@@ -73,6 +73,12 @@ const (
 
 	// ClusterValidationIDCnvRequirementsSatisfied captures enum value "cnv-requirements-satisfied"
 	ClusterValidationIDCnvRequirementsSatisfied ClusterValidationID = "cnv-requirements-satisfied"
+
+	// ClusterValidationIDSriovRequirementsSatisfied captures enum value "sriov-requirements-satisfied"
+	ClusterValidationIDSriovRequirementsSatisfied ClusterValidationID = "sriov-requirements-satisfied"
+
+	// ClusterValidationIDLvmRequirementsSatisfied captures enum value "lvm-requirements-satisfied"
+	ClusterValidationIDLvmRequirementsSatisfied ClusterValidationID = "lvm-requirements-satisfied"
 )
 
 // for schema
@@ -80,7 +86,7 @@ var clusterValidationIdEnum []interface{}
 
 func init() {
 	var res []ClusterValidationID
-	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vip-defined","api-vip-valid","ingress-vip-defined","ingress-vip-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","cnv-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vip-defined","api-vip-valid","ingress-vip-defined","ingress-vip-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","cnv-requirements-satisfied","sriov-requirements-satisfied","lvm-requirements-satisfied"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -31,7 +31,7 @@ type Event struct {
 	// event time
 	// Required: true
 	// Format: date-time
-	EventTime *strfmt.DateTime `json:"event_time" gorm:"type:timestamp with time zone"`
+	EventTime *strfmt.DateTime `json:"event_time" gorm:"type:timestamp with time zone;index"`
 
 	// Unique identifier of the host this event relates to.
 	// Format: uuid
@@ -51,9 +51,15 @@ type Host struct {
 	// Additional information about disks, formatted as JSON.
 	DisksInfo string `json:"disks_info,omitempty" gorm:"type:text"`
 
+	// The failure domain of the host, such as its rack. The automatic role assignment spreads the masters across failure domains.
+	FailureDomain string `json:"failure_domain,omitempty"`
+
 	// free addresses
 	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
 
+	// JSON-formatted inventory of the host when its role was assigned or its installation disk was selected, that the hardware of the host is validated against.
+	HardwareBaseline string `json:"hardware_baseline,omitempty" gorm:"type:text"`
+
 	// Self link.
 	// Required: true
 	Href *string `json:"href"`
@@ -85,6 +85,21 @@ const (
 
 	// HostValidationIDSufficientPacketLossRequirementForRole captures enum value "sufficient-packet-loss-requirement-for-role"
 	HostValidationIDSufficientPacketLossRequirementForRole HostValidationID = "sufficient-packet-loss-requirement-for-role"
+
+	// HostValidationIDCompatibleCPUArchitecture captures enum value "compatible-cpu-architecture"
+	HostValidationIDCompatibleCPUArchitecture HostValidationID = "compatible-cpu-architecture"
+
+	// HostValidationIDSriovRequirementsSatisfied captures enum value "sriov-requirements-satisfied"
+	HostValidationIDSriovRequirementsSatisfied HostValidationID = "sriov-requirements-satisfied"
+
+	// HostValidationIDLvmRequirementsSatisfied captures enum value "lvm-requirements-satisfied"
+	HostValidationIDLvmRequirementsSatisfied HostValidationID = "lvm-requirements-satisfied"
+
+	// HostValidationIDHardwareUnchanged captures enum value "hardware-unchanged"
+	HostValidationIDHardwareUnchanged HostValidationID = "hardware-unchanged"
+
+	// HostValidationIDMtuConsistent captures enum value "mtu-consistent"
+	HostValidationIDMtuConsistent HostValidationID = "mtu-consistent"
 )
 
 // for schema
@@ -92,7 +107,7 @@ var hostValidationIdEnum []interface{}
 
 func init() {
 	var res []HostValidationID
-	if err := json.Unmarshal([]byte(`["connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","api-vip-connected","belongs-to-majority-group","valid-platform","ntp-synced","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","sufficient-installation-disk-speed","cnv-requirements-satisfied","sufficient-network-latency-requirement-for-role","sufficient-packet-loss-requirement-for-role"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","api-vip-connected","belongs-to-majority-group","valid-platform","ntp-synced","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","sufficient-installation-disk-speed","cnv-requirements-satisfied","sufficient-network-latency-requirement-for-role","sufficient-packet-loss-requirement-for-role","compatible-cpu-architecture","sriov-requirements-satisfied","lvm-requirements-satisfied","hardware-unchanged","mtu-consistent"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
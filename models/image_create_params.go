@@ -24,7 +24,7 @@ type ImageCreateParams struct {
 	// SSH public key for debugging the installation.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
-	// static network config
+	// The static network configs of the hosts. The static network configs of the cluster are kept when not set.
 	StaticNetworkConfig []*HostStaticNetworkConfig `json:"static_network_config"`
 }
 
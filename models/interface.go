@@ -33,6 +33,9 @@ type Interface struct {
 	// ipv6 addresses
 	IPV6Addresses []string `json:"ipv6_addresses"`
 
+	// The names of the interfaces that the interface is on, which are the ports of a bond or a bridge, or the parent interface of a VLAN.
+	LowerInterfaces []string `json:"lower_interfaces"`
+
 	// mac address
 	MacAddress string `json:"mac_address,omitempty"`
 
@@ -48,8 +51,14 @@ type Interface struct {
 	// speed mbps
 	SpeedMbps int64 `json:"speed_mbps,omitempty"`
 
+	// The type of the interface, such as ethernet, bond, vlan or bridge.
+	Type string `json:"type,omitempty"`
+
 	// vendor
 	Vendor string `json:"vendor,omitempty"`
+
+	// The VLAN ID of a VLAN interface.
+	VlanID int64 `json:"vlan_id,omitempty"`
 }
 
 // Validate validates this interface
@@ -17,10 +17,16 @@ import (
 // swagger:model monitored-operator
 type MonitoredOperator struct {
 
+	// Subscription channel of the operator selected by the user.
+	Channel string `json:"channel,omitempty"`
+
 	// The cluster that this operator is associated with.
 	// Format: uuid
 	ClusterID strfmt.UUID `json:"cluster_id,omitempty" gorm:"primary_key;foreignkey:Cluster"`
 
+	// ClusterServiceVersion the subscription of the operator starts from, selected by the user. OLM upgrades the operator to the later releases of the channel.
+	Csv string `json:"csv,omitempty"`
+
 	// Unique name of the operator.
 	Name string `json:"name,omitempty" gorm:"primary_key"`
 
@@ -36,6 +42,9 @@ type MonitoredOperator struct {
 	// status
 	Status OperatorStatus `json:"status,omitempty"`
 
+	// status history
+	StatusHistory OperatorStatusHistory `json:"status_history,omitempty" gorm:"type:text"`
+
 	// Detailed information about the operator state.
 	StatusInfo string `json:"status_info,omitempty"`
 
@@ -66,6 +75,10 @@ func (m *MonitoredOperator) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStatusHistory(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatusUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -121,6 +134,22 @@ func (m *MonitoredOperator) validateStatus(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *MonitoredOperator) validateStatusHistory(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.StatusHistory) { // not required
+		return nil
+	}
+
+	if err := m.StatusHistory.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("status_history")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *MonitoredOperator) validateStatusUpdatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.StatusUpdatedAt) { // not required
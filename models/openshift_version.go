@@ -19,6 +19,9 @@ import (
 // swagger:model openshift-version
 type OpenshiftVersion struct {
 
+	// Images of additional CPU architectures keyed by architecture name. The top level images are used for x86_64.
+	CPUArchitectures map[string]CPUArchitectureImages `json:"cpu_architectures,omitempty"`
+
 	// Indication that the version is the recommended one.
 	Default bool `json:"default,omitempty"`
 
@@ -56,6 +59,10 @@ type OpenshiftVersion struct {
 func (m *OpenshiftVersion) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCPUArchitectures(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDisplayName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -90,6 +97,28 @@ func (m *OpenshiftVersion) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *OpenshiftVersion) validateCPUArchitectures(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CPUArchitectures) { // not required
+		return nil
+	}
+
+	for k := range m.CPUArchitectures {
+
+		if err := validate.Required("cpu_architectures"+"."+k, "body", m.CPUArchitectures[k]); err != nil {
+			return err
+		}
+		if val, ok := m.CPUArchitectures[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *OpenshiftVersion) validateDisplayName(formats strfmt.Registry) error {
 
 	if err := validate.Required("display_name", "body", m.DisplayName); err != nil {
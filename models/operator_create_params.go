@@ -15,6 +15,12 @@ import (
 // swagger:model operator-create-params
 type OperatorCreateParams struct {
 
+	// Subscription channel of the operator. Must be compatible with the OpenShift version of the cluster, the default channel of the operator is used if not set.
+	Channel string `json:"channel,omitempty"`
+
+	// ClusterServiceVersion the subscription of the operator starts from, e.g. an older release of the channel. OLM upgrades the operator to the later releases of the channel, so the version is not pinned. The latest release of the channel is installed if not set.
+	Csv string `json:"csv,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -0,0 +1,45 @@
+package models
+
+// This file is not generated: it stores the status history of the monitored operators in a JSON-formatted text column.
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Value stores the status history as a JSON-formatted list, or as an empty string when there are no status changes
+func (m OperatorStatusHistory) Value() (driver.Value, error) {
+	if len(m) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan reads the status history from its JSON-formatted list
+func (m *OperatorStatusHistory) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return fmt.Errorf("unsupported type %T of the operator status history", src)
+	}
+	if len(b) == 0 {
+		*m = nil
+		return nil
+	}
+	var history OperatorStatusHistory
+	if err := json.Unmarshal(b, &history); err != nil {
+		return fmt.Errorf("failed to parse the operator status history: %w", err)
+	}
+	*m = history
+	return nil
+}
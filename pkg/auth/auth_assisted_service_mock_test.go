@@ -180,6 +180,10 @@ func (f fakeInventory) GetClusterInstallConfig(ctx context.Context, params insta
 	return installer.NewGetClusterInstallConfigOK()
 }
 
+func (f fakeInventory) GetClusterInstallationReport(ctx context.Context, params installer.GetClusterInstallationReportParams) middleware.Responder {
+	return installer.NewGetClusterInstallationReportOK()
+}
+
 func (f fakeInventory) GetClusterDefaultConfig(ctx context.Context, params installer.GetClusterDefaultConfigParams) middleware.Responder {
 	return installer.NewGetClusterDefaultConfigOK()
 }
@@ -188,6 +192,14 @@ func (f fakeInventory) UpdateClusterInstallConfig(ctx context.Context, params in
 	return installer.NewUpdateClusterInstallConfigCreated()
 }
 
+func (f fakeInventory) ListHostValidationRules(ctx context.Context, params installer.ListHostValidationRulesParams) middleware.Responder {
+	return installer.NewListHostValidationRulesOK()
+}
+
+func (f fakeInventory) UpdateHostValidationRules(ctx context.Context, params installer.UpdateHostValidationRulesParams) middleware.Responder {
+	return installer.NewUpdateHostValidationRulesOK()
+}
+
 func (f fakeInventory) UpdateHostInstallProgress(ctx context.Context, params installer.UpdateHostInstallProgressParams) middleware.Responder {
 	return installer.NewUpdateHostInstallProgressOK()
 }
@@ -256,6 +268,10 @@ func (f fakeInventory) GetHostIgnition(ctx context.Context, params installer.Get
 	return installer.NewGetHostIgnitionOK()
 }
 
+func (f fakeInventory) GetHostInventoryHistory(ctx context.Context, params installer.GetHostInventoryHistoryParams) middleware.Responder {
+	return installer.NewGetHostInventoryHistoryOK()
+}
+
 func (f fakeInventory) DownloadHostIgnition(ctx context.Context, params installer.DownloadHostIgnitionParams) middleware.Responder {
 	file, err := ioutil.TempFile("/tmp", "test.file")
 	if err != nil {
@@ -280,6 +296,58 @@ func (f fakeInventory) ResetHostValidation(ctx context.Context, params installer
 	return installer.NewResetHostValidationOK()
 }
 
+func (f fakeInventory) ScheduleClusterInstallation(ctx context.Context, params installer.ScheduleClusterInstallationParams) middleware.Responder {
+	return installer.NewScheduleClusterInstallationOK()
+}
+
+func (f fakeInventory) AssignClusterRoles(ctx context.Context, params installer.AssignClusterRolesParams) middleware.Responder {
+	return installer.NewAssignClusterRolesOK()
+}
+
+func (f fakeInventory) ListClusterStaticNetworkConfigs(ctx context.Context, params installer.ListClusterStaticNetworkConfigsParams) middleware.Responder {
+	return installer.NewListClusterStaticNetworkConfigsOK()
+}
+
+func (f fakeInventory) CreateClusterStaticNetworkConfig(ctx context.Context, params installer.CreateClusterStaticNetworkConfigParams) middleware.Responder {
+	return installer.NewCreateClusterStaticNetworkConfigCreated()
+}
+
+func (f fakeInventory) UpdateClusterStaticNetworkConfig(ctx context.Context, params installer.UpdateClusterStaticNetworkConfigParams) middleware.Responder {
+	return installer.NewUpdateClusterStaticNetworkConfigOK()
+}
+
+func (f fakeInventory) DeleteClusterStaticNetworkConfig(ctx context.Context, params installer.DeleteClusterStaticNetworkConfigParams) middleware.Responder {
+	return installer.NewDeleteClusterStaticNetworkConfigNoContent()
+}
+
+func (f fakeInventory) PreviewClusterStaticNetworkConfigs(ctx context.Context, params installer.PreviewClusterStaticNetworkConfigsParams) middleware.Responder {
+	return installer.NewPreviewClusterStaticNetworkConfigsOK()
+}
+
+func (f fakeInventory) GetClusterIPPool(ctx context.Context, params installer.GetClusterIPPoolParams) middleware.Responder {
+	return installer.NewGetClusterIPPoolOK()
+}
+
+func (f fakeInventory) UpdateClusterIPPool(ctx context.Context, params installer.UpdateClusterIPPoolParams) middleware.Responder {
+	return installer.NewUpdateClusterIPPoolOK()
+}
+
+func (f fakeInventory) ListClusterIPLeases(ctx context.Context, params installer.ListClusterIPLeasesParams) middleware.Responder {
+	return installer.NewListClusterIPLeasesOK()
+}
+
+func (f fakeInventory) AllocateClusterIPLease(ctx context.Context, params installer.AllocateClusterIPLeaseParams) middleware.Responder {
+	return installer.NewAllocateClusterIPLeaseCreated()
+}
+
+func (f fakeInventory) ReleaseClusterIPLease(ctx context.Context, params installer.ReleaseClusterIPLeaseParams) middleware.Responder {
+	return installer.NewReleaseClusterIPLeaseNoContent()
+}
+
+func (f fakeInventory) UnscheduleClusterInstallation(ctx context.Context, params installer.UnscheduleClusterInstallationParams) middleware.Responder {
+	return installer.NewUnscheduleClusterInstallationOK()
+}
+
 var _ restapi.InstallerAPI = fakeInventory{}
 
 type fakeEventsAPI struct{}
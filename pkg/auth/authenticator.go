@@ -17,8 +17,14 @@ const (
 	TypeNone  AuthType = "none"
 	TypeRHSSO AuthType = "rhsso"
 	TypeLocal AuthType = "local"
+	TypeOIDC  AuthType = "oidc"
 )
 
+// HasLocalAgentAuth returns true if the agents and URLs are authenticated by tokens that are signed by the service
+func (t AuthType) HasLocalAgentAuth() bool {
+	return t == TypeLocal || t == TypeOIDC
+}
+
 type Authenticator interface {
 	CreateAuthenticator() func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator
 	AuthUserAuth(token string) (interface{}, error)
@@ -35,6 +41,9 @@ type Config struct {
 	// Will be split with "," as separator
 	AllowedDomains string   `envconfig:"ALLOWED_DOMAINS" default:""`
 	AdminUsers     []string `envconfig:"ADMIN_USERS" default:""`
+	// An admin token of the local authentication, used to create the first API tokens
+	LocalAdminToken string `envconfig:"LOCAL_ADMIN_TOKEN" default:""`
+	OIDC            OIDCConfig
 }
 
 func NewAuthenticator(cfg *Config, ocmClient *ocm.Client, log logrus.FieldLogger, db *gorm.DB) (a Authenticator, err error) {
@@ -45,6 +54,8 @@ func NewAuthenticator(cfg *Config, ocmClient *ocm.Client, log logrus.FieldLogger
 		a = NewNoneAuthenticator(log)
 	case TypeLocal:
 		a, err = NewLocalAuthenticator(cfg, log, db)
+	case TypeOIDC:
+		a, err = NewOIDCAuthenticator(cfg, log, db)
 	default:
 		err = fmt.Errorf("invalid authenticator type %v", cfg.AuthType)
 	}
@@ -14,22 +14,27 @@ import (
 )
 
 type AuthzHandler struct {
-	Enabled bool
-	log     logrus.FieldLogger
-	client  *ocm.Client
+	Enabled  bool
+	authType AuthType
+	log      logrus.FieldLogger
+	client   *ocm.Client
 }
 
 func NewAuthzHandler(cfg *Config, ocmCLient *ocm.Client, log logrus.FieldLogger) *AuthzHandler {
 	a := &AuthzHandler{
-		Enabled: cfg.AuthType == TypeRHSSO,
-		client:  ocmCLient,
-		log:     log,
+		Enabled:  cfg.AuthType == TypeRHSSO,
+		authType: cfg.AuthType,
+		client:   ocmCLient,
+		log:      log,
 	}
 	return a
 }
 
 // CreateAuthorizer returns Authorizer if auth is enabled
 func (a *AuthzHandler) CreateAuthorizer() func(*http.Request) error {
+	if a.authType == TypeLocal || a.authType == TypeOIDC {
+		return a.RoleAuthorizer
+	}
 	if !a.Enabled {
 		return func(*http.Request) error {
 			return nil
@@ -79,6 +84,20 @@ func (a *AuthzHandler) Authorizer(request *http.Request) (err error) {
 	return
 }
 
+// RoleAuthorizer only verifies that the role of the principal is allowed to access the route. It is used
+// when the users are authenticated by the API tokens of the local authentication or by an OIDC issuer.
+func (a *AuthzHandler) RoleAuthorizer(request *http.Request) error {
+	payload := ocm.PayloadFromContext(request.Context())
+	if ok := a.hasSufficientRole(request, payload); !ok {
+		return common.NewInfraError(
+			http.StatusUnauthorized,
+			fmt.Errorf(
+				"%s: Unauthorized to access route (insufficient role %s)",
+				payload.Username, payload.Role))
+	}
+	return nil
+}
+
 func (a *AuthzHandler) allowedToUseAssistedInstaller(username string) (bool, error) {
 	return a.client.Authorization.AccessReview(
 		context.Background(), username, ocm.AMSActionCreate, ocm.BareMetalClusterResource)
@@ -48,6 +48,9 @@ var _ = Describe("NewAuthzHandler", func() {
 		cfg = &Config{AuthType: TypeNone}
 		handler = NewAuthzHandler(cfg, nil, logrus.New())
 		Expect(handler.Enabled).To(BeFalse())
+		cfg = &Config{AuthType: TypeLocal}
+		handler = NewAuthzHandler(cfg, nil, logrus.New())
+		Expect(handler.Enabled).To(BeFalse())
 	})
 })
 
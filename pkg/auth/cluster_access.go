@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// clusterAccessLevel is the access of a user to a cluster. Each level is allowed
+// everything that the lower levels are allowed.
+type clusterAccessLevel int
+
+const (
+	clusterAccessNone clusterAccessLevel = iota
+	clusterAccessViewer
+	clusterAccessInstaller
+	clusterAccessEditor
+	clusterAccessOwner
+)
+
+var clusterAccessLevelByRole = map[models.ClusterAccessRole]clusterAccessLevel{
+	models.ClusterAccessRoleViewer:    clusterAccessViewer,
+	models.ClusterAccessRoleInstaller: clusterAccessInstaller,
+	models.ClusterAccessRoleEditor:    clusterAccessEditor,
+}
+
+// ownerOperations may only be called by the owner of the cluster
+var ownerOperations = []string{
+	"DeregisterCluster",
+	"GrantClusterAccess",
+	"RevokeClusterAccess",
+}
+
+// installerOperations control the installation of the cluster or expose its credentials
+var installerOperations = []string{
+	"InstallCluster",
+	"ScheduleClusterInstallation",
+	"UnscheduleClusterInstallation",
+	"CancelInstallation",
+	"ResetCluster",
+	"InstallHosts",
+	"InstallHost",
+	"ResetHost",
+	"GetCredentials",
+	"DownloadClusterKubeconfig",
+	"DownloadClusterFiles",
+	"GetPresignedForClusterFiles",
+	"DownloadClusterLogs",
+	// the ISO, the ignitions and the install config embed the pull secret and the cluster credentials
+	"DownloadClusterISO",
+	"DownloadClusterISOHeaders",
+	"GetDiscoveryIgnition",
+	"GetClusterInstallConfig",
+	"GetHostIgnition",
+	"DownloadHostIgnition",
+	"DownloadHostLogs",
+}
+
+// editorOperations read the cluster but require the editor role, since their result holds its whole configuration
+var editorOperations = []string{
+	"ExportCluster",
+}
+
+// requiredClusterAccessLevel returns the access level that the matched route of the request requires
+func requiredClusterAccessLevel(r *http.Request) clusterAccessLevel {
+	operationID := ""
+	if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+		operationID = route.Operation.ID
+	}
+	return operationClusterAccessLevel(operationID, r.Method)
+}
+
+// operationClusterAccessLevel returns the access level that the operation requires. Reading the cluster's
+// metadata only requires a viewer, unless the operation is listed as exposing the cluster's secrets.
+func operationClusterAccessLevel(operationID, method string) clusterAccessLevel {
+	switch {
+	case funk.ContainsString(ownerOperations, operationID):
+		return clusterAccessOwner
+	case funk.ContainsString(editorOperations, operationID):
+		return clusterAccessEditor
+	case funk.ContainsString(installerOperations, operationID):
+		return clusterAccessInstaller
+	case method == http.MethodGet || method == http.MethodHead:
+		return clusterAccessViewer
+	default:
+		return clusterAccessEditor
+	}
+}
+
+// verifyClusterAccessLevel returns an error if the access level of the user to the cluster is insufficient for the request
+func verifyClusterAccessLevel(r *http.Request, accessLevel clusterAccessLevel) error {
+	if accessLevel == clusterAccessNone {
+		return common.NewApiError(http.StatusNotFound, errors.New("Cluster Not Found"))
+	}
+	if accessLevel < requiredClusterAccessLevel(r) {
+		return common.NewApiError(http.StatusForbidden, errors.New("Insufficient role for the cluster"))
+	}
+	return nil
+}
+
+// getClusterAccessLevel returns the access of the user to the cluster, either as its owner or
+// according to the role that the cluster was shared with the user
+func getClusterAccessLevel(db *gorm.DB, clusterID string, payload *ocm.AuthPayload) (clusterAccessLevel, error) {
+	err := db.First(&common.Cluster{}, "id = ? and user_name = ?", clusterID, payload.Username).Error
+	if err == nil {
+		return clusterAccessOwner, nil
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		return clusterAccessNone, err
+	}
+
+	var access models.ClusterAccess
+	err = db.First(&access, "cluster_id = ? and user_name = ?", clusterID, payload.Username).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return clusterAccessNone, nil
+		}
+		return clusterAccessNone, err
+	}
+	return clusterAccessLevelByRole[access.Role], nil
+}
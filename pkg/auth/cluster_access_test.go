@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+)
+
+var _ = DescribeTable("operationClusterAccessLevel",
+	func(operationID, method string, expected clusterAccessLevel) {
+		Expect(operationClusterAccessLevel(operationID, method)).Should(Equal(expected))
+	},
+	Entry("get cluster", "GetCluster", http.MethodGet, clusterAccessViewer),
+	Entry("list hosts", "ListHosts", http.MethodGet, clusterAccessViewer),
+	Entry("download kubeconfig", "DownloadClusterKubeconfig", http.MethodGet, clusterAccessInstaller),
+	Entry("get credentials", "GetCredentials", http.MethodGet, clusterAccessInstaller),
+	Entry("download ISO", "DownloadClusterISO", http.MethodGet, clusterAccessInstaller),
+	Entry("download ISO headers", "DownloadClusterISOHeaders", http.MethodHead, clusterAccessInstaller),
+	Entry("get discovery ignition", "GetDiscoveryIgnition", http.MethodGet, clusterAccessInstaller),
+	Entry("get install config", "GetClusterInstallConfig", http.MethodGet, clusterAccessInstaller),
+	Entry("get host ignition", "GetHostIgnition", http.MethodGet, clusterAccessInstaller),
+	Entry("download host ignition", "DownloadHostIgnition", http.MethodGet, clusterAccessInstaller),
+	Entry("download cluster files", "DownloadClusterFiles", http.MethodGet, clusterAccessInstaller),
+	Entry("install cluster", "InstallCluster", http.MethodPost, clusterAccessInstaller),
+	Entry("update cluster", "UpdateCluster", http.MethodPatch, clusterAccessEditor),
+	Entry("export cluster", "ExportCluster", http.MethodGet, clusterAccessEditor),
+	Entry("deregister host", "DeregisterHost", http.MethodDelete, clusterAccessEditor),
+	Entry("deregister cluster", "DeregisterCluster", http.MethodDelete, clusterAccessOwner),
+	Entry("grant cluster access", "GrantClusterAccess", http.MethodPut, clusterAccessOwner),
+)
+
+var _ = Describe("getClusterAccessLevel", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: "owner"}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&models.ClusterAccess{
+			ClusterID: &clusterID,
+			UserName:  swag.String("installer"),
+			Role:      models.ClusterAccessRoleInstaller,
+		}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("returns the access level of the user", func() {
+		for username, expected := range map[string]clusterAccessLevel{
+			"owner":     clusterAccessOwner,
+			"installer": clusterAccessInstaller,
+			"other":     clusterAccessNone,
+		} {
+			level, err := getClusterAccessLevel(db, clusterID.String(), &ocm.AuthPayload{Username: username})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(level).Should(Equal(expected), username)
+		}
+	})
+})
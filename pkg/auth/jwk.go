@@ -95,6 +95,12 @@ func (au *aUtils) proccessPublicKeys(cas *x509.CertPool) (keyMap map[string]*rsa
 	for _, c := range certs.Keys {
 		var pubKey *rsa.PublicKey
 
+		// Providers may publish keys of other types, e.g. EC or OKP, that aren't used for our tokens.
+		if c.Kty != "RSA" {
+			logrus.Infof("Skipping JWK %s of unsupported key type %s", c.KID, c.Kty)
+			continue
+		}
+
 		// Try to convert cert to string.
 		pemStr, err = au.certToPEM(c)
 		if err != nil {
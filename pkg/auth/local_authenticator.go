@@ -2,24 +2,34 @@ package auth
 
 import (
 	"crypto"
+	"crypto/subtle"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/security"
+	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	params "github.com/openshift/assisted-service/pkg/context"
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
+// apiTokenLastUsedResolution limits the updates of the last usage time of API tokens
+const apiTokenLastUsedResolution = time.Minute
+
 type LocalAuthenticator struct {
-	cache     *cache.Cache
-	db        *gorm.DB
-	log       logrus.FieldLogger
-	publicKey crypto.PublicKey
+	cache      *cache.Cache
+	db         *gorm.DB
+	log        logrus.FieldLogger
+	publicKey  crypto.PublicKey
+	adminToken string
 }
 
 func NewLocalAuthenticator(cfg *Config, log logrus.FieldLogger, db *gorm.DB) (*LocalAuthenticator, error) {
@@ -33,10 +43,11 @@ func NewLocalAuthenticator(cfg *Config, log logrus.FieldLogger, db *gorm.DB) (*L
 	}
 
 	a := &LocalAuthenticator{
-		cache:     cache.New(10*time.Minute, 30*time.Minute),
-		db:        db,
-		log:       log,
-		publicKey: key,
+		cache:      cache.New(10*time.Minute, 30*time.Minute),
+		db:         db,
+		log:        log,
+		publicKey:  key,
+		adminToken: cfg.LocalAdminToken,
 	}
 
 	return a, nil
@@ -83,16 +94,90 @@ func (a *LocalAuthenticator) AuthAgentAuth(token string) (interface{}, error) {
 	return ocm.AdminPayload(), nil
 }
 
-func (a *LocalAuthenticator) AuthUserAuth(_ string) (interface{}, error) {
-	return nil, common.NewInfraError(401, errors.Errorf("User Authentication not allowed for local auth"))
+// AuthUserAuth authenticates users by the API tokens that were created for them, or by the admin token
+func (a *LocalAuthenticator) AuthUserAuth(token string) (interface{}, error) {
+	token = strings.TrimPrefix(token, "Bearer ")
+	if a.adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.adminToken)) == 1 {
+		a.log.Debug("Authenticating the admin token")
+		return ocm.AdminPayload(), nil
+	}
+
+	var apiToken common.APIToken
+	if err := a.db.Take(&apiToken, "token_hash = ?", gencrypto.HashAPIToken(token)).Error; err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			a.log.WithError(err).Error("failed to get API token")
+			return nil, common.NewInfraError(500, err)
+		}
+		err = errors.Errorf("invalid API token")
+		a.log.Error(err)
+		return nil, common.NewInfraError(401, err)
+	}
+
+	now := time.Now()
+	if apiToken.ExpiresAt != nil && now.After(time.Time(*apiToken.ExpiresAt)) {
+		err := errors.Errorf("API token %s expired", apiToken.ID.String())
+		a.log.Error(err)
+		return nil, common.NewInfraError(401, err)
+	}
+	if apiToken.LastUsedAt == nil || now.Sub(time.Time(*apiToken.LastUsedAt)) > apiTokenLastUsedResolution {
+		if err := a.db.Model(&common.APIToken{}).Where("id = ?", apiToken.ID.String()).
+			Update("last_used_at", now).Error; err != nil {
+			a.log.WithError(err).Warnf("failed to update the last usage of API token %s", apiToken.ID.String())
+		}
+	}
+
+	a.log.Debugf("Authenticating API token %s of user %s", apiToken.ID.String(), swag.StringValue(apiToken.UserName))
+	return &ocm.AuthPayload{
+		Username:     swag.StringValue(apiToken.UserName),
+		Role:         ocm.RoleType(apiToken.Role),
+		IsAuthorized: true,
+	}, nil
 }
 
 func (a *LocalAuthenticator) AuthURLAuth(token string) (interface{}, error) {
 	return a.AuthAgentAuth(token)
 }
 
-func (a *LocalAuthenticator) CreateAuthenticator() func(_, _ string, _ security.TokenAuthentication) runtime.Authenticator {
-	return security.APIKeyAuth
+func (a *LocalAuthenticator) CreateAuthenticator() func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator {
+	return func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator {
+		getToken := func(r *http.Request) string { return r.Header.Get(name) }
+		if in == "query" {
+			getToken = func(r *http.Request) string { return r.URL.Query().Get(name) }
+		}
+
+		return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+			token := getToken(r)
+			if token == "" {
+				return false, nil, nil
+			}
+			p, err := authenticate(token)
+			if err != nil {
+				return true, nil, err
+			}
+			if err = a.authorizeClusterAccess(r, p.(*ocm.AuthPayload)); err != nil {
+				return true, nil, err
+			}
+			return true, p, nil
+		})
+	}
+}
+
+// authorizeClusterAccess verifies that a user that is authenticated by an API token may access the cluster of the request
+func (a *LocalAuthenticator) authorizeClusterAccess(r *http.Request, payload *ocm.AuthPayload) error {
+	clusterID := params.GetParam(r.Context(), params.ClusterId)
+	if payload.Role != ocm.UserRole || clusterID == "" {
+		return nil
+	}
+	accessLevel, err := getClusterAccessLevel(a.db, clusterID, payload)
+	if err != nil {
+		a.log.WithError(err).Errorf("Fail to verify access to cluster %s", clusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = verifyClusterAccessLevel(r, accessLevel); err != nil {
+		a.log.WithError(err).Errorf("Unauthorized access to cluster %s by user %s", clusterID, payload.Username)
+		return err
+	}
+	return nil
 }
 
 func validateToken(token string, pub crypto.PublicKey) (*jwt.Token, error) {
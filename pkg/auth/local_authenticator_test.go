@@ -4,8 +4,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	. "github.com/onsi/ginkgo"
@@ -13,6 +15,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/sirupsen/logrus"
 )
 
@@ -125,3 +128,88 @@ var _ = Describe("AuthAgentAuth", func() {
 		validateErrorResponse(err)
 	})
 })
+
+var _ = Describe("AuthUserAuth", func() {
+	var (
+		a      *LocalAuthenticator
+		db     *gorm.DB
+		dbName string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		pubKey, _, err := gencrypto.ECDSAKeyPairPEM()
+		Expect(err).ToNot(HaveOccurred())
+
+		a, err = NewLocalAuthenticator(&Config{ECPublicKeyPEM: pubKey, LocalAdminToken: "admin-token"}, logrus.New(), db)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createAPIToken := func(userName string, role models.APITokenRole, expiresAt *strfmt.DateTime) string {
+		token, err := gencrypto.NewAPIToken()
+		Expect(err).ToNot(HaveOccurred())
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.APIToken{
+			APIToken: models.APIToken{
+				ID:        &id,
+				Name:      swag.String("test"),
+				UserName:  swag.String(userName),
+				Role:      role,
+				ExpiresAt: expiresAt,
+			},
+			TokenHash: gencrypto.HashAPIToken(token),
+		}).Error).ToNot(HaveOccurred())
+		return token
+	}
+
+	validateErrorResponse := func(err error) {
+		infraError, ok := err.(*common.InfraErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(infraError.StatusCode()).To(Equal(int32(401)))
+	}
+
+	It("Authenticates the admin token", func() {
+		payload, err := a.AuthUserAuth("admin-token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload.(*ocm.AuthPayload).Role).To(Equal(ocm.AdminRole))
+	})
+
+	It("Authenticates an API token", func() {
+		token := createAPIToken("ci-bot", models.APITokenRoleUser, nil)
+		payload, err := a.AuthUserAuth("Bearer " + token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload.(*ocm.AuthPayload).Username).To(Equal("ci-bot"))
+		Expect(payload.(*ocm.AuthPayload).Role).To(Equal(ocm.UserRole))
+
+		var apiToken common.APIToken
+		Expect(db.Take(&apiToken, "user_name = ?", "ci-bot").Error).ToNot(HaveOccurred())
+		Expect(apiToken.LastUsedAt).ToNot(BeNil())
+	})
+
+	It("Fails an unknown token", func() {
+		createAPIToken("ci-bot", models.APITokenRoleUser, nil)
+		_, err := a.AuthUserAuth("ait_unknown")
+		Expect(err).To(HaveOccurred())
+		validateErrorResponse(err)
+	})
+
+	It("Fails an expired token", func() {
+		expiresAt := strfmt.DateTime(time.Now().Add(-time.Minute))
+		token := createAPIToken("ci-bot", models.APITokenRoleAdmin, &expiresAt)
+		_, err := a.AuthUserAuth(token)
+		Expect(err).To(HaveOccurred())
+		validateErrorResponse(err)
+	})
+
+	It("Fails a revoked token", func() {
+		token := createAPIToken("ci-bot", models.APITokenRoleUser, nil)
+		Expect(db.Where("user_name = ?", "ci-bot").Delete(&common.APIToken{}).Error).ToNot(HaveOccurred())
+		_, err := a.AuthUserAuth(token)
+		Expect(err).To(HaveOccurred())
+		validateErrorResponse(err)
+	})
+})
@@ -0,0 +1,259 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// oidcKeysMinRefreshInterval limits the refreshes of the signing keys of the issuer when tokens
+// are signed by unknown keys
+const oidcKeysMinRefreshInterval = time.Minute
+
+type OIDCConfig struct {
+	IssuerURL  string `envconfig:"OIDC_ISSUER_URL" default:""`
+	ClientID   string `envconfig:"OIDC_CLIENT_ID" default:""`
+	CACertFile string `envconfig:"OIDC_CA_CERT_FILE" default:""`
+	// Nested claims are separated by dots, for example realm_access.roles
+	UsernameClaim      string   `envconfig:"OIDC_USERNAME_CLAIM" default:"preferred_username"`
+	OrgClaim           string   `envconfig:"OIDC_ORG_CLAIM" default:""`
+	RolesClaim         string   `envconfig:"OIDC_ROLES_CLAIM" default:"groups"`
+	AdminRoles         []string `envconfig:"OIDC_ADMIN_ROLES" default:""`
+	ReadOnlyAdminRoles []string `envconfig:"OIDC_READ_ONLY_ADMIN_ROLES" default:""`
+}
+
+// OIDCAuthenticator authenticates users by the tokens of an OpenID Connect issuer. The agents and signed
+// URLs are authenticated like in the local authentication.
+type OIDCAuthenticator struct {
+	*LocalAuthenticator
+	cfg        OIDCConfig
+	adminUsers []string
+	log        logrus.FieldLogger
+	utils      AUtilsInteface
+	cas        *x509.CertPool
+
+	keysLock      sync.Mutex
+	keyMap        map[string]*rsa.PublicKey
+	keysUpdatedAt time.Time
+}
+
+func NewOIDCAuthenticator(cfg *Config, log logrus.FieldLogger, db *gorm.DB) (*OIDCAuthenticator, error) {
+	if cfg.OIDC.IssuerURL == "" {
+		return nil, errors.Errorf("oidc authentication requires an issuer URL")
+	}
+	local, err := NewLocalAuthenticator(cfg, log, db)
+	if err != nil {
+		return nil, err
+	}
+	cas, err := oidcCertPool(cfg.OIDC.CACertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &OIDCAuthenticator{
+		LocalAuthenticator: local,
+		cfg:                cfg.OIDC,
+		adminUsers:         cfg.AdminUsers,
+		log:                log,
+		cas:                cas,
+	}
+	jwksURL, err := a.discoverJWKSURL()
+	if err != nil {
+		return nil, err
+	}
+	a.utils = NewAuthUtils("", jwksURL)
+	if err = a.refreshKeys(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+var _ Authenticator = &OIDCAuthenticator{}
+
+func (a *OIDCAuthenticator) AuthType() AuthType {
+	return TypeOIDC
+}
+
+func oidcCertPool(caCertFile string) (*x509.CertPool, error) {
+	cas, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, errors.Errorf("can't load system trusted CAs: %v", err)
+	}
+	if caCertFile != "" {
+		pem, err := ioutil.ReadFile(caCertFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the CA certificate of the oidc issuer")
+		}
+		if !cas.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("failed to parse the CA certificate of the oidc issuer")
+		}
+	}
+	return cas, nil
+}
+
+// discoverJWKSURL returns the URL of the signing keys of the issuer from its discovery document
+func (a *OIDCAuthenticator) discoverJWKSURL() (string, error) {
+	discoveryURL := strings.TrimSuffix(a.cfg.IssuerURL, "/") + "/.well-known/openid-configuration"
+	client := &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: a.cas}},
+	}
+	res, err := client.Get(discoveryURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the oidc discovery document %s", discoveryURL)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to get the oidc discovery document %s: %s", discoveryURL, res.Status)
+	}
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&discovery); err != nil {
+		return "", errors.Wrapf(err, "failed to parse the oidc discovery document %s", discoveryURL)
+	}
+	if discovery.Issuer != a.cfg.IssuerURL {
+		return "", errors.Errorf("oidc issuer %s does not match the configured issuer %s", discovery.Issuer, a.cfg.IssuerURL)
+	}
+	if discovery.JWKSURI == "" {
+		return "", errors.Errorf("oidc discovery document %s has no jwks_uri", discoveryURL)
+	}
+	return discovery.JWKSURI, nil
+}
+
+func (a *OIDCAuthenticator) refreshKeys() error {
+	keyMap, err := a.utils.proccessPublicKeys(a.cas)
+	if err != nil {
+		return err
+	}
+	a.keyMap = keyMap
+	a.keysUpdatedAt = time.Now()
+	return nil
+}
+
+func (a *OIDCAuthenticator) getValidationToken(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, errors.Errorf("no kid found in jwt token")
+	}
+
+	a.keysLock.Lock()
+	defer a.keysLock.Unlock()
+	key, ok := a.keyMap[kid]
+	if !ok && time.Since(a.keysUpdatedAt) > oidcKeysMinRefreshInterval {
+		// the issuer may have rotated its keys
+		if err := a.refreshKeys(); err != nil {
+			a.log.WithError(err).Error("failed to refresh the oidc signing keys")
+		}
+		key, ok = a.keyMap[kid]
+	}
+	if !ok {
+		return nil, errors.Errorf("No matching key in auth keymap for key id [%v]", kid)
+	}
+	return key, nil
+}
+
+func (a *OIDCAuthenticator) AuthUserAuth(token string) (interface{}, error) {
+	authHeaderParts := strings.Fields(token)
+	if len(authHeaderParts) != 2 || strings.ToLower(authHeaderParts[0]) != "bearer" {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Authorization header format must be Bearer {token}"))
+	}
+
+	parser := &jwt.Parser{ValidMethods: []string{jwt.SigningMethodRS256.Alg()}}
+	parsedToken, err := parser.Parse(authHeaderParts[1], a.getValidationToken)
+	if err != nil && !isValidationErrorIssuedAt(err) {
+		a.log.WithError(err).Errorf("Error parsing token or token is invalid")
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Error parsing token or token is invalid"))
+	}
+
+	claims := parsedToken.Claims.(jwt.MapClaims)
+	if !claims.VerifyIssuer(a.cfg.IssuerURL, true) {
+		a.log.Errorf("Unexpected token issuer %v", claims["iss"])
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Unexpected token issuer"))
+	}
+	if a.cfg.ClientID != "" && !funk.ContainsString(claimValues(claims, "aud"), a.cfg.ClientID) {
+		a.log.Errorf("Token audience %v does not include client %s", claims["aud"], a.cfg.ClientID)
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Unexpected token audience"))
+	}
+
+	payload := &ocm.AuthPayload{IsAuthorized: true}
+	payload.Username, _ = claimValue(claims, a.cfg.UsernameClaim).(string)
+	if payload.Username == "" {
+		a.log.Errorf("Missing claim %s in token", a.cfg.UsernameClaim)
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Missing username in token"))
+	}
+	if a.cfg.OrgClaim != "" {
+		payload.Organization, _ = claimValue(claims, a.cfg.OrgClaim).(string)
+	}
+	payload.Email, _ = claims["email"].(string)
+	payload.FirstName, _ = claims["given_name"].(string)
+	payload.LastName, _ = claims["family_name"].(string)
+	payload.Role = a.getRole(payload.Username, claimValues(claims, a.cfg.RolesClaim))
+	return payload, nil
+}
+
+func (a *OIDCAuthenticator) getRole(username string, roles []string) ocm.RoleType {
+	hasRole := func(allowed []string) bool {
+		for _, role := range roles {
+			if role != "" && funk.ContainsString(allowed, role) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case funk.ContainsString(a.adminUsers, username) || hasRole(a.cfg.AdminRoles):
+		return ocm.AdminRole
+	case hasRole(a.cfg.ReadOnlyAdminRoles):
+		return ocm.ReadOnlyAdminRole
+	default:
+		return ocm.UserRole
+	}
+}
+
+// claimValue returns the value of a claim, where the names of nested claims are separated by dots
+func claimValue(claims jwt.MapClaims, name string) interface{} {
+	var value interface{} = map[string]interface{}(claims)
+	for _, part := range strings.Split(name, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[part]
+	}
+	return value
+}
+
+// claimValues returns the values of a claim that is either a list of strings or a single string
+func claimValues(claims jwt.MapClaims, name string) []string {
+	switch value := claimValue(claims, name).(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var ret []string
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				ret = append(ret, s)
+			}
+		}
+		return ret
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"crypto"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("OIDC authenticator", func() {
+	var (
+		server  *httptest.Server
+		privKey crypto.PrivateKey
+		kid     string
+		jwks    []byte
+		cfg     *Config
+		a       *OIDCAuthenticator
+	)
+
+	BeforeEach(func() {
+		var pubKey crypto.PublicKey
+		pubKey, privKey, _ = GenKeys(2048)
+		jwks, _, kid, _ = GenJSJWKS(privKey, pubKey)
+
+		mux := http.NewServeMux()
+		server = httptest.NewServer(mux)
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+			Expect(json.NewEncoder(w).Encode(map[string]string{
+				"issuer":   server.URL,
+				"jwks_uri": server.URL + "/certs",
+			})).To(Succeed())
+		})
+		mux.HandleFunc("/certs", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(jwks)
+		})
+
+		ecPubKey, _, err := gencrypto.ECDSAKeyPairPEM()
+		Expect(err).ToNot(HaveOccurred())
+		cfg = &Config{
+			ECPublicKeyPEM: ecPubKey,
+			AdminUsers:     []string{"root"},
+			OIDC: OIDCConfig{
+				IssuerURL:          server.URL,
+				ClientID:           "assisted-service",
+				UsernameClaim:      "preferred_username",
+				OrgClaim:           "org",
+				RolesClaim:         "realm_access.roles",
+				AdminRoles:         []string{"assisted-admin"},
+				ReadOnlyAdminRoles: []string{"assisted-auditor"},
+			},
+		}
+		a, err = NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	signToken := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		tokenString, err := token.SignedString(privKey)
+		Expect(err).ToNot(HaveOccurred())
+		return "Bearer " + tokenString
+	}
+
+	claims := func(roles ...string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":                server.URL,
+			"aud":                []string{"account", "assisted-service"},
+			"exp":                time.Now().Add(time.Hour).Unix(),
+			"preferred_username": "jdoe",
+			"org":                "lab",
+			"email":              "jdoe@example.com",
+			"realm_access":       map[string]interface{}{"roles": roles},
+		}
+	}
+
+	validateErrorResponse := func(err error) {
+		infraError, ok := err.(*common.InfraErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(infraError.StatusCode()).To(Equal(int32(401)))
+	}
+
+	It("maps the claims of the token", func() {
+		p, err := a.AuthUserAuth(signToken(claims("offline_access")))
+		Expect(err).ToNot(HaveOccurred())
+		payload := p.(*ocm.AuthPayload)
+		Expect(payload.Username).To(Equal("jdoe"))
+		Expect(payload.Organization).To(Equal("lab"))
+		Expect(payload.Email).To(Equal("jdoe@example.com"))
+		Expect(payload.Role).To(Equal(ocm.UserRole))
+	})
+
+	It("maps the roles of the user", func() {
+		p, err := a.AuthUserAuth(signToken(claims("assisted-admin")))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.(*ocm.AuthPayload).Role).To(Equal(ocm.AdminRole))
+
+		p, err = a.AuthUserAuth(signToken(claims("assisted-auditor")))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.(*ocm.AuthPayload).Role).To(Equal(ocm.ReadOnlyAdminRole))
+
+		c := claims()
+		c["preferred_username"] = "root"
+		p, err = a.AuthUserAuth(signToken(c))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.(*ocm.AuthPayload).Role).To(Equal(ocm.AdminRole))
+	})
+
+	It("fails an expired token", func() {
+		c := claims()
+		c["exp"] = time.Now().Add(-time.Minute).Unix()
+		_, err := a.AuthUserAuth(signToken(c))
+		validateErrorResponse(err)
+	})
+
+	It("fails a token of another issuer", func() {
+		c := claims()
+		c["iss"] = "https://sso.example.com"
+		_, err := a.AuthUserAuth(signToken(c))
+		validateErrorResponse(err)
+	})
+
+	It("fails a token of another client", func() {
+		c := claims()
+		c["aud"] = "account"
+		_, err := a.AuthUserAuth(signToken(c))
+		validateErrorResponse(err)
+	})
+
+	It("fails a token without a username", func() {
+		c := claims()
+		delete(c, "preferred_username")
+		_, err := a.AuthUserAuth(signToken(c))
+		validateErrorResponse(err)
+	})
+
+	It("fails a token signed by an unknown key", func() {
+		_, otherKey, _ := GenKeys(2048)
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims())
+		token.Header["kid"] = kid
+		tokenString, err := token.SignedString(otherKey)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = a.AuthUserAuth("Bearer " + tokenString)
+		validateErrorResponse(err)
+	})
+
+	It("refreshes the keys when the issuer rotates them", func() {
+		var pubKey crypto.PublicKey
+		pubKey, privKey, _ = GenKeys(2048)
+		jwks, _, kid, _ = GenJSJWKS(privKey, pubKey)
+		a.keysUpdatedAt = time.Now().Add(-2 * oidcKeysMinRefreshInterval)
+
+		_, err := a.AuthUserAuth(signToken(claims()))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("skips the keys of unsupported types", func() {
+		var keys map[string][]map[string]string
+		Expect(json.Unmarshal(jwks, &keys)).To(Succeed())
+		keys["keys"] = append([]map[string]string{
+			{"kid": "ec", "kty": "EC", "crv": "P-256", "x": "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU", "y": "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"},
+			{"kid": "okp", "kty": "OKP", "crv": "Ed25519", "x": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"},
+		}, keys["keys"]...)
+		var err error
+		jwks, err = json.Marshal(keys)
+		Expect(err).ToNot(HaveOccurred())
+
+		a, err = NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = a.AuthUserAuth(signToken(claims()))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("fails when the issuer does not match the discovery document", func() {
+		cfg.OIDC.IssuerURL = server.URL + "/"
+		_, err := NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("authenticates agents with local tokens", func() {
+		Expect(a.AuthType()).To(Equal(TypeOIDC))
+		Expect(a.AuthType().HasLocalAgentAuth()).To(BeTrue())
+	})
+})
@@ -256,29 +256,22 @@ func (a *RHSSOAuthenticator) isReadOnlyAdmin(username string) (bool, error) {
 	return isAllowed, err
 }
 
-func (a *RHSSOAuthenticator) isClusterOwnedByUser(clusterID string, payload *ocm.AuthPayload) (bool, error) {
+// getClusterAccessLevel returns the access of the user to the cluster
+func (a *RHSSOAuthenticator) getClusterAccessLevel(clusterID string, payload *ocm.AuthPayload) (clusterAccessLevel, error) {
 	roll, _ := a.getRole(payload)
 	if roll != ocm.UserRole {
-		return true, nil //admins has always access to the cluster
+		return clusterAccessOwner, nil //admins has always access to the cluster
 	}
 
 	if clusterID == "" {
-		return true, nil //not an API of clusters, so grant permission to access
+		return clusterAccessOwner, nil //not an API of clusters, so grant permission to access
 	}
 
 	if a.db != nil {
-		err := a.db.First(&common.Cluster{}, "id = ? and user_name = ?", clusterID, payload.Username).Error
-		if err != nil {
-			//if user is not the owner of the cluster return false
-			if err == gorm.ErrRecordNotFound {
-				return false, nil
-			}
-			//in case of a real db error, indicate it to the caller
-			return false, err
-		}
+		return getClusterAccessLevel(a.db, clusterID, payload)
 	}
 
-	return true, nil
+	return clusterAccessOwner, nil
 }
 
 func (a *RHSSOAuthenticator) AuthURLAuth(_ string) (interface{}, error) {
@@ -306,14 +299,14 @@ func (a *RHSSOAuthenticator) CreateAuthenticator() func(name, in string, authent
 			//this code is part of the authorization process and should move to authz_handler
 			//after https://github.com/go-openapi/runtime/issues/158 is resolved
 			clusterID := params.GetParam(r.Context(), params.ClusterId)
-			ownedBy, err := a.isClusterOwnedByUser(clusterID, p.(*ocm.AuthPayload))
+			accessLevel, err := a.getClusterAccessLevel(clusterID, p.(*ocm.AuthPayload))
 			if err != nil {
 				log.Errorf("Fail to verify access to cluster. Error %v", err)
 				return true, nil, common.NewApiError(http.StatusInternalServerError, err)
 			}
-			if !ownedBy {
-				log.Errorf("Unauthorized access to cluster %s by a user other than the owner\n", clusterID)
-				return true, nil, common.NewApiError(http.StatusNotFound, errors.New("Cluster Not Found"))
+			if err = verifyClusterAccessLevel(r, accessLevel); err != nil {
+				log.WithError(err).Errorf("Unauthorized access to cluster %s\n", clusterID)
+				return true, nil, err
 			}
 
 			return true, p, nil
@@ -3,6 +3,7 @@ package s3wrapper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -12,6 +13,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/versions"
@@ -187,8 +189,8 @@ var _ = Describe("s3filesystem", func() {
 			Expect(err).ToNot(HaveOccurred())
 			err = ioutil.WriteFile(filepath.Join(baseDir, "files/isolinux/isolinux.cfg"), []byte(" append initrd=/images/pxeboot/initrd.img"), 0600)
 			Expect(err).ToNot(HaveOccurred())
-			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(1)
-			srcObject, err := client.GetBaseIsoObject(defaultTestOpenShiftVersion)
+			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosVersion, nil).Times(1)
+			srcObject, err := client.GetBaseIsoObject(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture)
 			Expect(err).ToNot(HaveOccurred())
 			err = ioutil.WriteFile(filepath.Join(baseDir, "files/images/assisted_installer_custom.img"), make([]byte, isoeditor.RamDiskPaddingLength), 0600)
 			Expect(err).ToNot(HaveOccurred())
@@ -201,27 +203,27 @@ var _ = Describe("s3filesystem", func() {
 			err = os.RemoveAll(filepath.Join(baseDir, "files"))
 			Expect(err).ToNot(HaveOccurred())
 
-			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(1)
-			minimalIso, err := client.GetMinimalIsoObjectName(defaultTestOpenShiftVersion)
+			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosVersion, nil).Times(1)
+			minimalIso, err := client.GetMinimalIsoObjectName(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture)
 			Expect(err).ShouldNot(HaveOccurred())
 
 			err = ioutil.WriteFile(filepath.Join(baseDir, minimalIso),
 				[]byte("minimal iso"), 0600)
 			Expect(err).Should(BeNil())
 
-			mockVersions.EXPECT().GetRHCOSImage(defaultTestOpenShiftVersion).Return(defaultTestRhcosURL, nil).Times(1)
+			mockVersions.EXPECT().GetRHCOSImage(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosURL, nil).Times(1)
 
 			// Called once for GetBaseIsoObject and once for GetMinimalIsoObjectName
-			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(2)
+			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosVersion, nil).Times(2)
 			mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).Times(1)
 
-			err = client.UploadISOs(ctx, defaultTestOpenShiftVersion, true)
+			err = client.UploadISOs(ctx, defaultTestOpenShiftVersion, common.DefaultCPUArchitecture, true)
 			Expect(err).ToNot(HaveOccurred())
 		})
 		It("unsupported openshift version", func() {
 			unsupportedVersion := "999"
-			mockVersions.EXPECT().GetRHCOSImage(unsupportedVersion).Return("", errors.New("unsupported")).Times(1)
-			err := client.UploadISOs(ctx, unsupportedVersion, false)
+			mockVersions.EXPECT().GetRHCOSImage(unsupportedVersion, common.DefaultCPUArchitecture).Return("", errors.New("unsupported")).Times(1)
+			err := client.UploadISOs(ctx, unsupportedVersion, common.DefaultCPUArchitecture, false)
 			Expect(err).To(HaveOccurred())
 		})
 		It("iso exists", func() {
@@ -241,8 +243,8 @@ var _ = Describe("s3filesystem", func() {
 			Expect(err).ToNot(HaveOccurred())
 			err = ioutil.WriteFile(filepath.Join(baseDir, "files/isolinux/isolinux.cfg"), []byte(" append initrd=/images/pxeboot/initrd.img"), 0600)
 			Expect(err).ToNot(HaveOccurred())
-			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(1)
-			srcObject, err := client.GetBaseIsoObject(defaultTestOpenShiftVersion)
+			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosVersion, nil).Times(1)
+			srcObject, err := client.GetBaseIsoObject(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture)
 			Expect(err).ToNot(HaveOccurred())
 			err = ioutil.WriteFile(filepath.Join(baseDir, "files/images/assisted_installer_custom.img"), make([]byte, isoeditor.RamDiskPaddingLength), 0600)
 			Expect(err).ToNot(HaveOccurred())
@@ -255,22 +257,32 @@ var _ = Describe("s3filesystem", func() {
 			err = os.RemoveAll(filepath.Join(baseDir, "files"))
 			Expect(err).ToNot(HaveOccurred())
 
-			mockVersions.EXPECT().GetRHCOSImage(defaultTestOpenShiftVersion).Return(defaultTestRhcosURL, nil).Times(1)
-			mockVersions.EXPECT().GetRHCOSRootFS(defaultTestOpenShiftVersion).Return(defaultTestRhcosRootFSURL, nil).Times(1)
+			mockVersions.EXPECT().GetRHCOSImage(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosURL, nil).Times(1)
+			mockVersions.EXPECT().GetRHCOSRootFS(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosRootFSURL, nil).Times(1)
 
 			// Called once for GetBaseIsoObject and once for GetMinimalIsoObjectName
-			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(2)
+			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosVersion, nil).Times(2)
 			mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
 
-			err = client.UploadISOs(ctx, defaultTestOpenShiftVersion, true)
+			err = client.UploadISOs(ctx, defaultTestOpenShiftVersion, common.DefaultCPUArchitecture, true)
 			Expect(err).ToNot(HaveOccurred())
 
-			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(1)
-			_, err = client.GetBaseIsoObject(defaultTestOpenShiftVersion)
+			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture).Return(defaultTestRhcosVersion, nil).Times(1)
+			_, err = client.GetBaseIsoObject(defaultTestOpenShiftVersion, common.DefaultCPUArchitecture)
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 	})
 
+	It("ISO object names of additional CPU architectures", func() {
+		mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion, "arm64").Return(defaultTestRhcosVersion, nil).Times(2)
+		baseIsoObject, err := client.GetBaseIsoObject(defaultTestOpenShiftVersion, "arm64")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(baseIsoObject).Should(Equal(fmt.Sprintf("rhcos-%s-arm64.iso", defaultTestRhcosVersion)))
+		minimalIsoObject, err := client.GetMinimalIsoObjectName(defaultTestOpenShiftVersion, "arm64")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(minimalIsoObject).Should(Equal(fmt.Sprintf("rhcos-%s-arm64-minimal.iso", defaultTestRhcosVersion)))
+	})
+
 	It("ListObjectByPrefix lists the correct object without a leading slash", func() {
 		_, _ = createFileObject(client.basedir, "dir/other/file", now)
 		_, _ = createFileObject(client.basedir, "dir/other/file2", now)
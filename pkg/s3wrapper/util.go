@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -47,6 +48,16 @@ func FixEndpointURL(endpoint string) (string, error) {
 	return new_url, nil
 }
 
+// isoObjectVersion returns the version part of the RHCOS ISO object names.
+// RHCOS build IDs are shared between CPU architectures, so every architecture
+// other than the default one is appended to keep the objects apart.
+func isoObjectVersion(rhcosVersion, cpuArchitecture string) string {
+	if cpuArchitecture == "" || cpuArchitecture == common.DefaultCPUArchitecture {
+		return rhcosVersion
+	}
+	return fmt.Sprintf("%s-%s", rhcosVersion, cpuArchitecture)
+}
+
 func DownloadURLToTemporaryFile(url string) (string, error) {
 	tmpfile, err := ioutil.TempFile("", "isodownload")
 	if err != nil {
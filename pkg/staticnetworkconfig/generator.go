@@ -28,6 +28,7 @@ type StaticNetworkConfigData struct {
 //go:generate mockgen -source=generator.go -package=staticnetworkconfig -destination=mock_generator.go
 type StaticNetworkConfig interface {
 	GenerateStaticNetworkConfigData(hostsYAMLS string) ([]StaticNetworkConfigData, error)
+	GenerateHostStaticNetworkConfigData(hostConfig *models.HostStaticNetworkConfig) ([]StaticNetworkConfigData, error)
 	FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetworkConfig) string
 	ValidateStaticConfigParams(staticNetworkConfig []*models.HostStaticNetworkConfig) error
 }
@@ -55,6 +56,13 @@ func (s *StaticNetworkConfigGenerator) GenerateStaticNetworkConfigData(hostsYAML
 	return filesList, nil
 }
 
+// GenerateHostStaticNetworkConfigData generates the files of a single host, with paths relative to the directory of
+// the host
+func (s *StaticNetworkConfigGenerator) GenerateHostStaticNetworkConfigData(hostConfig *models.HostStaticNetworkConfig) ([]StaticNetworkConfigData, error) {
+	return s.generateHostStaticNetworkConfigData(
+		hostConfig.NetworkYaml+hostStaticNetworkDelimeter+s.formatMacInterfaceMap(hostConfig.MacInterfaceMap), "")
+}
+
 func (s *StaticNetworkConfigGenerator) generateHostStaticNetworkConfigData(hostConfigString, hostDir string) ([]StaticNetworkConfigData, error) {
 	hostConfig := strings.Split(hostConfigString, hostStaticNetworkDelimeter)
 	if len(hostConfig) != 2 {
@@ -209,3 +217,30 @@ func (s *StaticNetworkConfigGenerator) formatMacInterfaceMap(macInterfaceMap mod
 	sort.Strings(lines)
 	return strings.Join(lines, "\n")
 }
+
+// ParseStaticNetworkConfigFromDB returns the static network configs of the hosts from their DB format
+func ParseStaticNetworkConfigFromDB(staticNetworkConfig string) []*models.HostStaticNetworkConfig {
+	ret := make([]*models.HostStaticNetworkConfig, 0)
+	if staticNetworkConfig == "" {
+		return ret
+	}
+	for _, hostLine := range strings.Split(staticNetworkConfig, staticNetworkConfigHostsDelimeter) {
+		hostConfig := strings.Split(hostLine, hostStaticNetworkDelimeter)
+		hostStaticNetworkConfig := &models.HostStaticNetworkConfig{
+			NetworkYaml:     hostConfig[0],
+			MacInterfaceMap: models.MacInterfaceMap{},
+		}
+		if len(hostConfig) > 1 {
+			for _, line := range strings.Split(hostConfig[1], "\n") {
+				entry := strings.SplitN(line, "=", 2)
+				if len(entry) != 2 {
+					continue
+				}
+				hostStaticNetworkConfig.MacInterfaceMap = append(hostStaticNetworkConfig.MacInterfaceMap,
+					&models.MacInterfaceMapItems0{MacAddress: entry[0], LogicalNicName: entry[1]})
+			}
+		}
+		ret = append(ret, hostStaticNetworkConfig)
+	}
+	return ret
+}
@@ -48,6 +48,21 @@ func (mr *MockStaticNetworkConfigMockRecorder) GenerateStaticNetworkConfigData(h
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStaticNetworkConfigData", reflect.TypeOf((*MockStaticNetworkConfig)(nil).GenerateStaticNetworkConfigData), hostsYAMLS)
 }
 
+// GenerateHostStaticNetworkConfigData mocks base method
+func (m *MockStaticNetworkConfig) GenerateHostStaticNetworkConfigData(hostConfig *models.HostStaticNetworkConfig) ([]StaticNetworkConfigData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHostStaticNetworkConfigData", hostConfig)
+	ret0, _ := ret[0].([]StaticNetworkConfigData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHostStaticNetworkConfigData indicates an expected call of GenerateHostStaticNetworkConfigData
+func (mr *MockStaticNetworkConfigMockRecorder) GenerateHostStaticNetworkConfigData(hostConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHostStaticNetworkConfigData", reflect.TypeOf((*MockStaticNetworkConfig)(nil).GenerateHostStaticNetworkConfigData), hostConfig)
+}
+
 // FormatStaticNetworkConfigForDB mocks base method
 func (m *MockStaticNetworkConfig) FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetworkConfig) string {
 	m.ctrl.T.Helper()
@@ -0,0 +1,99 @@
+package staticnetworkconfig
+
+import (
+	"net"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+type staticIPAddress struct {
+	IP           string `yaml:"ip"`
+	PrefixLength int    `yaml:"prefix-length"`
+}
+
+type staticIP struct {
+	Enabled  bool              `yaml:"enabled"`
+	Dhcp     bool              `yaml:"dhcp"`
+	Autoconf *bool             `yaml:"autoconf,omitempty"`
+	Address  []staticIPAddress `yaml:"address"`
+}
+
+type staticIPInterface struct {
+	Name  string    `yaml:"name"`
+	Type  string    `yaml:"type"`
+	State string    `yaml:"state"`
+	Ipv4  *staticIP `yaml:"ipv4,omitempty"`
+	Ipv6  *staticIP `yaml:"ipv6,omitempty"`
+}
+
+type staticIPRoute struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address"`
+	NextHopInterface string `yaml:"next-hop-interface"`
+}
+
+type staticIPDNSResolver struct {
+	Config struct {
+		Server []string `yaml:"server"`
+	} `yaml:"config"`
+}
+
+type staticIPNetwork struct {
+	DNSResolver *staticIPDNSResolver `yaml:"dns-resolver,omitempty"`
+	Interfaces  []staticIPInterface  `yaml:"interfaces"`
+	Routes      struct {
+		Config []staticIPRoute `yaml:"config"`
+	} `yaml:"routes"`
+}
+
+// GenerateStaticIPNetworkYaml returns the nmstate network yaml of a host whose ethernet interface has a static address
+// in the subnet, with a default route through the gateway and the DNS servers
+func GenerateStaticIPNetworkYaml(nicName, address, cidr, gateway string, dnsServers []string) (string, error) {
+	ip := net.ParseIP(address)
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	if ip == nil || !ipNet.Contains(ip) {
+		return "", errors.Errorf("Address %s is not in the subnet %s", address, cidr)
+	}
+	prefixLength, _ := ipNet.Mask.Size()
+	staticAddress := &staticIP{
+		Enabled: true,
+		Address: []staticIPAddress{{IP: ip.String(), PrefixLength: prefixLength}},
+	}
+	iface := staticIPInterface{Name: nicName, Type: "ethernet", State: "up"}
+	destination := "0.0.0.0/0"
+	if ip.To4() != nil {
+		iface.Ipv4 = staticAddress
+	} else {
+		autoconf := false
+		staticAddress.Autoconf = &autoconf
+		iface.Ipv6 = staticAddress
+		destination = "::/0"
+	}
+
+	network := staticIPNetwork{Interfaces: []staticIPInterface{iface}}
+	network.Routes.Config = []staticIPRoute{{Destination: destination, NextHopAddress: gateway, NextHopInterface: nicName}}
+	if len(dnsServers) > 0 {
+		network.DNSResolver = &staticIPDNSResolver{}
+		network.DNSResolver.Config.Server = dnsServers
+	}
+	ret, err := yaml.Marshal(&network)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// StaticAddresses returns the static addresses that are configured in the network yaml of the host, or none when the
+// yaml is invalid
+func StaticAddresses(hostConfig *models.HostStaticNetworkConfig) []string {
+	var network nmstateNetwork
+	if err := yaml.Unmarshal([]byte(hostConfig.NetworkYaml), &network); err != nil {
+		return nil
+	}
+	return network.staticAddresses()
+}
@@ -0,0 +1,55 @@
+package staticnetworkconfig
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("GenerateStaticIPNetworkYaml", func() {
+	macInterfaceMap := models.MacInterfaceMap{{MacAddress: "02:00:00:00:00:10", LogicalNicName: "eth0"}}
+
+	It("generates the network yaml of an IPv4 address", func() {
+		networkYaml, err := GenerateStaticIPNetworkYaml("eth0", "192.168.126.30", "192.168.126.0/24", "192.168.126.1", []string{"192.168.126.2"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkYaml).To(Equal(`dns-resolver:
+  config:
+    server:
+    - 192.168.126.2
+interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: false
+    address:
+    - ip: 192.168.126.30
+      prefix-length: 24
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.168.126.1
+    next-hop-interface: eth0
+`))
+		hostConfig := &models.HostStaticNetworkConfig{NetworkYaml: networkYaml, MacInterfaceMap: macInterfaceMap}
+		Expect(StaticAddresses(hostConfig)).To(Equal([]string{"192.168.126.30"}))
+		Expect(ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{hostConfig},
+			[]string{"192.168.126.0/24"}, nil)).To(Equal([][]string{{}}))
+	})
+
+	It("generates the network yaml of an IPv6 address", func() {
+		networkYaml, err := GenerateStaticIPNetworkYaml("eth0", "1001:db8::30", "1001:db8::/120", "1001:db8::1", []string{"1001:db8::2"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkYaml).To(ContainSubstring("ipv6:\n    enabled: true\n    dhcp: false\n    autoconf: false\n"))
+		Expect(networkYaml).To(ContainSubstring("destination: ::/0"))
+		hostConfig := &models.HostStaticNetworkConfig{NetworkYaml: networkYaml, MacInterfaceMap: macInterfaceMap}
+		Expect(ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{hostConfig},
+			[]string{"1001:db8::/120"}, nil)).To(Equal([][]string{{}}))
+	})
+
+	It("rejects an address outside of the subnet", func() {
+		_, err := GenerateStaticIPNetworkYaml("eth0", "192.168.127.30", "192.168.126.0/24", "192.168.126.1", nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,208 @@
+package staticnetworkconfig
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"gopkg.in/yaml.v2"
+)
+
+type nmstateAddress struct {
+	IP string `yaml:"ip"`
+}
+
+type nmstateIP struct {
+	Enabled  bool             `yaml:"enabled"`
+	Dhcp     bool             `yaml:"dhcp"`
+	Autoconf bool             `yaml:"autoconf"`
+	Address  []nmstateAddress `yaml:"address"`
+}
+
+type nmstateInterface struct {
+	Name string    `yaml:"name"`
+	Ipv4 nmstateIP `yaml:"ipv4"`
+	Ipv6 nmstateIP `yaml:"ipv6"`
+}
+
+type nmstateRoute struct {
+	Destination string `yaml:"destination"`
+}
+
+type nmstateNetwork struct {
+	DNSResolver struct {
+		Config struct {
+			Server []string `yaml:"server"`
+		} `yaml:"config"`
+	} `yaml:"dns-resolver"`
+	Interfaces []nmstateInterface `yaml:"interfaces"`
+	Routes     struct {
+		Config []nmstateRoute `yaml:"config"`
+	} `yaml:"routes"`
+}
+
+func (n *nmstateNetwork) usesDhcp() bool {
+	for _, iface := range n.Interfaces {
+		if (iface.Ipv4.Enabled && iface.Ipv4.Dhcp) || (iface.Ipv6.Enabled && (iface.Ipv6.Dhcp || iface.Ipv6.Autoconf)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *nmstateNetwork) staticAddresses() []string {
+	ret := make([]string, 0)
+	for _, iface := range n.Interfaces {
+		for _, ip := range []nmstateIP{iface.Ipv4, iface.Ipv6} {
+			if !ip.Enabled {
+				continue
+			}
+			for _, address := range ip.Address {
+				ret = append(ret, address.IP)
+			}
+		}
+	}
+	return ret
+}
+
+func (n *nmstateNetwork) hasDefaultRoute() bool {
+	for _, route := range n.Routes.Config {
+		if route.Destination == "0.0.0.0/0" || route.Destination == "::/0" {
+			return true
+		}
+	}
+	return false
+}
+
+// HostName returns the name by which the static network config of a host is referred to in validation errors
+func HostName(hostConfig *models.HostStaticNetworkConfig, hostIdx int) string {
+	if len(hostConfig.MacInterfaceMap) > 0 && hostConfig.MacInterfaceMap[0] != nil {
+		return fmt.Sprintf("host with MAC %s", hostConfig.MacInterfaceMap[0].MacAddress)
+	}
+	return fmt.Sprintf("host %d", hostIdx)
+}
+
+// ValidateStaticConfigSemantics returns the validation errors of each of the static network configs, in addition to
+// the ones of nmstate: addresses that are configured for several hosts or are VIPs of the cluster, hosts that have no
+// address in the machine networks of the cluster, hosts that have no default route or DNS server and do not use DHCP,
+// and MAC addresses that are in the static network configs of several hosts. The VIPs map each VIP to its description, such as "API VIP". The machine networks are not validated when
+// they are not known yet.
+func ValidateStaticConfigSemantics(staticNetworkConfig []*models.HostStaticNetworkConfig, machineNetworkCidrs []string,
+	vips map[string]string) [][]string {
+	ret := make([][]string, len(staticNetworkConfig))
+	networks := make([]*nmstateNetwork, len(staticNetworkConfig))
+	addressHosts := make(map[string][]int)
+	macHosts := make(map[string]int)
+	for i, hostConfig := range staticNetworkConfig {
+		ret[i] = make([]string, 0)
+		for _, entry := range hostConfig.MacInterfaceMap {
+			mac := strings.ToLower(entry.MacAddress)
+			if other, ok := macHosts[mac]; ok && other != i {
+				ret[i] = append(ret[i], fmt.Sprintf("MAC address %s is also in the static network config of the %s",
+					entry.MacAddress, HostName(staticNetworkConfig[other], other)))
+			}
+			macHosts[mac] = i
+		}
+		var network nmstateNetwork
+		if err := yaml.Unmarshal([]byte(hostConfig.NetworkYaml), &network); err != nil {
+			ret[i] = append(ret[i], fmt.Sprintf("The network yaml is invalid: %s", err.Error()))
+			continue
+		}
+		networks[i] = &network
+		for _, address := range network.staticAddresses() {
+			addressHosts[normalizeIP(address)] = append(addressHosts[normalizeIP(address)], i)
+		}
+	}
+
+	normalizedVips := make(map[string]string, len(vips))
+	for vip, description := range vips {
+		normalizedVips[normalizeIP(vip)] = description
+	}
+	var machineNetworks []*net.IPNet
+	for _, cidr := range machineNetworkCidrs {
+		if _, machineNetwork, err := net.ParseCIDR(cidr); err == nil {
+			machineNetworks = append(machineNetworks, machineNetwork)
+		}
+	}
+
+	for i, network := range networks {
+		if network == nil {
+			continue
+		}
+		addresses := network.staticAddresses()
+		inMachineNetwork := false
+		seen := make(map[string]bool)
+		for _, address := range addresses {
+			ip := net.ParseIP(address)
+			if ip == nil {
+				ret[i] = append(ret[i], fmt.Sprintf("Address %s is not a valid IP address", address))
+				continue
+			}
+			if seen[ip.String()] {
+				continue
+			}
+			seen[ip.String()] = true
+			if vip, ok := normalizedVips[ip.String()]; ok {
+				ret[i] = append(ret[i], fmt.Sprintf("Address %s is the %s of the cluster", address, vip))
+			}
+			others, repeated := otherHosts(addressHosts[ip.String()], i)
+			if repeated {
+				ret[i] = append(ret[i], fmt.Sprintf("Address %s is configured more than once", address))
+			}
+			if len(others) > 0 {
+				names := make([]string, 0, len(others))
+				for _, other := range others {
+					names = append(names, HostName(staticNetworkConfig[other], other))
+				}
+				ret[i] = append(ret[i], fmt.Sprintf("Address %s is also configured for the %s", address, strings.Join(names, ", ")))
+			}
+			for _, machineNetwork := range machineNetworks {
+				if machineNetwork.Contains(ip) {
+					inMachineNetwork = true
+				}
+			}
+		}
+		if len(machineNetworks) > 0 && len(addresses) > 0 && !inMachineNetwork {
+			ret[i] = append(ret[i], fmt.Sprintf("None of the addresses is in the machine networks %s", strings.Join(machineNetworkCidrs, ", ")))
+		}
+		if network.usesDhcp() {
+			continue
+		}
+		if !network.hasDefaultRoute() {
+			ret[i] = append(ret[i], "No default route is configured and none of the interfaces uses DHCP")
+		}
+		if len(network.DNSResolver.Config.Server) == 0 {
+			ret[i] = append(ret[i], "No DNS server is configured and none of the interfaces uses DHCP")
+		}
+	}
+	return ret
+}
+
+func normalizeIP(address string) string {
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String()
+	}
+	return address
+}
+
+// otherHosts returns the hosts, other than the host itself, that have the address, and whether the host itself has
+// the address several times
+func otherHosts(hosts []int, hostIdx int) ([]int, bool) {
+	others := make(map[int]bool)
+	selfCount := 0
+	for _, host := range hosts {
+		if host == hostIdx {
+			selfCount++
+		} else {
+			others[host] = true
+		}
+	}
+	ret := make([]int, 0, len(others))
+	for host := range others {
+		ret = append(ret, host)
+	}
+	sort.Ints(ret)
+	return ret, selfCount > 1
+}
@@ -0,0 +1,143 @@
+package staticnetworkconfig
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("ParseStaticNetworkConfigFromDB", func() {
+	staticNetworkGenerator := StaticNetworkConfigGenerator{log: logrus.New()}
+
+	It("parses the formatted static network configs", func() {
+		staticNetworkConfig := []*models.HostStaticNetworkConfig{
+			common.FormatStaticConfigHostYAML("nic10", "nic11", "192.168.126.30", "192.168.141.30", "192.168.126.1",
+				models.MacInterfaceMap{
+					{MacAddress: "02:00:00:00:00:10", LogicalNicName: "nic10"},
+					{MacAddress: "02:00:00:00:00:11", LogicalNicName: "nic11"},
+				}),
+			common.FormatStaticConfigHostYAML("nic20", "nic21", "192.168.126.31", "192.168.141.31", "192.168.126.1",
+				models.MacInterfaceMap{
+					{MacAddress: "02:00:00:00:00:20", LogicalNicName: "nic20"},
+					{MacAddress: "02:00:00:00:00:21", LogicalNicName: "nic21"},
+				}),
+		}
+		parsed := ParseStaticNetworkConfigFromDB(staticNetworkGenerator.FormatStaticNetworkConfigForDB(staticNetworkConfig))
+		Expect(parsed).To(Equal(staticNetworkConfig))
+	})
+
+	It("returns no configs for an empty string", func() {
+		parsed := ParseStaticNetworkConfigFromDB("")
+		Expect(parsed).ToNot(BeNil())
+		Expect(parsed).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ValidateStaticConfigSemantics", func() {
+	var (
+		machineNetworkCidrs = []string{"192.168.126.0/24"}
+		vips                = map[string]string{"192.168.126.100": "API VIP", "192.168.126.101": "Ingress VIP"}
+	)
+
+	hostConfig := func(mac, ip1, ip2 string) *models.HostStaticNetworkConfig {
+		return common.FormatStaticConfigHostYAML("eth0", "eth1", ip1, ip2, "192.168.126.1",
+			models.MacInterfaceMap{{MacAddress: mac, LogicalNicName: "eth0"}})
+	}
+
+	It("accepts valid configs", func() {
+		validationErrors := ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			hostConfig("02:00:00:00:00:10", "192.168.126.30", "192.168.141.30"),
+			hostConfig("02:00:00:00:00:20", "192.168.126.31", "192.168.141.31"),
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors).To(Equal([][]string{{}, {}}))
+	})
+
+	It("reports addresses that are configured for several hosts", func() {
+		validationErrors := ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			hostConfig("02:00:00:00:00:10", "192.168.126.30", "192.168.141.30"),
+			hostConfig("02:00:00:00:00:20", "192.168.126.30", "192.168.141.31"),
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors[0]).To(ConsistOf("Address 192.168.126.30 is also configured for the host with MAC 02:00:00:00:00:20"))
+		Expect(validationErrors[1]).To(ConsistOf("Address 192.168.126.30 is also configured for the host with MAC 02:00:00:00:00:10"))
+	})
+
+	It("reports addresses that are configured more than once for a host", func() {
+		validationErrors := ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			hostConfig("02:00:00:00:00:10", "192.168.126.30", "192.168.126.30"),
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors[0]).To(ConsistOf("Address 192.168.126.30 is configured more than once"))
+	})
+
+	It("reports VIPs", func() {
+		validationErrors := ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			hostConfig("02:00:00:00:00:10", "192.168.126.100", "192.168.141.30"),
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors[0]).To(ConsistOf("Address 192.168.126.100 is the API VIP of the cluster"))
+	})
+
+	It("reports hosts without an address in the machine networks", func() {
+		validationErrors := ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			hostConfig("02:00:00:00:00:10", "192.168.127.30", "192.168.141.30"),
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors[0]).To(ConsistOf("None of the addresses is in the machine networks 192.168.126.0/24"))
+
+		By("not validating the machine networks before they are known")
+		validationErrors = ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			hostConfig("02:00:00:00:00:10", "192.168.127.30", "192.168.141.30"),
+		}, nil, vips)
+		Expect(validationErrors[0]).To(BeEmpty())
+	})
+
+	It("reports MAC addresses that are in the configs of several hosts", func() {
+		validationErrors := ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			hostConfig("02:00:00:00:00:10", "192.168.126.30", "192.168.141.30"),
+			hostConfig("02:00:00:00:00:10", "192.168.126.31", "192.168.141.31"),
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors[0]).To(BeEmpty())
+		Expect(validationErrors[1]).To(ConsistOf("MAC address 02:00:00:00:00:10 is also in the static network config of the host with MAC 02:00:00:00:00:10"))
+	})
+
+	It("reports hosts without a default route or DNS server that do not use DHCP", func() {
+		networkYaml := `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: false
+    address:
+    - ip: 192.168.126.30
+      prefix-length: 24
+`
+		validationErrors := ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			{NetworkYaml: networkYaml, MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "02:00:00:00:00:10", LogicalNicName: "eth0"}}},
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors[0]).To(ConsistOf(
+			"No default route is configured and none of the interfaces uses DHCP",
+			"No DNS server is configured and none of the interfaces uses DHCP"))
+
+		By("accepting interfaces that use DHCP")
+		networkYaml = `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: true
+`
+		validationErrors = ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			{NetworkYaml: networkYaml, MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "02:00:00:00:00:10", LogicalNicName: "eth0"}}},
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors[0]).To(BeEmpty())
+	})
+
+	It("reports invalid network yaml", func() {
+		validationErrors := ValidateStaticConfigSemantics([]*models.HostStaticNetworkConfig{
+			{NetworkYaml: "interfaces: [", MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "02:00:00:00:00:10", LogicalNicName: "eth0"}}},
+		}, machineNetworkCidrs, vips)
+		Expect(validationErrors[0]).To(HaveLen(1))
+		Expect(validationErrors[0][0]).To(HavePrefix("The network yaml is invalid"))
+	})
+})
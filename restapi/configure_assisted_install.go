@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -15,19 +16,38 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/openshift/assisted-service/restapi/operations"
+	"github.com/openshift/assisted-service/restapi/operations/access"
 	"github.com/openshift/assisted-service/restapi/operations/assisted_service_iso"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/export"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/tokens"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/watch"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name AccessAPI -inpkg
+
+/* AccessAPI  */
+type AccessAPI interface {
+	/* GrantClusterAccess Grants a user access to the cluster with the given role, or changes the role of a user that the cluster is already shared with. Only the owner of the cluster may share it. */
+	GrantClusterAccess(ctx context.Context, params access.GrantClusterAccessParams) middleware.Responder
+
+	/* ListClusterAccess Lists the users that the cluster is shared with and their roles. */
+	ListClusterAccess(ctx context.Context, params access.ListClusterAccessParams) middleware.Responder
+
+	/* RevokeClusterAccess Revokes the access of a user to the cluster. Only the owner of the cluster may revoke access. */
+	RevokeClusterAccess(ctx context.Context, params access.RevokeClusterAccessParams) middleware.Responder
+}
+
 //go:generate mockery -name AssistedServiceIsoAPI -inpkg
 
 /* AssistedServiceIsoAPI  */
@@ -50,6 +70,17 @@ type EventsAPI interface {
 	ListEvents(ctx context.Context, params events.ListEventsParams) middleware.Responder
 }
 
+//go:generate mockery -name ExportAPI -inpkg
+
+/* ExportAPI  */
+type ExportAPI interface {
+	/* ExportCluster Exports the configuration of the cluster as a portable document, that equivalent clusters may be imported from. The pull secret of the cluster is not exported. */
+	ExportCluster(ctx context.Context, params export.ExportClusterParams) middleware.Responder
+
+	/* ImportCluster Creates a cluster from the exported document of another cluster. */
+	ImportCluster(ctx context.Context, params export.ImportClusterParams) middleware.Responder
+}
+
 //go:generate mockery -name InstallerAPI -inpkg
 
 /* InstallerAPI  */
@@ -108,6 +139,9 @@ type InstallerAPI interface {
 	/* GetClusterInstallConfig Get the cluster's install config YAML. */
 	GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder
 
+	/* GetClusterInstallationReport Reports the timeline of the latest installation of the cluster, with the duration of the phases of the cluster and of the stages of its hosts. */
+	GetClusterInstallationReport(ctx context.Context, params installer.GetClusterInstallationReportParams) middleware.Responder
+
 	/* GetCredentials Get the cluster admin credentials. */
 	GetCredentials(ctx context.Context, params installer.GetCredentialsParams) middleware.Responder
 
@@ -126,6 +160,9 @@ type InstallerAPI interface {
 	/* GetHostIgnition Get the customized ignition file for this host */
 	GetHostIgnition(ctx context.Context, params installer.GetHostIgnitionParams) middleware.Responder
 
+	/* GetHostInventoryHistory Lists the latest inventory snapshots of the host, with the changes of each snapshot from the previous one. */
+	GetHostInventoryHistory(ctx context.Context, params installer.GetHostInventoryHistoryParams) middleware.Responder
+
 	/* GetHostRequirements Get minimum host requirements. */
 	GetHostRequirements(ctx context.Context, params installer.GetHostRequirementsParams) middleware.Responder
 
@@ -150,6 +187,9 @@ type InstallerAPI interface {
 	/* ListClusters Retrieves the list of OpenShift clusters. */
 	ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder
 
+	/* ListHostValidationRules Lists the user-defined host validation rules of the cluster. */
+	ListHostValidationRules(ctx context.Context, params installer.ListHostValidationRulesParams) middleware.Responder
+
 	/* ListHosts Retrieves the list of OpenShift hosts. */
 	ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder
 
@@ -174,6 +214,45 @@ type InstallerAPI interface {
 	/* ResetHostValidation Reset failed host validation. */
 	ResetHostValidation(ctx context.Context, params installer.ResetHostValidationParams) middleware.Responder
 
+	/* ScheduleClusterInstallation Schedules the installation of the OpenShift cluster. The installation starts once the schedule's window opens and the cluster is ready. */
+	ScheduleClusterInstallation(ctx context.Context, params installer.ScheduleClusterInstallationParams) middleware.Responder
+
+	/* AssignClusterRoles Assigns the roles of the hosts whose role is selected automatically, considering all the hosts of the cluster at once. Masters are selected by their capacity and spread across failure domains, while minimizing the operator requirements that are not satisfied. */
+	AssignClusterRoles(ctx context.Context, params installer.AssignClusterRolesParams) middleware.Responder
+
+	/* ListClusterStaticNetworkConfigs Lists the static network configs of the hosts of the cluster, that are included in its discovery ISO. */
+	ListClusterStaticNetworkConfigs(ctx context.Context, params installer.ListClusterStaticNetworkConfigsParams) middleware.Responder
+
+	/* CreateClusterStaticNetworkConfig Adds the static network config of a host to the cluster. The discovery ISO of the cluster is regenerated when it was already generated. */
+	CreateClusterStaticNetworkConfig(ctx context.Context, params installer.CreateClusterStaticNetworkConfigParams) middleware.Responder
+
+	/* UpdateClusterStaticNetworkConfig Replaces the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated. */
+	UpdateClusterStaticNetworkConfig(ctx context.Context, params installer.UpdateClusterStaticNetworkConfigParams) middleware.Responder
+
+	/* DeleteClusterStaticNetworkConfig Removes the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated. */
+	DeleteClusterStaticNetworkConfig(ctx context.Context, params installer.DeleteClusterStaticNetworkConfigParams) middleware.Responder
+
+	/* PreviewClusterStaticNetworkConfigs Validates static network configs of the hosts of the cluster and returns the NetworkManager keyfiles generated from them, without changing the cluster. */
+	PreviewClusterStaticNetworkConfigs(ctx context.Context, params installer.PreviewClusterStaticNetworkConfigsParams) middleware.Responder
+
+	/* GetClusterIPPool Returns the IP pool of the cluster, from which the addresses of its static-IP hosts are allocated. */
+	GetClusterIPPool(ctx context.Context, params installer.GetClusterIPPoolParams) middleware.Responder
+
+	/* UpdateClusterIPPool Replaces the IP pool of the cluster. The addresses that are already leased must remain in the pool. */
+	UpdateClusterIPPool(ctx context.Context, params installer.UpdateClusterIPPoolParams) middleware.Responder
+
+	/* ListClusterIPLeases Lists the addresses leased to the hosts of the cluster from its IP pool. */
+	ListClusterIPLeases(ctx context.Context, params installer.ListClusterIPLeasesParams) middleware.Responder
+
+	/* AllocateClusterIPLease Leases an address from the IP pool of the cluster to a host, and adds the static network config of the host, generated with the address, to the cluster. The discovery ISO of the cluster is regenerated when it was already generated. */
+	AllocateClusterIPLease(ctx context.Context, params installer.AllocateClusterIPLeaseParams) middleware.Responder
+
+	/* ReleaseClusterIPLease Releases the address leased to the host that has the MAC address, and removes the static network config of the host from the cluster. The discovery ISO of the cluster is regenerated when it was already generated. */
+	ReleaseClusterIPLease(ctx context.Context, params installer.ReleaseClusterIPLeaseParams) middleware.Responder
+
+	/* UnscheduleClusterInstallation Removes the installation schedule of the OpenShift cluster. */
+	UnscheduleClusterInstallation(ctx context.Context, params installer.UnscheduleClusterInstallationParams) middleware.Responder
+
 	/* UpdateCluster Updates an OpenShift cluster definition. */
 	UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder
 
@@ -198,6 +277,9 @@ type InstallerAPI interface {
 	/* UpdateHostLogsProgress Update log collection state and progress. */
 	UpdateHostLogsProgress(ctx context.Context, params installer.UpdateHostLogsProgressParams) middleware.Responder
 
+	/* UpdateHostValidationRules Replaces the user-defined host validation rules of the cluster. The rules are evaluated in addition to the service-wide rules, and replace the service-wide rules that have the same identifier. */
+	UpdateHostValidationRules(ctx context.Context, params installer.UpdateHostValidationRulesParams) middleware.Responder
+
 	/* UploadClusterIngressCert Transfer the ingress certificate for the cluster. */
 	UploadClusterIngressCert(ctx context.Context, params installer.UploadClusterIngressCertParams) middleware.Responder
 
@@ -250,6 +332,20 @@ type OperatorsAPI interface {
 	ReportMonitoredOperatorStatus(ctx context.Context, params operators.ReportMonitoredOperatorStatusParams) middleware.Responder
 }
 
+//go:generate mockery -name TokensAPI -inpkg
+
+/* TokensAPI  */
+type TokensAPI interface {
+	/* CreateAPIToken Creates an API token. The token is only returned in the response and cannot be retrieved later. Admins may create tokens for other users and service accounts. */
+	CreateAPIToken(ctx context.Context, params tokens.CreateAPITokenParams) middleware.Responder
+
+	/* ListAPITokens Lists the API tokens of the user. Admins list the API tokens of all the users. */
+	ListAPITokens(ctx context.Context, params tokens.ListAPITokensParams) middleware.Responder
+
+	/* RevokeAPIToken Revokes an API token of the user. Admins may revoke the API tokens of all the users. */
+	RevokeAPIToken(ctx context.Context, params tokens.RevokeAPITokenParams) middleware.Responder
+}
+
 //go:generate mockery -name VersionsAPI -inpkg
 
 /* VersionsAPI  */
@@ -261,15 +357,51 @@ type VersionsAPI interface {
 	ListSupportedOpenshiftVersions(ctx context.Context, params versions.ListSupportedOpenshiftVersionsParams) middleware.Responder
 }
 
+//go:generate mockery -name WatchAPI -inpkg
+
+/* WatchAPI  */
+type WatchAPI interface {
+	/* WatchCluster Streams the status transitions of a cluster and of its hosts, together with the cluster events, as Server-Sent Events. Every message carries a cursor in its id field, which can be used to resume the watch. */
+	WatchCluster(ctx context.Context, params watch.WatchClusterParams) middleware.Responder
+}
+
+//go:generate mockery -name WebhooksAPI -inpkg
+
+/* WebhooksAPI  */
+type WebhooksAPI interface {
+	/* DeregisterClusterWebhook Deregisters a webhook of a cluster. */
+	DeregisterClusterWebhook(ctx context.Context, params webhooks.DeregisterClusterWebhookParams) middleware.Responder
+
+	/* DeregisterWebhook Deregisters a webhook of the user's organization. */
+	DeregisterWebhook(ctx context.Context, params webhooks.DeregisterWebhookParams) middleware.Responder
+
+	/* ListClusterWebhooks Lists the webhooks that receive the events of a cluster. */
+	ListClusterWebhooks(ctx context.Context, params webhooks.ListClusterWebhooksParams) middleware.Responder
+
+	/* ListWebhooks Lists the webhooks that receive the events of all the clusters of the user's organization. */
+	ListWebhooks(ctx context.Context, params webhooks.ListWebhooksParams) middleware.Responder
+
+	/* RegisterClusterWebhook Registers a webhook that receives the events of a cluster. */
+	RegisterClusterWebhook(ctx context.Context, params webhooks.RegisterClusterWebhookParams) middleware.Responder
+
+	/* RegisterWebhook Registers a webhook that receives the events of all the clusters of the user's organization. */
+	RegisterWebhook(ctx context.Context, params webhooks.RegisterWebhookParams) middleware.Responder
+}
+
 // Config is configuration for Handler
 type Config struct {
+	AccessAPI
 	AssistedServiceIsoAPI
 	EventsAPI
+	ExportAPI
 	InstallerAPI
 	ManagedDomainsAPI
 	ManifestsAPI
 	OperatorsAPI
+	TokensAPI
 	VersionsAPI
+	WatchAPI
+	WebhooksAPI
 	Logger func(string, ...interface{})
 	// InnerMiddleware is for the handler executors. These do not apply to the swagger.json document.
 	// The middleware executes after routing but before authentication, binding and validation
@@ -329,6 +461,9 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	api.MultipartformConsumer = runtime.DiscardConsumer
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
 	api.AgentAuthAuth = func(token string) (interface{}, error) {
 		if c.AuthAgentAuth == nil {
 			return token, nil
@@ -361,6 +496,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.CompleteInstallation(ctx, params)
 	})
+	api.TokensCreateAPITokenHandler = tokens.CreateAPITokenHandlerFunc(func(params tokens.CreateAPITokenParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.TokensAPI.CreateAPIToken(ctx, params)
+	})
 	api.ManifestsCreateClusterManifestHandler = manifests.CreateClusterManifestHandlerFunc(func(params manifests.CreateClusterManifestParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -381,11 +521,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DeregisterCluster(ctx, params)
 	})
+	api.WebhooksDeregisterClusterWebhookHandler = webhooks.DeregisterClusterWebhookHandlerFunc(func(params webhooks.DeregisterClusterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.DeregisterClusterWebhook(ctx, params)
+	})
 	api.InstallerDeregisterHostHandler = installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DeregisterHost(ctx, params)
 	})
+	api.WebhooksDeregisterWebhookHandler = webhooks.DeregisterWebhookHandlerFunc(func(params webhooks.DeregisterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.DeregisterWebhook(ctx, params)
+	})
 	api.InstallerDisableHostHandler = installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -441,6 +591,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.EnableHost(ctx, params)
 	})
+	api.ExportExportClusterHandler = export.ExportClusterHandlerFunc(func(params export.ExportClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ExportAPI.ExportCluster(ctx, params)
+	})
 	api.InstallerGenerateClusterISOHandler = installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -466,6 +621,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetClusterInstallConfig(ctx, params)
 	})
+	api.InstallerGetClusterInstallationReportHandler = installer.GetClusterInstallationReportHandlerFunc(func(params installer.GetClusterInstallationReportParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterInstallationReport(ctx, params)
+	})
 	api.InstallerGetCredentialsHandler = installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -491,6 +651,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetHostIgnition(ctx, params)
 	})
+	api.InstallerGetHostInventoryHistoryHandler = installer.GetHostInventoryHistoryHandlerFunc(func(params installer.GetHostInventoryHistoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetHostInventoryHistory(ctx, params)
+	})
 	api.InstallerGetHostRequirementsHandler = installer.GetHostRequirementsHandlerFunc(func(params installer.GetHostRequirementsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -516,6 +681,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetPresignedForClusterFiles(ctx, params)
 	})
+	api.AccessGrantClusterAccessHandler = access.GrantClusterAccessHandlerFunc(func(params access.GrantClusterAccessParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AccessAPI.GrantClusterAccess(ctx, params)
+	})
+	api.ExportImportClusterHandler = export.ImportClusterHandlerFunc(func(params export.ImportClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ExportAPI.ImportCluster(ctx, params)
+	})
 	api.InstallerInstallClusterHandler = installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -531,11 +706,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.InstallHosts(ctx, params)
 	})
+	api.TokensListAPITokensHandler = tokens.ListAPITokensHandlerFunc(func(params tokens.ListAPITokensParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.TokensAPI.ListAPITokens(ctx, params)
+	})
+	api.AccessListClusterAccessHandler = access.ListClusterAccessHandlerFunc(func(params access.ListClusterAccessParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AccessAPI.ListClusterAccess(ctx, params)
+	})
 	api.ManifestsListClusterManifestsHandler = manifests.ListClusterManifestsHandlerFunc(func(params manifests.ListClusterManifestsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.ListClusterManifests(ctx, params)
 	})
+	api.WebhooksListClusterWebhooksHandler = webhooks.ListClusterWebhooksHandlerFunc(func(params webhooks.ListClusterWebhooksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.ListClusterWebhooks(ctx, params)
+	})
 	api.InstallerListClustersHandler = installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -551,6 +741,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.ListEvents(ctx, params)
 	})
+	api.InstallerListHostValidationRulesHandler = installer.ListHostValidationRulesHandlerFunc(func(params installer.ListHostValidationRulesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListHostValidationRules(ctx, params)
+	})
 	api.InstallerListHostsHandler = installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -581,6 +776,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.ListSupportedOperators(ctx, params)
 	})
+	api.WebhooksListWebhooksHandler = webhooks.ListWebhooksHandlerFunc(func(params webhooks.ListWebhooksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.ListWebhooks(ctx, params)
+	})
 	api.InstallerPostStepReplyHandler = installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -596,11 +796,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.RegisterCluster(ctx, params)
 	})
+	api.WebhooksRegisterClusterWebhookHandler = webhooks.RegisterClusterWebhookHandlerFunc(func(params webhooks.RegisterClusterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.RegisterClusterWebhook(ctx, params)
+	})
 	api.InstallerRegisterHostHandler = installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.RegisterHost(ctx, params)
 	})
+	api.WebhooksRegisterWebhookHandler = webhooks.RegisterWebhookHandlerFunc(func(params webhooks.RegisterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.RegisterWebhook(ctx, params)
+	})
 	api.OperatorsReportMonitoredOperatorStatusHandler = operators.ReportMonitoredOperatorStatusHandlerFunc(func(params operators.ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -621,6 +831,81 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ResetHostValidation(ctx, params)
 	})
+	api.TokensRevokeAPITokenHandler = tokens.RevokeAPITokenHandlerFunc(func(params tokens.RevokeAPITokenParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.TokensAPI.RevokeAPIToken(ctx, params)
+	})
+	api.AccessRevokeClusterAccessHandler = access.RevokeClusterAccessHandlerFunc(func(params access.RevokeClusterAccessParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AccessAPI.RevokeClusterAccess(ctx, params)
+	})
+	api.InstallerScheduleClusterInstallationHandler = installer.ScheduleClusterInstallationHandlerFunc(func(params installer.ScheduleClusterInstallationParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ScheduleClusterInstallation(ctx, params)
+	})
+	api.InstallerAssignClusterRolesHandler = installer.AssignClusterRolesHandlerFunc(func(params installer.AssignClusterRolesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.AssignClusterRoles(ctx, params)
+	})
+	api.InstallerListClusterStaticNetworkConfigsHandler = installer.ListClusterStaticNetworkConfigsHandlerFunc(func(params installer.ListClusterStaticNetworkConfigsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterStaticNetworkConfigs(ctx, params)
+	})
+	api.InstallerCreateClusterStaticNetworkConfigHandler = installer.CreateClusterStaticNetworkConfigHandlerFunc(func(params installer.CreateClusterStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.CreateClusterStaticNetworkConfig(ctx, params)
+	})
+	api.InstallerUpdateClusterStaticNetworkConfigHandler = installer.UpdateClusterStaticNetworkConfigHandlerFunc(func(params installer.UpdateClusterStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateClusterStaticNetworkConfig(ctx, params)
+	})
+	api.InstallerDeleteClusterStaticNetworkConfigHandler = installer.DeleteClusterStaticNetworkConfigHandlerFunc(func(params installer.DeleteClusterStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DeleteClusterStaticNetworkConfig(ctx, params)
+	})
+	api.InstallerPreviewClusterStaticNetworkConfigsHandler = installer.PreviewClusterStaticNetworkConfigsHandlerFunc(func(params installer.PreviewClusterStaticNetworkConfigsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.PreviewClusterStaticNetworkConfigs(ctx, params)
+	})
+	api.InstallerGetClusterIPPoolHandler = installer.GetClusterIPPoolHandlerFunc(func(params installer.GetClusterIPPoolParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterIPPool(ctx, params)
+	})
+	api.InstallerUpdateClusterIPPoolHandler = installer.UpdateClusterIPPoolHandlerFunc(func(params installer.UpdateClusterIPPoolParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateClusterIPPool(ctx, params)
+	})
+	api.InstallerListClusterIPLeasesHandler = installer.ListClusterIPLeasesHandlerFunc(func(params installer.ListClusterIPLeasesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterIPLeases(ctx, params)
+	})
+	api.InstallerAllocateClusterIPLeaseHandler = installer.AllocateClusterIPLeaseHandlerFunc(func(params installer.AllocateClusterIPLeaseParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.AllocateClusterIPLease(ctx, params)
+	})
+	api.InstallerReleaseClusterIPLeaseHandler = installer.ReleaseClusterIPLeaseHandlerFunc(func(params installer.ReleaseClusterIPLeaseParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ReleaseClusterIPLease(ctx, params)
+	})
+	api.InstallerUnscheduleClusterInstallationHandler = installer.UnscheduleClusterInstallationHandlerFunc(func(params installer.UnscheduleClusterInstallationParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UnscheduleClusterInstallation(ctx, params)
+	})
 	api.InstallerUpdateClusterHandler = installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -661,6 +946,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateHostLogsProgress(ctx, params)
 	})
+	api.InstallerUpdateHostValidationRulesHandler = installer.UpdateHostValidationRulesHandlerFunc(func(params installer.UpdateHostValidationRulesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateHostValidationRules(ctx, params)
+	})
 	api.InstallerUploadClusterIngressCertHandler = installer.UploadClusterIngressCertHandlerFunc(func(params installer.UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -676,6 +966,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UploadLogs(ctx, params)
 	})
+	api.WatchWatchClusterHandler = watch.WatchClusterHandlerFunc(func(params watch.WatchClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WatchAPI.WatchCluster(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
@@ -285,6 +285,77 @@ func init() {
             "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
             "name": "ams_subscription_ids",
             "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned clusters are filtered to those with matching statuses.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned clusters are filtered to those with this OpenShift version.",
+            "name": "openshift_version",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned clusters are filtered to those whose name contains this string, ignoring case.",
+            "name": "name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned clusters are filtered to those created after this time.",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned clusters are filtered to those created before this time.",
+            "name": "created_before",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of items to return. The cursor of the next page is returned in the Next-Cursor header.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned in the Next-Cursor header of the previous page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "created_at",
+              "name",
+              "status_updated_at"
+            ],
+            "type": "string",
+            "default": "created_at",
+            "description": "The field to sort the clusters by.",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "description": "The sort order.",
+            "name": "order",
+            "in": "query"
           }
         ],
         "responses": {
@@ -292,6 +363,18 @@ func init() {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster-list"
+            },
+            "headers": {
+              "Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page. Not set for the last page."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -427,6 +510,65 @@ func init() {
         }
       }
     },
+    "/clusters/import": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Creates a cluster from the exported document of another cluster.",
+        "tags": [
+          "export"
+        ],
+        "operationId": "ImportCluster",
+        "parameters": [
+          {
+            "name": "cluster-import-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-import-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/clusters/{cluster_id}": {
       "get": {
         "security": [
@@ -649,28 +791,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+    "/clusters/{cluster_id}/access": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the users that the cluster is shared with and their roles.",
         "tags": [
-          "installer"
+          "access"
         ],
-        "operationId": "CancelInstallation",
+        "operationId": "ListClusterAccess",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster whose access should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-access-list"
             }
           },
           "401": {
@@ -697,12 +848,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -712,48 +857,49 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/complete_installation": {
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+    "/clusters/{cluster_id}/access/{user_name}": {
+      "put": {
+        "description": "Grants a user access to the cluster with the given role, or changes the role of a user that the cluster is already shared with. Only the owner of the cluster may share it.",
         "tags": [
-          "installer"
+          "access"
         ],
-        "operationId": "CompleteInstallation",
+        "operationId": "GrantClusterAccess",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster to be shared.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
+            "type": "string",
+            "description": "The user that the cluster is shared with.",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "cluster-access-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/completion-params"
+              "$ref": "#/definitions/cluster-access-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-access"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -780,56 +926,40 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+      },
+      "delete": {
+        "description": "Revokes the access of a user to the cluster. Only the owner of the cluster may revoke access.",
         "tags": [
-          "installer"
+          "access"
         ],
-        "operationId": "InstallCluster",
+        "operationId": "RevokeClusterAccess",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster that is shared.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The user whose access is revoked.",
+            "name": "user_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -855,12 +985,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -870,28 +994,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install_hosts": {
+    "/clusters/{cluster_id}/actions/assign-roles": {
       "post": {
-        "description": "Installs the OpenShift cluster.",
+        "description": "Assigns the roles of the hosts whose role is selected automatically, considering all the hosts of the cluster at once. Masters are selected by their capacity and spread across failure domains, while minimizing the operator requirements that are not satisfied.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHosts",
+        "operationId": "AssignClusterRoles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The existing cluster whose hosts should be added.",
+            "description": "The cluster whose host roles are being assigned.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "assign-roles-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/role-assignment-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/role-assignment"
             }
           },
           "400": {
@@ -939,18 +1071,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/reset": {
+    "/clusters/{cluster_id}/actions/cancel": {
       "post": {
-        "description": "Resets a failed installation.",
+        "description": "Cancels an ongoing installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetCluster",
+        "operationId": "CancelInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster whose installation is to be canceled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1002,37 +1134,48 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/credentials": {
-      "get": {
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Agent API to mark a finalizing installation as complete.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCredentials",
+        "operationId": "CompleteInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster whose installation is being completing.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1070,41 +1213,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/discovery-ignition": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "InstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1131,6 +1277,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1138,35 +1290,31 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the discovery ignition config.",
+      }
+    },
+    "/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "operationId": "InstallHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The existing cluster whose hosts should be added.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "400": {
             "description": "Error.",
@@ -1198,6 +1346,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1207,71 +1361,28 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "ResetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is to be reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign",
-              "custom_manifests.yaml"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1309,17 +1420,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
+    "/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
@@ -1330,75 +1435,26 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "GetCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose admin credentials should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -1440,7 +1496,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
+    "/clusters/{cluster_id}/discovery-ignition": {
       "get": {
         "security": [
           {
@@ -1449,24 +1505,18 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "GetDiscoveryIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1476,14 +1526,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           },
           "401": {
@@ -1505,10 +1548,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1521,37 +1561,34 @@ func init() {
           }
         }
       },
-      "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
         "tags": [
           "installer"
         ],
-        "operationId": "GenerateClusterISO",
+        "operationId": "UpdateDiscoveryIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster for which the discovery ignition config should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/image-create-params"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -1583,12 +1620,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1596,8 +1627,10 @@ func init() {
             }
           }
         }
-      },
-      "head": {
+      }
+    },
+    "/clusters/{cluster_id}/downloads/files": {
+      "get": {
         "security": [
           {
             "userAuth": [
@@ -1606,39 +1639,61 @@ func init() {
               "user"
             ]
           },
+          {
+            "agentAuth": []
+          },
           {
             "urlAuth": []
           }
         ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "operationId": "DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -1660,7 +1715,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "409": {
             "description": "Error.",
@@ -1673,11 +1731,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
+    "/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
@@ -1686,35 +1750,77 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads the kubeconfig file for this cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1756,7 +1862,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/events": {
+    "/clusters/{cluster_id}/downloads/image": {
       "get": {
         "security": [
           {
@@ -1770,42 +1876,36 @@ func init() {
             "urlAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "operationId": "DownloadClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster whose ISO should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1827,7 +1927,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1839,62 +1942,43 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/free_addresses": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the free address list for a network.",
+      },
+      "post": {
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The cluster whose ISO should be generated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
-            "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
-            "in": "query",
-            "required": true
-          },
-          {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/image-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1921,6 +2005,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1928,10 +2018,8 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/host-requirements": {
-      "get": {
+      },
+      "head": {
         "security": [
           {
             "userAuth": [
@@ -1939,18 +2027,21 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Get host requirements of a cluster.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "DownloadClusterISOHeaders",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose ISO headers should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1959,8 +2050,17 @@ func init() {
         "responses": {
           "200": {
             "description": "Success.",
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1982,7 +2082,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1996,7 +2099,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
       "get": {
         "security": [
           {
@@ -2007,35 +2110,33 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts.",
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster whose kubeconfig should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -2050,67 +2151,148 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/events": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Registers a new OpenShift host.",
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "RegisterHost",
+        "operationId": "ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster into which the host should be registered.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the host being registered.",
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "If non-empty, returned events are filtered to those with matching severities.",
+            "name": "severities",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is registering the host.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "If set, returned events are filtered to those whose message contains this string, ignoring case.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned events are filtered to those that occurred after this time.",
+            "name": "event_time_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned events are filtered to those that occurred before this time.",
+            "name": "event_time_before",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of events to return. The cursor of the next page is returned in the Next-Cursor header.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned in the Next-Cursor header of the previous page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "description": "The order of the events by their time.",
+            "name": "order",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page. Not set for the last page."
+              }
             }
           },
           "400": {
@@ -2143,28 +2325,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new hosts due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/export": {
       "get": {
         "security": [
           {
@@ -2175,34 +2345,40 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Exports the configuration of the cluster as a portable document, that equivalent clusters may be imported from. The pull secret of the cluster is not exported.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "export"
         ],
-        "operationId": "GetHost",
+        "operationId": "ExportCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster to be exported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "yaml",
+              "json"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that should be retrieved.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "default": "yaml",
+            "description": "The format of the exported document.",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "401": {
@@ -2223,12 +2399,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2236,39 +2406,62 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster to return free addresses for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "format": "uuid",
-            "description": "The host that should be deregistered.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
             "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -2304,36 +2497,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/clusters/{cluster_id}/host-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get host requirements of a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "EnableHost",
+        "operationId": "GetClusterHostRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being enabled.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -2360,12 +2554,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2373,36 +2561,39 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      }
+    },
+    "/clusters/{cluster_id}/host-validation-rules": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the user-defined host validation rules of the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DisableHost",
+        "operationId": "ListHostValidationRules",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The cluster whose host validation rules are being listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being disabled.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-validation-rules"
             }
           },
           "401": {
@@ -2423,18 +2614,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2442,38 +2621,43 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+      },
+      "put": {
+        "description": "Replaces the user-defined host validation rules of the cluster. The rules are evaluated in addition to the service-wide rules, and replace the service-wide rules that have the same identifier.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHost",
+        "operationId": "UpdateHostValidationRules",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster whose host validation rules are being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The host validation rules of the cluster.",
+            "name": "host-validation-rules",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-validation-rules"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-validation-rules"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2509,36 +2693,123 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetHost",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The software version of the discovery agent that is listing hosts.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned hosts are filtered to those with matching statuses.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned hosts are filtered to those whose requested hostname or inventory hostname contains this string, ignoring case.",
+            "name": "name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned hosts are filtered to those created after this time.",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned hosts are filtered to those created before this time.",
+            "name": "created_before",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of items to return. The cursor of the next page is returned in the Next-Cursor header.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned in the Next-Cursor header of the previous page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "created_at",
+              "requested_hostname",
+              "status_updated_at"
+            ],
+            "type": "string",
+            "default": "created_at",
+            "description": "The field to sort the hosts by.",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "description": "The sort order.",
+            "name": "order",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-list"
+            },
+            "headers": {
+              "Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page. Not set for the last page."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2553,69 +2824,71 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift host.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster into which the host should be registered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The description of the host being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
           },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "The software version of the discovery agent that is registering the host.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host_registration_response"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2638,8 +2911,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new hosts due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2649,11 +2928,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
       "get": {
         "security": [
           {
@@ -2662,24 +2947,18 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2687,7 +2966,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
+            "description": "The host that should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -2697,7 +2976,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -2724,20 +3003,74 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster of the host that should be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "500": {
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2745,18 +3078,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Get the customized ignition file for this host",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2764,7 +3097,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
+            "description": "The host that is being enabled.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -2774,7 +3107,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -2815,17 +3148,17 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster of the host that is being disabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2833,29 +3166,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
+            "description": "The host that is being disabled.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -2882,6 +3203,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2891,18 +3218,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2910,34 +3237,19 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host that is being installed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "202": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -2956,12 +3268,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -2977,23 +3283,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
-      "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetNextSteps",
+        "operationId": "ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is retrieving instructions.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3001,23 +3302,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
+            "description": "The host that is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -3038,8 +3333,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3049,37 +3344,23 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "PostStepReply",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is posting results.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3087,26 +3368,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is posting results.",
+            "description": "The host that its validation is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3129,8 +3412,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3140,17 +3423,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -3161,23 +3438,22 @@ func init() {
             ]
           },
           {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Download host logs.",
+        "description": "Downloads the customized ignition file for this host",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostLogs",
-        "deprecated": true,
+        "operationId": "DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3185,7 +3461,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
+            "description": "The host whose ignition file should be downloaded.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -3233,29 +3509,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Get the customized ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
+        "operationId": "GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3263,28 +3538,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
+            "description": "The host whose customized ignition file should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3304,38 +3569,37 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostLogsProgress",
+        "operationId": "UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3343,24 +3607,30 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
+            "description": "The host whose ignition file should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/host-ignition-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3386,50 +3656,27 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation progress.",
+    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallProgress",
+        "operationId": "UpdateHostInstallerArgs",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3437,24 +3684,33 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to retrieve.",
+            "description": "The host whose installer arguments should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "host-progress",
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-progress"
+              "$ref": "#/definitions/installer-args-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3480,14 +3736,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3495,37 +3751,47 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/install-config": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterInstallConfig",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster of the host that is retrieving instructions.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -3557,36 +3823,60 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
-      "patch": {
-        "description": "Override values in the install config.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterInstallConfig",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster of the host that is posting results.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/step-reply"
             }
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "Success."
           },
           "400": {
@@ -3624,11 +3914,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
+    "/clusters/{cluster_id}/hosts/{host_id}/inventory-history": {
       "get": {
         "security": [
           {
@@ -3637,52 +3933,36 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists the latest inventory snapshots of the host, with the changes of each snapshot from the previous one.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterLogs",
+        "operationId": "GetHostInventoryHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster of the host.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "description": "The host whose inventory history is being listed.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host-inventory-history"
             }
           },
           "401": {
@@ -3703,12 +3983,87 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download host logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadHostLogs",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster of the host whose logs should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -3736,41 +4091,37 @@ func init() {
         "tags": [
           "installer"
         ],
-        "operationId": "UploadLogs",
+        "operationId": "UploadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "maxLength": 104857600,
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
             "type": "file",
             "x-mimetype": "application/zip",
             "description": "The log file to be uploaded.",
             "name": "upfile",
             "in": "formData"
           },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
@@ -3810,7 +4161,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs_progress": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
       "put": {
         "security": [
           {
@@ -3821,7 +4172,7 @@ func init() {
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterLogsProgress",
+        "operationId": "UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
@@ -3831,6 +4182,14 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
           {
             "description": "Parameters for updating log progress.",
             "name": "logs-progress-params",
@@ -3890,34 +4249,54 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Update installation progress.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "ListClusterManifests",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-manifests"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -3943,61 +4322,52 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/install-config": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "CreateClusterManifest",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string"
             }
           },
           "401": {
@@ -4024,12 +4394,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4038,44 +4402,41 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DeleteClusterManifest",
+        "operationId": "UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4100,13 +4461,7 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -4115,54 +4470,42 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/install-schedule": {
+      "put": {
+        "description": "Schedules the installation of the OpenShift cluster. The installation starts once the schedule's window opens and the cluster is ready.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DownloadClusterManifest",
+        "operationId": "ScheduleClusterInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "name": "install-schedule-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/install-schedule-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4202,42 +4545,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/monitored_operators": {
-      "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Lists operators to be monitored for a cluster.",
+      },
+      "delete": {
+        "description": "Removes the installation schedule of the OpenShift cluster.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ListOfClusterOperators",
+        "operationId": "UnscheduleClusterInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose installation schedule is to be removed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -4264,6 +4593,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4271,46 +4606,39 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/clusters/{cluster_id}/installation-report": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Reports the timeline of the latest installation of the cluster, with the duration of the phases of the cluster and of the stages of its hosts.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
+        "operationId": "GetClusterInstallationReport",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster whose installation is being reported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/installation-report"
             }
           },
           "401": {
@@ -4331,34 +4659,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/preflight-requirements": {
+    "/clusters/{cluster_id}/ip-leases": {
       "get": {
         "security": [
           {
@@ -4369,16 +4679,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Lists the addresses leased to the hosts of the cluster from its IP pool.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPreflightRequirements",
+        "operationId": "ListClusterIPLeases",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
+            "description": "The cluster whose leases are listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -4388,7 +4698,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/ip_lease_list"
             }
           },
           "401": {
@@ -4422,48 +4732,37 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      },
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Leases an address from the IP pool of the cluster to a host, and adds the static network config of the host, generated with the address, to the cluster. The discovery ISO of the cluster is regenerated when it was already generated.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadClusterIngressCert",
+        "operationId": "AllocateClusterIPLease",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster from whose IP pool the address is leased.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
+            "name": "ip-lease-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/ip_lease_params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ip_lease"
+            }
           },
           "400": {
             "description": "Error.",
@@ -4495,14 +4794,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4510,53 +4809,62 @@ func init() {
         }
       }
     },
-    "/component_versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of component versions.",
+    "/clusters/{cluster_id}/ip-leases/{mac_address}": {
+      "delete": {
+        "description": "Releases the address leased to the host that has the MAC address, and removes the static network config of the host from the cluster. The discovery ISO of the cluster is regenerated when it was already generated.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/domains": {
-      "get": {
-        "security": [
+        "operationId": "ReleaseClusterIPLease",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose lease is released.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The MAC address of the lease.",
+            "name": "mac_address",
+            "in": "path",
+            "required": true
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "ListManagedDomains",
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -4568,7 +4876,7 @@ func init() {
         }
       }
     },
-    "/host_requirements": {
+    "/clusters/{cluster_id}/ip-pool": {
       "get": {
         "security": [
           {
@@ -4579,25 +4887,26 @@ func init() {
             ]
           }
         ],
-        "description": "Get minimum host requirements.",
+        "description": "Returns the IP pool of the cluster, from which the addresses of its static-IP hosts are allocated.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostRequirements",
+        "operationId": "GetClusterIPPool",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Get hw requirements for single node.",
-            "name": "single_node",
-            "in": "header"
-          }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose IP pool is returned.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-requirements"
+              "$ref": "#/definitions/ip_pool"
             }
           },
           "401": {
@@ -4612,6 +4921,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -4625,55 +4940,42 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/openshift_versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+      },
+      "put": {
+        "description": "Replaces the IP pool of the cluster. The addresses that are already leased must remain in the pool.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "ListSupportedOpenshiftVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/openshift-versions"
-            }
+        "operationId": "UpdateClusterIPPool",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose IP pool is replaced.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "503": {
-            "description": "Unavailable.",
+          {
+            "name": "ip-pool",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/ip_pool"
             }
           }
-        }
-      }
-    },
-    "/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
-        "tags": [
-          "operators"
         ],
-        "operationId": "ListSupportedOperators",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/ip_pool"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4688,6 +4990,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4697,7 +5017,7 @@ func init() {
         }
       }
     },
-    "/supported-operators/{operator_name}": {
+    "/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
           {
@@ -4706,27 +5026,52 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators"
+          "installer"
         ],
-        "operationId": "ListOperatorProperties",
+        "operationId": "DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The cluster whose logs should be downloaded.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "type": "file"
             }
           },
           "401": {
@@ -4747,6 +5092,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4754,1012 +5111,5492 @@ func init() {
             }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "add-hosts-cluster-create-params": {
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "api_vip_dnsname",
-        "openshift_version"
-      ],
-      "properties": {
-        "api_vip_dnsname": {
-          "description": "api vip domain.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UploadLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs should be uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "url": {
-          "description": "URL address of the API.",
-          "type": "string"
-        },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
-          "type": "boolean"
+    "/clusters/{cluster_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose log progress is being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "api_vip_connectivity_response": {
-      "type": "object",
-      "properties": {
-        "is_success": {
-          "description": "API VIP connectivity check result.",
-          "type": "boolean"
+    "/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "ListClusterManifests",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the manifests should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-manifests"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "assisted-service-iso-create-params": {
-      "type": "object",
-      "properties": {
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Creates a manifest for customizing cluster installation.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "CreateClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which a new manifest should be created.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "current_boot_mode": {
-          "type": "string"
-        },
-        "pxe_interface": {
-          "type": "string"
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "DeleteClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be deleted.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "cluster": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "created_at": {
-          "description": "The time that this cluster was created.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "deleted_at": {
-          "description": "The time that the cluster was deleted.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-nullable": true
-        },
-        "email_domain": {
-          "type": "string"
-        },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
+    "/clusters/{cluster_id}/manifests/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "DownloadClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
-          "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ]
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/monitored_operators": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Lists operators to be monitored for a cluster.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "ListOfClusterOperators",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return operators for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "ReportMonitoredOperatorStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose operators are being monitored.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get preflight requirements for a cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetPreflightRequirements",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return preflight requrements for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/preflight-hardware-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/static-network-configs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the static network configs of the hosts of the cluster, that are included in its discovery ISO.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListClusterStaticNetworkConfigs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose static network configs are listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_static_network_config_list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Adds the static network config of a host to the cluster. The discovery ISO of the cluster is regenerated when it was already generated.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CreateClusterStaticNetworkConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to which the static network config is added.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "static-network-config",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host_static_network_config"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_static_network_config"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/static-network-configs/preview": {
+      "post": {
+        "description": "Validates static network configs of the hosts of the cluster and returns the NetworkManager keyfiles generated from them, without changing the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "PreviewClusterStaticNetworkConfigs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose static network configs are previewed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "preview-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/static_network_config_preview_params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/static_network_config_preview"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/static-network-configs/{mac_address}": {
+      "put": {
+        "description": "Replaces the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterStaticNetworkConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose static network config is replaced.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "A MAC address in the mac interface map of the static network config.",
+            "name": "mac_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "static-network-config",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host_static_network_config"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_static_network_config"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Removes the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeleteClusterStaticNetworkConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose static network config is removed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "A MAC address in the mac interface map of the static network config.",
+            "name": "mac_address",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Transfer the ingress certificate for the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UploadClusterIngressCert",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/watch": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Streams the status transitions of a cluster and of its hosts, together with the cluster events, as Server-Sent Events. Every message carries a cursor in its id field, which can be used to resume the watch.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "watch"
+        ],
+        "operationId": "WatchCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to watch.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the last received message, sent by clients that reconnect in order to resume the watch.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the last received message, for clients that can't set the Last-Event-ID header. Ignored if the header is set.",
+            "name": "cursor",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success. A stream of 'cluster-status', 'host-status' and 'event' messages, whose data is a status-update or an event.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/webhooks": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the webhooks that receive the events of a cluster.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "ListClusterWebhooks",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose webhooks should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a webhook that receives the events of a cluster.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "RegisterClusterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose events should be delivered to the webhook.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The webhook to register.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/webhooks/{webhook_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deregisters a webhook of a cluster.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "DeregisterClusterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose webhook should be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to deregister.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/host_requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get minimum host requirements.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetHostRequirements",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Get hw requirements for single node.",
+            "name": "single_node",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/openshift_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tokens": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the API tokens of the user. Admins list the API tokens of all the users.",
+        "tags": [
+          "tokens"
+        ],
+        "operationId": "ListAPITokens",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/api-token-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Creates an API token. The token is only returned in the response and cannot be retrieved later. Admins may create tokens for other users and service accounts.",
+        "tags": [
+          "tokens"
+        ],
+        "operationId": "CreateAPIToken",
+        "parameters": [
+          {
+            "description": "The API token to create.",
+            "name": "new-api-token-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/api-token-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/api-token-created"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tokens/{token_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Revokes an API token of the user. Admins may revoke the API tokens of all the users.",
+        "tags": [
+          "tokens"
+        ],
+        "operationId": "RevokeAPIToken",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The API token to revoke.",
+            "name": "token_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the webhooks that receive the events of all the clusters of the user's organization.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "ListWebhooks",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a webhook that receives the events of all the clusters of the user's organization.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "RegisterWebhook",
+        "parameters": [
+          {
+            "description": "The webhook to register.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{webhook_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deregisters a webhook of the user's organization.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "DeregisterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to deregister.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "add-hosts-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "api_vip_dnsname",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "api vip domain.",
+          "type": "string"
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the hosts of the OpenShift cluster (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "api-token": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "user_name",
+        "role"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "created_by": {
+          "description": "The user that created the API token.",
+          "type": "string"
+        },
+        "expires_at": {
+          "description": "The time that the API token expires. Not set for API tokens that do not expire.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the API token.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "last_used_at": {
+          "description": "The last time that the API token authenticated a request.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "A name that describes the usage of the API token.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/api-token-role"
+        },
+        "user_name": {
+          "description": "The user or service account that the API token authenticates.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "api-token-create-params": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "expires_at": {
+          "description": "The time that the API token expires. If not set, the API token expires after the default lifetime of API tokens.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "A name that describes the usage of the API token.",
+          "type": "string",
+          "minLength": 1
+        },
+        "role": {
+          "$ref": "#/definitions/api-token-role"
+        },
+        "user_name": {
+          "description": "The user or service account that the API token authenticates. Only admins may create API tokens for other users. Defaults to the user that creates the API token.",
+          "type": "string"
+        }
+      }
+    },
+    "api-token-created": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/api-token"
+        },
+        {
+          "type": "object",
+          "required": [
+            "token"
+          ],
+          "properties": {
+            "token": {
+              "description": "The API token. It is only returned when the API token is created.",
+              "type": "string"
+            }
+          }
+        }
+      ]
+    },
+    "api-token-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/api-token"
+      }
+    },
+    "api-token-role": {
+      "description": "The role of the user that the API token authenticates.",
+      "type": "string",
+      "enum": [
+        "admin",
+        "read-only-admin",
+        "user"
+      ]
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "type": "object",
+      "properties": {
+        "is_success": {
+          "description": "API VIP connectivity check result.",
+          "type": "boolean"
+        }
+      }
+    },
+    "assisted-service-iso-create-params": {
+      "type": "object",
+      "properties": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The API virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the api_vip.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "deleted_at": {
+          "description": "The time that the cluster was deleted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "host_validation_rules": {
+          "description": "JSON-formatted list of the user-defined host validation rules of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
         "ignition_config_overrides": {
           "description": "Json formatted string containing the user overrides for the initial ignition config",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "image_info": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
+          "$ref": "#/definitions/image_info"
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "ingress_vips": {
+          "description": "The Ingress virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the ingress_vip.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+        },
+        "install_schedule": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:install_schedule_\"",
+          "$ref": "#/definitions/install-schedule"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "ip_pool": {
+          "description": "JSON-formatted IP pool of the cluster, from which the addresses of its static-IP hosts are allocated.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
+          "$ref": "#/definitions/logs_state"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "The machine networks of the cluster, with the IPv4 network first in dual-stack clusters. Multi-subnet clusters, whose hosts are in several routed subnets, have several networks of the same IP address family and require user-managed networking. The first network is also the machine_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validation_policy": {
+          "description": "JSON-formatted validation policy of the cluster, that overrides the service-wide disabled validations and thresholds.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-access": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "user_name",
+        "role"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that is shared.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "granted_by": {
+          "description": "The user that shared the cluster.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/cluster-access-role"
+        },
+        "user_name": {
+          "description": "The user that the cluster is shared with.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key;index\""
+        }
+      }
+    },
+    "cluster-access-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-access"
+      }
+    },
+    "cluster-access-params": {
+      "type": "object",
+      "required": [
+        "role"
+      ],
+      "properties": {
+        "role": {
+          "$ref": "#/definitions/cluster-access-role"
+        }
+      }
+    },
+    "cluster-access-role": {
+      "description": "The role of a user that a cluster is shared with. Viewers may read the cluster, installers may also download its credentials and install it, and editors may also update it. Only the owner may share and deregister the cluster.\n",
+      "type": "string",
+      "enum": [
+        "viewer",
+        "installer",
+        "editor"
+      ]
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          }
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ]
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          }
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-export": {
+      "description": "A portable document of the configuration of a cluster, that equivalent clusters may be imported from.",
+      "type": "object",
+      "required": [
+        "version",
+        "cluster"
+      ],
+      "properties": {
+        "cluster": {
+          "$ref": "#/definitions/cluster-export-settings"
+        },
+        "discovery_ignition_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the discovery ignition.",
+          "type": "string"
+        },
+        "hosts": {
+          "description": "The settings of the hosts, that are applied to the hosts that register to the imported cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-export-host"
+          }
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-export-manifest"
+          }
+        },
+        "version": {
+          "description": "The version of the format of the document.",
+          "type": "string",
+          "enum": [
+            "v1"
+          ]
+        }
+      }
+    },
+    "cluster-export-host": {
+      "type": "object",
+      "required": [
+        "mac_addresses"
+      ],
+      "properties": {
+        "hostname": {
+          "description": "The requested hostname of the host.",
+          "type": "string"
+        },
+        "mac_addresses": {
+          "description": "The MAC addresses of the interfaces of the host. A host that registers to the imported cluster gets the settings if one of its interfaces has one of these MAC addresses.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "machine_config_pool_name": {
+          "description": "The machine config pool of the host.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        }
+      }
+    },
+    "cluster-export-manifest": {
+      "type": "object",
+      "required": [
+        "folder",
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "The content of the manifest.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The file name of the manifest.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The folder of the manifest.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "cluster-export-settings": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API. Not imported when the virtual IPs are allocated by DHCP.",
+          "type": "string"
+        },
+        "api_vips": {
+          "description": "The API virtual IPs of a dual-stack cluster, with the IPv4 address first. Not imported with user-managed networking.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node.",
+          "type": "integer"
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, with the IPv4 network first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          },
+          "x-omitempty": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image.",
+          "type": "string"
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster.",
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_validation_rules": {
+          "$ref": "#/definitions/host-validation-rules"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.",
+          "type": "string"
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic. Not imported when the virtual IPs are allocated by DHCP.",
+          "type": "string"
+        },
+        "ingress_vips": {
+          "description": "The Ingress virtual IPs of a dual-stack cluster, with the IPv4 address first. Not imported with user-managed networking.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "ip_pool": {
+          "description": "The IP pool from which the addresses of the static-IP hosts are allocated.",
+          "$ref": "#/definitions/ip_pool"
+        },
+        "machine_network_cidr": {
+          "description": "The CIDR of the machine network. Only imported when the virtual IPs are allocated by DHCP.",
+          "type": "string"
+        },
+        "machine_networks": {
+          "description": "The machine networks of a dual-stack or multi-subnet cluster, with the IPv4 network first. Only imported with user-managed networking.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destinations to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses.",
+          "type": "string"
+        },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, with the IPv4 network first.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "The static network configs of the hosts, that are included in the discovery ISO.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          },
+          "x-omitempty": true
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean"
+        },
+        "validation_policy": {
+          "description": "Overrides the service-wide disabled validations and thresholds for the cluster.",
+          "$ref": "#/definitions/validation-policy"
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean"
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-import-params": {
+      "type": "object",
+      "required": [
+        "pull_secret",
+        "document"
+      ],
+      "properties": {
+        "document": {
+          "description": "The exported document of a cluster, in YAML or JSON.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the imported cluster. Defaults to the name in the document.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "pull_secret": {
+          "description": "The pull secret of the imported cluster, since pull secrets are not exported.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-network": {
+      "description": "A network from which Pod IPs are allocated.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node out of the cidr.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        }
+      }
+    },
+    "cluster-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The API virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the api_vip.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          }
+        },
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "disks_config": {
+                "description": "The desired disks parameters (such as the disk's role).",
+                "type": "array",
+                "items": {
+                  "$ref": "#/definitions/disk-config-params"
+                }
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_failure_domains": {
+          "description": "The failure domains of hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "failure_domain": {
+                "type": "string"
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_machine_config_pool_names": {
+          "description": "The desired machine config pool for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "machine_config_pool_name": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_names": {
+          "description": "The desired hostname for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "hostname": {
+                "type": "string"
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_roles": {
+          "description": "The desired role for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "role": {
+                "$ref": "#/definitions/host-role-update-params"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ],
+          "x-nullable": true
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "ingress_vips": {
+          "description": "The Ingress virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the ingress_vip.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "machine_networks": {
+          "description": "The machine networks of the cluster, with the IPv4 network first in dual-stack clusters. Multi-subnet clusters, whose hosts are in several routed subnets, have several networks of the same IP address family and require user-managed networking. The first network is also the machine_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          }
+        },
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          }
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "validation_policy": {
+          "description": "Overrides the service-wide disabled validations and thresholds for the cluster.",
+          "$ref": "#/definitions/validation-policy"
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vip-defined",
+        "api-vip-valid",
+        "ingress-vip-defined",
+        "ingress-vip-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "cnv-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "lvm-requirements-satisfied"
+      ]
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
+        }
+      }
+    },
+    "container_image_availability_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "cpu-architecture-images": {
+      "type": "object",
+      "required": [
+        "release_image",
+        "rhcos_image",
+        "rhcos_rootfs",
+        "rhcos_version"
+      ],
+      "properties": {
+        "release_image": {
+          "description": "The installation image of the OpenShift cluster for this CPU architecture.",
+          "type": "string"
+        },
+        "rhcos_image": {
+          "description": "The base RHCOS image used for the discovery iso for this CPU architecture.",
+          "type": "string"
+        },
+        "rhcos_rootfs": {
+          "description": "The RHCOS rootfs url for this CPU architecture.",
+          "type": "string"
+        },
+        "rhcos_version": {
+          "description": "Build ID of the RHCOS image for this CPU architecture.",
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "base64 encoded manifest content.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
+          "type": "string",
+          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
+          "type": "string"
+        },
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
+          "type": "string"
+        }
+      }
+    },
+    "discovery-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "bootable": {
+          "type": "boolean"
+        },
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
+          "type": "string"
+        },
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
+          "type": "string"
+        },
+        "drive_type": {
+          "type": "string"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
+            },
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": false
+        },
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
+        },
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
+        },
+        "model": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "smart": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-config-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-role": {
+      "type": "string",
+      "enum": [
+        "none",
+        "install"
+      ]
+    },
+    "disk_info": {
+      "type": "object",
+      "properties": {
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
+        },
+        "speed_ms": {
+          "type": "integer"
+        },
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain name that should be resolved",
+                "type": "string"
+              }
+            },
+            "x-go-name": "DomainResolutionRequestDomain"
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
+            },
+            "x-go-name": "DomainResolutionResponseDomain"
+          }
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
         },
-        "image_info": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
-          "$ref": "#/definitions/image_info"
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
+          "type": "string",
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
+        "event_time": {
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+      }
+    },
+    "free_network_addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
+        },
+        "network": {
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        }
+      }
+    },
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "gpu": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
+          "type": "string"
+        },
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
+          "type": "string"
+        },
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+          "type": "string"
+        },
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
+        },
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
+          "type": "string"
+        }
+      }
+    },
+    "host": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "api_vip_connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        },
+        "connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "deleted_at": {
+          "description": "The time that the host was deleted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "discovery_agent_version": {
+          "type": "string"
+        },
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "failure_domain": {
+          "description": "The failure domain of the host, such as its rack. The automatic role assignment spreads the masters across failure domains.",
+          "type": "string"
+        },
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "hardware_baseline": {
+          "description": "JSON-formatted inventory of the host when its role was assigned or its installation disk was selected, that the hardware of the host is validated against.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
+        },
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+          "type": "string",
+          "example": "/dev/sda"
+        },
+        "installer_args": {
+          "type": "string"
+        },
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
+        },
+        "inventory": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
           "type": "string",
           "enum": [
-            "Cluster",
-            "AddHostsCluster"
+            "Host",
+            "AddToExistingClusterHost"
           ]
         },
+        "logs_collected_at": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
         "logs_info": {
           "description": "The progress of log collection or empty if logs are not applicable",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
           "$ref": "#/definitions/logs_state"
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "logs_started_at": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/monitored-operator"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
+        "machine_config_pool_name": {
           "type": "string"
         },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
           "type": "string",
-          "format": "uuid"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "progress": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
+          "$ref": "#/definitions/host-progress-info"
         },
-        "org_id": {
-          "type": "string"
+        "progress_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
+        "requested_hostname": {
+          "type": "string"
         },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
         "status": {
-          "description": "Status of the OpenShift cluster.",
           "type": "string",
           "enum": [
+            "discovering",
+            "known",
+            "disconnected",
             "insufficient",
-            "ready",
-            "error",
+            "disabled",
             "preparing-for-installation",
+            "preparing-successful",
             "pending-for-input",
             "installing",
-            "finalizing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
             "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action"
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled"
           ]
         },
         "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
         "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
+          "description": "The last time that the host status was updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
         "updated_at": {
-          "description": "The last time that this cluster was updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "host-create-params": {
+      "type": "object",
+      "required": [
+        "host_id"
+      ],
+      "properties": {
+        "discovery_agent_version": {
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "host-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
+      }
+    },
+    "host-installation-report": {
+      "description": "The timeline of the installation of a host.",
+      "type": "object",
+      "properties": {
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "completed_at": {
+          "description": "The time the installation of the host completed, empty if the installation of the host is still in progress.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "duration_seconds": {
+          "description": "The duration of the installation of the host, until it completed or until the report was generated.",
+          "type": "integer"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "stages": {
+          "description": "The installation stages of the host, oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-stage"
+          }
+        },
+        "started_at": {
+          "description": "The time the host started its first installation stage.",
+          "type": "string",
+          "format": "date-time",
           "x-nullable": true
         },
-        "user_name": {
-          "type": "string"
+        "status": {
+          "description": "The status of the host.",
+          "type": "string"
+        }
+      }
+    },
+    "host-inventory-history": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-inventory-snapshot"
+      }
+    },
+    "host-inventory-snapshot": {
+      "description": "An inventory reported by a host, that differs from the previous inventory of the host.",
+      "type": "object",
+      "properties": {
+        "changes": {
+          "description": "The changes of the inventory from the previous snapshot, empty for the first snapshot.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inventory-change"
+          }
+        },
+        "inventory": {
+          "description": "The JSON-formatted inventory of the host.",
+          "type": "string"
+        },
+        "reported_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "host-progress": {
+      "type": "object",
+      "required": [
+        "current_stage"
+      ],
+      "properties": {
+        "current_stage": {
+          "type": "string",
+          "$ref": "#/definitions/host-stage"
+        },
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        }
+      }
+    },
+    "host-progress-info": {
+      "type": "object",
+      "required": [
+        "current_stage"
+      ],
+      "properties": {
+        "current_stage": {
+          "type": "string",
+          "$ref": "#/definitions/host-stage"
+        },
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        }
+      }
+    },
+    "host-requirements": {
+      "type": "object",
+      "properties": {
+        "master": {
+          "$ref": "#/definitions/host-requirements-role"
+        },
+        "worker": {
+          "$ref": "#/definitions/host-requirements-role"
+        }
+      }
+    },
+    "host-requirements-role": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "type": "integer"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "disk_size_gb": {
+          "type": "integer"
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+        "installation_disk_speed_threshold_ms": {
+          "type": "integer"
+        },
+        "ram_gib": {
+          "type": "integer"
         }
       }
     },
-    "cluster-create-params": {
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-assignment": {
       "type": "object",
-      "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
-      ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
+        "assignable": {
+          "description": "Whether the role of the host may be assigned automatically, as opposed to a role that was set by the user.",
+          "type": "boolean"
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "current_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "failure_domain": {
           "type": "string"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "host_id": {
           "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "format": "uuid"
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
+        "hostname": {
+          "type": "string"
         },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
+        "reasons": {
+          "description": "The reasons for the proposed role.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+        "score": {
+          "description": "The capacity of the host as a master, from 0 to 100, relative to the other hosts that may be assigned automatically.",
+          "type": "integer"
+        }
+      }
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Waiting for bootkube",
+        "Waiting for controller",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-type-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
-          "type": "string",
-          "default": "all",
-          "enum": [
-            "masters",
-            "workers",
-            "none",
-            "all"
-          ]
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "host-type-hardware-requirements-wrapper": {
+      "type": "object",
+      "properties": {
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
+        }
+      }
+    },
+    "host-validation-id": {
+      "type": "string",
+      "enum": [
+        "connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "api-vip-connected",
+        "belongs-to-majority-group",
+        "valid-platform",
+        "ntp-synced",
+        "container-images-available",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "sufficient-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sufficient-network-latency-requirement-for-role",
+        "sufficient-packet-loss-requirement-for-role",
+        "compatible-cpu-architecture",
+        "sriov-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "hardware-unchanged",
+        "mtu-consistent"
+      ]
+    },
+    "host-validation-rule": {
+      "description": "A user-defined validation of the hosts, that blocks the hosts from being ready for installation until it succeeds.",
+      "type": "object",
+      "required": [
+        "id",
+        "expression"
+      ],
+      "properties": {
+        "description": {
+          "description": "A human-readable description of the requirement, that is reported when the validation fails.",
+          "type": "string"
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
+        "expression": {
+          "description": "A JMESPath expression that is evaluated over the inventory of the host, and that must evaluate to true for the validation to succeed.",
+          "type": "string"
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "id": {
+          "description": "The identifier of the rule. The validation is reported in the validations info of the hosts as custom-\u003cid\u003e.",
           "type": "string",
-          "x-nullable": true
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
+          "maxLength": 63,
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        }
+      }
+    },
+    "host-validation-rules": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-validation-rule"
+      }
+    },
+    "host_network": {
+      "type": "object",
+      "properties": {
+        "cidr": {
           "type": "string"
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
+        "host_ids": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-create-params"
+            "type": "string",
+            "format": "uuid"
           }
+        }
+      }
+    },
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
+                  "type": "string"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "host_static_network_config": {
+      "type": "object",
+      "properties": {
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
           "type": "string"
-        },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": true,
-          "x-nullable": true
         }
       }
     },
-    "cluster-host-requirements": {
+    "host_static_network_config_list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host_static_network_config"
+      }
+    },
+    "host_static_network_config_preview": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
-          "type": "string",
-          "format": "uuid"
+        "files": {
+          "description": "The NetworkManager keyfiles generated from the network yaml, as written to the discovery ISO.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static_network_config_file"
+          }
         },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "mac_interface_map": {
+          "description": "The mac interface map of the static network config.",
+          "$ref": "#/definitions/mac_interface_map"
         },
-        "operators": {
-          "description": "Host requirements related to requested operators",
+        "validation_errors": {
+          "description": "The problems found in the static network config, such as addresses that are configured for other hosts, are VIPs of the cluster or are not in its machine networks.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-host-requirements"
+            "type": "string"
           }
-        },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "cluster-host-requirements-details": {
+    "image-create-params": {
       "type": "object",
       "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
-        },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
-        },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
-        },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
         },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
+        "static_network_config": {
+          "description": "The static network configs of the hosts. The static network configs of the cluster are kept when not set.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
         }
       }
     },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
-      }
-    },
-    "cluster-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster"
-      }
-    },
-    "cluster-update-params": {
+    "image_info": {
       "type": "object",
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+        "created_at": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
+        "download_url": {
+          "type": "string"
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "expires_at": {
           "type": "string",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
+        "generator_version": {
+          "description": "Image generator version.",
+          "type": "string"
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
+        "size_bytes": {
+          "type": "integer"
         },
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "disks_config": {
-                "description": "The desired disks parameters (such as the disk's role).",
-                "type": "array",
-                "items": {
-                  "$ref": "#/definitions/disk-config-params"
-                }
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-nullable": true
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
         },
-        "hosts_machine_config_pool_names": {
-          "description": "The desired machine config pool for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "machine_config_pool_name": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": true
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
+          "type": "string"
         },
-        "hosts_names": {
-          "description": "The desired hostname for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "hostname": {
-                "type": "string"
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-nullable": true
+        "type": {
+          "$ref": "#/definitions/image_type"
+        }
+      }
+    },
+    "image_type": {
+      "type": "string",
+      "enum": [
+        "full-iso",
+        "minimal-iso"
+      ]
+    },
+    "infra_error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
         },
-        "hosts_roles": {
-          "description": "The desired role for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "role": {
-                "$ref": "#/definitions/host-role-update-params"
-              }
-            }
-          },
-          "x-nullable": true
+        "message": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "install-schedule": {
+      "type": "object",
+      "properties": {
+        "hosts_known_for_minutes": {
+          "description": "The installation only starts once all the hosts have been known for this number of minutes.",
+          "type": "integer"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "not_after": {
+          "description": "The end of the installation window.",
           "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-nullable": true
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "not_before": {
+          "description": "The installation does not start before this time.",
           "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-nullable": true
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+        "status": {
+          "description": "Pending until the installation is triggered by the schedule, or the schedule is canceled.",
           "type": "string",
           "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
+            "pending",
+            "triggered",
+            "canceled"
           ],
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "status_info": {
+          "description": "Additional information on the schedule status.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        }
+      }
+    },
+    "install-schedule-params": {
+      "type": "object",
+      "properties": {
+        "hosts_known_for_minutes": {
+          "description": "The installation only starts once all the hosts have been known for this number of minutes.",
+          "type": "integer"
+        },
+        "not_after": {
+          "description": "The end of the installation window. If the installation does not start before this time, the schedule is canceled.",
+          "type": "string",
+          "format": "date-time",
           "x-nullable": true
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "not_before": {
+          "description": "The installation does not start before this time. If not set, it starts as soon as the policy is met.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "installation-bootstrap": {
+      "description": "The time the bootstrap host waited for the control plane of the cluster.",
+      "type": "object",
+      "properties": {
+        "completed_at": {
+          "type": "string",
+          "format": "date-time",
           "x-nullable": true
         },
-        "name": {
-          "description": "OpenShift cluster name.",
+        "duration_seconds": {
+          "type": "integer"
+        },
+        "host_id": {
           "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time",
           "x-nullable": true
+        }
+      }
+    },
+    "installation-failure": {
+      "description": "A failure of the cluster, or of one of its hosts, during the installation.",
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "The failed host, empty for failures of the cluster.",
+          "type": "string",
+          "format": "uuid"
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "hostname": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "stage": {
+          "description": "The last installation stage of the host, or the last phase of the cluster, before the failure.",
+          "type": "string"
+        }
+      }
+    },
+    "installation-report": {
+      "description": "The timeline of the latest installation of a cluster.",
+      "type": "object",
+      "properties": {
+        "bootstrap": {
+          "$ref": "#/definitions/installation-bootstrap"
+        },
+        "cluster_id": {
           "type": "string",
+          "format": "uuid"
+        },
+        "completed_at": {
+          "description": "The time the installation completed, empty if the installation is still in progress.",
+          "type": "string",
+          "format": "date-time",
           "x-nullable": true
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
+        "duration_seconds": {
+          "description": "The duration of the installation, until it completed or until the report was generated.",
+          "type": "integer"
+        },
+        "failures": {
+          "description": "The failures of the cluster and of its hosts during the installation.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-create-params"
+            "$ref": "#/definitions/installation-failure"
           }
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
+        "hosts": {
+          "description": "The installation reports of the hosts of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-installation-report"
+          }
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "phases": {
+          "description": "The phases of the installation of the cluster, named after the statuses of the cluster, oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-stage"
+          }
+        },
+        "started_at": {
+          "description": "The time the installation started, empty if the cluster was not installed yet.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "format": "date-time",
           "x-nullable": true
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "status": {
+          "description": "The status of the cluster.",
+          "type": "string"
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "installation-stage": {
+      "description": "A stage of the installation of a host, or a phase of the installation of a cluster.",
+      "type": "object",
+      "properties": {
+        "completed_at": {
+          "description": "The time the stage completed, empty if the stage is still in progress.",
           "type": "string",
+          "format": "date-time",
           "x-nullable": true
         },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
+        "duration_seconds": {
+          "description": "The duration of the stage, until it completed or until the report was generated.",
+          "type": "integer"
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+        "info": {
+          "description": "The progress info of the host stage, or the status info of the cluster, when the stage started.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The host stage, or the status of the cluster.",
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vip-defined",
-        "api-vip-valid",
-        "ingress-vip-defined",
-        "ingress-vip-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "cnv-requirements-satisfied"
-      ]
+    "installer-args-params": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
+        }
+      }
     },
-    "cluster_default_config": {
+    "interface": {
       "type": "object",
       "properties": {
-        "cluster_network_cidr": {
-          "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        "biosdevname": {
+          "type": "string"
         },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "client_id": {
+          "type": "string"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "has_carrier": {
+          "type": "boolean"
+        },
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lower_interfaces": {
+          "description": "The names of the interfaces that the interface is on, which are the ports of a bond or a bridge, or the parent interface of a VLAN.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac_address": {
+          "type": "string"
+        },
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "product": {
+          "type": "string"
+        },
+        "speed_mbps": {
+          "type": "integer"
+        },
+        "type": {
+          "description": "The type of the interface, such as ethernet, bond, vlan or bridge.",
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "vlan_id": {
+          "description": "The VLAN ID of a VLAN interface.",
+          "type": "integer"
+        }
+      }
+    },
+    "inventory-change": {
+      "description": "A change of the hardware or of the network interfaces of a host between two inventories.",
+      "type": "object",
+      "properties": {
+        "current": {
+          "description": "The current value, empty for removed disks and interfaces.",
+          "type": "string"
+        },
+        "description": {
+          "description": "A human-readable description of the change.",
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "disk-added",
+            "disk-removed",
+            "disk-size-changed",
+            "interface-added",
+            "interface-removed",
+            "interface-link-changed",
+            "interface-speed-changed",
+            "interface-addresses-changed",
+            "memory-changed",
+            "cpu-changed"
+          ]
         },
-        "ntp_source": {
-          "type": "string",
-          "x-omitempty": false
+        "previous": {
+          "description": "The previous value, empty for added disks and interfaces.",
+          "type": "string"
         },
-        "service_network_cidr": {
-          "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        "subject": {
+          "description": "The disk or the interface that changed, empty for memory and CPU changes.",
+          "type": "string"
         }
       }
     },
-    "completion-params": {
+    "inventory": {
       "type": "object",
-      "required": [
-        "is_success"
-      ],
       "properties": {
-        "error_info": {
+        "bmc_address": {
           "type": "string"
         },
-        "is_success": {
-          "type": "boolean"
+        "bmc_v6address": {
+          "type": "string"
+        },
+        "boot": {
+          "$ref": "#/definitions/boot"
+        },
+        "cpu": {
+          "$ref": "#/definitions/cpu"
+        },
+        "disks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk"
+          }
+        },
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
+        },
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
+          }
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        },
+        "timestamp": {
+          "type": "integer"
         }
       }
     },
-    "connectivity-check-host": {
+    "io_perf": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
+          "type": "integer"
         }
       }
     },
-    "connectivity-check-nic": {
+    "ip_lease": {
       "type": "object",
       "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "mac": {
+        "address": {
+          "description": "The leased address.",
           "type": "string"
         },
-        "name": {
+        "allocated_at": {
+          "description": "The time at which the address was leased.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "mac_address": {
+          "description": "The MAC address of the host interface that the address is configured on.",
           "type": "string"
         }
       }
     },
-    "connectivity-check-params": {
+    "ip_lease_list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/connectivity-check-host"
+        "$ref": "#/definitions/ip_lease"
       }
     },
-    "connectivity-remote-host": {
+    "ip_lease_params": {
       "type": "object",
+      "required": [
+        "mac_interface_map"
+      ],
       "properties": {
-        "host_id": {
+        "mac_interface_map": {
+          "description": "The mac interface map of the host. The address is configured on the interface of the first MAC address.",
+          "$ref": "#/definitions/mac_interface_map"
+        }
+      }
+    },
+    "ip_pool": {
+      "type": "object",
+      "required": [
+        "cidr",
+        "gateway"
+      ],
+      "properties": {
+        "cidr": {
+          "description": "The subnet from which the addresses of the hosts are allocated.",
           "type": "string",
-          "format": "uuid"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
-        "l2_connectivity": {
+        "dns_servers": {
+          "description": "The DNS servers of the hosts.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l2-connectivity"
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
           }
         },
-        "l3_connectivity": {
+        "excluded_ranges": {
+          "description": "The ranges of addresses of the subnet that are not allocated, in addition to the gateway, the DNS servers and the VIPs of the cluster.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l3-connectivity"
+            "$ref": "#/definitions/ip_range"
           }
+        },
+        "gateway": {
+          "description": "The default gateway of the hosts, in the subnet.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
         }
       }
     },
-    "connectivity-report": {
+    "ip_range": {
       "type": "object",
+      "required": [
+        "start",
+        "end"
+      ],
       "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
+        "end": {
+          "description": "The last address of the range.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "start": {
+          "description": "The first address of the range.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
         }
       }
     },
-    "container_image_availability": {
+    "l2-connectivity": {
       "type": "object",
       "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
+        "outgoing_ip_address": {
+          "type": "string"
         },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
+        "outgoing_nic": {
           "type": "string"
         },
-        "result": {
-          "$ref": "#/definitions/container_image_availability_result"
+        "remote_ip_address": {
+          "type": "string"
         },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
+        "remote_mac": {
+          "type": "string"
         },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "container_image_availability_request": {
+    "l3-connectivity": {
       "type": "object",
-      "required": [
-        "images"
-      ],
       "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
-          "type": "array",
-          "items": {
-            "description": "A fully qualified image name (FQIN).",
-            "type": "string"
-          }
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
         },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
-          "type": "integer"
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "container_image_availability_response": {
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
+      }
+    },
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
+    },
+    "list-versions": {
+      "type": "object",
+      "properties": {
+        "release_tag": {
+          "type": "string"
+        },
+        "versions": {
+          "$ref": "#/definitions/versions"
+        }
+      }
+    },
+    "logs-progress-params": {
       "type": "object",
       "required": [
-        "images"
+        "logs_state"
       ],
       "properties": {
-        "images": {
-          "description": "List of images that were checked.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/container_image_availability"
-          }
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
         }
       }
     },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
+    "logs_state": {
       "type": "string",
       "enum": [
-        "success",
-        "failure"
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
+      ]
+    },
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
       ]
     },
-    "cpu": {
+    "mac_interface_map": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          }
+        }
+      }
+    },
+    "managed-domain": {
       "type": "object",
       "properties": {
-        "architecture": {
+        "domain": {
           "type": "string"
         },
-        "count": {
-          "type": "integer"
-        },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "frequency": {
-          "type": "number"
-        },
-        "model_name": {
-          "type": "string"
+        "provider": {
+          "type": "string",
+          "enum": [
+            "route53",
+            "rfc2136",
+            "powerdns"
+          ]
         }
       }
     },
-    "create-manifest-params": {
+    "manifest": {
       "type": "object",
-      "required": [
-        "file_name",
-        "content"
-      ],
       "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
-          "type": "string"
-        },
         "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
-          "type": "string",
-          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+          "description": "The file name prefaced by the folder that contains it.",
+          "type": "string"
         },
         "folder": {
           "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
           "type": "string",
-          "default": "manifests",
           "enum": [
             "manifests",
             "openshift"
@@ -5767,1876 +10604,3121 @@ func init() {
         }
       }
     },
-    "credentials": {
+    "memory": {
       "type": "object",
       "properties": {
-        "console_url": {
-          "type": "string"
-        },
-        "password": {
-          "type": "string"
+        "physical_bytes": {
+          "type": "integer"
         },
-        "username": {
-          "type": "string"
+        "usable_bytes": {
+          "type": "integer"
         }
       }
     },
-    "dhcp_allocation_request": {
+    "monitored-operator": {
       "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
       "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
+        "channel": {
+          "description": "Subscription channel of the operator selected by the user.",
           "type": "string"
         },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
           "type": "string",
-          "format": "mac"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+        "csv": {
+          "description": "ClusterServiceVersion the subscription of the operator starts from, selected by the user. OLM upgrades the operator to the later releases of the channel.",
           "type": "string"
         },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
+        "name": {
+          "description": "Unique name of the operator.",
           "type": "string",
-          "format": "mac"
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
           "type": "string"
-        }
-      }
-    },
-    "dhcp_allocation_response": {
-      "type": "object",
-      "required": [
-        "api_vip_address",
-        "ingress_vip_address"
-      ],
-      "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+        },
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
-          "format": "ipv4"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_history": {
+          "$ref": "#/definitions/operator-status-history",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
           "type": "string",
-          "format": "ipv4"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
           "type": "string"
+        },
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
+          "type": "integer"
         }
       }
     },
-    "discovery-ignition-params": {
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
+      }
+    },
+    "ntp_source": {
+      "type": "object",
       "properties": {
-        "config": {
+        "source_name": {
+          "description": "NTP source name or IP.",
           "type": "string"
+        },
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
         }
       }
     },
-    "disk": {
+    "ntp_synchronization_request": {
       "type": "object",
+      "required": [
+        "ntp_source"
+      ],
       "properties": {
-        "bootable": {
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        }
+      }
+    },
+    "ntp_synchronization_response": {
+      "properties": {
+        "ntp_sources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ntp_source"
+          }
+        }
+      }
+    },
+    "openshift-version": {
+      "type": "object",
+      "required": [
+        "display_name",
+        "release_image",
+        "release_version",
+        "rhcos_image",
+        "rhcos_rootfs",
+        "rhcos_version",
+        "support_level"
+      ],
+      "properties": {
+        "cpu_architectures": {
+          "description": "Images of additional CPU architectures keyed by architecture name. The top level images are used for x86_64.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/cpu-architecture-images"
+          }
+        },
+        "default": {
+          "description": "Indication that the version is the recommended one.",
           "type": "boolean"
         },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
           "type": "string"
         },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
+        "release_image": {
+          "description": "The installation image of the OpenShift cluster.",
           "type": "string"
         },
-        "drive_type": {
+        "release_version": {
+          "description": "OCP version from the release metadata.",
           "type": "string"
         },
-        "hctl": {
+        "rhcos_image": {
+          "description": "The base RHCOS image used for the discovery iso.",
           "type": "string"
         },
-        "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+        "rhcos_rootfs": {
+          "description": "The RHCOS rootfs url.",
           "type": "string"
         },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": false
+        "rhcos_version": {
+          "description": "Build ID of the RHCOS image.",
+          "type": "string"
         },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "custom"
+          ]
+        }
+      }
+    },
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
+    "operator-create-params": {
+      "type": "object",
+      "properties": {
+        "channel": {
+          "description": "Subscription channel of the operator. Must be compatible with the OpenShift version of the cluster, the default channel of the operator is used if not set.",
+          "type": "string"
+        },
+        "csv": {
+          "description": "ClusterServiceVersion the subscription of the operator starts from, e.g. an older release of the channel. OLM upgrades the operator to the later releases of the channel, so the version is not pinned. The latest release of the channel is installed if not set.",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "operator-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
-          "type": "boolean"
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "string"
         },
-        "model": {
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
+    "operator-host-requirements": {
+      "type": "object",
+      "properties": {
+        "operator_name": {
+          "description": "Name of the operator",
           "type": "string"
         },
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "operator-monitor-report": {
+      "type": "object",
+      "properties": {
         "name": {
+          "description": "Unique name of the operator.",
           "type": "string"
         },
-        "path": {
-          "type": "string"
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "serial": {
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
+        }
+      }
+    },
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
+      "type": "object",
+      "properties": {
+        "data_type": {
+          "description": "Type of the property",
+          "type": "string",
+          "enum": [
+            "boolean",
+            "string",
+            "integer",
+            "float"
+          ]
         },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "smart": {
+        "default_value": {
+          "description": "Default value for the property",
           "type": "string"
         },
-        "vendor": {
+        "description": {
+          "description": "Description of a property",
           "type": "string"
         },
-        "wwn": {
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Name of the property",
           "type": "string"
+        },
+        "options": {
+          "description": "Values to select from",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "disk-config-params": {
+    "operator-status": {
+      "description": "Represents the operator state.",
+      "type": "string",
+      "enum": [
+        "failed",
+        "progressing",
+        "available"
+      ]
+    },
+    "operator-status-change": {
       "type": "object",
-      "required": [
-        "id"
-      ],
       "properties": {
-        "id": {
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/disk-role"
+        "updated_at": {
+          "description": "Time at which the operator changed to the status.",
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "disk-role": {
+    "operator-status-history": {
+      "description": "The status changes of an operator, oldest first.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-status-change"
+      }
+    },
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
       "type": "string",
       "enum": [
-        "none",
-        "install"
+        "builtin",
+        "olm"
       ]
     },
-    "disk_info": {
+    "preflight-hardware-requirements": {
       "type": "object",
       "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         },
-        "path": {
+        "operators": {
+          "description": "Preflight operators hardware requirements",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-hardware-requirements"
+          }
+        }
+      }
+    },
+    "presigned": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
           "type": "string"
         }
       }
     },
-    "disk_speed": {
+    "role-assignment": {
+      "description": "The roles proposed for the hosts of a cluster by the automatic role assignment.",
       "type": "object",
       "properties": {
-        "exit_code": {
-          "type": "integer"
+        "applied": {
+          "description": "Whether the roles of the hosts were changed to the proposed roles.",
+          "type": "boolean"
         },
-        "speed_ms": {
-          "type": "integer"
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-role-assignment"
+          }
         },
-        "tested": {
+        "warnings": {
+          "description": "Requirements that the proposed assignment does not satisfy.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "role-assignment-params": {
+      "type": "object",
+      "properties": {
+        "dry_run": {
+          "description": "Only propose the assignment, without changing the roles of the hosts.",
           "type": "boolean"
         }
       }
     },
-    "disk_speed_check_request": {
+    "route": {
       "type": "object",
-      "required": [
-        "path"
-      ],
       "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
+        "destination": {
+          "description": "The destination network or destination host",
+          "type": "string"
+        },
+        "family": {
+          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
+          "type": "integer",
+          "format": "int32"
+        },
+        "gateway": {
+          "description": "Gateway address where the packets are sent",
+          "type": "string"
+        },
+        "interface": {
+          "description": "Interface to which packets for this route will be sent",
           "type": "string"
         }
       }
     },
-    "disk_speed_check_response": {
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
+    },
+    "static_network_config_file": {
       "type": "object",
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
+        "contents": {
+          "description": "The contents of the file.",
+          "type": "string"
         },
         "path": {
-          "description": "The device path.",
+          "description": "The path of the file, relative to the static network config directory of the discovery ISO.",
           "type": "string"
         }
       }
     },
-    "domain_resolution_request": {
+    "static_network_config_preview": {
       "type": "object",
       "required": [
-        "domains"
+        "valid",
+        "hosts"
       ],
       "properties": {
-        "domains": {
+        "hosts": {
           "type": "array",
           "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain name that should be resolved",
-                "type": "string"
-              }
-            },
-            "x-go-name": "DomainResolutionRequestDomain"
+            "$ref": "#/definitions/host_static_network_config_preview"
           }
+        },
+        "valid": {
+          "description": "Whether none of the static network configs has validation errors.",
+          "type": "boolean"
         }
       }
     },
-    "domain_resolution_response": {
+    "static_network_config_preview_params": {
       "type": "object",
-      "required": [
-        "resolutions"
-      ],
       "properties": {
-        "resolutions": {
+        "static_network_config": {
+          "description": "The static network configs to preview. The static network configs of the cluster are previewed when not set.",
           "type": "array",
           "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
-                }
-              }
-            },
-            "x-go-name": "DomainResolutionResponseDomain"
+            "$ref": "#/definitions/host_static_network_config"
           }
         }
       }
     },
-    "error": {
+    "status-update": {
+      "description": "A status transition of a cluster or of a host, streamed by the watch API.",
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
+        "cluster_id",
+        "status"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
+        "cluster_id": {
+          "description": "The cluster that this update relates to.",
+          "type": "string",
+          "format": "uuid"
         },
-        "href": {
-          "description": "Self link.",
+        "host_id": {
+          "description": "The host that this update relates to. Not set for cluster status updates.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "description": "The current status.",
           "type": "string"
         },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+        "status_info": {
+          "description": "Additional information pertaining to the status.",
+          "type": "string"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+        "status_updated_at": {
+          "description": "The last time that the status was updated.",
           "type": "string",
-          "enum": [
-            "Error"
-          ]
+          "format": "date-time"
+        }
+      }
+    },
+    "step": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "reason": {
-          "description": "Human-readable description of the error.",
+        "command": {
           "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "event": {
+    "step-reply": {
       "type": "object",
-      "required": [
-        "cluster_id",
-        "severity",
-        "message",
-        "event_time"
-      ],
       "properties": {
-        "category": {
-          "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
-        },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
+        "error": {
+          "type": "string"
         },
-        "event_time": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "exit_code": {
+          "type": "integer"
         },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
-          "type": "string",
-          "format": "uuid"
+        "output": {
+          "type": "string"
         },
-        "message": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        "step_id": {
+          "type": "string"
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "step_type": {
+          "$ref": "#/definitions/step-type"
+        }
+      }
+    },
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "reset-installation",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "ntp-synchronizer",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution"
+      ]
+    },
+    "steps": {
+      "type": "object",
+      "properties": {
+        "instructions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/step"
+          }
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
-          "type": "string",
-          "format": "uuid"
+        "next_instruction_seconds": {
+          "type": "integer"
         },
-        "severity": {
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
           "type": "string",
+          "default": "continue",
           "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
+            "exit",
+            "continue"
           ]
         }
       }
     },
-    "event-list": {
+    "steps-reply": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/event"
+        "$ref": "#/definitions/step-reply"
       }
     },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
+    "system_vendor": {
+      "type": "object",
+      "properties": {
+        "manufacturer": {
+          "type": "string"
+        },
+        "product_name": {
+          "type": "string"
+        },
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
+          "type": "boolean"
+        }
       }
     },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+    "usage": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "name": {
+          "description": "name of the feature to track",
+          "type": "string"
+        }
       }
     },
-    "free_network_addresses": {
+    "validation-policy": {
+      "description": "The validation policy of a cluster, that overrides the service-wide disabled validations and thresholds for the cluster.",
       "type": "object",
       "properties": {
-        "free_addresses": {
+        "disabled_cluster_validations": {
+          "description": "Identifiers of cluster validations that are disabled for the cluster.",
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "ipv4"
+            "type": "string"
           }
         },
-        "network": {
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "disabled_host_validations": {
+          "description": "Identifiers of host validations that are disabled for the hosts of the cluster, in addition to the service-wide disabled host validations.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Overrides the maximum allowed 99th percentile of fdatasync durations of the installation disk, in milliseconds.",
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "max_time_diff_minutes": {
+          "description": "Overrides the maximum allowed difference between the clocks of the hosts of the cluster, in minutes.",
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "network_latency_threshold_ms": {
+          "description": "Overrides the maximum allowed network latency between the hosts of the cluster, in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Overrides the maximum allowed percentage of packet loss between the hosts of the cluster.",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "x-nullable": true
         }
       }
     },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "gpu": {
+    "versioned-host-requirements": {
       "type": "object",
       "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
-          "type": "string"
-        },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
-          "type": "string"
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
-          "type": "string"
+        "sno": {
+          "description": "Single node OpenShift node requirements",
+          "x-go-name": "SNORequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+        "version": {
+          "description": "Version of the component for which requirements are defined",
           "type": "string"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
-          "type": "string"
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "host": {
+    "versions": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "string"
+      }
+    },
+    "webhook": {
       "type": "object",
       "required": [
-        "kind",
         "id",
-        "href",
-        "status",
-        "status_info"
+        "url"
       ],
       "properties": {
-        "api_vip_connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "bootstrap": {
-          "type": "boolean"
-        },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
         "cluster_id": {
-          "description": "The cluster that this host is associated with.",
+          "description": "The cluster whose events are delivered to the webhook. Not set for webhooks that receive the events of all the clusters of the organization.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
         "created_at": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "deleted_at": {
-          "description": "The time that the host was deleted.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-nullable": true
-        },
-        "discovery_agent_version": {
-          "type": "string"
-        },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "free_addresses": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
         "id": {
-          "description": "Unique identifier of the object.",
+          "description": "Unique identifier of the webhook.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
-        },
-        "images_status": {
-          "description": "Array of image statuses.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
-          "type": "string"
-        },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+        "org_id": {
+          "description": "The organization whose events are delivered to the webhook.",
           "type": "string",
-          "example": "/dev/sda"
-        },
-        "installer_args": {
-          "type": "string"
-        },
-        "installer_version": {
-          "description": "Installer version.",
-          "type": "string"
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "inventory": {
+        "url": {
+          "description": "The URL that events are posted to.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
-          "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
-        },
-        "logs_collected_at": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "$ref": "#/definitions/logs_state"
-        },
-        "logs_started_at": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "machine_config_pool_name": {
+        "user_name": {
+          "description": "The user that registered the webhook.",
           "type": "string"
-        },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "progress": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
-          "$ref": "#/definitions/host-progress-info"
-        },
-        "progress_stages": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "requested_hostname": {
+        }
+      }
+    },
+    "webhook-create-params": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "secret": {
+          "description": "A shared secret used to sign the delivered payloads. The HMAC-SHA256 signature of each payload is sent in the X-Assisted-Signature header.",
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled"
-          ]
-        },
-        "status_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "user_name": {
+        "url": {
+          "description": "The http or https URL that events are posted to.",
           "type": "string"
-        },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "host-create-params": {
-      "type": "object",
-      "required": [
-        "host_id"
-      ],
-      "properties": {
-        "discovery_agent_version": {
-          "type": "string"
-        },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
-        }
-      }
+    "webhook-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook"
+      }
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
+    },
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
+      ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Sharing of clusters with other users.",
+      "name": "access"
+    },
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
+    },
+    {
+      "description": "ISO that contains the Assisted Service.",
+      "name": "assisted-service-iso"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "Export and import of the configuration of clusters.",
+      "name": "export"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
+    },
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
+    },
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
+    },
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
+    },
+    {
+      "description": "API tokens for the local authentication of users and service accounts.",
+      "name": "tokens"
     },
-    "host-ignition-params": {
-      "properties": {
-        "config": {
-          "type": "string"
-        }
-      }
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
     },
-    "host-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/host"
-      }
+    {
+      "description": "Streaming updates of a cluster installation.",
+      "name": "watch"
     },
-    "host-progress": {
-      "type": "object",
-      "required": [
-        "current_stage"
-      ],
-      "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
-        },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        }
-      }
+    {
+      "description": "Webhooks that receive the events of cluster installations.",
+      "name": "webhooks"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
     },
-    "host-progress-info": {
-      "type": "object",
-      "required": [
-        "current_stage"
-      ],
-      "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
-        },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install/v1",
+  "paths": {
+    "/add_hosts_clusters": {
+      "post": {
+        "description": "Creates a new OpenShift cluster definition for adding nodes to and existing OCP cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterAddHostsCluster",
+        "parameters": [
+          {
+            "description": "Parameters for creating a new cluster for adding nodes.",
+            "name": "new-add-hosts-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/add-hosts-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "host-requirements": {
-      "type": "object",
-      "properties": {
-        "master": {
-          "$ref": "#/definitions/host-requirements-role"
-        },
-        "worker": {
-          "$ref": "#/definitions/host-requirements-role"
+    "/assisted-service-iso": {
+      "post": {
+        "description": "Creates ISO for the user and uploads to S3.",
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "CreateISOAndUploadToS3",
+        "parameters": [
+          {
+            "description": "Parameters for creating an Assisted Service ISO.",
+            "name": "assisted-service-iso-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/assisted-service-iso-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "host-requirements-role": {
-      "type": "object",
-      "properties": {
-        "cpu_cores": {
-          "type": "integer"
-        },
-        "disk_size_gb": {
-          "type": "integer"
-        },
-        "installation_disk_speed_threshold_ms": {
-          "type": "integer"
-        },
-        "ram_gib": {
-          "type": "integer"
+    "/assisted-service-iso/data": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Downloads the Assisted Service ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "DownloadISO",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker",
-        "bootstrap"
-      ]
-    },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker"
-      ]
-    },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Waiting for bootkube",
-        "Waiting for controller",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
+    "/assisted-service-iso/presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading assisted-service ISO.",
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "GetPresignedForAssistedServiceISO",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "host-type-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned clusters are filtered to those with matching statuses.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned clusters are filtered to those with this OpenShift version.",
+            "name": "openshift_version",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned clusters are filtered to those whose name contains this string, ignoring case.",
+            "name": "name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned clusters are filtered to those created after this time.",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned clusters are filtered to those created before this time.",
+            "name": "created_before",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of items to return. The cursor of the next page is returned in the Next-Cursor header.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned in the Next-Cursor header of the previous page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "created_at",
+              "name",
+              "status_updated_at"
+            ],
+            "type": "string",
+            "default": "created_at",
+            "description": "The field to sort the clusters by.",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "description": "The sort order.",
+            "name": "order",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            },
+            "headers": {
+              "Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page. Not set for the last page."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "host-type-hardware-requirements-wrapper": {
-      "type": "object",
-      "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
-        },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+    "/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "host-validation-id": {
-      "type": "string",
-      "enum": [
-        "connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "api-vip-connected",
-        "belongs-to-majority-group",
-        "valid-platform",
-        "ntp-synced",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "sufficient-installation-disk-speed",
-        "cnv-requirements-satisfied",
-        "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role"
-      ]
+    "/clusters/import": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Creates a cluster from the exported document of another cluster.",
+        "tags": [
+          "export"
+        ],
+        "operationId": "ImportCluster",
+        "parameters": [
+          {
+            "name": "cluster-import-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-import-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "host_network": {
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "type": "string"
-        },
-        "host_ids": {
-          "type": "array",
-          "items": {
+    "/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCluster",
+        "parameters": [
+          {
             "type": "string",
-            "format": "uuid"
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
-        },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
-      ]
-    },
-    "host_static_network_config": {
-      "type": "object",
-      "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
-        },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
-          "type": "string"
-        }
-      }
-    },
-    "image-create-params": {
-      "type": "object",
-      "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
-        },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
           }
-        }
-      }
-    },
-    "image_info": {
-      "type": "object",
-      "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "download_url": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
-        },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
-        },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
-          "type": "string"
-        },
-        "type": {
-          "$ref": "#/definitions/image_type"
-        }
-      }
-    },
-    "image_type": {
-      "type": "string",
-      "enum": [
-        "full-iso",
-        "minimal-iso"
-      ]
-    },
-    "infra_error": {
-      "type": "object",
-      "required": [
-        "code",
-        "message"
-      ],
-      "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
-        },
-        "message": {
-          "description": "Human-readable description of the error.",
-          "type": "string"
-        }
-      }
-    },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "installer-args-params": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "description": "List of additional arguments passed to coreos-installer",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
-          ]
-        }
-      }
-    },
-    "interface": {
-      "type": "object",
-      "properties": {
-        "biosdevname": {
-          "type": "string"
-        },
-        "client_id": {
-          "type": "string"
-        },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "has_carrier": {
-          "type": "boolean"
-        },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "ipv6_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "product": {
-          "type": "string"
-        },
-        "speed_mbps": {
-          "type": "integer"
-        },
-        "vendor": {
-          "type": "string"
         }
       }
     },
-    "inventory": {
-      "type": "object",
-      "properties": {
-        "bmc_address": {
-          "type": "string"
-        },
-        "bmc_v6address": {
-          "type": "string"
-        },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk"
-          }
-        },
-        "gpus": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/gpu"
+    "/clusters/{cluster_id}/access": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
-        },
-        "hostname": {
-          "type": "string"
-        },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
+        ],
+        "description": "Lists the users that the cluster is shared with and their roles.",
+        "tags": [
+          "access"
+        ],
+        "operationId": "ListClusterAccess",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose access should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "routes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/route"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-access-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
-        },
-        "timestamp": {
-          "type": "integer"
-        }
-      }
-    },
-    "io_perf": {
-      "type": "object",
-      "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
-        }
-      }
-    },
-    "l2-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "remote_mac": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
         }
       }
     },
-    "l3-connectivity": {
-      "type": "object",
-      "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
+    "/clusters/{cluster_id}/access/{user_name}": {
+      "put": {
+        "description": "Grants a user access to the cluster with the given role, or changes the role of a user that the cluster is already shared with. Only the owner of the cluster may share it.",
+        "tags": [
+          "access"
+        ],
+        "operationId": "GrantClusterAccess",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be shared.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The user that the cluster is shared with.",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "cluster-access-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-access-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-access"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
-      }
-    },
-    "list-manifests": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/manifest"
-      }
-    },
-    "list-versions": {
-      "type": "object",
-      "properties": {
-        "release_tag": {
-          "type": "string"
-        },
-        "versions": {
-          "$ref": "#/definitions/versions"
+      },
+      "delete": {
+        "description": "Revokes the access of a user to the cluster. Only the owner of the cluster may revoke access.",
+        "tags": [
+          "access"
+        ],
+        "operationId": "RevokeClusterAccess",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that is shared.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The user whose access is revoked.",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "logs-progress-params": {
-      "type": "object",
-      "required": [
-        "logs_state"
-      ],
-      "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
+    "/clusters/{cluster_id}/actions/assign-roles": {
+      "post": {
+        "description": "Assigns the roles of the hosts whose role is selected automatically, considering all the hosts of the cluster at once. Masters are selected by their capacity and spread across failure domains, while minimizing the operator requirements that are not satisfied.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "AssignClusterRoles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose host roles are being assigned.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "assign-roles-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/role-assignment-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-assignment"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
-      ]
-    },
-    "logs_type": {
-      "type": "string",
-      "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
-      ]
-    },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
-          },
-          "mac_address": {
-            "description": "mac address present on the host",
+    "/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CancelInstallation",
+        "parameters": [
+          {
             "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "managed-domain": {
-      "type": "object",
-      "properties": {
-        "domain": {
-          "type": "string"
-        },
-        "provider": {
-          "type": "string",
-          "enum": [
-            "route53"
-          ]
-        }
-      }
-    },
-    "manifest": {
-      "type": "object",
-      "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
-          "type": "string"
-        },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
-      }
-    },
-    "memory": {
-      "type": "object",
-      "properties": {
-        "physical_bytes": {
-          "type": "integer"
-        },
-        "usable_bytes": {
-          "type": "integer"
-        }
-      }
-    },
-    "monitored-operator": {
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
-        },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
-        },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
-          "type": "string"
-        },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
-    "ntp_source": {
-      "type": "object",
-      "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
-          "type": "string"
-        },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "ntp_synchronization_request": {
-      "type": "object",
-      "required": [
-        "ntp_source"
-      ],
-      "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
+    "/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The existing cluster whose hosts should be added.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "ntp_synchronization_response": {
-      "properties": {
-        "ntp_sources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ntp_source"
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "openshift-version": {
-      "type": "object",
-      "required": [
-        "display_name",
-        "release_image",
-        "release_version",
-        "rhcos_image",
-        "rhcos_rootfs",
-        "rhcos_version",
-        "support_level"
-      ],
-      "properties": {
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
-          "type": "string"
-        },
-        "release_image": {
-          "description": "The installation image of the OpenShift cluster.",
-          "type": "string"
-        },
-        "release_version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
-        },
-        "rhcos_image": {
-          "description": "The base RHCOS image used for the discovery iso.",
-          "type": "string"
-        },
-        "rhcos_rootfs": {
-          "description": "The RHCOS rootfs url.",
-          "type": "string"
-        },
-        "rhcos_version": {
-          "description": "Build ID of the RHCOS image.",
-          "type": "string"
-        },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "custom"
-          ]
+    "/clusters/{cluster_id}/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "openshift-versions": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
-      }
-    },
-    "operator-create-params": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+    "/clusters/{cluster_id}/discovery-ignition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetDiscoveryIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/discovery-ignition-params"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateDiscoveryIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/discovery-ignition-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "operator-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "string"
-        },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        }
-      }
-    },
-    "operator-host-requirements": {
-      "type": "object",
-      "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
-          "type": "string"
-        },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "operator-monitor-report": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+    "/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetPresignedForClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "operator-properties": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/operator-property"
-      }
-    },
-    "operator-property": {
-      "type": "object",
-      "properties": {
-        "data_type": {
-          "description": "Type of the property",
-          "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
-        },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
-        },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Name of the property",
-          "type": "string"
-        },
-        "options": {
-          "description": "Values to select from",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/downloads/image": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadClusterISO",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "operator-status": {
-      "description": "Represents the operator state.",
-      "type": "string",
-      "enum": [
-        "failed",
-        "progressing",
-        "available"
-      ]
-    },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
-      "type": "string",
-      "enum": [
-        "builtin",
-        "olm"
-      ]
-    },
-    "preflight-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+      },
+      "post": {
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GenerateClusterISO",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO should be generated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/image-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "presigned": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "url": {
-          "type": "string"
-        }
-      }
-    },
-    "route": {
-      "type": "object",
-      "properties": {
-        "destination": {
-          "description": "The destination network or destination host",
-          "type": "string"
-        },
-        "family": {
-          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
-          "type": "integer",
-          "format": "int32"
-        },
-        "gateway": {
-          "description": "Gateway address where the packets are sent",
-          "type": "string"
-        },
-        "interface": {
-          "description": "Interface to which packets for this route will be sent",
-          "type": "string"
-        }
-      }
-    },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "head": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadClusterISOHeaders",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO headers should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "command": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
-        }
-      }
-    },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "reset-installation",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "ntp-synchronizer",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
-        }
-      }
-    },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
-        }
-      }
-    },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+        ],
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadClusterKubeconfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose kubeconfig should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
-        }
-      }
-    },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "sno": {
-          "description": "Single node OpenShift node requirements",
-          "x-go-name": "SNORequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        }
-      }
-    },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "ISO that contains the Assisted Service.",
-      "name": "assisted-service-iso"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install/v1",
-  "paths": {
-    "/add_hosts_clusters": {
-      "post": {
-        "description": "Creates a new OpenShift cluster definition for adding nodes to and existing OCP cluster.",
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "RegisterAddHostsCluster",
+        "operationId": "ListEvents",
         "parameters": [
           {
-            "description": "Parameters for creating a new cluster for adding nodes.",
-            "name": "new-add-hosts-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/add-hosts-cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return events for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "If non-empty, returned events are filtered to those with matching severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned events are filtered to those whose message contains this string, ignoring case.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned events are filtered to those that occurred after this time.",
+            "name": "event_time_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned events are filtered to those that occurred before this time.",
+            "name": "event_time_before",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of events to return. The cursor of the next page is returned in the Next-Cursor header.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned in the Next-Cursor header of the previous page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "description": "The order of the events by their time.",
+            "name": "order",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page. Not set for the last page."
+              }
             }
           },
           "400": {
@@ -7657,6 +13739,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7666,32 +13760,51 @@ func init() {
         }
       }
     },
-    "/assisted-service-iso": {
-      "post": {
-        "description": "Creates ISO for the user and uploads to S3.",
+    "/clusters/{cluster_id}/export": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Exports the configuration of the cluster as a portable document, that equivalent clusters may be imported from. The pull secret of the cluster is not exported.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "assisted-service-iso"
+          "export"
         ],
-        "operationId": "CreateISOAndUploadToS3",
+        "operationId": "ExportCluster",
         "parameters": [
           {
-            "description": "Parameters for creating an Assisted Service ISO.",
-            "name": "assisted-service-iso-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/assisted-service-iso-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be exported.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "yaml",
+              "json"
+            ],
+            "type": "string",
+            "default": "yaml",
+            "description": "The format of the exported document.",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -7706,6 +13819,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7715,7 +13834,7 @@ func init() {
         }
       }
     },
-    "/assisted-service-iso/data": {
+    "/clusters/{cluster_id}/free_addresses": {
       "get": {
         "security": [
           {
@@ -7726,20 +13845,49 @@ func init() {
             ]
           }
         ],
-        "description": "Downloads the Assisted Service ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the free address list for a network.",
         "tags": [
-          "assisted-service-iso"
+          "installer"
+        ],
+        "operationId": "GetFreeAddresses",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return free addresses for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+            "type": "string",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
+          }
         ],
-        "operationId": "DownloadISO",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -7760,6 +13908,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7769,7 +13923,7 @@ func init() {
         }
       }
     },
-    "/assisted-service-iso/presigned": {
+    "/clusters/{cluster_id}/host-requirements": {
       "get": {
         "security": [
           {
@@ -7780,16 +13934,26 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading assisted-service ISO.",
+        "description": "Get host requirements of a cluster.",
         "tags": [
-          "assisted-service-iso"
+          "installer"
+        ],
+        "operationId": "GetClusterHostRequirements",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return operators for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "GetPresignedForAssistedServiceISO",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -7810,6 +13974,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7819,7 +13989,7 @@ func init() {
         }
       }
     },
-    "/clusters": {
+    "/clusters/{cluster_id}/host-validation-rules": {
       "get": {
         "security": [
           {
@@ -7830,41 +14000,26 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Lists the user-defined host validation rules of the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListClusters",
+        "operationId": "ListHostValidationRules",
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
+            "description": "The cluster whose host validation rules are being listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/host-validation-rules"
             }
           },
           "401": {
@@ -7879,8 +14034,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -7890,37 +14045,39 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+      "put": {
+        "description": "Replaces the user-defined host validation rules of the cluster. The rules are evaluated in addition to the service-wide rules, and replace the service-wide rules that have the same identifier.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterCluster",
+        "operationId": "UpdateHostValidationRules",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose host validation rules are being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The host validation rules of the cluster.",
+            "name": "host-validation-rules",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/host-validation-rules"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-validation-rules"
             }
           },
           "400": {
@@ -7941,55 +14098,17 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/clusters/default-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the default values for various cluster properties.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "GetClusterDefaultConfig",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster_default_config"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
           },
           "500": {
             "description": "Error.",
@@ -8000,7 +14119,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}": {
+    "/clusters/{cluster_id}/hosts": {
       "get": {
         "security": [
           {
@@ -8014,39 +14133,109 @@ func init() {
             "agentAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCluster",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "description": "The software version of the discovery agent that is listing hosts.",
             "name": "discovery_agent_version",
             "in": "header"
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned hosts are filtered to those with matching statuses.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned hosts are filtered to those whose requested hostname or inventory hostname contains this string, ignoring case.",
+            "name": "name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned hosts are filtered to those created after this time.",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "If set, returned hosts are filtered to those created before this time.",
+            "name": "created_before",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of items to return. The cursor of the next page is returned in the Next-Cursor header.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned in the Next-Cursor header of the previous page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "created_at",
+              "requested_hostname",
+              "status_updated_at"
+            ],
+            "type": "string",
+            "default": "created_at",
+            "description": "The field to sort the hosts by.",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "description": "The sort order.",
+            "name": "order",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-list"
+            },
+            "headers": {
+              "Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page. Not set for the last page."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8061,12 +14250,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -8087,25 +14270,54 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterCluster",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster into which the host should be registered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The description of the host being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the host.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_registration_response"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -8132,7 +14344,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new hosts due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -8142,45 +14354,55 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateCluster",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-update-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -8207,12 +14429,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8220,30 +14436,39 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "CancelInstallation",
+        "operationId": "DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster of the host that should be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8270,12 +14495,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8285,45 +14504,33 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/complete_installation": {
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "CompleteInstallation",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The host that is being enabled.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
@@ -8364,46 +14571,40 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+      },
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallCluster",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster of the host that is being disabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being disabled.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -8443,34 +14644,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install_hosts": {
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
       "post": {
-        "description": "Installs the OpenShift cluster.",
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHosts",
+        "operationId": "InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The existing cluster whose hosts should be added.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -8491,12 +14694,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -8512,28 +14709,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/reset": {
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
       "post": {
-        "description": "Resets a failed installation.",
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetCluster",
+        "operationId": "ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -8554,12 +14759,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -8575,37 +14774,50 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCredentials",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8626,12 +14838,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -8647,7 +14853,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/discovery-ignition": {
+    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -8656,28 +14862,42 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "type": "file"
             }
           },
           "401": {
@@ -8704,47 +14924,57 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the discovery ignition config.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Get the customized ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "operationId": "GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose customized ignition file should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -8771,6 +15001,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8778,73 +15014,48 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign",
-              "custom_manifests.yaml"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8871,101 +15082,54 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
+            "description": "The host whose installer arguments should be updated.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -9013,50 +15177,47 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The cluster of the host that is retrieving instructions.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "400": {
-            "description": "Error.",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -9078,10 +15239,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9091,40 +15249,61 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
       "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "GenerateClusterISO",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster of the host that is posting results.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/image-create-params"
+              "$ref": "#/definitions/step-reply"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -9156,21 +15335,23 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "head": {
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/inventory-history": {
+      "get": {
         "security": [
           {
             "userAuth": [
@@ -9178,40 +15359,36 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+        "description": "Lists the latest inventory snapshots of the host, with the changes of each snapshot from the previous one.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "operationId": "GetHostInventoryHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster of the host.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose inventory history is being listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-inventory-history"
             }
           },
           "401": {
@@ -9232,15 +15409,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9250,7 +15418,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
         "security": [
           {
@@ -9264,30 +15432,38 @@ func init() {
             "urlAuth": []
           }
         ],
-        "description": "Downloads the kubeconfig file for this cluster.",
+        "description": "Download host logs.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "DownloadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster of the host whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "type": "file"
             }
           },
           "401": {
@@ -9327,32 +15503,27 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/events": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "operationId": "UploadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9360,26 +15531,104 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
+            "description": "The host whose logs should be uploaded.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateHostLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose log progress is being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
             }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -9393,20 +15642,32 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9414,61 +15675,54 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/free_addresses": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the free address list for a network.",
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
-            "in": "query",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           },
           {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/free-addresses-list"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -9499,11 +15753,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/host-requirements": {
+    "/clusters/{cluster_id}/install-config": {
       "get": {
         "security": [
           {
@@ -9514,16 +15774,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get host requirements of a cluster.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9533,7 +15793,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "type": "string"
             }
           },
           "401": {
@@ -9567,48 +15827,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the list of OpenShift hosts.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -9623,67 +15875,57 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift host.",
+      }
+    },
+    "/clusters/{cluster_id}/install-schedule": {
+      "put": {
+        "description": "Schedules the installation of the OpenShift cluster. The installation starts once the schedule's window opens and the cluster is ready.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterHost",
+        "operationId": "ScheduleClusterInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster into which the host should be registered.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the host being registered.",
-            "name": "new-host-params",
+            "name": "install-schedule-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/install-schedule-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the host.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/cluster"
             }
           },
           "400": {
@@ -9717,7 +15959,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new hosts due to its current state.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9727,55 +15969,30 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the details of the OpenShift host.",
+      },
+      "delete": {
+        "description": "Removes the installation schedule of the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHost",
+        "operationId": "UnscheduleClusterInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster whose installation schedule is to be removed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be retrieved.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -9802,6 +16019,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9809,39 +16032,39 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/clusters/{cluster_id}/installation-report": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Reports the timeline of the latest installation of the cluster, with the duration of the phases of the cluster and of the stages of its hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "GetClusterInstallationReport",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster whose installation is being reported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be deregistered.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/installation-report"
             }
           },
           "401": {
@@ -9862,12 +16085,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9877,36 +16094,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/clusters/{cluster_id}/ip-leases": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the addresses leased to the hosts of the cluster from its IP pool.",
         "tags": [
           "installer"
         ],
-        "operationId": "EnableHost",
+        "operationId": "ListClusterIPLeases",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster whose leases are listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being enabled.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/ip_lease_list"
             }
           },
           "401": {
@@ -9933,12 +16151,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9947,35 +16159,41 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      "post": {
+        "description": "Leases an address from the IP pool of the cluster to a host, and adds the static network config of the host, generated with the address, to the cluster. The discovery ISO of the cluster is regenerated when it was already generated.",
         "tags": [
           "installer"
         ],
-        "operationId": "DisableHost",
+        "operationId": "AllocateClusterIPLease",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The cluster from whose IP pool the address is leased.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being disabled.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "name": "ip-lease-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ip_lease_params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/ip_lease"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10017,37 +16235,33 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+    "/clusters/{cluster_id}/ip-leases/{mac_address}": {
+      "delete": {
+        "description": "Releases the address leased to the host that has the MAC address, and removes the static network config of the host from the cluster. The discovery ISO of the cluster is regenerated when it was already generated.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHost",
+        "operationId": "ReleaseClusterIPLease",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster whose lease is released.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
+            "description": "The MAC address of the lease.",
+            "name": "mac_address",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10067,6 +16281,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -10082,36 +16302,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/clusters/{cluster_id}/ip-pool": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Returns the IP pool of the cluster, from which the addresses of its static-IP hosts are allocated.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetHost",
+        "operationId": "GetClusterIPPool",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster whose IP pool is returned.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/ip_pool"
             }
           },
           "401": {
@@ -10132,8 +16353,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10145,50 +16366,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+      },
+      "put": {
+        "description": "Replaces the IP pool of the cluster. The addresses that are already leased must remain in the pool.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "UpdateClusterIPPool",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster whose IP pool is replaced.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "name": "ip-pool",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ip_pool"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/ip_pool"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10211,6 +16422,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -10226,7 +16443,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
+    "/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
           {
@@ -10237,33 +16454,43 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
+        "description": "Download cluster logs.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
@@ -10308,47 +16535,62 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Get the customized ignition file for this host",
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
+            "description": "The host whose logs should be uploaded.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10368,68 +16610,55 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/clusters/{cluster_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/logs-progress-params"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10455,60 +16684,54 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -10548,49 +16771,48 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "GetNextSteps",
+        "operationId": "CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is retrieving instructions.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10617,73 +16839,58 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "PostStepReply",
+        "operationId": "DeleteClusterManifest",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is posting results.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
           {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -10708,14 +16915,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10723,44 +16930,46 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+    "/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Download host logs.",
+        "description": "Downloads cluster manifest.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "DownloadHostLogs",
-        "deprecated": true,
+        "operationId": "DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "path",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
             "required": true
           }
         ],
@@ -10808,56 +17017,43 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/monitored_operators": {
+      "get": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
+        "operationId": "ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -10877,63 +17073,60 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
+      },
       "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "UpdateHostLogsProgress",
+        "operationId": "ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The operators monitor report.",
+            "name": "report-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/operator-monitor-report"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -10980,54 +17173,38 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallProgress",
+        "operationId": "GetPreflightRequirements",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
+            "description": "The cluster to return preflight requrements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to retrieve.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/preflight-hardware-requirements"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11058,17 +17235,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/install-config": {
+    "/clusters/{cluster_id}/static-network-configs": {
       "get": {
         "security": [
           {
@@ -11079,16 +17250,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Lists the static network configs of the hosts of the cluster, that are included in its discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterInstallConfig",
+        "operationId": "ListClusterStaticNetworkConfigs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster whose static network configs are listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -11098,7 +17269,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/host_static_network_config_list"
             }
           },
           "401": {
@@ -11133,34 +17304,36 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Override values in the install config.",
+      "post": {
+        "description": "Adds the static network config of a host to the cluster. The discovery ISO of the cluster is regenerated when it was already generated.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterInstallConfig",
+        "operationId": "CreateClusterStaticNetworkConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster to which the static network config is added.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "name": "static-network-config",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/host_static_network_config"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_static_network_config"
+            }
           },
           "400": {
             "description": "Error.",
@@ -11192,6 +17365,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11201,61 +17380,120 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
-      "get": {
-        "security": [
+    "/clusters/{cluster_id}/static-network-configs/preview": {
+      "post": {
+        "description": "Validates static network configs of the hosts of the cluster and returns the NetworkManager keyfiles generated from them, without changing the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "PreviewClusterStaticNetworkConfigs",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose static network configs are previewed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
-            "urlAuth": []
+            "name": "preview-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/static_network_config_preview_params"
+            }
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/static_network_config_preview"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/static-network-configs/{mac_address}": {
+      "put": {
+        "description": "Replaces the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterLogs",
+        "operationId": "UpdateClusterStaticNetworkConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster whose static network config is replaced.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
             "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
+            "description": "A MAC address in the mac interface map of the static network config.",
+            "name": "mac_address",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
+            "name": "static-network-config",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host_static_network_config"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host_static_network_config"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11296,54 +17534,27 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+      "delete": {
+        "description": "Removes the static network config of the host that has the MAC address. The discovery ISO of the cluster is regenerated when it was already generated.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadLogs",
+        "operationId": "DeleteClusterStaticNetworkConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster whose static network config is removed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "A MAC address in the mac interface map of the static network config.",
+            "name": "mac_address",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -11368,14 +17579,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11383,40 +17600,52 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs_progress": {
-      "put": {
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterLogsProgress",
+        "operationId": "UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11442,12 +17671,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11463,33 +17686,58 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests": {
+    "/clusters/{cluster_id}/watch": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Streams the status transitions of a cluster and of its hosts, together with the cluster events, as Server-Sent Events. Every message carries a cursor in its id field, which can be used to resume the watch.",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "manifests"
+          "watch"
         ],
-        "operationId": "ListClusterManifests",
+        "operationId": "WatchCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster to watch.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the last received message, sent by clients that reconnect in order to resume the watch.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the last received message, for clients that can't set the Last-Event-ID header. Ignored if the header is set.",
+            "name": "cursor",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "Success. A stream of 'cluster-status', 'host-status' and 'event' messages, whose data is a status-update or an event.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11516,12 +17764,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11529,48 +17771,39 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/webhooks": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Lists the webhooks that receive the events of a cluster.",
         "tags": [
-          "manifests"
+          "webhooks"
         ],
-        "operationId": "CreateClusterManifest",
+        "operationId": "ListClusterWebhooks",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster whose webhooks should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/webhook-list"
             }
           },
           "401": {
@@ -11597,12 +17830,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11611,43 +17838,51 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a webhook that receives the events of a cluster.",
         "tags": [
-          "manifests"
+          "webhooks"
         ],
-        "operationId": "DeleteClusterManifest",
+        "operationId": "RegisterClusterWebhook",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose events should be delivered to the webhook.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The webhook to register.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11673,12 +17908,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11688,55 +17917,42 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests/files": {
-      "get": {
+    "/clusters/{cluster_id}/webhooks/{webhook_id}": {
+      "delete": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "user"
+            ]
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Deregisters a webhook of a cluster.",
         "tags": [
-          "manifests"
+          "webhooks"
         ],
-        "operationId": "DownloadClusterManifest",
+        "operationId": "DeregisterClusterWebhook",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster whose webhook should be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The webhook to deregister.",
+            "name": "webhook_id",
+            "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11762,10 +17978,62 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
             }
           },
           "500": {
@@ -11777,40 +18045,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/monitored_operators": {
+    "/host_requirements": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Get minimum host requirements.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ListOfClusterOperators",
+        "operationId": "GetHostRequirements",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return operators for.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
+            "type": "boolean",
+            "default": false,
+            "description": "Get hw requirements for single node.",
+            "name": "single_node",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/host-requirements"
             }
           },
           "401": {
@@ -11825,12 +18089,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -11844,46 +18102,55 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/openshift_versions": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Retrieves the list of OpenShift supported versions.",
         "tags": [
-          "operators",
-          "installer"
+          "versions"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+        "operationId": "ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
           },
-          {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
+          "503": {
+            "description": "Unavailable.",
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
         ],
+        "operationId": "ListSupportedOperators",
         "responses": {
           "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "401": {
@@ -11898,40 +18165,16 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/preflight-requirements": {
+    "/supported-operators/{operator_name}": {
       "get": {
         "security": [
           {
@@ -11942,17 +18185,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Lists properties for an operator.",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "GetPreflightRequirements",
+        "operationId": "ListOperatorProperties",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
-            "name": "cluster_id",
+            "description": "The operator name.",
+            "name": "operator_name",
             "in": "path",
             "required": true
           }
@@ -11961,7 +18203,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/operator-properties"
             }
           },
           "401": {
@@ -11982,12 +18224,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11997,47 +18233,29 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/tokens": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Lists the API tokens of the user. Admins list the API tokens of all the users.",
         "tags": [
-          "installer"
+          "tokens"
         ],
-        "operationId": "UploadClusterIngressCert",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
+        "operationId": "ListAPITokens",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/api-token-list"
             }
           },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success."
-          },
           "400": {
             "description": "Error.",
             "schema": {
@@ -12056,80 +18274,62 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/component_versions": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "List of component versions.",
+        "description": "Creates an API token. The token is only returned in the response and cannot be retrieved later. Admins may create tokens for other users and service accounts.",
         "tags": [
-          "versions"
+          "tokens"
         ],
-        "operationId": "ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/domains": {
-      "get": {
-        "security": [
+        "operationId": "CreateAPIToken",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
+            "description": "The API token to create.",
+            "name": "new-api-token-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/api-token-create-params"
+            }
+          }
         ],
-        "operationId": "ListManagedDomains",
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/api-token-created"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
             }
           },
           "500": {
@@ -12141,36 +18341,39 @@ func init() {
         }
       }
     },
-    "/host_requirements": {
-      "get": {
+    "/tokens/{token_id}": {
+      "delete": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Get minimum host requirements.",
+        "description": "Revokes an API token of the user. Admins may revoke the API tokens of all the users.",
         "tags": [
-          "installer"
+          "tokens"
         ],
-        "operationId": "GetHostRequirements",
+        "operationId": "RevokeAPIToken",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Get hw requirements for single node.",
-            "name": "single_node",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "The API token to revoke.",
+            "name": "token_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host-requirements"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12185,8 +18388,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -12200,7 +18403,7 @@ func init() {
         }
       }
     },
-    "/openshift_versions": {
+    "/webhooks": {
       "get": {
         "security": [
           {
@@ -12211,42 +18414,74 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Lists the webhooks that receive the events of all the clusters of the user's organization.",
         "tags": [
-          "versions"
+          "webhooks"
         ],
-        "operationId": "ListSupportedOpenshiftVersions",
+        "operationId": "ListWebhooks",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/webhook-list"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a webhook that receives the events of all the clusters of the user's organization.",
         "tags": [
-          "operators"
+          "webhooks"
+        ],
+        "operationId": "RegisterWebhook",
+        "parameters": [
+          {
+            "description": "The webhook to register.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
+          }
         ],
-        "operationId": "ListSupportedOperators",
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12270,37 +18505,34 @@ func init() {
         }
       }
     },
-    "/supported-operators/{operator_name}": {
-      "get": {
+    "/webhooks/{webhook_id}": {
+      "delete": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Deregisters a webhook of the user's organization.",
         "tags": [
-          "operators"
+          "webhooks"
         ],
-        "operationId": "ListOperatorProperties",
+        "operationId": "DeregisterWebhook",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The webhook to deregister.",
+            "name": "webhook_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/operator-properties"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12347,6 +18579,18 @@ func init() {
         }
       }
     },
+    "ClusterUpdateParamsHostsFailureDomainsItems0": {
+      "type": "object",
+      "properties": {
+        "failure_domain": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
     "ClusterUpdateParamsHostsMachineConfigPoolNamesItems0": {
       "type": "object",
       "properties": {
@@ -12488,6 +18732,17 @@ func init() {
           "description": "api vip domain.",
           "type": "string"
         },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the hosts of the OpenShift cluster (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ]
+        },
         "id": {
           "description": "Unique identifier of the object.",
           "type": "string",
@@ -12503,6 +18758,118 @@ func init() {
         }
       }
     },
+    "api-token": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "user_name",
+        "role"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "created_by": {
+          "description": "The user that created the API token.",
+          "type": "string"
+        },
+        "expires_at": {
+          "description": "The time that the API token expires. Not set for API tokens that do not expire.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the API token.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "last_used_at": {
+          "description": "The last time that the API token authenticated a request.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "A name that describes the usage of the API token.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/api-token-role"
+        },
+        "user_name": {
+          "description": "The user or service account that the API token authenticates.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "api-token-create-params": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "expires_at": {
+          "description": "The time that the API token expires. If not set, the API token expires after the default lifetime of API tokens.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "A name that describes the usage of the API token.",
+          "type": "string",
+          "minLength": 1
+        },
+        "role": {
+          "$ref": "#/definitions/api-token-role"
+        },
+        "user_name": {
+          "description": "The user or service account that the API token authenticates. Only admins may create API tokens for other users. Defaults to the user that creates the API token.",
+          "type": "string"
+        }
+      }
+    },
+    "api-token-created": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/api-token"
+        },
+        {
+          "type": "object",
+          "required": [
+            "token"
+          ],
+          "properties": {
+            "token": {
+              "description": "The API token. It is only returned when the API token is created.",
+              "type": "string"
+            }
+          }
+        }
+      ]
+    },
+    "api-token-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/api-token"
+      }
+    },
+    "api-token-role": {
+      "description": "The role of the user that the API token authenticates.",
+      "type": "string",
+      "enum": [
+        "admin",
+        "read-only-admin",
+        "user"
+      ]
+    },
     "api_vip_connectivity_request": {
       "type": "object",
       "required": [
@@ -12586,6 +18953,15 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "api_vips": {
+          "description": "The API virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the api_vip.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string"
@@ -12601,6 +18977,14 @@ func init() {
           "maximum": 128,
           "minimum": 1
         },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
         "connectivity_majority_groups": {
           "description": "Json formatted string containing the majority groups for connectivity checks.",
           "type": "string",
@@ -12616,11 +19000,16 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
         "created_at": {
           "description": "The time that this cluster was created.",
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
         "deleted_at": {
           "description": "The time that the cluster was deleted.",
@@ -12660,6 +19049,11 @@ func init() {
           },
           "x-go-custom-tag": "gorm:\"-\""
         },
+        "host_validation_rules": {
+          "description": "JSON-formatted list of the user-defined host validation rules of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "hosts": {
           "description": "Hosts that are associated with this cluster.",
           "type": "array",
@@ -12713,6 +19107,15 @@ func init() {
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
         },
+        "ingress_vips": {
+          "description": "The Ingress virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the ingress_vip.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
         "install_completed_at": {
           "description": "The time that this cluster completed installation.",
           "type": "string",
@@ -12725,12 +19128,21 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:text\"",
           "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
         },
+        "install_schedule": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:install_schedule_\"",
+          "$ref": "#/definitions/install-schedule"
+        },
         "install_started_at": {
           "description": "The time that this cluster started installation.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
         },
+        "ip_pool": {
+          "description": "JSON-formatted IP pool of the cluster, from which the addresses of its static-IP hosts are allocated.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "kind": {
           "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
           "type": "string",
@@ -12749,6 +19161,15 @@ func init() {
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
+        "machine_networks": {
+          "description": "The machine networks of the cluster, with the IPv4 network first in dual-stack clusters. Multi-subnet clusters, whose hosts are in several routed subnets, have several networks of the same IP address family and require user-managed networking. The first network is also the machine_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
         "monitored_operators": {
           "description": "Operators that are associated with this cluster.",
           "type": "array",
@@ -12797,6 +19218,15 @@ func init() {
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
@@ -12849,6 +19279,11 @@ func init() {
         "user_name": {
           "type": "string"
         },
+        "validation_policy": {
+          "description": "JSON-formatted validation policy of the cluster, that overrides the service-wide disabled validations and thresholds.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "validations_info": {
           "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
           "type": "string",
@@ -12861,6 +19296,65 @@ func init() {
         }
       }
     },
+    "cluster-access": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "user_name",
+        "role"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that is shared.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "granted_by": {
+          "description": "The user that shared the cluster.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/cluster-access-role"
+        },
+        "user_name": {
+          "description": "The user that the cluster is shared with.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key;index\""
+        }
+      }
+    },
+    "cluster-access-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-access"
+      }
+    },
+    "cluster-access-params": {
+      "type": "object",
+      "required": [
+        "role"
+      ],
+      "properties": {
+        "role": {
+          "$ref": "#/definitions/cluster-access-role"
+        }
+      }
+    },
+    "cluster-access-role": {
+      "description": "The role of a user that a cluster is shared with. Viewers may read the cluster, installers may also download its credentials and install it, and editors may also update it. Only the owner may share and deregister the cluster.\n",
+      "type": "string",
+      "enum": [
+        "viewer",
+        "installer",
+        "editor"
+      ]
+    },
     "cluster-create-params": {
       "type": "object",
       "required": [
@@ -12879,41 +19373,296 @@ func init() {
           "type": "string"
         },
         "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          }
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ]
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          }
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-export": {
+      "description": "A portable document of the configuration of a cluster, that equivalent clusters may be imported from.",
+      "type": "object",
+      "required": [
+        "version",
+        "cluster"
+      ],
+      "properties": {
+        "cluster": {
+          "$ref": "#/definitions/cluster-export-settings"
+        },
+        "discovery_ignition_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the discovery ignition.",
+          "type": "string"
+        },
+        "hosts": {
+          "description": "The settings of the hosts, that are applied to the hosts that register to the imported cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-export-host"
+          }
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-export-manifest"
+          }
+        },
+        "version": {
+          "description": "The version of the format of the document.",
+          "type": "string",
+          "enum": [
+            "v1"
+          ]
+        }
+      }
+    },
+    "cluster-export-host": {
+      "type": "object",
+      "required": [
+        "mac_addresses"
+      ],
+      "properties": {
+        "hostname": {
+          "description": "The requested hostname of the host.",
+          "type": "string"
+        },
+        "mac_addresses": {
+          "description": "The MAC addresses of the interfaces of the host. A host that registers to the imported cluster gets the settings if one of its interfaces has one of these MAC addresses.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "machine_config_pool_name": {
+          "description": "The machine config pool of the host.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        }
+      }
+    },
+    "cluster-export-manifest": {
+      "type": "object",
+      "required": [
+        "folder",
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "The content of the manifest.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The file name of the manifest.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The folder of the manifest.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "cluster-export-settings": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API. Not imported when the virtual IPs are allocated by DHCP.",
+          "type": "string"
+        },
+        "api_vips": {
+          "description": "The API virtual IPs of a dual-stack cluster, with the IPv4 address first. Not imported with user-managed networking.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string"
         },
         "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
+          "description": "The subnet prefix length to assign to each individual node.",
+          "type": "integer"
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, with the IPv4 network first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          },
+          "x-omitempty": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image.",
+          "type": "string"
         },
         "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "description": "Guaranteed availability of the installed cluster.",
           "type": "string",
-          "default": "Full",
           "enum": [
             "Full",
             "None"
           ]
         },
+        "host_validation_rules": {
+          "$ref": "#/definitions/host-validation-rules"
+        },
         "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.",
+          "type": "string"
         },
         "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.",
+          "type": "string"
         },
         "hyperthreading": {
           "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
           "type": "string",
-          "default": "all",
           "enum": [
             "masters",
             "workers",
@@ -12922,20 +19671,40 @@ func init() {
           ]
         },
         "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          "description": "The virtual IP used for cluster ingress traffic. Not imported when the virtual IPs are allocated by DHCP.",
+          "type": "string"
+        },
+        "ingress_vips": {
+          "description": "The Ingress virtual IPs of a dual-stack cluster, with the IPv4 address first. Not imported with user-managed networking.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "ip_pool": {
+          "description": "The IP pool from which the addresses of the static-IP hosts are allocated.",
+          "$ref": "#/definitions/ip_pool"
+        },
+        "machine_network_cidr": {
+          "description": "The CIDR of the machine network. Only imported when the virtual IPs are allocated by DHCP.",
+          "type": "string"
+        },
+        "machine_networks": {
+          "description": "The machine networks of a dual-stack or multi-subnet cluster, with the IPv4 network first. Only imported with user-managed networking.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
         "name": {
           "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
+          "type": "string"
         },
         "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
+          "description": "A comma-separated list of destinations to exclude from proxying.",
+          "type": "string"
         },
         "ocp_release_image": {
           "description": "OpenShift release image URI.",
@@ -12952,31 +19721,41 @@ func init() {
           "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses.",
           "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, with the IPv4 network first.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
         },
+        "static_network_config": {
+          "description": "The static network configs of the hosts, that are included in the discovery ISO.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          },
+          "x-omitempty": true
+        },
         "user_managed_networking": {
           "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
+          "type": "boolean"
+        },
+        "validation_policy": {
+          "description": "Overrides the service-wide disabled validations and thresholds for the cluster.",
+          "$ref": "#/definitions/validation-policy"
         },
         "vip_dhcp_allocation": {
           "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": true,
-          "x-nullable": true
+          "type": "boolean"
         }
       }
     },
@@ -13044,12 +19823,52 @@ func init() {
         "$ref": "#/definitions/cluster-host-requirements"
       }
     },
+    "cluster-import-params": {
+      "type": "object",
+      "required": [
+        "pull_secret",
+        "document"
+      ],
+      "properties": {
+        "document": {
+          "description": "The exported document of a cluster, in YAML or JSON.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the imported cluster. Defaults to the name in the document.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "pull_secret": {
+          "description": "The pull secret of the imported cluster, since pull secrets are not exported.",
+          "type": "string"
+        }
+      }
+    },
     "cluster-list": {
       "type": "array",
       "items": {
         "$ref": "#/definitions/cluster"
       }
     },
+    "cluster-network": {
+      "description": "A network from which Pod IPs are allocated.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node out of the cidr.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        }
+      }
+    },
     "cluster-update-params": {
       "type": "object",
       "properties": {
@@ -13069,6 +19888,14 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "api_vips": {
+          "description": "The API virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the api_vip.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string",
@@ -13087,6 +19914,13 @@ func init() {
           "minimum": 1,
           "x-nullable": true
         },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the cluster_network_cidr and cluster_network_host_prefix.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          }
+        },
         "disks_selected_config": {
           "type": "array",
           "items": {
@@ -13094,6 +19928,14 @@ func init() {
           },
           "x-nullable": true
         },
+        "hosts_failure_domains": {
+          "description": "The failure domains of hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusterUpdateParamsHostsFailureDomainsItems0"
+          },
+          "x-nullable": true
+        },
         "hosts_machine_config_pool_names": {
           "description": "The desired machine config pool for hosts associated with the cluster.",
           "type": "array",
@@ -13145,12 +19987,28 @@ func init() {
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
           "x-nullable": true
         },
+        "ingress_vips": {
+          "description": "The Ingress virtual IPs of a dual-stack cluster, at most one of each IP address family with the IPv4 address first. The first address is also the ingress_vip.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
         "machine_network_cidr": {
           "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
           "x-nullable": true
         },
+        "machine_networks": {
+          "description": "The machine networks of the cluster, with the IPv4 network first in dual-stack clusters. Multi-subnet clusters, whose hosts are in several routed subnets, have several networks of the same IP address family and require user-managed networking. The first network is also the machine_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          }
+        },
         "name": {
           "description": "OpenShift cluster name.",
           "type": "string",
@@ -13181,6 +20039,14 @@ func init() {
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
           "x-nullable": true
         },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, at most one of each IP address family with the IPv4 network first. The first network is also the service_network_cidr.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          }
+        },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string",
@@ -13191,6 +20057,10 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "validation_policy": {
+          "description": "Overrides the service-wide disabled validations and thresholds for the cluster.",
+          "$ref": "#/definitions/validation-policy"
+        },
         "vip_dhcp_allocation": {
           "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
           "type": "boolean",
@@ -13218,7 +20088,9 @@ func init() {
         "ntp-server-configured",
         "lso-requirements-satisfied",
         "ocs-requirements-satisfied",
-        "cnv-requirements-satisfied"
+        "cnv-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "lvm-requirements-satisfied"
       ]
     },
     "cluster_default_config": {
@@ -13417,6 +20289,33 @@ func init() {
         }
       }
     },
+    "cpu-architecture-images": {
+      "type": "object",
+      "required": [
+        "release_image",
+        "rhcos_image",
+        "rhcos_rootfs",
+        "rhcos_version"
+      ],
+      "properties": {
+        "release_image": {
+          "description": "The installation image of the OpenShift cluster for this CPU architecture.",
+          "type": "string"
+        },
+        "rhcos_image": {
+          "description": "The base RHCOS image used for the discovery iso for this CPU architecture.",
+          "type": "string"
+        },
+        "rhcos_rootfs": {
+          "description": "The RHCOS rootfs url for this CPU architecture.",
+          "type": "string"
+        },
+        "rhcos_version": {
+          "description": "Build ID of the RHCOS image for this CPU architecture.",
+          "type": "string"
+        }
+      }
+    },
     "create-manifest-params": {
       "type": "object",
       "required": [
@@ -13765,7 +20664,7 @@ func init() {
         "event_time": {
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
         "host_id": {
           "description": "Unique identifier of the host this event relates to.",
@@ -13917,10 +20816,19 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "failure_domain": {
+          "description": "The failure domain of the host, such as its rack. The automatic role assignment spreads the masters across failure domains.",
+          "type": "string"
+        },
         "free_addresses": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "hardware_baseline": {
+          "description": "JSON-formatted inventory of the host when its role was assigned or its installation disk was selected, that the hardware of the host is validated against.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "href": {
           "description": "Self link.",
           "type": "string"
@@ -14091,6 +20999,79 @@ func init() {
         }
       }
     },
+    "host-installation-report": {
+      "description": "The timeline of the installation of a host.",
+      "type": "object",
+      "properties": {
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "completed_at": {
+          "description": "The time the installation of the host completed, empty if the installation of the host is still in progress.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "duration_seconds": {
+          "description": "The duration of the installation of the host, until it completed or until the report was generated.",
+          "type": "integer"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "stages": {
+          "description": "The installation stages of the host, oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-stage"
+          }
+        },
+        "started_at": {
+          "description": "The time the host started its first installation stage.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "The status of the host.",
+          "type": "string"
+        }
+      }
+    },
+    "host-inventory-history": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-inventory-snapshot"
+      }
+    },
+    "host-inventory-snapshot": {
+      "description": "An inventory reported by a host, that differs from the previous inventory of the host.",
+      "type": "object",
+      "properties": {
+        "changes": {
+          "description": "The changes of the inventory from the previous snapshot, empty for the first snapshot.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inventory-change"
+          }
+        },
+        "inventory": {
+          "description": "The JSON-formatted inventory of the host.",
+          "type": "string"
+        },
+        "reported_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "host-list": {
       "type": "array",
       "items": {
@@ -14164,20 +21145,56 @@ func init() {
         "installation_disk_speed_threshold_ms": {
           "type": "integer"
         },
-        "ram_gib": {
+        "ram_gib": {
+          "type": "integer"
+        }
+      }
+    },
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-assignment": {
+      "type": "object",
+      "properties": {
+        "assignable": {
+          "description": "Whether the role of the host may be assigned automatically, as opposed to a role that was set by the user.",
+          "type": "boolean"
+        },
+        "current_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "failure_domain": {
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "reasons": {
+          "description": "The reasons for the proposed role.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "score": {
+          "description": "The capacity of the host as a master, from 0 to 100, relative to the other hosts that may be assigned automatically.",
           "type": "integer"
         }
       }
     },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker",
-        "bootstrap"
-      ]
-    },
     "host-role-update-params": {
       "type": "string",
       "enum": [
@@ -14256,9 +21273,44 @@ func init() {
         "sufficient-installation-disk-speed",
         "cnv-requirements-satisfied",
         "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role"
+        "sufficient-packet-loss-requirement-for-role",
+        "compatible-cpu-architecture",
+        "sriov-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "hardware-unchanged",
+        "mtu-consistent"
       ]
     },
+    "host-validation-rule": {
+      "description": "A user-defined validation of the hosts, that blocks the hosts from being ready for installation until it succeeds.",
+      "type": "object",
+      "required": [
+        "id",
+        "expression"
+      ],
+      "properties": {
+        "description": {
+          "description": "A human-readable description of the requirement, that is reported when the validation fails.",
+          "type": "string"
+        },
+        "expression": {
+          "description": "A JMESPath expression that is evaluated over the inventory of the host, and that must evaluate to true for the validation to succeed.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The identifier of the rule. The validation is reported in the validations info of the hosts as custom-\u003cid\u003e.",
+          "type": "string",
+          "maxLength": 63,
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        }
+      }
+    },
+    "host-validation-rules": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-validation-rule"
+      }
+    },
     "host_network": {
       "type": "object",
       "properties": {
@@ -14317,6 +21369,35 @@ func init() {
         }
       }
     },
+    "host_static_network_config_list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host_static_network_config"
+      }
+    },
+    "host_static_network_config_preview": {
+      "type": "object",
+      "properties": {
+        "files": {
+          "description": "The NetworkManager keyfiles generated from the network yaml, as written to the discovery ISO.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static_network_config_file"
+          }
+        },
+        "mac_interface_map": {
+          "description": "The mac interface map of the static network config.",
+          "$ref": "#/definitions/mac_interface_map"
+        },
+        "validation_errors": {
+          "description": "The problems found in the static network config, such as addresses that are configured for other hosts, are VIPs of the cluster or are not in its machine networks.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "image-create-params": {
       "type": "object",
       "properties": {
@@ -14329,6 +21410,7 @@ func init() {
           "type": "string"
         },
         "static_network_config": {
+          "description": "The static network configs of the hosts. The static network configs of the cluster are kept when not set.",
           "type": "array",
           "items": {
             "$ref": "#/definitions/host_static_network_config"
@@ -14403,6 +21485,203 @@ func init() {
     "ingress-cert-params": {
       "type": "string"
     },
+    "install-schedule": {
+      "type": "object",
+      "properties": {
+        "hosts_known_for_minutes": {
+          "description": "The installation only starts once all the hosts have been known for this number of minutes.",
+          "type": "integer"
+        },
+        "not_after": {
+          "description": "The end of the installation window.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "not_before": {
+          "description": "The installation does not start before this time.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "Pending until the installation is triggered by the schedule, or the schedule is canceled.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "triggered",
+            "canceled"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "status_info": {
+          "description": "Additional information on the schedule status.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        }
+      }
+    },
+    "install-schedule-params": {
+      "type": "object",
+      "properties": {
+        "hosts_known_for_minutes": {
+          "description": "The installation only starts once all the hosts have been known for this number of minutes.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "not_after": {
+          "description": "The end of the installation window. If the installation does not start before this time, the schedule is canceled.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "not_before": {
+          "description": "The installation does not start before this time. If not set, it starts as soon as the policy is met.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "installation-bootstrap": {
+      "description": "The time the bootstrap host waited for the control plane of the cluster.",
+      "type": "object",
+      "properties": {
+        "completed_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "duration_seconds": {
+          "type": "integer"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "installation-failure": {
+      "description": "A failure of the cluster, or of one of its hosts, during the installation.",
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "The failed host, empty for failures of the cluster.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "stage": {
+          "description": "The last installation stage of the host, or the last phase of the cluster, before the failure.",
+          "type": "string"
+        }
+      }
+    },
+    "installation-report": {
+      "description": "The timeline of the latest installation of a cluster.",
+      "type": "object",
+      "properties": {
+        "bootstrap": {
+          "$ref": "#/definitions/installation-bootstrap"
+        },
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "completed_at": {
+          "description": "The time the installation completed, empty if the installation is still in progress.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "duration_seconds": {
+          "description": "The duration of the installation, until it completed or until the report was generated.",
+          "type": "integer"
+        },
+        "failures": {
+          "description": "The failures of the cluster and of its hosts during the installation.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-failure"
+          }
+        },
+        "hosts": {
+          "description": "The installation reports of the hosts of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-installation-report"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "phases": {
+          "description": "The phases of the installation of the cluster, named after the statuses of the cluster, oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-stage"
+          }
+        },
+        "started_at": {
+          "description": "The time the installation started, empty if the cluster was not installed yet.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "The status of the cluster.",
+          "type": "string"
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "installation-stage": {
+      "description": "A stage of the installation of a host, or a phase of the installation of a cluster.",
+      "type": "object",
+      "properties": {
+        "completed_at": {
+          "description": "The time the stage completed, empty if the stage is still in progress.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "duration_seconds": {
+          "description": "The duration of the stage, until it completed or until the report was generated.",
+          "type": "integer"
+        },
+        "info": {
+          "description": "The progress info of the host stage, or the status info of the cluster, when the stage started.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The host stage, or the status of the cluster.",
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "installer-args-params": {
       "type": "object",
       "properties": {
@@ -14452,6 +21731,13 @@ func init() {
             "type": "string"
           }
         },
+        "lower_interfaces": {
+          "description": "The names of the interfaces that the interface is on, which are the ports of a bond or a bridge, or the parent interface of a VLAN.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "mac_address": {
           "type": "string"
         },
@@ -14467,8 +21753,53 @@ func init() {
         "speed_mbps": {
           "type": "integer"
         },
+        "type": {
+          "description": "The type of the interface, such as ethernet, bond, vlan or bridge.",
+          "type": "string"
+        },
         "vendor": {
           "type": "string"
+        },
+        "vlan_id": {
+          "description": "The VLAN ID of a VLAN interface.",
+          "type": "integer"
+        }
+      }
+    },
+    "inventory-change": {
+      "description": "A change of the hardware or of the network interfaces of a host between two inventories.",
+      "type": "object",
+      "properties": {
+        "current": {
+          "description": "The current value, empty for removed disks and interfaces.",
+          "type": "string"
+        },
+        "description": {
+          "description": "A human-readable description of the change.",
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "disk-added",
+            "disk-removed",
+            "disk-size-changed",
+            "interface-added",
+            "interface-removed",
+            "interface-link-changed",
+            "interface-speed-changed",
+            "interface-addresses-changed",
+            "memory-changed",
+            "cpu-changed"
+          ]
+        },
+        "previous": {
+          "description": "The previous value, empty for added disks and interfaces.",
+          "type": "string"
+        },
+        "subject": {
+          "description": "The disk or the interface that changed, empty for memory and CPU changes.",
+          "type": "string"
         }
       }
     },
@@ -14499,38 +21830,127 @@ func init() {
             "$ref": "#/definitions/gpu"
           }
         },
-        "hostname": {
+        "hostname": {
+          "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
+        },
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
+          }
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        },
+        "timestamp": {
+          "type": "integer"
+        }
+      }
+    },
+    "io_perf": {
+      "type": "object",
+      "properties": {
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
+          "type": "integer"
+        }
+      }
+    },
+    "ip_lease": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "The leased address.",
+          "type": "string"
+        },
+        "allocated_at": {
+          "description": "The time at which the address was leased.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "mac_address": {
+          "description": "The MAC address of the host interface that the address is configured on.",
           "type": "string"
+        }
+      }
+    },
+    "ip_lease_list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ip_lease"
+      }
+    },
+    "ip_lease_params": {
+      "type": "object",
+      "required": [
+        "mac_interface_map"
+      ],
+      "properties": {
+        "mac_interface_map": {
+          "description": "The mac interface map of the host. The address is configured on the interface of the first MAC address.",
+          "$ref": "#/definitions/mac_interface_map"
+        }
+      }
+    },
+    "ip_pool": {
+      "type": "object",
+      "required": [
+        "cidr",
+        "gateway"
+      ],
+      "properties": {
+        "cidr": {
+          "description": "The subnet from which the addresses of the hosts are allocated.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
-        "interfaces": {
+        "dns_servers": {
+          "description": "The DNS servers of the hosts.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/interface"
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
           }
         },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "routes": {
+        "excluded_ranges": {
+          "description": "The ranges of addresses of the subnet that are not allocated, in addition to the gateway, the DNS servers and the VIPs of the cluster.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/route"
+            "$ref": "#/definitions/ip_range"
           }
         },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
-        },
-        "timestamp": {
-          "type": "integer"
+        "gateway": {
+          "description": "The default gateway of the hosts, in the subnet.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
         }
       }
     },
-    "io_perf": {
+    "ip_range": {
       "type": "object",
+      "required": [
+        "start",
+        "end"
+      ],
       "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
+        "end": {
+          "description": "The last address of the range.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "start": {
+          "description": "The first address of the range.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
         }
       }
     },
@@ -14648,7 +22068,9 @@ func init() {
         "provider": {
           "type": "string",
           "enum": [
-            "route53"
+            "route53",
+            "rfc2136",
+            "powerdns"
           ]
         }
       }
@@ -14684,12 +22106,20 @@ func init() {
     "monitored-operator": {
       "type": "object",
       "properties": {
+        "channel": {
+          "description": "Subscription channel of the operator selected by the user.",
+          "type": "string"
+        },
         "cluster_id": {
           "description": "The cluster that this operator is associated with.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
+        "csv": {
+          "description": "ClusterServiceVersion the subscription of the operator starts from, selected by the user. OLM upgrades the operator to the later releases of the channel.",
+          "type": "string"
+        },
         "name": {
           "description": "Unique name of the operator.",
           "type": "string",
@@ -14710,6 +22140,10 @@ func init() {
         "status": {
           "$ref": "#/definitions/operator-status"
         },
+        "status_history": {
+          "$ref": "#/definitions/operator-status-history",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "status_info": {
           "description": "Detailed information about the operator state.",
           "type": "string"
@@ -14783,6 +22217,13 @@ func init() {
         "support_level"
       ],
       "properties": {
+        "cpu_architectures": {
+          "description": "Images of additional CPU architectures keyed by architecture name. The top level images are used for x86_64.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/cpu-architecture-images"
+          }
+        },
         "default": {
           "description": "Indication that the version is the recommended one.",
           "type": "boolean"
@@ -14831,6 +22272,14 @@ func init() {
     "operator-create-params": {
       "type": "object",
       "properties": {
+        "channel": {
+          "description": "Subscription channel of the operator. Must be compatible with the OpenShift version of the cluster, the default channel of the operator is used if not set.",
+          "type": "string"
+        },
+        "csv": {
+          "description": "ClusterServiceVersion the subscription of the operator starts from, e.g. an older release of the channel. OLM upgrades the operator to the later releases of the channel, so the version is not pinned. The latest release of the channel is installed if not set.",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
@@ -14942,6 +22391,30 @@ func init() {
         "available"
       ]
     },
+    "operator-status-change": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        },
+        "updated_at": {
+          "description": "Time at which the operator changed to the status.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "operator-status-history": {
+      "description": "The status changes of an operator, oldest first.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-status-change"
+      }
+    },
     "operator-type": {
       "description": "Kind of operator. Different types are monitored by the service differently.",
       "type": "string",
@@ -14977,6 +22450,38 @@ func init() {
         }
       }
     },
+    "role-assignment": {
+      "description": "The roles proposed for the hosts of a cluster by the automatic role assignment.",
+      "type": "object",
+      "properties": {
+        "applied": {
+          "description": "Whether the roles of the hosts were changed to the proposed roles.",
+          "type": "boolean"
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-role-assignment"
+          }
+        },
+        "warnings": {
+          "description": "Requirements that the proposed assignment does not satisfy.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "role-assignment-params": {
+      "type": "object",
+      "properties": {
+        "dry_run": {
+          "description": "Only propose the assignment, without changing the roles of the hosts.",
+          "type": "boolean"
+        }
+      }
+    },
     "route": {
       "type": "object",
       "properties": {
@@ -15010,6 +22515,83 @@ func init() {
         "unreachable"
       ]
     },
+    "static_network_config_file": {
+      "type": "object",
+      "properties": {
+        "contents": {
+          "description": "The contents of the file.",
+          "type": "string"
+        },
+        "path": {
+          "description": "The path of the file, relative to the static network config directory of the discovery ISO.",
+          "type": "string"
+        }
+      }
+    },
+    "static_network_config_preview": {
+      "type": "object",
+      "required": [
+        "valid",
+        "hosts"
+      ],
+      "properties": {
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config_preview"
+          }
+        },
+        "valid": {
+          "description": "Whether none of the static network configs has validation errors.",
+          "type": "boolean"
+        }
+      }
+    },
+    "static_network_config_preview_params": {
+      "type": "object",
+      "properties": {
+        "static_network_config": {
+          "description": "The static network configs to preview. The static network configs of the cluster are previewed when not set.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "status-update": {
+      "description": "A status transition of a cluster or of a host, streamed by the watch API.",
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "status"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this update relates to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "host_id": {
+          "description": "The host that this update relates to. Not set for cluster status updates.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "description": "The current status.",
+          "type": "string"
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status.",
+          "type": "string"
+        },
+        "status_updated_at": {
+          "description": "The last time that the status was updated.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -15130,6 +22712,53 @@ func init() {
         }
       }
     },
+    "validation-policy": {
+      "description": "The validation policy of a cluster, that overrides the service-wide disabled validations and thresholds for the cluster.",
+      "type": "object",
+      "properties": {
+        "disabled_cluster_validations": {
+          "description": "Identifiers of cluster validations that are disabled for the cluster.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "disabled_host_validations": {
+          "description": "Identifiers of host validations that are disabled for the hosts of the cluster, in addition to the service-wide disabled host validations.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Overrides the maximum allowed 99th percentile of fdatasync durations of the installation disk, in milliseconds.",
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "max_time_diff_minutes": {
+          "description": "Overrides the maximum allowed difference between the clocks of the hosts of the cluster, in minutes.",
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "network_latency_threshold_ms": {
+          "description": "Overrides the maximum allowed network latency between the hosts of the cluster, in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Overrides the maximum allowed percentage of packet loss between the hosts of the cluster.",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0,
+          "x-nullable": true
+        }
+      }
+    },
     "versioned-host-requirements": {
       "type": "object",
       "properties": {
@@ -15159,6 +22788,69 @@ func init() {
       "additionalProperties": {
         "type": "string"
       }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster whose events are delivered to the webhook. Not set for webhooks that receive the events of all the clusters of the organization.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "id": {
+          "description": "Unique identifier of the webhook.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "org_id": {
+          "description": "The organization whose events are delivered to the webhook.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "url": {
+          "description": "The URL that events are posted to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "user_name": {
+          "description": "The user that registered the webhook.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-create-params": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "secret": {
+          "description": "A shared secret used to sign the delivered payloads. The HMAC-SHA256 signature of each payload is sent in the X-Assisted-Signature header.",
+          "type": "string"
+        },
+        "url": {
+          "description": "The http or https URL that events are posted to.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook"
+      }
     }
   },
   "securityDefinitions": {
@@ -15187,6 +22879,10 @@ func init() {
     }
   ],
   "tags": [
+    {
+      "description": "Sharing of clusters with other users.",
+      "name": "access"
+    },
     {
       "description": "Agent-driven installation",
       "name": "Assisted installation"
@@ -15199,6 +22895,10 @@ func init() {
       "description": "Events related to a cluster installation.",
       "name": "events"
     },
+    {
+      "description": "Export and import of the configuration of clusters.",
+      "name": "export"
+    },
     {
       "description": "General OpenShift cluster installation APIs.",
       "name": "installer"
@@ -15215,9 +22915,21 @@ func init() {
       "description": "Information regarding supported operators.",
       "name": "operators"
     },
+    {
+      "description": "API tokens for the local authentication of users and service accounts.",
+      "name": "tokens"
+    },
     {
       "description": "Information regarding versions.",
       "name": "versions"
+    },
+    {
+      "description": "Streaming updates of a cluster installation.",
+      "name": "watch"
+    },
+    {
+      "description": "Webhooks that receive the events of cluster installations.",
+      "name": "webhooks"
     }
   ]
 }`))
@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,13 +20,18 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openshift/assisted-service/restapi/operations/access"
 	"github.com/openshift/assisted-service/restapi/operations/assisted_service_iso"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/export"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/tokens"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/watch"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 // NewAssistedInstallAPI creates a new AssistedInstall instance
@@ -51,6 +57,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
 		InstallerCancelInstallationHandler: installer.CancelInstallationHandlerFunc(func(params installer.CancelInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.CancelInstallation has not yet been implemented")
@@ -58,6 +67,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerCompleteInstallationHandler: installer.CompleteInstallationHandlerFunc(func(params installer.CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.CompleteInstallation has not yet been implemented")
 		}),
+		TokensCreateAPITokenHandler: tokens.CreateAPITokenHandlerFunc(func(params tokens.CreateAPITokenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tokens.CreateAPIToken has not yet been implemented")
+		}),
 		ManifestsCreateClusterManifestHandler: manifests.CreateClusterManifestHandlerFunc(func(params manifests.CreateClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.CreateClusterManifest has not yet been implemented")
 		}),
@@ -70,9 +82,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerDeregisterClusterHandler: installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterCluster has not yet been implemented")
 		}),
+		WebhooksDeregisterClusterWebhookHandler: webhooks.DeregisterClusterWebhookHandlerFunc(func(params webhooks.DeregisterClusterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.DeregisterClusterWebhook has not yet been implemented")
+		}),
 		InstallerDeregisterHostHandler: installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterHost has not yet been implemented")
 		}),
+		WebhooksDeregisterWebhookHandler: webhooks.DeregisterWebhookHandlerFunc(func(params webhooks.DeregisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.DeregisterWebhook has not yet been implemented")
+		}),
 		InstallerDisableHostHandler: installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DisableHost has not yet been implemented")
 		}),
@@ -106,6 +124,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerEnableHostHandler: installer.EnableHostHandlerFunc(func(params installer.EnableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.EnableHost has not yet been implemented")
 		}),
+		ExportExportClusterHandler: export.ExportClusterHandlerFunc(func(params export.ExportClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation export.ExportCluster has not yet been implemented")
+		}),
 		InstallerGenerateClusterISOHandler: installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GenerateClusterISO has not yet been implemented")
 		}),
@@ -121,6 +142,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetClusterInstallConfigHandler: installer.GetClusterInstallConfigHandlerFunc(func(params installer.GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerGetClusterInstallationReportHandler: installer.GetClusterInstallationReportHandlerFunc(func(params installer.GetClusterInstallationReportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterInstallationReport has not yet been implemented")
+		}),
 		InstallerGetCredentialsHandler: installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCredentials has not yet been implemented")
 		}),
@@ -136,6 +160,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetHostIgnitionHandler: installer.GetHostIgnitionHandlerFunc(func(params installer.GetHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHostIgnition has not yet been implemented")
 		}),
+		InstallerGetHostInventoryHistoryHandler: installer.GetHostInventoryHistoryHandlerFunc(func(params installer.GetHostInventoryHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetHostInventoryHistory has not yet been implemented")
+		}),
 		InstallerGetHostRequirementsHandler: installer.GetHostRequirementsHandlerFunc(func(params installer.GetHostRequirementsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHostRequirements has not yet been implemented")
 		}),
@@ -151,6 +178,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetPresignedForClusterFilesHandler: installer.GetPresignedForClusterFilesHandlerFunc(func(params installer.GetPresignedForClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetPresignedForClusterFiles has not yet been implemented")
 		}),
+		AccessGrantClusterAccessHandler: access.GrantClusterAccessHandlerFunc(func(params access.GrantClusterAccessParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation access.GrantClusterAccess has not yet been implemented")
+		}),
+		ExportImportClusterHandler: export.ImportClusterHandlerFunc(func(params export.ImportClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation export.ImportCluster has not yet been implemented")
+		}),
 		InstallerInstallClusterHandler: installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.InstallCluster has not yet been implemented")
 		}),
@@ -160,9 +193,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerInstallHostsHandler: installer.InstallHostsHandlerFunc(func(params installer.InstallHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.InstallHosts has not yet been implemented")
 		}),
+		TokensListAPITokensHandler: tokens.ListAPITokensHandlerFunc(func(params tokens.ListAPITokensParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tokens.ListAPITokens has not yet been implemented")
+		}),
+		AccessListClusterAccessHandler: access.ListClusterAccessHandlerFunc(func(params access.ListClusterAccessParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation access.ListClusterAccess has not yet been implemented")
+		}),
 		ManifestsListClusterManifestsHandler: manifests.ListClusterManifestsHandlerFunc(func(params manifests.ListClusterManifestsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.ListClusterManifests has not yet been implemented")
 		}),
+		WebhooksListClusterWebhooksHandler: webhooks.ListClusterWebhooksHandlerFunc(func(params webhooks.ListClusterWebhooksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ListClusterWebhooks has not yet been implemented")
+		}),
 		InstallerListClustersHandler: installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListClusters has not yet been implemented")
 		}),
@@ -172,6 +214,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		EventsListEventsHandler: events.ListEventsHandlerFunc(func(params events.ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.ListEvents has not yet been implemented")
 		}),
+		InstallerListHostValidationRulesHandler: installer.ListHostValidationRulesHandlerFunc(func(params installer.ListHostValidationRulesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListHostValidationRules has not yet been implemented")
+		}),
 		InstallerListHostsHandler: installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListHosts has not yet been implemented")
 		}),
@@ -190,6 +235,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		OperatorsListSupportedOperatorsHandler: operators.ListSupportedOperatorsHandlerFunc(func(params operators.ListSupportedOperatorsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.ListSupportedOperators has not yet been implemented")
 		}),
+		WebhooksListWebhooksHandler: webhooks.ListWebhooksHandlerFunc(func(params webhooks.ListWebhooksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ListWebhooks has not yet been implemented")
+		}),
 		InstallerPostStepReplyHandler: installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.PostStepReply has not yet been implemented")
 		}),
@@ -199,9 +247,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerRegisterClusterHandler: installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterCluster has not yet been implemented")
 		}),
+		WebhooksRegisterClusterWebhookHandler: webhooks.RegisterClusterWebhookHandlerFunc(func(params webhooks.RegisterClusterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.RegisterClusterWebhook has not yet been implemented")
+		}),
 		InstallerRegisterHostHandler: installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterHost has not yet been implemented")
 		}),
+		WebhooksRegisterWebhookHandler: webhooks.RegisterWebhookHandlerFunc(func(params webhooks.RegisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.RegisterWebhook has not yet been implemented")
+		}),
 		OperatorsReportMonitoredOperatorStatusHandler: operators.ReportMonitoredOperatorStatusHandlerFunc(func(params operators.ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
@@ -214,6 +268,51 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerResetHostValidationHandler: installer.ResetHostValidationHandlerFunc(func(params installer.ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ResetHostValidation has not yet been implemented")
 		}),
+		TokensRevokeAPITokenHandler: tokens.RevokeAPITokenHandlerFunc(func(params tokens.RevokeAPITokenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation tokens.RevokeAPIToken has not yet been implemented")
+		}),
+		AccessRevokeClusterAccessHandler: access.RevokeClusterAccessHandlerFunc(func(params access.RevokeClusterAccessParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation access.RevokeClusterAccess has not yet been implemented")
+		}),
+		InstallerScheduleClusterInstallationHandler: installer.ScheduleClusterInstallationHandlerFunc(func(params installer.ScheduleClusterInstallationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ScheduleClusterInstallation has not yet been implemented")
+		}),
+		InstallerAssignClusterRolesHandler: installer.AssignClusterRolesHandlerFunc(func(params installer.AssignClusterRolesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.AssignClusterRoles has not yet been implemented")
+		}),
+		InstallerListClusterStaticNetworkConfigsHandler: installer.ListClusterStaticNetworkConfigsHandlerFunc(func(params installer.ListClusterStaticNetworkConfigsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterStaticNetworkConfigs has not yet been implemented")
+		}),
+		InstallerCreateClusterStaticNetworkConfigHandler: installer.CreateClusterStaticNetworkConfigHandlerFunc(func(params installer.CreateClusterStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.CreateClusterStaticNetworkConfig has not yet been implemented")
+		}),
+		InstallerUpdateClusterStaticNetworkConfigHandler: installer.UpdateClusterStaticNetworkConfigHandlerFunc(func(params installer.UpdateClusterStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateClusterStaticNetworkConfig has not yet been implemented")
+		}),
+		InstallerDeleteClusterStaticNetworkConfigHandler: installer.DeleteClusterStaticNetworkConfigHandlerFunc(func(params installer.DeleteClusterStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DeleteClusterStaticNetworkConfig has not yet been implemented")
+		}),
+		InstallerPreviewClusterStaticNetworkConfigsHandler: installer.PreviewClusterStaticNetworkConfigsHandlerFunc(func(params installer.PreviewClusterStaticNetworkConfigsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.PreviewClusterStaticNetworkConfigs has not yet been implemented")
+		}),
+		InstallerGetClusterIPPoolHandler: installer.GetClusterIPPoolHandlerFunc(func(params installer.GetClusterIPPoolParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterIPPool has not yet been implemented")
+		}),
+		InstallerUpdateClusterIPPoolHandler: installer.UpdateClusterIPPoolHandlerFunc(func(params installer.UpdateClusterIPPoolParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateClusterIPPool has not yet been implemented")
+		}),
+		InstallerListClusterIPLeasesHandler: installer.ListClusterIPLeasesHandlerFunc(func(params installer.ListClusterIPLeasesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterIPLeases has not yet been implemented")
+		}),
+		InstallerAllocateClusterIPLeaseHandler: installer.AllocateClusterIPLeaseHandlerFunc(func(params installer.AllocateClusterIPLeaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.AllocateClusterIPLease has not yet been implemented")
+		}),
+		InstallerReleaseClusterIPLeaseHandler: installer.ReleaseClusterIPLeaseHandlerFunc(func(params installer.ReleaseClusterIPLeaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ReleaseClusterIPLease has not yet been implemented")
+		}),
+		InstallerUnscheduleClusterInstallationHandler: installer.UnscheduleClusterInstallationHandlerFunc(func(params installer.UnscheduleClusterInstallationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UnscheduleClusterInstallation has not yet been implemented")
+		}),
 		InstallerUpdateClusterHandler: installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateCluster has not yet been implemented")
 		}),
@@ -238,6 +337,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUpdateHostLogsProgressHandler: installer.UpdateHostLogsProgressHandlerFunc(func(params installer.UpdateHostLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateHostLogsProgress has not yet been implemented")
 		}),
+		InstallerUpdateHostValidationRulesHandler: installer.UpdateHostValidationRulesHandlerFunc(func(params installer.UpdateHostValidationRulesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateHostValidationRules has not yet been implemented")
+		}),
 		InstallerUploadClusterIngressCertHandler: installer.UploadClusterIngressCertHandlerFunc(func(params installer.UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UploadClusterIngressCert has not yet been implemented")
 		}),
@@ -247,6 +349,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUploadLogsHandler: installer.UploadLogsHandlerFunc(func(params installer.UploadLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UploadLogs has not yet been implemented")
 		}),
+		WatchWatchClusterHandler: watch.WatchClusterHandlerFunc(func(params watch.WatchClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation watch.WatchCluster has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -301,6 +406,9 @@ type AssistedInstallAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// AgentAuthAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key X-Secret-Key provided in the header
@@ -321,6 +429,8 @@ type AssistedInstallAPI struct {
 	InstallerCancelInstallationHandler installer.CancelInstallationHandler
 	// InstallerCompleteInstallationHandler sets the operation handler for the complete installation operation
 	InstallerCompleteInstallationHandler installer.CompleteInstallationHandler
+	// TokensCreateAPITokenHandler sets the operation handler for the create API token operation
+	TokensCreateAPITokenHandler tokens.CreateAPITokenHandler
 	// ManifestsCreateClusterManifestHandler sets the operation handler for the create cluster manifest operation
 	ManifestsCreateClusterManifestHandler manifests.CreateClusterManifestHandler
 	// AssistedServiceIsoCreateISOAndUploadToS3Handler sets the operation handler for the create i s o and upload to s3 operation
@@ -329,8 +439,12 @@ type AssistedInstallAPI struct {
 	ManifestsDeleteClusterManifestHandler manifests.DeleteClusterManifestHandler
 	// InstallerDeregisterClusterHandler sets the operation handler for the deregister cluster operation
 	InstallerDeregisterClusterHandler installer.DeregisterClusterHandler
+	// WebhooksDeregisterClusterWebhookHandler sets the operation handler for the deregister cluster webhook operation
+	WebhooksDeregisterClusterWebhookHandler webhooks.DeregisterClusterWebhookHandler
 	// InstallerDeregisterHostHandler sets the operation handler for the deregister host operation
 	InstallerDeregisterHostHandler installer.DeregisterHostHandler
+	// WebhooksDeregisterWebhookHandler sets the operation handler for the deregister webhook operation
+	WebhooksDeregisterWebhookHandler webhooks.DeregisterWebhookHandler
 	// InstallerDisableHostHandler sets the operation handler for the disable host operation
 	InstallerDisableHostHandler installer.DisableHostHandler
 	// InstallerDownloadClusterFilesHandler sets the operation handler for the download cluster files operation
@@ -353,6 +467,8 @@ type AssistedInstallAPI struct {
 	AssistedServiceIsoDownloadISOHandler assisted_service_iso.DownloadISOHandler
 	// InstallerEnableHostHandler sets the operation handler for the enable host operation
 	InstallerEnableHostHandler installer.EnableHostHandler
+	// ExportExportClusterHandler sets the operation handler for the export cluster operation
+	ExportExportClusterHandler export.ExportClusterHandler
 	// InstallerGenerateClusterISOHandler sets the operation handler for the generate cluster i s o operation
 	InstallerGenerateClusterISOHandler installer.GenerateClusterISOHandler
 	// InstallerGetClusterHandler sets the operation handler for the get cluster operation
@@ -363,6 +479,8 @@ type AssistedInstallAPI struct {
 	InstallerGetClusterHostRequirementsHandler installer.GetClusterHostRequirementsHandler
 	// InstallerGetClusterInstallConfigHandler sets the operation handler for the get cluster install config operation
 	InstallerGetClusterInstallConfigHandler installer.GetClusterInstallConfigHandler
+	// InstallerGetClusterInstallationReportHandler sets the operation handler for the get cluster installation report operation
+	InstallerGetClusterInstallationReportHandler installer.GetClusterInstallationReportHandler
 	// InstallerGetCredentialsHandler sets the operation handler for the get credentials operation
 	InstallerGetCredentialsHandler installer.GetCredentialsHandler
 	// InstallerGetDiscoveryIgnitionHandler sets the operation handler for the get discovery ignition operation
@@ -373,6 +491,8 @@ type AssistedInstallAPI struct {
 	InstallerGetHostHandler installer.GetHostHandler
 	// InstallerGetHostIgnitionHandler sets the operation handler for the get host ignition operation
 	InstallerGetHostIgnitionHandler installer.GetHostIgnitionHandler
+	// InstallerGetHostInventoryHistoryHandler sets the operation handler for the get host inventory history operation
+	InstallerGetHostInventoryHistoryHandler installer.GetHostInventoryHistoryHandler
 	// InstallerGetHostRequirementsHandler sets the operation handler for the get host requirements operation
 	InstallerGetHostRequirementsHandler installer.GetHostRequirementsHandler
 	// InstallerGetNextStepsHandler sets the operation handler for the get next steps operation
@@ -383,20 +503,32 @@ type AssistedInstallAPI struct {
 	AssistedServiceIsoGetPresignedForAssistedServiceISOHandler assisted_service_iso.GetPresignedForAssistedServiceISOHandler
 	// InstallerGetPresignedForClusterFilesHandler sets the operation handler for the get presigned for cluster files operation
 	InstallerGetPresignedForClusterFilesHandler installer.GetPresignedForClusterFilesHandler
+	// AccessGrantClusterAccessHandler sets the operation handler for the grant cluster access operation
+	AccessGrantClusterAccessHandler access.GrantClusterAccessHandler
+	// ExportImportClusterHandler sets the operation handler for the import cluster operation
+	ExportImportClusterHandler export.ImportClusterHandler
 	// InstallerInstallClusterHandler sets the operation handler for the install cluster operation
 	InstallerInstallClusterHandler installer.InstallClusterHandler
 	// InstallerInstallHostHandler sets the operation handler for the install host operation
 	InstallerInstallHostHandler installer.InstallHostHandler
 	// InstallerInstallHostsHandler sets the operation handler for the install hosts operation
 	InstallerInstallHostsHandler installer.InstallHostsHandler
+	// TokensListAPITokensHandler sets the operation handler for the list API tokens operation
+	TokensListAPITokensHandler tokens.ListAPITokensHandler
+	// AccessListClusterAccessHandler sets the operation handler for the list cluster access operation
+	AccessListClusterAccessHandler access.ListClusterAccessHandler
 	// ManifestsListClusterManifestsHandler sets the operation handler for the list cluster manifests operation
 	ManifestsListClusterManifestsHandler manifests.ListClusterManifestsHandler
+	// WebhooksListClusterWebhooksHandler sets the operation handler for the list cluster webhooks operation
+	WebhooksListClusterWebhooksHandler webhooks.ListClusterWebhooksHandler
 	// InstallerListClustersHandler sets the operation handler for the list clusters operation
 	InstallerListClustersHandler installer.ListClustersHandler
 	// VersionsListComponentVersionsHandler sets the operation handler for the list component versions operation
 	VersionsListComponentVersionsHandler versions.ListComponentVersionsHandler
 	// EventsListEventsHandler sets the operation handler for the list events operation
 	EventsListEventsHandler events.ListEventsHandler
+	// InstallerListHostValidationRulesHandler sets the operation handler for the list host validation rules operation
+	InstallerListHostValidationRulesHandler installer.ListHostValidationRulesHandler
 	// InstallerListHostsHandler sets the operation handler for the list hosts operation
 	InstallerListHostsHandler installer.ListHostsHandler
 	// ManagedDomainsListManagedDomainsHandler sets the operation handler for the list managed domains operation
@@ -409,14 +541,20 @@ type AssistedInstallAPI struct {
 	VersionsListSupportedOpenshiftVersionsHandler versions.ListSupportedOpenshiftVersionsHandler
 	// OperatorsListSupportedOperatorsHandler sets the operation handler for the list supported operators operation
 	OperatorsListSupportedOperatorsHandler operators.ListSupportedOperatorsHandler
+	// WebhooksListWebhooksHandler sets the operation handler for the list webhooks operation
+	WebhooksListWebhooksHandler webhooks.ListWebhooksHandler
 	// InstallerPostStepReplyHandler sets the operation handler for the post step reply operation
 	InstallerPostStepReplyHandler installer.PostStepReplyHandler
 	// InstallerRegisterAddHostsClusterHandler sets the operation handler for the register add hosts cluster operation
 	InstallerRegisterAddHostsClusterHandler installer.RegisterAddHostsClusterHandler
 	// InstallerRegisterClusterHandler sets the operation handler for the register cluster operation
 	InstallerRegisterClusterHandler installer.RegisterClusterHandler
+	// WebhooksRegisterClusterWebhookHandler sets the operation handler for the register cluster webhook operation
+	WebhooksRegisterClusterWebhookHandler webhooks.RegisterClusterWebhookHandler
 	// InstallerRegisterHostHandler sets the operation handler for the register host operation
 	InstallerRegisterHostHandler installer.RegisterHostHandler
+	// WebhooksRegisterWebhookHandler sets the operation handler for the register webhook operation
+	WebhooksRegisterWebhookHandler webhooks.RegisterWebhookHandler
 	// OperatorsReportMonitoredOperatorStatusHandler sets the operation handler for the report monitored operator status operation
 	OperatorsReportMonitoredOperatorStatusHandler operators.ReportMonitoredOperatorStatusHandler
 	// InstallerResetClusterHandler sets the operation handler for the reset cluster operation
@@ -425,6 +563,36 @@ type AssistedInstallAPI struct {
 	InstallerResetHostHandler installer.ResetHostHandler
 	// InstallerResetHostValidationHandler sets the operation handler for the reset host validation operation
 	InstallerResetHostValidationHandler installer.ResetHostValidationHandler
+	// TokensRevokeAPITokenHandler sets the operation handler for the revoke API token operation
+	TokensRevokeAPITokenHandler tokens.RevokeAPITokenHandler
+	// AccessRevokeClusterAccessHandler sets the operation handler for the revoke cluster access operation
+	AccessRevokeClusterAccessHandler access.RevokeClusterAccessHandler
+	// InstallerScheduleClusterInstallationHandler sets the operation handler for the schedule cluster installation operation
+	InstallerScheduleClusterInstallationHandler installer.ScheduleClusterInstallationHandler
+	// InstallerAssignClusterRolesHandler sets the operation handler for the assign cluster roles operation
+	InstallerAssignClusterRolesHandler installer.AssignClusterRolesHandler
+	// InstallerListClusterStaticNetworkConfigsHandler sets the operation handler for the list cluster static network configs operation
+	InstallerListClusterStaticNetworkConfigsHandler installer.ListClusterStaticNetworkConfigsHandler
+	// InstallerCreateClusterStaticNetworkConfigHandler sets the operation handler for the create cluster static network config operation
+	InstallerCreateClusterStaticNetworkConfigHandler installer.CreateClusterStaticNetworkConfigHandler
+	// InstallerUpdateClusterStaticNetworkConfigHandler sets the operation handler for the update cluster static network config operation
+	InstallerUpdateClusterStaticNetworkConfigHandler installer.UpdateClusterStaticNetworkConfigHandler
+	// InstallerDeleteClusterStaticNetworkConfigHandler sets the operation handler for the delete cluster static network config operation
+	InstallerDeleteClusterStaticNetworkConfigHandler installer.DeleteClusterStaticNetworkConfigHandler
+	// InstallerPreviewClusterStaticNetworkConfigsHandler sets the operation handler for the preview cluster static network configs operation
+	InstallerPreviewClusterStaticNetworkConfigsHandler installer.PreviewClusterStaticNetworkConfigsHandler
+	// InstallerGetClusterIPPoolHandler sets the operation handler for the get cluster i p pool operation
+	InstallerGetClusterIPPoolHandler installer.GetClusterIPPoolHandler
+	// InstallerUpdateClusterIPPoolHandler sets the operation handler for the update cluster i p pool operation
+	InstallerUpdateClusterIPPoolHandler installer.UpdateClusterIPPoolHandler
+	// InstallerListClusterIPLeasesHandler sets the operation handler for the list cluster i p leases operation
+	InstallerListClusterIPLeasesHandler installer.ListClusterIPLeasesHandler
+	// InstallerAllocateClusterIPLeaseHandler sets the operation handler for the allocate cluster i p lease operation
+	InstallerAllocateClusterIPLeaseHandler installer.AllocateClusterIPLeaseHandler
+	// InstallerReleaseClusterIPLeaseHandler sets the operation handler for the release cluster i p lease operation
+	InstallerReleaseClusterIPLeaseHandler installer.ReleaseClusterIPLeaseHandler
+	// InstallerUnscheduleClusterInstallationHandler sets the operation handler for the unschedule cluster installation operation
+	InstallerUnscheduleClusterInstallationHandler installer.UnscheduleClusterInstallationHandler
 	// InstallerUpdateClusterHandler sets the operation handler for the update cluster operation
 	InstallerUpdateClusterHandler installer.UpdateClusterHandler
 	// InstallerUpdateClusterInstallConfigHandler sets the operation handler for the update cluster install config operation
@@ -441,12 +609,16 @@ type AssistedInstallAPI struct {
 	InstallerUpdateHostInstallerArgsHandler installer.UpdateHostInstallerArgsHandler
 	// InstallerUpdateHostLogsProgressHandler sets the operation handler for the update host logs progress operation
 	InstallerUpdateHostLogsProgressHandler installer.UpdateHostLogsProgressHandler
+	// InstallerUpdateHostValidationRulesHandler sets the operation handler for the update host validation rules operation
+	InstallerUpdateHostValidationRulesHandler installer.UpdateHostValidationRulesHandler
 	// InstallerUploadClusterIngressCertHandler sets the operation handler for the upload cluster ingress cert operation
 	InstallerUploadClusterIngressCertHandler installer.UploadClusterIngressCertHandler
 	// InstallerUploadHostLogsHandler sets the operation handler for the upload host logs operation
 	InstallerUploadHostLogsHandler installer.UploadHostLogsHandler
 	// InstallerUploadLogsHandler sets the operation handler for the upload logs operation
 	InstallerUploadLogsHandler installer.UploadLogsHandler
+	// WatchWatchClusterHandler sets the operation handler for the watch cluster operation
+	WatchWatchClusterHandler watch.WatchClusterHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -528,6 +700,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.AgentAuthAuth == nil {
 		unregistered = append(unregistered, "XSecretKeyAuth")
@@ -545,6 +720,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerCompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.CompleteInstallationHandler")
 	}
+	if o.TokensCreateAPITokenHandler == nil {
+		unregistered = append(unregistered, "tokens.CreateAPITokenHandler")
+	}
 	if o.ManifestsCreateClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.CreateClusterManifestHandler")
 	}
@@ -557,9 +735,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerDeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterClusterHandler")
 	}
+	if o.WebhooksDeregisterClusterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.DeregisterClusterWebhookHandler")
+	}
 	if o.InstallerDeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterHostHandler")
 	}
+	if o.WebhooksDeregisterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.DeregisterWebhookHandler")
+	}
 	if o.InstallerDisableHostHandler == nil {
 		unregistered = append(unregistered, "installer.DisableHostHandler")
 	}
@@ -593,6 +777,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerEnableHostHandler == nil {
 		unregistered = append(unregistered, "installer.EnableHostHandler")
 	}
+	if o.ExportExportClusterHandler == nil {
+		unregistered = append(unregistered, "export.ExportClusterHandler")
+	}
 	if o.InstallerGenerateClusterISOHandler == nil {
 		unregistered = append(unregistered, "installer.GenerateClusterISOHandler")
 	}
@@ -608,6 +795,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterInstallConfigHandler")
 	}
+	if o.InstallerGetClusterInstallationReportHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterInstallationReportHandler")
+	}
 	if o.InstallerGetCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.GetCredentialsHandler")
 	}
@@ -623,6 +813,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostIgnitionHandler")
 	}
+	if o.InstallerGetHostInventoryHistoryHandler == nil {
+		unregistered = append(unregistered, "installer.GetHostInventoryHistoryHandler")
+	}
 	if o.InstallerGetHostRequirementsHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostRequirementsHandler")
 	}
@@ -638,6 +831,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetPresignedForClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.GetPresignedForClusterFilesHandler")
 	}
+	if o.AccessGrantClusterAccessHandler == nil {
+		unregistered = append(unregistered, "access.GrantClusterAccessHandler")
+	}
+	if o.ExportImportClusterHandler == nil {
+		unregistered = append(unregistered, "export.ImportClusterHandler")
+	}
 	if o.InstallerInstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.InstallClusterHandler")
 	}
@@ -647,9 +846,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerInstallHostsHandler == nil {
 		unregistered = append(unregistered, "installer.InstallHostsHandler")
 	}
+	if o.TokensListAPITokensHandler == nil {
+		unregistered = append(unregistered, "tokens.ListAPITokensHandler")
+	}
+	if o.AccessListClusterAccessHandler == nil {
+		unregistered = append(unregistered, "access.ListClusterAccessHandler")
+	}
 	if o.ManifestsListClusterManifestsHandler == nil {
 		unregistered = append(unregistered, "manifests.ListClusterManifestsHandler")
 	}
+	if o.WebhooksListClusterWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhooks.ListClusterWebhooksHandler")
+	}
 	if o.InstallerListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.ListClustersHandler")
 	}
@@ -659,6 +867,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.EventsListEventsHandler == nil {
 		unregistered = append(unregistered, "events.ListEventsHandler")
 	}
+	if o.InstallerListHostValidationRulesHandler == nil {
+		unregistered = append(unregistered, "installer.ListHostValidationRulesHandler")
+	}
 	if o.InstallerListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.ListHostsHandler")
 	}
@@ -677,6 +888,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.OperatorsListSupportedOperatorsHandler == nil {
 		unregistered = append(unregistered, "operators.ListSupportedOperatorsHandler")
 	}
+	if o.WebhooksListWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhooks.ListWebhooksHandler")
+	}
 	if o.InstallerPostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.PostStepReplyHandler")
 	}
@@ -686,9 +900,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerRegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterClusterHandler")
 	}
+	if o.WebhooksRegisterClusterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.RegisterClusterWebhookHandler")
+	}
 	if o.InstallerRegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterHostHandler")
 	}
+	if o.WebhooksRegisterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.RegisterWebhookHandler")
+	}
 	if o.OperatorsReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.ReportMonitoredOperatorStatusHandler")
 	}
@@ -701,6 +921,51 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.ResetHostValidationHandler")
 	}
+	if o.TokensRevokeAPITokenHandler == nil {
+		unregistered = append(unregistered, "tokens.RevokeAPITokenHandler")
+	}
+	if o.AccessRevokeClusterAccessHandler == nil {
+		unregistered = append(unregistered, "access.RevokeClusterAccessHandler")
+	}
+	if o.InstallerScheduleClusterInstallationHandler == nil {
+		unregistered = append(unregistered, "installer.ScheduleClusterInstallationHandler")
+	}
+	if o.InstallerAssignClusterRolesHandler == nil {
+		unregistered = append(unregistered, "installer.AssignClusterRolesHandler")
+	}
+	if o.InstallerListClusterStaticNetworkConfigsHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterStaticNetworkConfigsHandler")
+	}
+	if o.InstallerCreateClusterStaticNetworkConfigHandler == nil {
+		unregistered = append(unregistered, "installer.CreateClusterStaticNetworkConfigHandler")
+	}
+	if o.InstallerUpdateClusterStaticNetworkConfigHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateClusterStaticNetworkConfigHandler")
+	}
+	if o.InstallerDeleteClusterStaticNetworkConfigHandler == nil {
+		unregistered = append(unregistered, "installer.DeleteClusterStaticNetworkConfigHandler")
+	}
+	if o.InstallerPreviewClusterStaticNetworkConfigsHandler == nil {
+		unregistered = append(unregistered, "installer.PreviewClusterStaticNetworkConfigsHandler")
+	}
+	if o.InstallerGetClusterIPPoolHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterIPPoolHandler")
+	}
+	if o.InstallerUpdateClusterIPPoolHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateClusterIPPoolHandler")
+	}
+	if o.InstallerListClusterIPLeasesHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterIPLeasesHandler")
+	}
+	if o.InstallerAllocateClusterIPLeaseHandler == nil {
+		unregistered = append(unregistered, "installer.AllocateClusterIPLeaseHandler")
+	}
+	if o.InstallerReleaseClusterIPLeaseHandler == nil {
+		unregistered = append(unregistered, "installer.ReleaseClusterIPLeaseHandler")
+	}
+	if o.InstallerUnscheduleClusterInstallationHandler == nil {
+		unregistered = append(unregistered, "installer.UnscheduleClusterInstallationHandler")
+	}
 	if o.InstallerUpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterHandler")
 	}
@@ -725,6 +990,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUpdateHostLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateHostLogsProgressHandler")
 	}
+	if o.InstallerUpdateHostValidationRulesHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateHostValidationRulesHandler")
+	}
 	if o.InstallerUploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.UploadClusterIngressCertHandler")
 	}
@@ -734,6 +1002,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUploadLogsHandler == nil {
 		unregistered = append(unregistered, "installer.UploadLogsHandler")
 	}
+	if o.WatchWatchClusterHandler == nil {
+		unregistered = append(unregistered, "watch.WatchClusterHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -803,6 +1074,8 @@ func (o *AssistedInstallAPI) ProducersFor(mediaTypes []string) map[string]runtim
 			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -854,6 +1127,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/tokens"] = tokens.NewCreateAPIToken(o.context, o.TokensCreateAPITokenHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/manifests"] = manifests.NewCreateClusterManifest(o.context, o.ManifestsCreateClusterManifestHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -870,10 +1147,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/webhooks/{webhook_id}"] = webhooks.NewDeregisterClusterWebhook(o.context, o.WebhooksDeregisterClusterWebhookHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}/hosts/{host_id}"] = installer.NewDeregisterHost(o.context, o.InstallerDeregisterHostHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/webhooks/{webhook_id}"] = webhooks.NewDeregisterWebhook(o.context, o.WebhooksDeregisterWebhookHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}/hosts/{host_id}/actions/enable"] = installer.NewDisableHost(o.context, o.InstallerDisableHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -915,6 +1200,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/hosts/{host_id}/actions/enable"] = installer.NewEnableHost(o.context, o.InstallerEnableHostHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/export"] = export.NewExportCluster(o.context, o.ExportExportClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -938,6 +1227,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/installation-report"] = installer.NewGetClusterInstallationReport(o.context, o.InstallerGetClusterInstallationReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/credentials"] = installer.NewGetCredentials(o.context, o.InstallerGetCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -958,6 +1251,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/inventory-history"] = installer.NewGetHostInventoryHistory(o.context, o.InstallerGetHostInventoryHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/host_requirements"] = installer.NewGetHostRequirements(o.context, o.InstallerGetHostRequirementsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -975,6 +1272,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/clusters/{cluster_id}/downloads/files-presigned"] = installer.NewGetPresignedForClusterFiles(o.context, o.InstallerGetPresignedForClusterFilesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/access/{user_name}"] = access.NewGrantClusterAccess(o.context, o.AccessGrantClusterAccessHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/import"] = export.NewImportCluster(o.context, o.ExportImportClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -990,10 +1295,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/tokens"] = tokens.NewListAPITokens(o.context, o.TokensListAPITokensHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/access"] = access.NewListClusterAccess(o.context, o.AccessListClusterAccessHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/manifests"] = manifests.NewListClusterManifests(o.context, o.ManifestsListClusterManifestsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/webhooks"] = webhooks.NewListClusterWebhooks(o.context, o.WebhooksListClusterWebhooksHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters"] = installer.NewListClusters(o.context, o.InstallerListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1006,6 +1323,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/host-validation-rules"] = installer.NewListHostValidationRules(o.context, o.InstallerListHostValidationRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts"] = installer.NewListHosts(o.context, o.InstallerListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1027,6 +1348,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/supported-operators"] = operators.NewListSupportedOperators(o.context, o.OperatorsListSupportedOperatorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = webhooks.NewListWebhooks(o.context, o.WebhooksListWebhooksHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1042,7 +1367,15 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/clusters/{cluster_id}/webhooks"] = webhooks.NewRegisterClusterWebhook(o.context, o.WebhooksRegisterClusterWebhookHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/hosts"] = installer.NewRegisterHost(o.context, o.InstallerRegisterHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = webhooks.NewRegisterWebhook(o.context, o.WebhooksRegisterWebhookHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1059,6 +1392,66 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewResetHostValidation(o.context, o.InstallerResetHostValidationHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/tokens/{token_id}"] = tokens.NewRevokeAPIToken(o.context, o.TokensRevokeAPITokenHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/access/{user_name}"] = access.NewRevokeClusterAccess(o.context, o.AccessRevokeClusterAccessHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/install-schedule"] = installer.NewScheduleClusterInstallation(o.context, o.InstallerScheduleClusterInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/actions/assign-roles"] = installer.NewAssignClusterRoles(o.context, o.InstallerAssignClusterRolesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/static-network-configs"] = installer.NewListClusterStaticNetworkConfigs(o.context, o.InstallerListClusterStaticNetworkConfigsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/static-network-configs"] = installer.NewCreateClusterStaticNetworkConfig(o.context, o.InstallerCreateClusterStaticNetworkConfigHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/static-network-configs/{mac_address}"] = installer.NewUpdateClusterStaticNetworkConfig(o.context, o.InstallerUpdateClusterStaticNetworkConfigHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/static-network-configs/{mac_address}"] = installer.NewDeleteClusterStaticNetworkConfig(o.context, o.InstallerDeleteClusterStaticNetworkConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/static-network-configs/preview"] = installer.NewPreviewClusterStaticNetworkConfigs(o.context, o.InstallerPreviewClusterStaticNetworkConfigsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/ip-pool"] = installer.NewGetClusterIPPool(o.context, o.InstallerGetClusterIPPoolHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/ip-pool"] = installer.NewUpdateClusterIPPool(o.context, o.InstallerUpdateClusterIPPoolHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/ip-leases"] = installer.NewListClusterIPLeases(o.context, o.InstallerListClusterIPLeasesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/ip-leases"] = installer.NewAllocateClusterIPLease(o.context, o.InstallerAllocateClusterIPLeaseHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/ip-leases/{mac_address}"] = installer.NewReleaseClusterIPLease(o.context, o.InstallerReleaseClusterIPLeaseHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/install-schedule"] = installer.NewUnscheduleClusterInstallation(o.context, o.InstallerUnscheduleClusterInstallationHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1091,6 +1484,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/clusters/{cluster_id}/hosts/{host_id}/logs_progress"] = installer.NewUpdateHostLogsProgress(o.context, o.InstallerUpdateHostLogsProgressHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/host-validation-rules"] = installer.NewUpdateHostValidationRules(o.context, o.InstallerUpdateHostValidationRulesHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1103,6 +1500,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/logs"] = installer.NewUploadLogs(o.context, o.InstallerUploadLogsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/watch"] = watch.NewWatchCluster(o.context, o.WatchWatchClusterHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -6,6 +6,7 @@ package events
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -17,10 +18,18 @@ import (
 )
 
 // NewListEventsParams creates a new ListEventsParams object
-// no default values defined in spec.
+// with the default values initialized.
 func NewListEventsParams() ListEventsParams {
 
-	return ListEventsParams{}
+	var (
+		// initialize parameters with default values
+
+		orderDefault = string("asc")
+	)
+
+	return ListEventsParams{
+		Order: &orderDefault,
+	}
 }
 
 // ListEventsParams contains all the bound params for the list events operation
@@ -41,10 +50,40 @@ type ListEventsParams struct {
 	  In: path
 	*/
 	ClusterID strfmt.UUID
+	/*The cursor of the page to return, as returned in the Next-Cursor header of the previous page.
+	  In: query
+	*/
+	Cursor *string
+	/*If set, returned events are filtered to those that occurred after this time.
+	  In: query
+	*/
+	EventTimeAfter *strfmt.DateTime
+	/*If set, returned events are filtered to those that occurred before this time.
+	  In: query
+	*/
+	EventTimeBefore *strfmt.DateTime
 	/*A host in the specified cluster to return events for.
 	  In: query
 	*/
 	HostID *strfmt.UUID
+	/*The maximal number of events to return. The cursor of the next page is returned in the Next-Cursor header.
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
+	/*If set, returned events are filtered to those whose message contains this string, ignoring case.
+	  In: query
+	*/
+	Message *string
+	/*The order of the events by their time.
+	  In: query
+	  Default: "asc"
+	*/
+	Order *string
+	/*If non-empty, returned events are filtered to those with matching severities.
+	  In: query
+	*/
+	Severities []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -68,11 +107,46 @@ func (o *ListEventsParams) BindRequest(r *http.Request, route *middleware.Matche
 		res = append(res, err)
 	}
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qEventTimeAfter, qhkEventTimeAfter, _ := qs.GetOK("event_time_after")
+	if err := o.bindEventTimeAfter(qEventTimeAfter, qhkEventTimeAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qEventTimeBefore, qhkEventTimeBefore, _ := qs.GetOK("event_time_before")
+	if err := o.bindEventTimeBefore(qEventTimeBefore, qhkEventTimeBefore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qHostID, qhkHostID, _ := qs.GetOK("host_id")
 	if err := o.bindHostID(qHostID, qhkHostID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMessage, qhkMessage, _ := qs.GetOK("message")
+	if err := o.bindMessage(qMessage, qhkMessage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrder, qhkOrder, _ := qs.GetOK("order")
+	if err := o.bindOrder(qOrder, qhkOrder, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSeverities, qhkSeverities, _ := qs.GetOK("severities")
+	if err := o.bindSeverities(qSeverities, qhkSeverities, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -140,6 +214,96 @@ func (o *ListEventsParams) validateClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListEventsParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindEventTimeAfter binds and validates parameter EventTimeAfter from query.
+func (o *ListEventsParams) bindEventTimeAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("event_time_after", "query", "strfmt.DateTime", raw)
+	}
+	o.EventTimeAfter = (value.(*strfmt.DateTime))
+
+	if err := o.validateEventTimeAfter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateEventTimeAfter carries on validations for parameter EventTimeAfter
+func (o *ListEventsParams) validateEventTimeAfter(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("event_time_after", "query", "date-time", o.EventTimeAfter.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindEventTimeBefore binds and validates parameter EventTimeBefore from query.
+func (o *ListEventsParams) bindEventTimeBefore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("event_time_before", "query", "strfmt.DateTime", raw)
+	}
+	o.EventTimeBefore = (value.(*strfmt.DateTime))
+
+	if err := o.validateEventTimeBefore(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateEventTimeBefore carries on validations for parameter EventTimeBefore
+func (o *ListEventsParams) validateEventTimeBefore(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("event_time_before", "query", "date-time", o.EventTimeBefore.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
 // bindHostID binds and validates parameter HostID from query.
 func (o *ListEventsParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -175,3 +339,122 @@ func (o *ListEventsParams) validateHostID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListEventsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *ListEventsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMessage binds and validates parameter Message from query.
+func (o *ListEventsParams) bindMessage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Message = &raw
+
+	return nil
+}
+
+// bindOrder binds and validates parameter Order from query.
+func (o *ListEventsParams) bindOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListEventsParams()
+		return nil
+	}
+
+	o.Order = &raw
+
+	if err := o.validateOrder(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrder carries on validations for parameter Order
+func (o *ListEventsParams) validateOrder(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("order", "query", *o.Order, []interface{}{"asc", "desc"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSeverities binds and validates array parameter Severities from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *ListEventsParams) bindSeverities(rawData []string, hasKey bool, formats strfmt.Registry) error {
+
+	var qvSeverities string
+	if len(rawData) > 0 {
+		qvSeverities = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	severitiesIC := swag.SplitByFormat(qvSeverities, "")
+	if len(severitiesIC) == 0 {
+		return nil
+	}
+
+	var severitiesIR []string
+	for i, severitiesIV := range severitiesIC {
+		severitiesI := severitiesIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "severities", i), "query", severitiesI, []interface{}{"info", "warning", "error", "critical"}, true); err != nil {
+			return err
+		}
+
+		severitiesIR = append(severitiesIR, severitiesI)
+	}
+
+	o.Severities = severitiesIR
+
+	return nil
+}
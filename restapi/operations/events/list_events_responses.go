@@ -21,6 +21,10 @@ const ListEventsOKCode int = 200
 swagger:response listEventsOK
 */
 type ListEventsOK struct {
+	/*The cursor of the next page. Not set for the last page.
+
+	 */
+	NextCursor string `json:"Next-Cursor"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewListEventsOK() *ListEventsOK {
 	return &ListEventsOK{}
 }
 
+// WithNextCursor adds the nextCursor to the list events o k response
+func (o *ListEventsOK) WithNextCursor(nextCursor string) *ListEventsOK {
+	o.NextCursor = nextCursor
+	return o
+}
+
+// SetNextCursor sets the nextCursor to the list events o k response
+func (o *ListEventsOK) SetNextCursor(nextCursor string) {
+	o.NextCursor = nextCursor
+}
+
 // WithPayload adds the payload to the list events o k response
 func (o *ListEventsOK) WithPayload(payload models.EventList) *ListEventsOK {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *ListEventsOK) SetPayload(payload models.EventList) {
 // WriteResponse to the client
 func (o *ListEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Next-Cursor
+
+	nextCursor := o.NextCursor
+	if nextCursor != "" {
+		rw.Header().Set("Next-Cursor", nextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -60,6 +82,50 @@ func (o *ListEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pr
 	}
 }
 
+// ListEventsBadRequestCode is the HTTP code returned for type ListEventsBadRequest
+const ListEventsBadRequestCode int = 400
+
+/*ListEventsBadRequest Error.
+
+swagger:response listEventsBadRequest
+*/
+type ListEventsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListEventsBadRequest creates ListEventsBadRequest with default headers values
+func NewListEventsBadRequest() *ListEventsBadRequest {
+
+	return &ListEventsBadRequest{}
+}
+
+// WithPayload adds the payload to the list events bad request response
+func (o *ListEventsBadRequest) WithPayload(payload *models.Error) *ListEventsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list events bad request response
+func (o *ListEventsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListEventsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ListEventsUnauthorizedCode is the HTTP code returned for type ListEventsUnauthorized
 const ListEventsUnauthorizedCode int = 401
 
@@ -19,8 +19,15 @@ import (
 type ListEventsURL struct {
 	ClusterID strfmt.UUID
 
-	Categories []string
-	HostID     *strfmt.UUID
+	Categories      []string
+	Cursor          *string
+	EventTimeAfter  *strfmt.DateTime
+	EventTimeBefore *strfmt.DateTime
+	HostID          *strfmt.UUID
+	Limit           *int64
+	Message         *string
+	Order           *string
+	Severities      []string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -80,6 +87,30 @@ func (o *ListEventsURL) Build() (*url.URL, error) {
 		}
 	}
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var eventTimeAfterQ string
+	if o.EventTimeAfter != nil {
+		eventTimeAfterQ = o.EventTimeAfter.String()
+	}
+	if eventTimeAfterQ != "" {
+		qs.Set("event_time_after", eventTimeAfterQ)
+	}
+
+	var eventTimeBeforeQ string
+	if o.EventTimeBefore != nil {
+		eventTimeBeforeQ = o.EventTimeBefore.String()
+	}
+	if eventTimeBeforeQ != "" {
+		qs.Set("event_time_before", eventTimeBeforeQ)
+	}
+
 	var hostIDQ string
 	if o.HostID != nil {
 		hostIDQ = o.HostID.String()
@@ -88,6 +119,47 @@ func (o *ListEventsURL) Build() (*url.URL, error) {
 		qs.Set("host_id", hostIDQ)
 	}
 
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var messageQ string
+	if o.Message != nil {
+		messageQ = *o.Message
+	}
+	if messageQ != "" {
+		qs.Set("message", messageQ)
+	}
+
+	var orderQ string
+	if o.Order != nil {
+		orderQ = *o.Order
+	}
+	if orderQ != "" {
+		qs.Set("order", orderQ)
+	}
+
+	var severitiesIR []string
+	for _, severitiesI := range o.Severities {
+		severitiesIS := severitiesI
+		if severitiesIS != "" {
+			severitiesIR = append(severitiesIR, severitiesIS)
+		}
+	}
+
+	severities := swag.JoinByFormat(severitiesIR, "")
+
+	if len(severities) > 0 {
+		qsv := severities[0]
+		if qsv != "" {
+			qs.Set("severities", qsv)
+		}
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
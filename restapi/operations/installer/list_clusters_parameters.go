@@ -24,10 +24,17 @@ func NewListClustersParams() ListClustersParams {
 		// initialize parameters with default values
 
 		getUnregisteredClustersDefault = bool(false)
+
+		orderDefault  = string("asc")
+		sortByDefault = string("created_at")
 	)
 
 	return ListClustersParams{
 		GetUnregisteredClusters: &getUnregisteredClustersDefault,
+
+		Order: &orderDefault,
+
+		SortBy: &sortByDefault,
 	}
 }
 
@@ -44,15 +51,54 @@ type ListClustersParams struct {
 	  In: query
 	*/
 	AmsSubscriptionIds []string
+	/*If set, returned clusters are filtered to those created after this time.
+	  In: query
+	*/
+	CreatedAfter *strfmt.DateTime
+	/*If set, returned clusters are filtered to those created before this time.
+	  In: query
+	*/
+	CreatedBefore *strfmt.DateTime
+	/*The cursor of the page to return, as returned in the Next-Cursor header of the previous page.
+	  In: query
+	*/
+	Cursor *string
 	/*Whether to return clusters that have been unregistered.
 	  In: header
 	  Default: false
 	*/
 	GetUnregisteredClusters *bool
+	/*The maximal number of items to return. The cursor of the next page is returned in the Next-Cursor header.
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
+	/*If set, returned clusters are filtered to those whose name contains this string, ignoring case.
+	  In: query
+	*/
+	Name *string
 	/*A specific cluster to retrieve.
 	  In: query
 	*/
 	OpenshiftClusterID *strfmt.UUID
+	/*If set, returned clusters are filtered to those with this OpenShift version.
+	  In: query
+	*/
+	OpenshiftVersion *string
+	/*The sort order.
+	  In: query
+	  Default: "asc"
+	*/
+	Order *string
+	/*The field to sort the clusters by.
+	  In: query
+	  Default: "created_at"
+	*/
+	SortBy *string
+	/*If non-empty, returned clusters are filtered to those with matching statuses.
+	  In: query
+	*/
+	Status []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -71,15 +117,60 @@ func (o *ListClustersParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	qCreatedAfter, qhkCreatedAfter, _ := qs.GetOK("created_after")
+	if err := o.bindCreatedAfter(qCreatedAfter, qhkCreatedAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCreatedBefore, qhkCreatedBefore, _ := qs.GetOK("created_before")
+	if err := o.bindCreatedBefore(qCreatedBefore, qhkCreatedBefore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.bindGetUnregisteredClusters(r.Header[http.CanonicalHeaderKey("get_unregistered_clusters")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qName, qhkName, _ := qs.GetOK("name")
+	if err := o.bindName(qName, qhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qOpenshiftClusterID, qhkOpenshiftClusterID, _ := qs.GetOK("openshift_cluster_id")
 	if err := o.bindOpenshiftClusterID(qOpenshiftClusterID, qhkOpenshiftClusterID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qOpenshiftVersion, qhkOpenshiftVersion, _ := qs.GetOK("openshift_version")
+	if err := o.bindOpenshiftVersion(qOpenshiftVersion, qhkOpenshiftVersion, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrder, qhkOrder, _ := qs.GetOK("order")
+	if err := o.bindOrder(qOrder, qhkOrder, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSortBy, qhkSortBy, _ := qs.GetOK("sort_by")
+	if err := o.bindSortBy(qSortBy, qhkSortBy, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -114,6 +205,96 @@ func (o *ListClustersParams) bindAmsSubscriptionIds(rawData []string, hasKey boo
 	return nil
 }
 
+// bindCreatedAfter binds and validates parameter CreatedAfter from query.
+func (o *ListClustersParams) bindCreatedAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("created_after", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedAfter = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedAfter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedAfter carries on validations for parameter CreatedAfter
+func (o *ListClustersParams) validateCreatedAfter(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("created_after", "query", "date-time", o.CreatedAfter.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCreatedBefore binds and validates parameter CreatedBefore from query.
+func (o *ListClustersParams) bindCreatedBefore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("created_before", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedBefore = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedBefore(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedBefore carries on validations for parameter CreatedBefore
+func (o *ListClustersParams) validateCreatedBefore(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("created_before", "query", "date-time", o.CreatedBefore.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListClustersParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	return nil
+}
+
 // bindGetUnregisteredClusters binds and validates parameter GetUnregisteredClusters from header.
 func (o *ListClustersParams) bindGetUnregisteredClusters(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -137,6 +318,60 @@ func (o *ListClustersParams) bindGetUnregisteredClusters(rawData []string, hasKe
 	return nil
 }
 
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListClustersParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *ListClustersParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindName binds and validates parameter Name from query.
+func (o *ListClustersParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Name = &raw
+
+	return nil
+}
+
 // bindOpenshiftClusterID binds and validates parameter OpenshiftClusterID from query.
 func (o *ListClustersParams) bindOpenshiftClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -172,3 +407,115 @@ func (o *ListClustersParams) validateOpenshiftClusterID(formats strfmt.Registry)
 	}
 	return nil
 }
+
+// bindOpenshiftVersion binds and validates parameter OpenshiftVersion from query.
+func (o *ListClustersParams) bindOpenshiftVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.OpenshiftVersion = &raw
+
+	return nil
+}
+
+// bindOrder binds and validates parameter Order from query.
+func (o *ListClustersParams) bindOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListClustersParams()
+		return nil
+	}
+
+	o.Order = &raw
+
+	if err := o.validateOrder(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrder carries on validations for parameter Order
+func (o *ListClustersParams) validateOrder(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("order", "query", *o.Order, []interface{}{"asc", "desc"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSortBy binds and validates parameter SortBy from query.
+func (o *ListClustersParams) bindSortBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListClustersParams()
+		return nil
+	}
+
+	o.SortBy = &raw
+
+	if err := o.validateSortBy(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSortBy carries on validations for parameter SortBy
+func (o *ListClustersParams) validateSortBy(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sort_by", "query", *o.SortBy, []interface{}{"created_at", "name", "status_updated_at"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindStatus binds and validates array parameter Status from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *ListClustersParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+
+	var qvStatus string
+	if len(rawData) > 0 {
+		qvStatus = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	statusIC := swag.SplitByFormat(qvStatus, "")
+	if len(statusIC) == 0 {
+		return nil
+	}
+
+	var statusIR []string
+	for _, statusIV := range statusIC {
+		statusI := statusIV
+
+		statusIR = append(statusIR, statusI)
+	}
+
+	o.Status = statusIR
+
+	return nil
+}
@@ -21,6 +21,10 @@ const ListClustersOKCode int = 200
 swagger:response listClustersOK
 */
 type ListClustersOK struct {
+	/*The cursor of the next page. Not set for the last page.
+
+	 */
+	NextCursor string `json:"Next-Cursor"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewListClustersOK() *ListClustersOK {
 	return &ListClustersOK{}
 }
 
+// WithNextCursor adds the nextCursor to the list clusters o k response
+func (o *ListClustersOK) WithNextCursor(nextCursor string) *ListClustersOK {
+	o.NextCursor = nextCursor
+	return o
+}
+
+// SetNextCursor sets the nextCursor to the list clusters o k response
+func (o *ListClustersOK) SetNextCursor(nextCursor string) {
+	o.NextCursor = nextCursor
+}
+
 // WithPayload adds the payload to the list clusters o k response
 func (o *ListClustersOK) WithPayload(payload models.ClusterList) *ListClustersOK {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *ListClustersOK) SetPayload(payload models.ClusterList) {
 // WriteResponse to the client
 func (o *ListClustersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Next-Cursor
+
+	nextCursor := o.NextCursor
+	if nextCursor != "" {
+		rw.Header().Set("Next-Cursor", nextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -60,6 +82,50 @@ func (o *ListClustersOK) WriteResponse(rw http.ResponseWriter, producer runtime.
 	}
 }
 
+// ListClustersBadRequestCode is the HTTP code returned for type ListClustersBadRequest
+const ListClustersBadRequestCode int = 400
+
+/*ListClustersBadRequest Error.
+
+swagger:response listClustersBadRequest
+*/
+type ListClustersBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClustersBadRequest creates ListClustersBadRequest with default headers values
+func NewListClustersBadRequest() *ListClustersBadRequest {
+
+	return &ListClustersBadRequest{}
+}
+
+// WithPayload adds the payload to the list clusters bad request response
+func (o *ListClustersBadRequest) WithPayload(payload *models.Error) *ListClustersBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list clusters bad request response
+func (o *ListClustersBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClustersBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ListClustersUnauthorizedCode is the HTTP code returned for type ListClustersUnauthorized
 const ListClustersUnauthorizedCode int = 401
 
@@ -17,7 +17,16 @@ import (
 // ListClustersURL generates an URL for the list clusters operation
 type ListClustersURL struct {
 	AmsSubscriptionIds []string
+	CreatedAfter       *strfmt.DateTime
+	CreatedBefore      *strfmt.DateTime
+	Cursor             *string
+	Limit              *int64
+	Name               *string
 	OpenshiftClusterID *strfmt.UUID
+	OpenshiftVersion   *string
+	Order              *string
+	SortBy             *string
+	Status             []string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -70,6 +79,46 @@ func (o *ListClustersURL) Build() (*url.URL, error) {
 		}
 	}
 
+	var createdAfterQ string
+	if o.CreatedAfter != nil {
+		createdAfterQ = o.CreatedAfter.String()
+	}
+	if createdAfterQ != "" {
+		qs.Set("created_after", createdAfterQ)
+	}
+
+	var createdBeforeQ string
+	if o.CreatedBefore != nil {
+		createdBeforeQ = o.CreatedBefore.String()
+	}
+	if createdBeforeQ != "" {
+		qs.Set("created_before", createdBeforeQ)
+	}
+
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var nameQ string
+	if o.Name != nil {
+		nameQ = *o.Name
+	}
+	if nameQ != "" {
+		qs.Set("name", nameQ)
+	}
+
 	var openshiftClusterIDQ string
 	if o.OpenshiftClusterID != nil {
 		openshiftClusterIDQ = o.OpenshiftClusterID.String()
@@ -78,6 +127,47 @@ func (o *ListClustersURL) Build() (*url.URL, error) {
 		qs.Set("openshift_cluster_id", openshiftClusterIDQ)
 	}
 
+	var openshiftVersionQ string
+	if o.OpenshiftVersion != nil {
+		openshiftVersionQ = *o.OpenshiftVersion
+	}
+	if openshiftVersionQ != "" {
+		qs.Set("openshift_version", openshiftVersionQ)
+	}
+
+	var orderQ string
+	if o.Order != nil {
+		orderQ = *o.Order
+	}
+	if orderQ != "" {
+		qs.Set("order", orderQ)
+	}
+
+	var sortByQ string
+	if o.SortBy != nil {
+		sortByQ = *o.SortBy
+	}
+	if sortByQ != "" {
+		qs.Set("sort_by", sortByQ)
+	}
+
+	var statusIR []string
+	for _, statusI := range o.Status {
+		statusIS := statusI
+		if statusIS != "" {
+			statusIR = append(statusIR, statusIS)
+		}
+	}
+
+	status := swag.JoinByFormat(statusIR, "")
+
+	if len(status) > 0 {
+		qsv := status[0]
+		if qsv != "" {
+			qs.Set("status", qsv)
+		}
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
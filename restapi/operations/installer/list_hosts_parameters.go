@@ -9,16 +9,29 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
 // NewListHostsParams creates a new ListHostsParams object
-// no default values defined in spec.
+// with the default values initialized.
 func NewListHostsParams() ListHostsParams {
 
-	return ListHostsParams{}
+	var (
+		// initialize parameters with default values
+
+		orderDefault  = string("asc")
+		sortByDefault = string("created_at")
+	)
+
+	return ListHostsParams{
+		Order: &orderDefault,
+
+		SortBy: &sortByDefault,
+	}
 }
 
 // ListHostsParams contains all the bound params for the list hosts operation
@@ -35,10 +48,45 @@ type ListHostsParams struct {
 	  In: path
 	*/
 	ClusterID strfmt.UUID
+	/*If set, returned hosts are filtered to those created after this time.
+	  In: query
+	*/
+	CreatedAfter *strfmt.DateTime
+	/*If set, returned hosts are filtered to those created before this time.
+	  In: query
+	*/
+	CreatedBefore *strfmt.DateTime
+	/*The cursor of the page to return, as returned in the Next-Cursor header of the previous page.
+	  In: query
+	*/
+	Cursor *string
 	/*The software version of the discovery agent that is listing hosts.
 	  In: header
 	*/
 	DiscoveryAgentVersion *string
+	/*The maximal number of items to return. The cursor of the next page is returned in the Next-Cursor header.
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
+	/*If set, returned hosts are filtered to those whose requested hostname or inventory hostname contains this string, ignoring case.
+	  In: query
+	*/
+	Name *string
+	/*The sort order.
+	  In: query
+	  Default: "asc"
+	*/
+	Order *string
+	/*The field to sort the hosts by.
+	  In: query
+	  Default: "created_at"
+	*/
+	SortBy *string
+	/*If non-empty, returned hosts are filtered to those with matching statuses.
+	  In: query
+	*/
+	Status []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -50,15 +98,57 @@ func (o *ListHostsParams) BindRequest(r *http.Request, route *middleware.Matched
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qCreatedAfter, qhkCreatedAfter, _ := qs.GetOK("created_after")
+	if err := o.bindCreatedAfter(qCreatedAfter, qhkCreatedAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCreatedBefore, qhkCreatedBefore, _ := qs.GetOK("created_before")
+	if err := o.bindCreatedBefore(qCreatedBefore, qhkCreatedBefore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.bindDiscoveryAgentVersion(r.Header[http.CanonicalHeaderKey("discovery_agent_version")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qName, qhkName, _ := qs.GetOK("name")
+	if err := o.bindName(qName, qhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrder, qhkOrder, _ := qs.GetOK("order")
+	if err := o.bindOrder(qOrder, qhkOrder, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSortBy, qhkSortBy, _ := qs.GetOK("sort_by")
+	if err := o.bindSortBy(qSortBy, qhkSortBy, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -98,6 +188,96 @@ func (o *ListHostsParams) validateClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindCreatedAfter binds and validates parameter CreatedAfter from query.
+func (o *ListHostsParams) bindCreatedAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("created_after", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedAfter = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedAfter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedAfter carries on validations for parameter CreatedAfter
+func (o *ListHostsParams) validateCreatedAfter(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("created_after", "query", "date-time", o.CreatedAfter.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCreatedBefore binds and validates parameter CreatedBefore from query.
+func (o *ListHostsParams) bindCreatedBefore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("created_before", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedBefore = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedBefore(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedBefore carries on validations for parameter CreatedBefore
+func (o *ListHostsParams) validateCreatedBefore(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("created_before", "query", "date-time", o.CreatedBefore.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListHostsParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	return nil
+}
+
 // bindDiscoveryAgentVersion binds and validates parameter DiscoveryAgentVersion from header.
 func (o *ListHostsParams) bindDiscoveryAgentVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -115,3 +295,151 @@ func (o *ListHostsParams) bindDiscoveryAgentVersion(rawData []string, hasKey boo
 
 	return nil
 }
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListHostsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *ListHostsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindName binds and validates parameter Name from query.
+func (o *ListHostsParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Name = &raw
+
+	return nil
+}
+
+// bindOrder binds and validates parameter Order from query.
+func (o *ListHostsParams) bindOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListHostsParams()
+		return nil
+	}
+
+	o.Order = &raw
+
+	if err := o.validateOrder(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrder carries on validations for parameter Order
+func (o *ListHostsParams) validateOrder(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("order", "query", *o.Order, []interface{}{"asc", "desc"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSortBy binds and validates parameter SortBy from query.
+func (o *ListHostsParams) bindSortBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListHostsParams()
+		return nil
+	}
+
+	o.SortBy = &raw
+
+	if err := o.validateSortBy(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSortBy carries on validations for parameter SortBy
+func (o *ListHostsParams) validateSortBy(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sort_by", "query", *o.SortBy, []interface{}{"created_at", "requested_hostname", "status_updated_at"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindStatus binds and validates array parameter Status from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *ListHostsParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+
+	var qvStatus string
+	if len(rawData) > 0 {
+		qvStatus = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	statusIC := swag.SplitByFormat(qvStatus, "")
+	if len(statusIC) == 0 {
+		return nil
+	}
+
+	var statusIR []string
+	for _, statusIV := range statusIC {
+		statusI := statusIV
+
+		statusIR = append(statusIR, statusI)
+	}
+
+	o.Status = statusIR
+
+	return nil
+}
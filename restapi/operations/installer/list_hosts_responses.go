@@ -21,6 +21,10 @@ const ListHostsOKCode int = 200
 swagger:response listHostsOK
 */
 type ListHostsOK struct {
+	/*The cursor of the next page. Not set for the last page.
+
+	 */
+	NextCursor string `json:"Next-Cursor"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewListHostsOK() *ListHostsOK {
 	return &ListHostsOK{}
 }
 
+// WithNextCursor adds the nextCursor to the list hosts o k response
+func (o *ListHostsOK) WithNextCursor(nextCursor string) *ListHostsOK {
+	o.NextCursor = nextCursor
+	return o
+}
+
+// SetNextCursor sets the nextCursor to the list hosts o k response
+func (o *ListHostsOK) SetNextCursor(nextCursor string) {
+	o.NextCursor = nextCursor
+}
+
 // WithPayload adds the payload to the list hosts o k response
 func (o *ListHostsOK) WithPayload(payload models.HostList) *ListHostsOK {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *ListHostsOK) SetPayload(payload models.HostList) {
 // WriteResponse to the client
 func (o *ListHostsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Next-Cursor
+
+	nextCursor := o.NextCursor
+	if nextCursor != "" {
+		rw.Header().Set("Next-Cursor", nextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -60,6 +82,50 @@ func (o *ListHostsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pro
 	}
 }
 
+// ListHostsBadRequestCode is the HTTP code returned for type ListHostsBadRequest
+const ListHostsBadRequestCode int = 400
+
+/*ListHostsBadRequest Error.
+
+swagger:response listHostsBadRequest
+*/
+type ListHostsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListHostsBadRequest creates ListHostsBadRequest with default headers values
+func NewListHostsBadRequest() *ListHostsBadRequest {
+
+	return &ListHostsBadRequest{}
+}
+
+// WithPayload adds the payload to the list hosts bad request response
+func (o *ListHostsBadRequest) WithPayload(payload *models.Error) *ListHostsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list hosts bad request response
+func (o *ListHostsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ListHostsUnauthorizedCode is the HTTP code returned for type ListHostsUnauthorized
 const ListHostsUnauthorizedCode int = 401
 
@@ -12,12 +12,22 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // ListHostsURL generates an URL for the list hosts operation
 type ListHostsURL struct {
 	ClusterID strfmt.UUID
 
+	CreatedAfter  *strfmt.DateTime
+	CreatedBefore *strfmt.DateTime
+	Cursor        *string
+	Limit         *int64
+	Name          *string
+	Order         *string
+	SortBy        *string
+	Status        []string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -57,6 +67,83 @@ func (o *ListHostsURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var createdAfterQ string
+	if o.CreatedAfter != nil {
+		createdAfterQ = o.CreatedAfter.String()
+	}
+	if createdAfterQ != "" {
+		qs.Set("created_after", createdAfterQ)
+	}
+
+	var createdBeforeQ string
+	if o.CreatedBefore != nil {
+		createdBeforeQ = o.CreatedBefore.String()
+	}
+	if createdBeforeQ != "" {
+		qs.Set("created_before", createdBeforeQ)
+	}
+
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var nameQ string
+	if o.Name != nil {
+		nameQ = *o.Name
+	}
+	if nameQ != "" {
+		qs.Set("name", nameQ)
+	}
+
+	var orderQ string
+	if o.Order != nil {
+		orderQ = *o.Order
+	}
+	if orderQ != "" {
+		qs.Set("order", orderQ)
+	}
+
+	var sortByQ string
+	if o.SortBy != nil {
+		sortByQ = *o.SortBy
+	}
+	if sortByQ != "" {
+		qs.Set("sort_by", sortByQ)
+	}
+
+	var statusIR []string
+	for _, statusI := range o.Status {
+		statusIS := statusI
+		if statusIS != "" {
+			statusIR = append(statusIR, statusIS)
+		}
+	}
+
+	status := swag.JoinByFormat(statusIR, "")
+
+	if len(status) > 0 {
+		qsv := status[0]
+		if qsv != "" {
+			qs.Set("status", qsv)
+		}
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 